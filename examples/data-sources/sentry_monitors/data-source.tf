@@ -0,0 +1,10 @@
+data "sentry_monitors" "example" {
+  organization = "my-org"     # Or Organization ID
+  project      = "my-project" # Or Project ID
+  type         = "uptime_domain_failure"
+  query        = "checkout"
+}
+
+output "monitor_ids" {
+  value = data.sentry_monitors.example.monitors[*].id
+}
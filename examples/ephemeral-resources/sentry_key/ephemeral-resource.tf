@@ -0,0 +1,13 @@
+# Retrieve the DSN without storing it in state
+ephemeral "sentry_key" "default" {
+  organization = "my-organization"
+  project      = "web-app"
+  first        = true
+}
+
+# Write the DSN to a secret manager using a write-only attribute
+resource "aws_secretsmanager_secret_version" "sentry_dsn" {
+  secret_id                = aws_secretsmanager_secret.sentry_dsn.id
+  secret_string_wo         = ephemeral.sentry_key.default.dsn["public"]
+  secret_string_wo_version = 1
+}
@@ -0,0 +1,21 @@
+locals {
+  assertion = provider::sentry::assertion(
+    provider::sentry::op_and(
+      provider::sentry::op_status_code_check("equals", 200),
+      provider::sentry::op_jsonpath(
+        provider::sentry::op_jsonpath_operand_literal("ok"),
+        "equals",
+        "$.status",
+      ),
+    ),
+  )
+}
+
+output "healthy" {
+  value = provider::sentry::assertion_eval(
+    local.assertion,
+    200,
+    { "Content-Type" = "application/json" },
+    jsonencode({ status = "ok" }),
+  )
+}
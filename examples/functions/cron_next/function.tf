@@ -0,0 +1,3 @@
+output "next_runs" {
+  value = provider::sentry::cron_next("0 9 * * MON-FRI", "Europe/London", plantimestamp(), 3)
+}
@@ -0,0 +1,8 @@
+# List all alerts of an organization
+list "sentry_alert" "all" {
+  provider = sentry
+
+  config {
+    organization = "my-organization"
+  }
+}
@@ -0,0 +1,9 @@
+# List all cron monitors of a project
+list "sentry_cron_monitor" "default" {
+  provider = sentry
+
+  config {
+    organization = "my-organization"
+    project      = "web-app"
+  }
+}
@@ -0,0 +1,9 @@
+# List all client keys of a project
+list "sentry_key" "all" {
+  provider = sentry
+
+  config {
+    organization = "my-organization"
+    project      = "web-app"
+  }
+}
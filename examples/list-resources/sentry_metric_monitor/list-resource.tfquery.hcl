@@ -0,0 +1,9 @@
+# List all metric monitors of a project
+list "sentry_metric_monitor" "default" {
+  provider = sentry
+
+  config {
+    organization = "my-organization"
+    project      = "web-app"
+  }
+}
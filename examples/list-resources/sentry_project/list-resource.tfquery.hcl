@@ -0,0 +1,8 @@
+# List all projects of an organization
+list "sentry_project" "all" {
+  provider = sentry
+
+  config {
+    organization = "my-organization"
+  }
+}
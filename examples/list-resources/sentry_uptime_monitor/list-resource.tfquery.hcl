@@ -0,0 +1,9 @@
+# List all uptime monitors of a project
+list "sentry_uptime_monitor" "default" {
+  provider = sentry
+
+  config {
+    organization = "my-organization"
+    project      = "web-app"
+  }
+}
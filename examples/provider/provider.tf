@@ -5,4 +5,7 @@ provider "sentry" {
   # If you are self-hosting Sentry, set the base URL here.
   # The URL format must be "https://[hostname]/api/".
   # base_url = "https://example.com/api/"
+
+  # Resources and data sources that do not set `organization` use this organization.
+  # default_organization = "my-organization"
 }
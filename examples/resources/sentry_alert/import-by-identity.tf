@@ -0,0 +1,7 @@
+import {
+  to = sentry_alert.default
+  identity = {
+    organization = "my-organization"
+    id           = "1234567"
+  }
+}
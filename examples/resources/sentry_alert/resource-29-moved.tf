@@ -0,0 +1,39 @@
+# Replace the sentry_issue_alert resource with an equivalent sentry_alert
+# resource, then move its state. Requires Terraform v1.8.0 or later.
+data "sentry_project_issue_stream_monitor" "default" {
+  organization = "my-organization"
+  project      = "my-project"
+}
+
+resource "sentry_alert" "default" {
+  organization = "my-organization"
+  name         = "My Alert"
+
+  monitor_ids = [data.sentry_project_issue_stream_monitor.default.id]
+
+  frequency_minutes = 30
+
+  trigger_conditions = [
+    { first_seen_event = {} },
+  ]
+
+  action_filters = [
+    {
+      logic_type = "all"
+      conditions = []
+      actions = [
+        {
+          email = {
+            target_type      = "issue_owners"
+            fallthrough_type = "ActiveMembers"
+          }
+        }
+      ]
+    }
+  ]
+}
+
+moved {
+  from = sentry_issue_alert.default
+  to   = sentry_alert.default
+}
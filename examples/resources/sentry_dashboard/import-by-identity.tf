@@ -0,0 +1,7 @@
+import {
+  to = sentry_dashboard.default
+  identity = {
+    organization = "my-organization"
+    id           = "1234567"
+  }
+}
@@ -1,3 +1,6 @@
-# import using the dashboard id from the URL:
-# https://sentry.io/dashboard/[dashboard-id]
-terraform import sentry_dashboard.default org-slug/dashboard-id
+# import using the full URL:
+terraform import sentry_dashboard.default https://{organization}.sentry.io/dashboard/{id}/
+
+# import using the organization and dashboard id from the URL:
+# https://{organization}.sentry.io/dashboard/{id}/
+terraform import sentry_dashboard.default {organization}/{id}
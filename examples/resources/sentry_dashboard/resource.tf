@@ -2,6 +2,9 @@ resource "sentry_dashboard" "main" {
   organization = data.sentry_organization.main.slug
   title        = "Test dashboard"
 
+  environments = ["production"]
+  period       = "14d"
+
   widget {
     title        = "Number of Errors"
     display_type = "big_number"
@@ -11,7 +14,6 @@ resource "sentry_dashboard" "main" {
     query {
       fields     = ["count()"]
       aggregates = ["count()"]
-      conditions = ""
       order_by   = "count()"
     }
 
@@ -56,7 +58,6 @@ resource "sentry_dashboard" "main" {
       name       = "Events"
       fields     = ["count()"]
       aggregates = ["count()"]
-      conditions = ""
       order_by   = "count()"
     }
 
@@ -70,33 +71,42 @@ resource "sentry_dashboard" "main" {
   }
 
   widget {
-    title        = "Affected Users"
-    display_type = "line"
+    title        = "Overall User Misery"
+    display_type = "big_number"
     interval     = "5m"
-    widget_type  = "error-events"
+    widget_type  = "transaction-like"
 
     query {
-      name       = "Known Users"
-      fields     = ["count_unique(user)"]
-      aggregates = ["count_unique(user)"]
-      conditions = "has:user.email"
-      order_by   = "count_unique(user)"
+      fields     = ["user_misery(300)"]
+      aggregates = ["user_misery(300)"]
+    }
+
+    layout {
+      x     = 0
+      y     = 1
+      w     = 1
+      h     = 1
+      min_h = 1
     }
+  }
+
+  widget {
+    title        = "Overall Apdex"
+    display_type = "big_number"
+    interval     = "5m"
+    widget_type  = "transaction-like"
 
     query {
-      name       = "Anonymous Users"
-      fields     = ["count_unique(user)"]
-      aggregates = ["count_unique(user)"]
-      conditions = "!has:user.email"
-      order_by   = "count_unique(user)"
+      fields     = ["apdex(300)"]
+      aggregates = ["apdex(300)"]
     }
 
     layout {
       x     = 1
-      y     = 2
+      y     = 1
       w     = 1
-      h     = 2
-      min_h = 2
+      h     = 1
+      min_h = 1
     }
   }
 
@@ -132,44 +142,52 @@ resource "sentry_dashboard" "main" {
   }
 
   widget {
-    title        = "Errors by Country"
-    display_type = "table"
+    title        = "Affected Users"
+    display_type = "line"
     interval     = "5m"
     widget_type  = "error-events"
 
     query {
-      fields     = ["geo.country_code", "geo.region", "count()"]
-      aggregates = ["count()"]
-      conditions = "has:geo.country_code"
-      order_by   = "count()"
+      name       = "Known Users"
+      fields     = ["count_unique(user)"]
+      aggregates = ["count_unique(user)"]
+      conditions = "has:user.email"
+      order_by   = "count_unique(user)"
+    }
+
+    query {
+      name       = "Anonymous Users"
+      fields     = ["count_unique(user)"]
+      aggregates = ["count_unique(user)"]
+      conditions = "!has:user.email"
+      order_by   = "count_unique(user)"
     }
 
     layout {
-      x     = 4
-      y     = 6
-      w     = 2
-      h     = 4
+      x     = 1
+      y     = 2
+      w     = 1
+      h     = 2
       min_h = 2
     }
   }
 
   widget {
-    title        = "High Throughput Transactions"
+    title        = "Issues Assigned to Me or My Teams"
     display_type = "table"
     interval     = "5m"
-    widget_type  = "transaction-like"
+    widget_type  = "issue"
 
     query {
-      fields     = ["count()", "transaction"]
-      aggregates = ["count()"]
-      columns    = ["transaction"]
-      conditions = ""
-      order_by   = "-count()"
+      fields     = ["assignee", "issue", "title"]
+      columns    = ["assignee", "issue", "title"]
+      conditions = "assigned_or_suggested:me is:unresolved"
+      order_by   = "priority"
     }
 
     layout {
-      x     = 0
-      y     = 6
+      x     = 2
+      y     = 2
       w     = 2
       h     = 4
       min_h = 2
@@ -177,10 +195,11 @@ resource "sentry_dashboard" "main" {
   }
 
   widget {
-    title        = "Errors by Browser"
-    display_type = "table"
+    title        = "Errors by Browser Over Time"
+    display_type = "line"
     interval     = "5m"
     widget_type  = "error-events"
+    limit        = 5
 
     query {
       fields     = ["browser.name", "count()"]
@@ -191,7 +210,7 @@ resource "sentry_dashboard" "main" {
     }
 
     layout {
-      x     = 5
+      x     = 4
       y     = 2
       w     = 1
       h     = 4
@@ -200,50 +219,34 @@ resource "sentry_dashboard" "main" {
   }
 
   widget {
-    title        = "Overall User Misery"
-    display_type = "big_number"
-    interval     = "5m"
-    widget_type  = "transaction-like"
-
-    query {
-      fields     = ["user_misery(300)"]
-      aggregates = ["user_misery(300)"]
-    }
-
-    layout {
-      x     = 0
-      y     = 1
-      w     = 1
-      h     = 1
-      min_h = 1
-    }
-  }
-
-  widget {
-    title        = "Overall Apdex"
-    display_type = "big_number"
+    title        = "Errors by Browser"
+    display_type = "table"
     interval     = "5m"
-    widget_type  = "transaction-like"
+    widget_type  = "error-events"
 
     query {
-      fields     = ["apdex(300)"]
-      aggregates = ["apdex(300)"]
+      fields     = ["browser.name", "count()"]
+      aggregates = ["count()"]
+      columns    = ["browser.name"]
+      conditions = "has:browser.name"
+      order_by   = "-count()"
     }
 
     layout {
-      x     = 1
-      y     = 1
+      x     = 5
+      y     = 2
       w     = 1
-      h     = 1
-      min_h = 1
+      h     = 4
+      min_h = 2
     }
   }
 
   widget {
-    title        = "High Throughput Transactions"
-    display_type = "top_n"
+    title        = "High Throughput Transactions Over Time"
+    display_type = "line"
     interval     = "5m"
     widget_type  = "transaction-like"
+    limit        = 5
 
     query {
       fields     = ["transaction", "count()"]
@@ -263,21 +266,21 @@ resource "sentry_dashboard" "main" {
   }
 
   widget {
-    title        = "Issues Assigned to Me or My Teams"
+    title        = "High Throughput Transactions"
     display_type = "table"
     interval     = "5m"
-    widget_type  = "issue"
+    widget_type  = "transaction-like"
 
     query {
-      fields     = ["assignee", "issue", "title"]
-      columns    = ["assignee", "issue", "title"]
-      conditions = "assigned_or_suggested:me is:unresolved"
-      order_by   = "priority"
+      fields     = ["count()", "transaction"]
+      aggregates = ["count()"]
+      columns    = ["transaction"]
+      order_by   = "-count()"
     }
 
     layout {
-      x     = 2
-      y     = 2
+      x     = 0
+      y     = 6
       w     = 2
       h     = 4
       min_h = 2
@@ -307,23 +310,22 @@ resource "sentry_dashboard" "main" {
   }
 
   widget {
-    title        = "Errors by Browser Over Time"
-    display_type = "top_n"
+    title        = "Errors by Country"
+    display_type = "table"
     interval     = "5m"
     widget_type  = "error-events"
 
     query {
-      fields     = ["browser.name", "count()"]
+      fields     = ["geo.country_code", "geo.region", "count()"]
       aggregates = ["count()"]
-      columns    = ["browser.name"]
-      conditions = "has:browser.name"
-      order_by   = "-count()"
+      conditions = "has:geo.country_code"
+      order_by   = "count()"
     }
 
     layout {
       x     = 4
-      y     = 2
-      w     = 1
+      y     = 6
+      w     = 2
       h     = 4
       min_h = 2
     }
@@ -0,0 +1,8 @@
+import {
+  to = sentry_integration_pagerduty.default
+  identity = {
+    organization   = "my-organization"
+    integration_id = "123456"
+    id             = "1234567"
+  }
+}
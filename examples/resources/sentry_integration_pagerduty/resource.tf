@@ -14,3 +14,14 @@ resource "sentry_integration_pagerduty" "test" {
   service         = "my-pagerduty-service"
   integration_key = "my-pagerduty-integration-key"
 }
+
+# With Terraform 1.11 and later, use a write-only integration key that is never
+# stored in state. Increment the version to rotate the key.
+resource "sentry_integration_pagerduty" "write_only" {
+  organization   = "my-organization"
+  integration_id = data.sentry_organization_integration.pagerduty.id
+
+  service                    = "my-other-pagerduty-service"
+  integration_key_wo         = "my-other-pagerduty-integration-key"
+  integration_key_wo_version = 1
+}
@@ -0,0 +1,8 @@
+import {
+  to = sentry_issue_alert.default
+  identity = {
+    organization = "my-organization"
+    project      = "web-app"
+    id           = "1234567"
+  }
+}
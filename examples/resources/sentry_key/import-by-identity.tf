@@ -0,0 +1,8 @@
+import {
+  to = sentry_key.default
+  identity = {
+    organization = "my-organization"
+    project      = "web-app"
+    id           = "0123456789abcdef0123456789abcdef"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = sentry_metric_monitor.default
+  identity = {
+    organization = "my-organization"
+    id           = "1234567"
+  }
+}
@@ -0,0 +1,75 @@
+# Moving from sentry_metric_alert
+# Replace the sentry_metric_alert resource with an equivalent monitor and alert.
+# Terraform allows a single moved block per resource, so the monitor is moved
+# and the alert is imported. Requires Terraform v1.8.0 or later.
+resource "sentry_metric_monitor" "default" {
+  organization = "my-organization"
+  project      = "my-project"
+
+  name = "My Metric Alert"
+
+  aggregate           = "count()"
+  dataset             = "events"
+  event_types         = ["default", "error"]
+  query               = "is:unresolved"
+  time_window_seconds = 3600
+
+  condition_group = {
+    conditions = [
+      # The "critical" trigger.
+      {
+        type             = "gt"
+        comparison       = 100
+        condition_result = 75
+      },
+      # The resolve threshold.
+      {
+        type             = "lte"
+        comparison       = 100
+        condition_result = 0
+      },
+    ]
+  }
+
+  issue_detection = {
+    type = "static"
+  }
+}
+
+moved {
+  from = sentry_metric_alert.default
+  to   = sentry_metric_monitor.default
+}
+
+resource "sentry_alert" "default" {
+  organization = "my-organization"
+  name         = "My Metric Alert"
+
+  monitor_ids = [sentry_metric_monitor.default.id]
+
+  trigger_conditions = []
+
+  action_filters = [
+    {
+      logic_type = "any-short"
+      conditions = [
+        { issue_priority_greater_or_equal = { comparison = 75 } },
+        { issue_priority_deescalating = { comparison = 75 } },
+      ]
+      actions = [
+        {
+          email = {
+            target_type = "team"
+            target_id   = "1234"
+          }
+        }
+      ]
+    }
+  ]
+}
+
+# The ID of the alert Sentry migrated the metric alert to.
+import {
+  to = sentry_alert.default
+  id = "my-organization/1234567"
+}
@@ -0,0 +1,6 @@
+# import using the full URL:
+terraform import sentry_monitor.default https://{organization}.sentry.io/monitors/{id}/
+
+# import using the organization and monitor id from the URL:
+# https://{organization}.sentry.io/monitors/{id}/
+terraform import sentry_monitor.default {organization}/{id}
\ No newline at end of file
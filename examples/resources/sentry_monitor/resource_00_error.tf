@@ -0,0 +1,16 @@
+# A monitor type without a typed configuration
+resource "sentry_monitor" "error" {
+  organization = data.sentry_organization.default.slug
+  project      = sentry_project.default.slug
+
+  type = "error"
+  name = "New error monitor"
+
+  owner = {
+    team_id = sentry_team.default.internal_id
+  }
+
+  config = {
+    json = jsonencode({})
+  }
+}
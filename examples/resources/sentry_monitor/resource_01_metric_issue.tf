@@ -0,0 +1,44 @@
+# A monitor type with a typed configuration, with its data sources and
+# condition group in the shape of the Sentry API
+resource "sentry_monitor" "metric_issue" {
+  organization = data.sentry_organization.default.slug
+  project      = sentry_project.default.slug
+
+  type = "metric_issue"
+  name = "New metric monitor"
+
+  config = {
+    metric_issue = {
+      detection_type = "static"
+    }
+  }
+
+  data_sources_json = jsonencode([
+    {
+      aggregate         = "count()"
+      dataset           = "events"
+      environment       = null
+      eventTypes        = ["default", "error"]
+      query             = "is:unresolved"
+      queryType         = 0
+      timeWindow        = 3600
+      extrapolationMode = null
+    },
+  ])
+
+  condition_group_json = jsonencode({
+    logicType = "any"
+    conditions = [
+      {
+        type            = "gt"
+        comparison      = 100
+        conditionResult = 75
+      },
+      {
+        type            = "lte"
+        comparison      = 100
+        conditionResult = 0
+      },
+    ]
+  })
+}
@@ -0,0 +1,7 @@
+import {
+  to = sentry_notification_action.default
+  identity = {
+    organization = "my-organization"
+    id           = "1234567"
+  }
+}
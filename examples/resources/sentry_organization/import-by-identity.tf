@@ -0,0 +1,6 @@
+import {
+  to = sentry_organization.default
+  identity = {
+    id = "my-organization"
+  }
+}
@@ -0,0 +1,9 @@
+import {
+  to = sentry_organization_repository.default
+  identity = {
+    organization     = "my-organization"
+    integration_type = "github"
+    integration_id   = "123456"
+    id               = "1234567"
+  }
+}
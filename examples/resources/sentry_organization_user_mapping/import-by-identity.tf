@@ -0,0 +1,7 @@
+import {
+  to = sentry_organization_user_mapping.default
+  identity = {
+    organization = "my-organization"
+    internal_id  = "1234567"
+  }
+}
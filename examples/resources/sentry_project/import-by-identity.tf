@@ -0,0 +1,7 @@
+import {
+  to = sentry_project.default
+  identity = {
+    organization = "my-organization"
+    id           = "web-app"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = sentry_project_error_monitor.default
+  identity = {
+    organization = "my-organization"
+    project      = "web-app"
+  }
+}
@@ -0,0 +1,3 @@
+# import using the organization and project slugs from the URL:
+# https://sentry.io/settings/[org-slug]/projects/[project-slug]/
+terraform import sentry_project_error_monitor.default org-slug/project-slug
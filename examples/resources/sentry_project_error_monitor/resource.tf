@@ -0,0 +1,15 @@
+resource "sentry_project" "default" {
+  organization = "my-organization"
+
+  teams = ["my-first-team", "my-second-team"]
+  name  = "web-app"
+
+  platform = "javascript"
+}
+
+# Disable the error monitor of the project
+resource "sentry_project_error_monitor" "default" {
+  organization = sentry_project.default.organization
+  project      = sentry_project.default.id
+  enabled      = false
+}
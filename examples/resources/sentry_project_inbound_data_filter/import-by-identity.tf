@@ -0,0 +1,8 @@
+import {
+  to = sentry_project_inbound_data_filter.default
+  identity = {
+    organization = "my-organization"
+    project      = "web-app"
+    filter_id    = "browser-extensions"
+  }
+}
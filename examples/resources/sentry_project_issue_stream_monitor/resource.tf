@@ -0,0 +1,23 @@
+resource "sentry_project" "default" {
+  organization = "my-organization"
+
+  teams = ["my-first-team", "my-second-team"]
+  name  = "web-app"
+
+  platform = "javascript"
+}
+
+data "sentry_team" "default" {
+  organization = "my-organization"
+  slug         = "my-first-team"
+}
+
+# Assign issues from the issue stream monitor to a team
+resource "sentry_project_issue_stream_monitor" "default" {
+  organization = sentry_project.default.organization
+  project      = sentry_project.default.id
+
+  owner = {
+    team_id = data.sentry_team.default.internal_id
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = sentry_project_ownership.default
+  identity = {
+    organization = "my-organization"
+    project      = "web-app"
+  }
+}
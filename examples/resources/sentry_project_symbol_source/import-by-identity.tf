@@ -0,0 +1,8 @@
+import {
+  to = sentry_project_symbol_source.default
+  identity = {
+    organization = "my-organization"
+    project      = "web-app"
+    id           = "a1b2c3d4-e5f6-7890-abcd-ef1234567890"
+  }
+}
@@ -74,3 +74,21 @@ resource "sentry_project_symbol_source" "s3" {
   access_key = "access_key"
   secret_key = "secret_key"
 }
+
+# With Terraform 1.11 and later, use a write-only secret key that is never
+# stored in state. Increment the version to rotate the key.
+resource "sentry_project_symbol_source" "s3_write_only" {
+  organization = sentry_project.default.organization
+  project      = sentry_project.default.id
+  type         = "s3"
+  name         = "Amazon S3 (write-only)"
+  layout = {
+    type   = "native"
+    casing = "default"
+  }
+  bucket                = "s3-bucket-name"
+  region                = "us-east-1"
+  access_key            = "access_key"
+  secret_key_wo         = "secret_key"
+  secret_key_wo_version = 1
+}
@@ -0,0 +1,8 @@
+import {
+  to = sentry_project_team.default
+  identity = {
+    organization = "my-organization"
+    project      = "web-app"
+    team         = "my-team"
+  }
+}
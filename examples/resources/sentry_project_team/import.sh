@@ -0,0 +1,3 @@
+# import using the project and team slugs from the URL:
+# https://[org-slug].sentry.io/settings/projects/[project-slug]/teams/
+terraform import sentry_project_team.default org-slug/project-slug/team-slug
@@ -0,0 +1,16 @@
+# Give a team access to a project owned by another team
+resource "sentry_project" "default" {
+  organization = "my-organization"
+
+  teams               = ["my-first-team"]
+  ignore_team_changes = true
+  name                = "Web App"
+  slug                = "web-app"
+  platform            = "javascript"
+}
+
+resource "sentry_project_team" "default" {
+  organization = "my-organization"
+  project      = sentry_project.default.slug
+  team         = "my-second-team"
+}
@@ -0,0 +1,8 @@
+import {
+  to = sentry_team_member.default
+  identity = {
+    organization = "my-organization"
+    team         = "my-team"
+    member_id    = "1234567"
+  }
+}
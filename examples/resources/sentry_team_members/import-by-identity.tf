@@ -0,0 +1,7 @@
+import {
+  to = sentry_team_members.default
+  identity = {
+    organization = "my-organization"
+    team         = "my-team"
+  }
+}
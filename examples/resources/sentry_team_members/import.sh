@@ -0,0 +1,3 @@
+# import using the team slug from the URL:
+# https://[org-slug].sentry.io/settings/teams/[team-slug]/members/
+terraform import sentry_team_members.default org-slug/team-slug
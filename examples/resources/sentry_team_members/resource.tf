@@ -0,0 +1,36 @@
+# Manage all members of a team
+resource "sentry_organization_member" "alice" {
+  organization = "my-organization"
+  email        = "alice@example.com"
+  role         = "member"
+}
+
+resource "sentry_organization_member" "bob" {
+  organization = "my-organization"
+  email        = "bob@example.com"
+  role         = "member"
+}
+
+resource "sentry_team" "default" {
+  organization = "my-organization"
+  name         = "my-team"
+  slug         = "my-team"
+}
+
+resource "sentry_team_members" "default" {
+  organization = "my-organization"
+  team         = sentry_team.default.slug
+
+  members = [
+    {
+      member_id = sentry_organization_member.alice.internal_id
+      role      = "admin"
+    },
+    {
+      member_id = sentry_organization_member.bob.internal_id
+    },
+  ]
+
+  # Members that are neither added nor removed, e.g. owners or bots
+  ignore_members = ["1234567"]
+}
@@ -0,0 +1,16 @@
+# Manage all projects a team has access to
+resource "sentry_team" "default" {
+  organization = "my-organization"
+  name         = "my-team"
+  slug         = "my-team"
+}
+
+resource "sentry_team_projects" "default" {
+  organization = "my-organization"
+  team         = sentry_team.default.slug
+
+  projects = ["web-app", "mobile-app"]
+
+  # Projects that the team is neither added to nor removed from
+  ignore_projects = ["legacy-app"]
+}
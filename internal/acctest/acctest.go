@@ -7,6 +7,7 @@ import (
 
 	"github.com/jianyuan/go-sentry/v2/sentry"
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/mockserver"
 	"github.com/jianyuan/terraform-provider-sentry/internal/must"
 	"github.com/jianyuan/terraform-provider-sentry/internal/providerdata"
 	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
@@ -58,9 +59,24 @@ var (
 
 	// SharedProviderData is a shared provider data for acceptance tests.
 	SharedProviderData *providerdata.ProviderData
+
+	// MockServer is the mock Sentry server that acceptance tests run against
+	// when SENTRY_TEST_MOCK is set to 1, and nil otherwise.
+	MockServer *mockserver.Server
 )
 
+// mockToken is the token that the provider presents to the mock Sentry server.
+const mockToken = "mock-token"
+
 func init() {
+	if os.Getenv("SENTRY_TEST_MOCK") == "1" {
+		setupMockServer()
+	}
+
+	if v := os.Getenv("SENTRY_TEST_CASSETTE"); v != "" {
+		setupCassette(v)
+	}
+
 	var token string
 	if v := os.Getenv("SENTRY_AUTH_TOKEN"); v != "" {
 		token = v
@@ -79,7 +95,7 @@ func init() {
 		UserAgent: "Terraform/" + ProviderVersion + " (+https://www.terraform.io) terraform-provider-sentry/" + ProviderVersion,
 		Token:     token,
 	}
-	httpClient := config.HttpClient(context.Background())
+	httpClient := must.Get(config.HttpClient(context.Background()))
 
 	SharedApiClient = must.Get(apiclient.NewClientWithResponses(
 		baseUrl,
@@ -98,7 +114,26 @@ func init() {
 	}
 }
 
+// setupMockServer starts the mock Sentry server and points the provider at it
+// through the environment, the same way a live organization is configured.
+func setupMockServer() {
+	ts, s := mockserver.NewTestServer(mockserver.Config{
+		Token: mockToken,
+	})
+	MockServer = s
+
+	must.Do(os.Setenv("SENTRY_AUTH_TOKEN", mockToken))
+	must.Do(os.Unsetenv("SENTRY_TOKEN"))
+	must.Do(os.Setenv("SENTRY_BASE_URL", ts.URL+"/api/"))
+	must.Do(os.Setenv("SENTRY_TEST_ORGANIZATION", s.Organization()))
+	TestOrganization = s.Organization()
+}
+
 func PreCheck(t *testing.T) {
+	if Cassette != nil {
+		preCheckCassette(t)
+	}
+
 	if v := os.Getenv("SENTRY_AUTH_TOKEN"); v == "" {
 		t.Fatal("SENTRY_AUTH_TOKEN must be set for acceptance tests")
 	}
@@ -0,0 +1,114 @@
+package acctest
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/jianyuan/terraform-provider-sentry/internal/must"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+)
+
+// CassetteDir is the directory, relative to the package under test, where
+// the recordings of acceptance tests are stored.
+const CassetteDir = "testdata/cassettes"
+
+// sharedCassetteName is the name of the recording of the requests sent before
+// the tests run, such as the setup of the shared team and project. It also
+// holds the organization that the tests were recorded against.
+const sharedCassetteName = "TestMain"
+
+var (
+	// Cassette records or replays the requests of acceptance tests when
+	// SENTRY_TEST_CASSETTE is set to record or replay, and is nil otherwise.
+	Cassette *sentryclient.Cassette
+
+	// sharedCassetteMissing is true when replaying without a shared
+	// recording, in which case the tests are skipped.
+	sharedCassetteMissing bool
+
+	// cassetteRequired is true when running in CI, where a missing recording
+	// fails the test instead of skipping it.
+	cassetteRequired = os.Getenv("CI") != ""
+
+	ejectSharedCassetteOnce sync.Once
+
+	cassetteNameRegexp = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+)
+
+// replayToken is the token that the provider presents when replaying, since
+// replayed requests never reach Sentry.
+const replayToken = "replay-token"
+
+// setupCassette creates the cassette and loads the shared recording. In
+// replay mode, the provider is configured through the environment with the
+// recorded organization.
+func setupCassette(mode string) {
+	Cassette = must.Get(sentryclient.NewCassette(sentryclient.CassetteMode(mode)))
+	sentryclient.DefaultCassette = Cassette
+
+	err := Cassette.Load(cassettePath(sharedCassetteName))
+	if Cassette.Mode() == sentryclient.CassetteModeReplay && errors.Is(err, fs.ErrNotExist) {
+		sharedCassetteMissing = true
+		return
+	}
+	must.Do(err)
+
+	TestOrganization = must.Get(Cassette.Variable("organization", TestOrganization))
+
+	if Cassette.Mode() == sentryclient.CassetteModeReplay {
+		must.Do(os.Setenv("SENTRY_TEST_ORGANIZATION", TestOrganization))
+		if os.Getenv("SENTRY_AUTH_TOKEN") == "" && os.Getenv("SENTRY_TOKEN") == "" {
+			must.Do(os.Setenv("SENTRY_AUTH_TOKEN", replayToken))
+		}
+	}
+}
+
+func cassettePath(name string) string {
+	return filepath.Join(CassetteDir, cassetteNameRegexp.ReplaceAllString(name, "_")+".yaml")
+}
+
+// preCheckCassette loads the recording of the test, and saves or unloads it
+// when the test finishes. When replaying, tests without a recording are
+// skipped, or fail in CI.
+func preCheckCassette(t *testing.T) {
+	t.Helper()
+
+	// The shared recording ends when the first test starts.
+	ejectSharedCassetteOnce.Do(func() {
+		must.Do(Cassette.Eject())
+	})
+
+	if sharedCassetteMissing {
+		skipMissingCassette(t, cassettePath(sharedCassetteName))
+	}
+
+	path := cassettePath(t.Name())
+	if err := Cassette.Load(path); errors.Is(err, fs.ErrNotExist) {
+		skipMissingCassette(t, path)
+	} else if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := Cassette.Eject(); err != nil {
+			t.Error(err)
+		}
+		resetRandom()
+	})
+}
+
+// skipMissingCassette skips a replayed test that has no recording at path. In
+// CI, the test fails instead so that missing recordings are not hidden.
+func skipMissingCassette(t *testing.T, path string) {
+	t.Helper()
+
+	if cassetteRequired {
+		t.Fatalf("no recording in %s, record it with SENTRY_TEST_CASSETTE=record", path)
+	}
+	t.Skipf("no recording in %s, record it with SENTRY_TEST_CASSETTE=record", path)
+}
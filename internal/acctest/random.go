@@ -1,9 +1,36 @@
 package acctest
 
 import (
+	"fmt"
+	"math/rand/v2"
+	"sync"
+
 	pluginacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 )
 
+// randomSeed seeds the names of resources when recording or replaying, so
+// that a replayed test requests the same names as when it was recorded.
+const randomSeed = 1
+
+var (
+	randomMu     sync.Mutex
+	randomSource = rand.New(rand.NewPCG(randomSeed, randomSeed))
+)
+
 func RandomWithPrefix(name string) string {
-	return pluginacctest.RandomWithPrefix(name)
+	if Cassette == nil {
+		return pluginacctest.RandomWithPrefix(name)
+	}
+
+	randomMu.Lock()
+	defer randomMu.Unlock()
+	return fmt.Sprintf("%s-%d", name, randomSource.Int())
+}
+
+// resetRandom restarts the sequence of names, so that every test starts
+// from the same sequence regardless of the tests run before it.
+func resetRandom() {
+	randomMu.Lock()
+	defer randomMu.Unlock()
+	randomSource = rand.New(rand.NewPCG(randomSeed, randomSeed))
 }
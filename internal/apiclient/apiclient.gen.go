@@ -1497,6 +1497,40 @@ type ExternalUser struct {
 	UserId        string `json:"userId"`
 }
 
+// NotificationAction defines model for NotificationAction.
+type NotificationAction struct {
+	Id               json.Number                          `json:"id"`
+	IntegrationId    *json.Number                         `json:"integrationId,omitempty"`
+	Projects         []json.Number                        `json:"projects"`
+	ServiceType      string                               `json:"serviceType"`
+	TargetDisplay    *string                              `json:"targetDisplay,omitempty"`
+	TargetIdentifier *NotificationAction_TargetIdentifier `json:"targetIdentifier,omitempty"`
+	TargetType       *string                              `json:"targetType,omitempty"`
+	TriggerType      string                               `json:"triggerType"`
+}
+
+// NotificationActionTargetIdentifier0 defines model for NotificationAction.TargetIdentifier.0.
+type NotificationActionTargetIdentifier0 = string
+
+// NotificationActionTargetIdentifier1 defines model for NotificationAction.TargetIdentifier.1.
+type NotificationActionTargetIdentifier1 = json.Number
+
+// NotificationAction_TargetIdentifier defines model for NotificationAction.TargetIdentifier.
+type NotificationAction_TargetIdentifier struct {
+	union json.RawMessage
+}
+
+// NotificationActionRequest defines model for NotificationActionRequest.
+type NotificationActionRequest struct {
+	IntegrationId    *json.Number `json:"integrationId,omitempty"`
+	Projects         []string     `json:"projects"`
+	ServiceType      string       `json:"serviceType"`
+	TargetDisplay    *string      `json:"targetDisplay,omitempty"`
+	TargetIdentifier *string      `json:"targetIdentifier,omitempty"`
+	TargetType       *string      `json:"targetType,omitempty"`
+	TriggerType      string       `json:"triggerType"`
+}
+
 // Organization defines model for Organization.
 type Organization struct {
 	AlertsMemberWrite          *bool                      `json:"alertsMemberWrite,omitempty"`
@@ -1535,12 +1569,85 @@ type Organization struct {
 	TrustedRelays              *[]TrustedRelay            `json:"trustedRelays,omitempty"`
 }
 
+// OrganizationAlertRuleDetector defines model for OrganizationAlertRuleDetector.
+type OrganizationAlertRuleDetector struct {
+	AlertRuleId nullable.Nullable[string] `json:"alertRuleId,omitempty"`
+	DetectorId  string                    `json:"detectorId"`
+	RuleId      nullable.Nullable[string] `json:"ruleId,omitempty"`
+}
+
+// OrganizationAlertRuleWorkflow defines model for OrganizationAlertRuleWorkflow.
+type OrganizationAlertRuleWorkflow struct {
+	AlertRuleId nullable.Nullable[string] `json:"alertRuleId,omitempty"`
+	RuleId      nullable.Nullable[string] `json:"ruleId,omitempty"`
+	WorkflowId  string                    `json:"workflowId"`
+}
+
 // OrganizationAvatar defines model for OrganizationAvatar.
 type OrganizationAvatar struct {
 	AvatarType *string                   `json:"avatarType,omitempty"`
 	AvatarUuid nullable.Nullable[string] `json:"avatarUuid,omitempty"`
 }
 
+// OrganizationDashboard defines model for OrganizationDashboard.
+type OrganizationDashboard struct {
+	Environment []string                      `json:"environment"`
+	Id          string                        `json:"id"`
+	Period      nullable.Nullable[string]     `json:"period,omitempty"`
+	Projects    []int64                       `json:"projects"`
+	Title       string                        `json:"title"`
+	Widgets     []OrganizationDashboardWidget `json:"widgets"`
+}
+
+// OrganizationDashboardListItem defines model for OrganizationDashboardListItem.
+type OrganizationDashboardListItem struct {
+	Id    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// OrganizationDashboardRequest defines model for OrganizationDashboardRequest.
+type OrganizationDashboardRequest struct {
+	Environment *[]string                     `json:"environment,omitempty"`
+	Period      nullable.Nullable[string]     `json:"period,omitempty"`
+	Projects    *[]int64                      `json:"projects,omitempty"`
+	Title       string                        `json:"title"`
+	Widgets     []OrganizationDashboardWidget `json:"widgets"`
+}
+
+// OrganizationDashboardWidget defines model for OrganizationDashboard_Widget.
+type OrganizationDashboardWidget struct {
+	Description nullable.Nullable[string]          `json:"description,omitempty"`
+	DisplayType string                             `json:"displayType"`
+	Id          *string                            `json:"id,omitempty"`
+	Interval    *string                            `json:"interval,omitempty"`
+	Layout      *OrganizationDashboardWidgetLayout `json:"layout,omitempty"`
+	Limit       nullable.Nullable[int64]           `json:"limit,omitempty"`
+	Queries     []OrganizationDashboardWidgetQuery `json:"queries"`
+	Title       string                             `json:"title"`
+	WidgetType  *string                            `json:"widgetType,omitempty"`
+}
+
+// OrganizationDashboardWidgetLayout defines model for OrganizationDashboard_Widget_Layout.
+type OrganizationDashboardWidgetLayout struct {
+	H    int64 `json:"h"`
+	MinH int64 `json:"minH"`
+	W    int64 `json:"w"`
+	X    int64 `json:"x"`
+	Y    int64 `json:"y"`
+}
+
+// OrganizationDashboardWidgetQuery defines model for OrganizationDashboard_Widget_Query.
+type OrganizationDashboardWidgetQuery struct {
+	Aggregates   []string `json:"aggregates"`
+	Columns      []string `json:"columns"`
+	Conditions   string   `json:"conditions"`
+	FieldAliases []string `json:"fieldAliases"`
+	Fields       []string `json:"fields"`
+	Id           *string  `json:"id,omitempty"`
+	Name         string   `json:"name"`
+	Orderby      string   `json:"orderby"`
+}
+
 // OrganizationIntegration defines model for OrganizationIntegration.
 type OrganizationIntegration struct {
 	AccountType                   nullable.Nullable[string] `json:"accountType"`
@@ -1620,6 +1727,12 @@ type OrganizationMember struct {
 	} `json:"user"`
 }
 
+// OrganizationMemberTeam defines model for OrganizationMemberTeam.
+type OrganizationMemberTeam struct {
+	IsActive bool                      `json:"isActive"`
+	TeamRole nullable.Nullable[string] `json:"teamRole"`
+}
+
 // OrganizationMemberWithRoles defines model for OrganizationMemberWithRoles.
 type OrganizationMemberWithRoles struct {
 	Email        string                     `json:"email"`
@@ -1633,6 +1746,33 @@ type OrganizationMemberWithRoles struct {
 	TeamRoles    []TeamRole                 `json:"teamRoles"`
 }
 
+// OrganizationRepository defines model for OrganizationRepository.
+type OrganizationRepository struct {
+	DateCreated   *time.Time                          `json:"dateCreated,omitempty"`
+	ExternalId    nullable.Nullable[string]           `json:"externalId,omitempty"`
+	ExternalSlug  OrganizationRepository_ExternalSlug `json:"externalSlug"`
+	Id            string                              `json:"id"`
+	IntegrationId string                              `json:"integrationId"`
+	Name          string                              `json:"name"`
+	Provider      struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"provider"`
+	Status string                    `json:"status"`
+	Url    nullable.Nullable[string] `json:"url,omitempty"`
+}
+
+// OrganizationRepositoryExternalSlug0 defines model for OrganizationRepository.ExternalSlug.0.
+type OrganizationRepositoryExternalSlug0 = string
+
+// OrganizationRepositoryExternalSlug1 defines model for OrganizationRepository.ExternalSlug.1.
+type OrganizationRepositoryExternalSlug1 = json.Number
+
+// OrganizationRepository_ExternalSlug defines model for OrganizationRepository.ExternalSlug.
+type OrganizationRepository_ExternalSlug struct {
+	union json.RawMessage
+}
+
 // OrganizationRoleListItem defines model for OrganizationRoleListItem.
 type OrganizationRoleListItem struct {
 	Desc            string   `json:"desc"`
@@ -2267,6 +2407,23 @@ type Project struct {
 	VerifySSL            bool                      `json:"verifySSL"`
 }
 
+// ProjectFilter defines model for ProjectFilter.
+type ProjectFilter struct {
+	Active ProjectFilter_Active `json:"active"`
+	Id     string               `json:"id"`
+}
+
+// ProjectFilterActive0 defines model for ProjectFilter.Active.0.
+type ProjectFilterActive0 = bool
+
+// ProjectFilterActive1 defines model for ProjectFilter.Active.1.
+type ProjectFilterActive1 = []string
+
+// ProjectFilter_Active defines model for ProjectFilter.Active.
+type ProjectFilter_Active struct {
+	union json.RawMessage
+}
+
 // ProjectKey defines model for ProjectKey.
 type ProjectKey struct {
 	BrowserSdkVersion       string            `json:"browserSdkVersion"`
@@ -2321,6 +2478,19 @@ type ProjectMonitorRequestBase struct {
 	ProjectId   string                    `json:"projectId"`
 }
 
+// ProjectMonitorRequestGeneric defines model for ProjectMonitorRequest_Generic.
+type ProjectMonitorRequestGeneric struct {
+	ConditionGroup *json.RawMessage          `json:"conditionGroup,omitempty"`
+	Config         *ProjectMonitorConfig     `json:"config,omitempty"`
+	DataSources    *[]json.RawMessage        `json:"dataSources,omitempty"`
+	Description    nullable.Nullable[string] `json:"description"`
+	Enabled        nullable.Nullable[bool]   `json:"enabled,omitempty"`
+	Name           string                    `json:"name"`
+	Owner          nullable.Nullable[string] `json:"owner"`
+	ProjectId      string                    `json:"projectId"`
+	Type           string                    `json:"type"`
+}
+
 // ProjectMonitorRequestMetricIssue defines model for ProjectMonitorRequest_MetricIssue.
 type ProjectMonitorRequestMetricIssue struct {
 	ConditionGroup ProjectMonitorConditionGroup                     `json:"conditionGroup"`
@@ -2499,9 +2669,12 @@ type ProjectMonitorDataSourceSnubaQuerySubscription struct {
 type ProjectMonitorDataSourceUptimeDomainFailure struct {
 	Assertion       nullable.Nullable[json.RawMessage] `json:"assertion"`
 	Body            nullable.Nullable[string]          `json:"body"`
+	FollowRedirects *bool                              `json:"followRedirects,omitempty"`
 	Headers         [][]string                         `json:"headers"`
 	IntervalSeconds int64                              `json:"intervalSeconds"`
 	Method          string                             `json:"method"`
+	RegionMode      *string                            `json:"regionMode,omitempty"`
+	Regions         *[]string                          `json:"regions,omitempty"`
 	TimeoutMs       int64                              `json:"timeoutMs"`
 	TraceSampling   bool                               `json:"traceSampling"`
 	Url             string                             `json:"url"`
@@ -2570,7 +2743,7 @@ type ProjectMonitorOwnerUserType string
 // ProjectOwnership defines model for ProjectOwnership.
 type ProjectOwnership struct {
 	AutoAssignment     string    `json:"autoAssignment"`
-	CodeownersAutoSync bool      `json:"codeownersAutoSync"`
+	CodeownersAutoSync *bool     `json:"codeownersAutoSync,omitempty"`
 	DateCreated        time.Time `json:"dateCreated"`
 	Fallthrough        bool      `json:"fallthrough"`
 	LastUpdated        time.Time `json:"lastUpdated"`
@@ -3013,6 +3186,50 @@ type ProjectRuleFilterTaggedEvent struct {
 // ProjectRuleFilterTaggedEventId defines model for ProjectRuleFilterTaggedEvent.Id.
 type ProjectRuleFilterTaggedEventId string
 
+// ProjectSymbolSource defines model for ProjectSymbolSource.
+type ProjectSymbolSource struct {
+	AccessKey        *string                    `json:"access_key,omitempty"`
+	AppId            *string                    `json:"appId,omitempty"`
+	AppconnectIssuer *string                    `json:"appconnectIssuer,omitempty"`
+	Bucket           *string                    `json:"bucket,omitempty"`
+	ClientEmail      *string                    `json:"client_email,omitempty"`
+	Id               string                     `json:"id"`
+	Layout           *ProjectSymbolSourceLayout `json:"layout,omitempty"`
+	Name             string                     `json:"name"`
+	Prefix           *string                    `json:"prefix,omitempty"`
+	Region           *string                    `json:"region,omitempty"`
+	Type             string                     `json:"type"`
+	Url              *string                    `json:"url,omitempty"`
+	Username         *string                    `json:"username,omitempty"`
+}
+
+// ProjectSymbolSourceLayout defines model for ProjectSymbolSourceLayout.
+type ProjectSymbolSourceLayout struct {
+	Casing string `json:"casing"`
+	Type   string `json:"type"`
+}
+
+// ProjectSymbolSourceRequest defines model for ProjectSymbolSourceRequest.
+type ProjectSymbolSourceRequest struct {
+	AccessKey            *string                    `json:"access_key,omitempty"`
+	AppId                *string                    `json:"appId,omitempty"`
+	AppconnectIssuer     *string                    `json:"appconnectIssuer,omitempty"`
+	AppconnectPrivateKey *string                    `json:"appconnectPrivateKey,omitempty"`
+	Bucket               *string                    `json:"bucket,omitempty"`
+	ClientEmail          *string                    `json:"client_email,omitempty"`
+	Id                   *string                    `json:"id,omitempty"`
+	Layout               *ProjectSymbolSourceLayout `json:"layout,omitempty"`
+	Name                 string                     `json:"name"`
+	Password             *string                    `json:"password,omitempty"`
+	Prefix               *string                    `json:"prefix,omitempty"`
+	PrivateKey           *string                    `json:"private_key,omitempty"`
+	Region               *string                    `json:"region,omitempty"`
+	SecretKey            *string                    `json:"secret_key,omitempty"`
+	Type                 string                     `json:"type"`
+	Url                  *string                    `json:"url,omitempty"`
+	Username             *string                    `json:"username,omitempty"`
+}
+
 // SentryAppInstallation defines model for SentryAppInstallation.
 type SentryAppInstallation struct {
 	App struct {
@@ -3037,6 +3254,17 @@ type Team struct {
 	Slug      string `json:"slug"`
 }
 
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	Email    string                    `json:"email"`
+	Id       string                    `json:"id"`
+	Name     *string                   `json:"name,omitempty"`
+	OrgRole  *string                   `json:"orgRole,omitempty"`
+	Pending  *bool                     `json:"pending,omitempty"`
+	TeamRole nullable.Nullable[string] `json:"teamRole,omitempty"`
+	TeamSlug *string                   `json:"teamSlug,omitempty"`
+}
+
 // TeamRole defines model for TeamRole.
 type TeamRole struct {
 	Role     nullable.Nullable[string] `json:"role"`
@@ -3129,6 +3357,9 @@ type UpdateOrganizationWorkflowRequest struct {
 // Cursor defines model for cursor.
 type Cursor = string
 
+// DashboardId defines model for dashboard_id.
+type DashboardId = string
+
 // DetectorId defines model for detector_id.
 type DetectorId = string
 
@@ -3147,6 +3378,22 @@ type ProjectIdOrSlug = string
 // TeamIdOrSlug defines model for team_id_or_slug.
 type TeamIdOrSlug = string
 
+// GetOrganizationAlertRuleDetectorParams defines parameters for GetOrganizationAlertRuleDetector.
+type GetOrganizationAlertRuleDetectorParams struct {
+	AlertRuleId *string `form:"alert_rule_id,omitempty" json:"alert_rule_id,omitempty"`
+}
+
+// GetOrganizationAlertRuleWorkflowParams defines parameters for GetOrganizationAlertRuleWorkflow.
+type GetOrganizationAlertRuleWorkflowParams struct {
+	RuleId      *string `form:"rule_id,omitempty" json:"rule_id,omitempty"`
+	AlertRuleId *string `form:"alert_rule_id,omitempty" json:"alert_rule_id,omitempty"`
+}
+
+// ListOrganizationDashboardsParams defines parameters for ListOrganizationDashboards.
+type ListOrganizationDashboardsParams struct {
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
 // ListOrganizationMonitorsParams defines parameters for ListOrganizationMonitors.
 type ListOrganizationMonitorsParams struct {
 	Cursor  *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
@@ -3183,12 +3430,31 @@ type UpdateOrganizationMemberJSONBody struct {
 	TeamRoles *[]TeamRole `json:"teamRoles,omitempty"`
 }
 
+// UpdateOrganizationMemberTeamRoleJSONBody defines parameters for UpdateOrganizationMemberTeamRole.
+type UpdateOrganizationMemberTeamRoleJSONBody struct {
+	TeamRole string `json:"teamRole"`
+}
+
 // ListOrganizationProjectsParams defines parameters for ListOrganizationProjects.
 type ListOrganizationProjectsParams struct {
 	Cursor  *Cursor   `form:"cursor,omitempty" json:"cursor,omitempty"`
 	Options *[]string `form:"options,omitempty" json:"options,omitempty"`
 }
 
+// ListOrganizationRepositoriesParams defines parameters for ListOrganizationRepositories.
+type ListOrganizationRepositoriesParams struct {
+	Cursor        *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+	IntegrationId *string `form:"integration_id,omitempty" json:"integration_id,omitempty"`
+	Status        *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// CreateOrganizationRepositoryJSONBody defines parameters for CreateOrganizationRepository.
+type CreateOrganizationRepositoryJSONBody struct {
+	Identifier   string `json:"identifier"`
+	Installation string `json:"installation"`
+	Provider     string `json:"provider"`
+}
+
 // ListSentryAppInstallationsParams defines parameters for ListSentryAppInstallations.
 type ListSentryAppInstallationsParams struct {
 	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
@@ -3239,6 +3505,12 @@ type UpdateOrganizationProjectJSONBody struct {
 	VerifySSL            *bool                   `json:"verifySSL,omitempty"`
 }
 
+// UpdateProjectFilterJSONBody defines parameters for UpdateProjectFilter.
+type UpdateProjectFilterJSONBody struct {
+	Active     *bool     `json:"active,omitempty"`
+	Subfilters *[]string `json:"subfilters,omitempty"`
+}
+
 // ListProjectClientKeysParams defines parameters for ListProjectClientKeys.
 type ListProjectClientKeysParams struct {
 	Cursor *Cursor                            `form:"cursor,omitempty" json:"cursor,omitempty"`
@@ -3314,6 +3586,31 @@ type UpdateProjectRuleJSONBody struct {
 	Projects    []string               `json:"projects"`
 }
 
+// DeleteProjectSymbolSourceParams defines parameters for DeleteProjectSymbolSource.
+type DeleteProjectSymbolSourceParams struct {
+	Id string `form:"id" json:"id"`
+}
+
+// ListProjectSymbolSourcesParams defines parameters for ListProjectSymbolSources.
+type ListProjectSymbolSourcesParams struct {
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+}
+
+// UpdateProjectSymbolSourceParams defines parameters for UpdateProjectSymbolSource.
+type UpdateProjectSymbolSourceParams struct {
+	Id string `form:"id" json:"id"`
+}
+
+// ListOrganizationTeamMembersParams defines parameters for ListOrganizationTeamMembers.
+type ListOrganizationTeamMembersParams struct {
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListTeamProjectsParams defines parameters for ListTeamProjects.
+type ListTeamProjectsParams struct {
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
 // CreateOrganizationTeamProjectJSONBody defines parameters for CreateOrganizationTeamProject.
 type CreateOrganizationTeamProjectJSONBody struct {
 	DefaultRules *bool   `json:"default_rules,omitempty"`
@@ -3328,6 +3625,12 @@ type CreateOrganizationJSONRequestBody = CreateOrganization
 // UpdateOrganizationJSONRequestBody defines body for UpdateOrganization for application/json ContentType.
 type UpdateOrganizationJSONRequestBody = UpdateOrganization
 
+// CreateOrganizationDashboardJSONRequestBody defines body for CreateOrganizationDashboard for application/json ContentType.
+type CreateOrganizationDashboardJSONRequestBody = OrganizationDashboardRequest
+
+// UpdateOrganizationDashboardJSONRequestBody defines body for UpdateOrganizationDashboard for application/json ContentType.
+type UpdateOrganizationDashboardJSONRequestBody = OrganizationDashboardRequest
+
 // UpdateProjectMonitorJSONRequestBody defines body for UpdateProjectMonitor for application/json ContentType.
 type UpdateProjectMonitorJSONRequestBody = ProjectMonitorRequest
 
@@ -3346,9 +3649,21 @@ type CreateOrganizationMemberJSONRequestBody CreateOrganizationMemberJSONBody
 // UpdateOrganizationMemberJSONRequestBody defines body for UpdateOrganizationMember for application/json ContentType.
 type UpdateOrganizationMemberJSONRequestBody UpdateOrganizationMemberJSONBody
 
+// UpdateOrganizationMemberTeamRoleJSONRequestBody defines body for UpdateOrganizationMemberTeamRole for application/json ContentType.
+type UpdateOrganizationMemberTeamRoleJSONRequestBody UpdateOrganizationMemberTeamRoleJSONBody
+
+// CreateOrganizationNotificationActionJSONRequestBody defines body for CreateOrganizationNotificationAction for application/json ContentType.
+type CreateOrganizationNotificationActionJSONRequestBody = NotificationActionRequest
+
+// UpdateOrganizationNotificationActionJSONRequestBody defines body for UpdateOrganizationNotificationAction for application/json ContentType.
+type UpdateOrganizationNotificationActionJSONRequestBody = NotificationActionRequest
+
 // CreateProjectMonitorJSONRequestBody defines body for CreateProjectMonitor for application/json ContentType.
 type CreateProjectMonitorJSONRequestBody = ProjectMonitorRequest
 
+// CreateOrganizationRepositoryJSONRequestBody defines body for CreateOrganizationRepository for application/json ContentType.
+type CreateOrganizationRepositoryJSONRequestBody CreateOrganizationRepositoryJSONBody
+
 // DisableSpikeProtectionJSONRequestBody defines body for DisableSpikeProtection for application/json ContentType.
 type DisableSpikeProtectionJSONRequestBody DisableSpikeProtectionJSONBody
 
@@ -3367,6 +3682,9 @@ type UpdateOrganizationWorkflowJSONRequestBody = UpdateOrganizationWorkflowReque
 // UpdateOrganizationProjectJSONRequestBody defines body for UpdateOrganizationProject for application/json ContentType.
 type UpdateOrganizationProjectJSONRequestBody UpdateOrganizationProjectJSONBody
 
+// UpdateProjectFilterJSONRequestBody defines body for UpdateProjectFilter for application/json ContentType.
+type UpdateProjectFilterJSONRequestBody UpdateProjectFilterJSONBody
+
 // CreateProjectClientKeyJSONRequestBody defines body for CreateProjectClientKey for application/json ContentType.
 type CreateProjectClientKeyJSONRequestBody CreateProjectClientKeyJSONBody
 
@@ -3382,9 +3700,77 @@ type CreateProjectRuleJSONRequestBody CreateProjectRuleJSONBody
 // UpdateProjectRuleJSONRequestBody defines body for UpdateProjectRule for application/json ContentType.
 type UpdateProjectRuleJSONRequestBody UpdateProjectRuleJSONBody
 
+// CreateProjectSymbolSourceJSONRequestBody defines body for CreateProjectSymbolSource for application/json ContentType.
+type CreateProjectSymbolSourceJSONRequestBody = ProjectSymbolSourceRequest
+
+// UpdateProjectSymbolSourceJSONRequestBody defines body for UpdateProjectSymbolSource for application/json ContentType.
+type UpdateProjectSymbolSourceJSONRequestBody = ProjectSymbolSourceRequest
+
 // CreateOrganizationTeamProjectJSONRequestBody defines body for CreateOrganizationTeamProject for application/json ContentType.
 type CreateOrganizationTeamProjectJSONRequestBody CreateOrganizationTeamProjectJSONBody
 
+// AsNotificationActionTargetIdentifier0 returns the union data inside the NotificationAction_TargetIdentifier as a NotificationActionTargetIdentifier0
+func (t NotificationAction_TargetIdentifier) AsNotificationActionTargetIdentifier0() (NotificationActionTargetIdentifier0, error) {
+	var body NotificationActionTargetIdentifier0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNotificationActionTargetIdentifier0 overwrites any union data inside the NotificationAction_TargetIdentifier as the provided NotificationActionTargetIdentifier0
+func (t *NotificationAction_TargetIdentifier) FromNotificationActionTargetIdentifier0(v NotificationActionTargetIdentifier0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNotificationActionTargetIdentifier0 performs a merge with any union data inside the NotificationAction_TargetIdentifier, using the provided NotificationActionTargetIdentifier0
+func (t *NotificationAction_TargetIdentifier) MergeNotificationActionTargetIdentifier0(v NotificationActionTargetIdentifier0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsNotificationActionTargetIdentifier1 returns the union data inside the NotificationAction_TargetIdentifier as a NotificationActionTargetIdentifier1
+func (t NotificationAction_TargetIdentifier) AsNotificationActionTargetIdentifier1() (NotificationActionTargetIdentifier1, error) {
+	var body NotificationActionTargetIdentifier1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNotificationActionTargetIdentifier1 overwrites any union data inside the NotificationAction_TargetIdentifier as the provided NotificationActionTargetIdentifier1
+func (t *NotificationAction_TargetIdentifier) FromNotificationActionTargetIdentifier1(v NotificationActionTargetIdentifier1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNotificationActionTargetIdentifier1 performs a merge with any union data inside the NotificationAction_TargetIdentifier, using the provided NotificationActionTargetIdentifier1
+func (t *NotificationAction_TargetIdentifier) MergeNotificationActionTargetIdentifier1(v NotificationActionTargetIdentifier1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t NotificationAction_TargetIdentifier) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *NotificationAction_TargetIdentifier) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsOrganizationIntegrationOpsgenie returns the union data inside the OrganizationIntegration as a OrganizationIntegrationOpsgenie
 func (t OrganizationIntegration) AsOrganizationIntegrationOpsgenie() (OrganizationIntegrationOpsgenie, error) {
 	var body OrganizationIntegrationOpsgenie
@@ -3626,6 +4012,68 @@ func (t *OrganizationIntegration) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// AsOrganizationRepositoryExternalSlug0 returns the union data inside the OrganizationRepository_ExternalSlug as a OrganizationRepositoryExternalSlug0
+func (t OrganizationRepository_ExternalSlug) AsOrganizationRepositoryExternalSlug0() (OrganizationRepositoryExternalSlug0, error) {
+	var body OrganizationRepositoryExternalSlug0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationRepositoryExternalSlug0 overwrites any union data inside the OrganizationRepository_ExternalSlug as the provided OrganizationRepositoryExternalSlug0
+func (t *OrganizationRepository_ExternalSlug) FromOrganizationRepositoryExternalSlug0(v OrganizationRepositoryExternalSlug0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationRepositoryExternalSlug0 performs a merge with any union data inside the OrganizationRepository_ExternalSlug, using the provided OrganizationRepositoryExternalSlug0
+func (t *OrganizationRepository_ExternalSlug) MergeOrganizationRepositoryExternalSlug0(v OrganizationRepositoryExternalSlug0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationRepositoryExternalSlug1 returns the union data inside the OrganizationRepository_ExternalSlug as a OrganizationRepositoryExternalSlug1
+func (t OrganizationRepository_ExternalSlug) AsOrganizationRepositoryExternalSlug1() (OrganizationRepositoryExternalSlug1, error) {
+	var body OrganizationRepositoryExternalSlug1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationRepositoryExternalSlug1 overwrites any union data inside the OrganizationRepository_ExternalSlug as the provided OrganizationRepositoryExternalSlug1
+func (t *OrganizationRepository_ExternalSlug) FromOrganizationRepositoryExternalSlug1(v OrganizationRepositoryExternalSlug1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationRepositoryExternalSlug1 performs a merge with any union data inside the OrganizationRepository_ExternalSlug, using the provided OrganizationRepositoryExternalSlug1
+func (t *OrganizationRepository_ExternalSlug) MergeOrganizationRepositoryExternalSlug1(v OrganizationRepositoryExternalSlug1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t OrganizationRepository_ExternalSlug) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *OrganizationRepository_ExternalSlug) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsOrganizationWorkflowActionFilters0 returns the union data inside the OrganizationWorkflow_ActionFilters as a OrganizationWorkflowActionFilters0
 func (t OrganizationWorkflow_ActionFilters) AsOrganizationWorkflowActionFilters0() (OrganizationWorkflowActionFilters0, error) {
 	var body OrganizationWorkflowActionFilters0
@@ -5012,6 +5460,68 @@ func (t *OrganizationWorkflowTriggerCondition_Comparison) UnmarshalJSON(b []byte
 	return err
 }
 
+// AsProjectFilterActive0 returns the union data inside the ProjectFilter_Active as a ProjectFilterActive0
+func (t ProjectFilter_Active) AsProjectFilterActive0() (ProjectFilterActive0, error) {
+	var body ProjectFilterActive0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectFilterActive0 overwrites any union data inside the ProjectFilter_Active as the provided ProjectFilterActive0
+func (t *ProjectFilter_Active) FromProjectFilterActive0(v ProjectFilterActive0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectFilterActive0 performs a merge with any union data inside the ProjectFilter_Active, using the provided ProjectFilterActive0
+func (t *ProjectFilter_Active) MergeProjectFilterActive0(v ProjectFilterActive0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectFilterActive1 returns the union data inside the ProjectFilter_Active as a ProjectFilterActive1
+func (t ProjectFilter_Active) AsProjectFilterActive1() (ProjectFilterActive1, error) {
+	var body ProjectFilterActive1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectFilterActive1 overwrites any union data inside the ProjectFilter_Active as the provided ProjectFilterActive1
+func (t *ProjectFilter_Active) FromProjectFilterActive1(v ProjectFilterActive1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectFilterActive1 performs a merge with any union data inside the ProjectFilter_Active, using the provided ProjectFilterActive1
+func (t *ProjectFilter_Active) MergeProjectFilterActive1(v ProjectFilterActive1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectFilter_Active) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectFilter_Active) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsProjectMonitorRequestMetricIssue returns the union data inside the ProjectMonitorRequest as a ProjectMonitorRequestMetricIssue
 func (t ProjectMonitorRequest) AsProjectMonitorRequestMetricIssue() (ProjectMonitorRequestMetricIssue, error) {
 	var body ProjectMonitorRequestMetricIssue
@@ -5114,6 +5624,40 @@ func (t *ProjectMonitorRequest) MergeProjectMonitorRequestUptimeDomainFailure(v
 	return err
 }
 
+// AsProjectMonitorRequestGeneric returns the union data inside the ProjectMonitorRequest as a ProjectMonitorRequestGeneric
+func (t ProjectMonitorRequest) AsProjectMonitorRequestGeneric() (ProjectMonitorRequestGeneric, error) {
+	var body ProjectMonitorRequestGeneric
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorRequestGeneric overwrites any union data inside the ProjectMonitorRequest as the provided ProjectMonitorRequestGeneric
+func (t *ProjectMonitorRequest) FromProjectMonitorRequestGeneric(v ProjectMonitorRequestGeneric) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"ProjectMonitorRequest_Generic"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorRequestGeneric performs a merge with any union data inside the ProjectMonitorRequest, using the provided ProjectMonitorRequestGeneric
+func (t *ProjectMonitorRequest) MergeProjectMonitorRequestGeneric(v ProjectMonitorRequestGeneric) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"ProjectMonitorRequest_Generic"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t ProjectMonitorRequest) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"type"`
@@ -5128,6 +5672,8 @@ func (t ProjectMonitorRequest) ValueByDiscriminator() (interface{}, error) {
 		return nil, err
 	}
 	switch discriminator {
+	case "ProjectMonitorRequest_Generic":
+		return t.AsProjectMonitorRequestGeneric()
 	case "metric_issue":
 		return t.AsProjectMonitorRequestMetricIssue()
 	case "monitor_check_in_failure":
@@ -7268,45 +7814,98 @@ type ClientInterface interface {
 	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/ (the `UpdateOrganization` operationId).
 	UpdateOrganization(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// ListOrganizationMonitors List Monitors for an Organization
+	// GetOrganizationAlertRuleDetector Retrieve the Monitor Created for a Metric Alert Rule
 	//
-	// Corresponds with GET /0/organizations/{organization_id_or_slug}/detectors/ (the `ListOrganizationMonitors` operationId).
-	ListOrganizationMonitors(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/alert-rule-detector/ (the `GetOrganizationAlertRuleDetector` operationId).
+	GetOrganizationAlertRuleDetector(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationAlertRuleDetectorParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// DeleteProjectMonitor Delete a Monitor
+	// GetOrganizationAlertRuleWorkflow Retrieve the Alert Created for an Issue or Metric Alert Rule
 	//
-	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `DeleteProjectMonitor` operationId).
-	DeleteProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/alert-rule-workflow/ (the `GetOrganizationAlertRuleWorkflow` operationId).
+	GetOrganizationAlertRuleWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationAlertRuleWorkflowParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetProjectMonitor Retrieve a Monitor
+	// ListOrganizationDashboards List an Organization's Custom Dashboards
 	//
-	// Corresponds with GET /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `GetProjectMonitor` operationId).
-	GetProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/dashboards/ (the `ListOrganizationDashboards` operationId).
+	ListOrganizationDashboards(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDashboardsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// UpdateProjectMonitorWithBody Update a Monitor
+	// CreateOrganizationDashboardWithBody Create a New Dashboard for an Organization
 	//
 	// Takes any type of body and a specified content type.
 	//
-	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `UpdateProjectMonitor` operationId).
-	UpdateProjectMonitorWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/dashboards/ (the `CreateOrganizationDashboard` operationId).
+	CreateOrganizationDashboardWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// UpdateProjectMonitor Update a Monitor
+	// CreateOrganizationDashboard Create a New Dashboard for an Organization
 	//
 	// Takes a body of the `application/json` content type.
 	//
-	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `UpdateProjectMonitor` operationId).
-	UpdateProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/dashboards/ (the `CreateOrganizationDashboard` operationId).
+	CreateOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// CreateOrganizationExternalUserWithBody Create an External User
+	// DeleteOrganizationDashboard Delete an Organization's Custom Dashboard
 	//
-	// Takes any type of body and a specified content type.
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `DeleteOrganizationDashboard` operationId).
+	DeleteOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrganizationDashboard Retrieve an Organization's Custom Dashboard
 	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/external-users/ (the `CreateOrganizationExternalUser` operationId).
-	CreateOrganizationExternalUserWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `GetOrganizationDashboard` operationId).
+	GetOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// CreateOrganizationExternalUser Create an External User
+	// UpdateOrganizationDashboardWithBody Edit an Organization's Custom Dashboard
 	//
-	// Takes a body of the `application/json` content type.
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `UpdateOrganizationDashboard` operationId).
+	UpdateOrganizationDashboardWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationDashboard Edit an Organization's Custom Dashboard
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `UpdateOrganizationDashboard` operationId).
+	UpdateOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, body UpdateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOrganizationMonitors List Monitors for an Organization
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/detectors/ (the `ListOrganizationMonitors` operationId).
+	ListOrganizationMonitors(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProjectMonitor Delete a Monitor
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `DeleteProjectMonitor` operationId).
+	DeleteProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectMonitor Retrieve a Monitor
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `GetProjectMonitor` operationId).
+	GetProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectMonitorWithBody Update a Monitor
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `UpdateProjectMonitor` operationId).
+	UpdateProjectMonitorWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectMonitor Update a Monitor
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `UpdateProjectMonitor` operationId).
+	UpdateProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationExternalUserWithBody Create an External User
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/external-users/ (the `CreateOrganizationExternalUser` operationId).
+	CreateOrganizationExternalUserWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationExternalUser Create an External User
+	//
+	// Takes a body of the `application/json` content type.
 	//
 	// Corresponds with POST /0/organizations/{organization_id_or_slug}/external-users/ (the `CreateOrganizationExternalUser` operationId).
 	CreateOrganizationExternalUser(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationExternalUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -7397,6 +7996,68 @@ type ClientInterface interface {
 	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/ (the `UpdateOrganizationMember` operationId).
 	UpdateOrganizationMember(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, body UpdateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RemoveOrganizationMemberFromTeam Delete an Organization Member from a Team
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `RemoveOrganizationMemberFromTeam` operationId).
+	RemoveOrganizationMemberFromTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddOrganizationMemberToTeam Add an Organization Member to a Team
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `AddOrganizationMemberToTeam` operationId).
+	AddOrganizationMemberToTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationMemberTeamRoleWithBody Update an Organization Member's Team Role
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `UpdateOrganizationMemberTeamRole` operationId).
+	UpdateOrganizationMemberTeamRoleWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationMemberTeamRole Update an Organization Member's Team Role
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `UpdateOrganizationMemberTeamRole` operationId).
+	UpdateOrganizationMemberTeamRole(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, body UpdateOrganizationMemberTeamRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationNotificationActionWithBody Create a Spike Protection Notification Action
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/notifications/actions/ (the `CreateOrganizationNotificationAction` operationId).
+	CreateOrganizationNotificationActionWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationNotificationAction Create a Spike Protection Notification Action
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/notifications/actions/ (the `CreateOrganizationNotificationAction` operationId).
+	CreateOrganizationNotificationAction(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationNotificationActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrganizationNotificationAction Delete a Spike Protection Notification Action
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `DeleteOrganizationNotificationAction` operationId).
+	DeleteOrganizationNotificationAction(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrganizationNotificationAction Retrieve a Spike Protection Notification Action
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `GetOrganizationNotificationAction` operationId).
+	GetOrganizationNotificationAction(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationNotificationActionWithBody Update a Spike Protection Notification Action
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `UpdateOrganizationNotificationAction` operationId).
+	UpdateOrganizationNotificationActionWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationNotificationAction Update a Spike Protection Notification Action
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `UpdateOrganizationNotificationAction` operationId).
+	UpdateOrganizationNotificationAction(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, body UpdateOrganizationNotificationActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListOrganizationProjects List Organization Projects
 	//
 	// Corresponds with GET /0/organizations/{organization_id_or_slug}/projects/ (the `ListOrganizationProjects` operationId).
@@ -7416,6 +8077,30 @@ type ClientInterface interface {
 	// Corresponds with POST /0/organizations/{organization_id_or_slug}/projects/{project_id_or_slug}/detectors/ (the `CreateProjectMonitor` operationId).
 	CreateProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListOrganizationRepositories List an Organization's Repositories
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/repos/ (the `ListOrganizationRepositories` operationId).
+	ListOrganizationRepositories(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationRepositoriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationRepositoryWithBody Add a Repository to an Organization
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/repos/ (the `CreateOrganizationRepository` operationId).
+	CreateOrganizationRepositoryWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationRepository Add a Repository to an Organization
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/repos/ (the `CreateOrganizationRepository` operationId).
+	CreateOrganizationRepository(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrganizationRepository Delete a Repository
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/repos/{repo_id}/ (the `DeleteOrganizationRepository` operationId).
+	DeleteOrganizationRepository(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, repoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListSentryAppInstallations List Sentry App Installations
 	//
 	// Corresponds with GET /0/organizations/{organization_id_or_slug}/sentry-app-installations/ (the `ListSentryAppInstallations` operationId).
@@ -7535,6 +8220,25 @@ type ClientInterface interface {
 	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ (the `UpdateOrganizationProject` operationId).
 	UpdateOrganizationProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateOrganizationProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListProjectFilters List a Project's Inbound Data Filters
+	//
+	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/ (the `ListProjectFilters` operationId).
+	ListProjectFilters(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectFilterWithBody Update an Inbound Data Filter
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/{filter_id}/ (the `UpdateProjectFilter` operationId).
+	UpdateProjectFilterWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, filterId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectFilter Update an Inbound Data Filter
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/{filter_id}/ (the `UpdateProjectFilter` operationId).
+	UpdateProjectFilter(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, filterId string, body UpdateProjectFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListProjectClientKeys List Client Keys
 	//
 	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/ (the `ListProjectClientKeys` operationId).
@@ -7580,21 +8284,21 @@ type ClientInterface interface {
 
 	// GetProjectOwnership Retrieve ownership configuration for a project
 	//
-	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership (the `GetProjectOwnership` operationId).
+	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/ (the `GetProjectOwnership` operationId).
 	GetProjectOwnership(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateProjectOwnershipWithBody Update ownership configuration for a project
 	//
 	// Takes any type of body and a specified content type.
 	//
-	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership (the `UpdateProjectOwnership` operationId).
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/ (the `UpdateProjectOwnership` operationId).
 	UpdateProjectOwnershipWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateProjectOwnership Update ownership configuration for a project
 	//
 	// Takes a body of the `application/json` content type.
 	//
-	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership (the `UpdateProjectOwnership` operationId).
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/ (the `UpdateProjectOwnership` operationId).
 	UpdateProjectOwnership(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateProjectRuleWithBody Create a Rule
@@ -7635,6 +8339,44 @@ type ClientInterface interface {
 	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `UpdateProjectRule` operationId).
 	UpdateProjectRule(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteProjectSymbolSource Delete a Symbol Source from a Project
+	//
+	// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `DeleteProjectSymbolSource` operationId).
+	DeleteProjectSymbolSource(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *DeleteProjectSymbolSourceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProjectSymbolSources Retrieve a Project's Symbol Sources
+	//
+	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `ListProjectSymbolSources` operationId).
+	ListProjectSymbolSources(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectSymbolSourcesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProjectSymbolSourceWithBody Add a Symbol Source to a Project
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `CreateProjectSymbolSource` operationId).
+	CreateProjectSymbolSourceWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProjectSymbolSource Add a Symbol Source to a Project
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `CreateProjectSymbolSource` operationId).
+	CreateProjectSymbolSource(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectSymbolSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectSymbolSourceWithBody Update a Project's Symbol Source
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `UpdateProjectSymbolSource` operationId).
+	UpdateProjectSymbolSourceWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *UpdateProjectSymbolSourceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectSymbolSource Update a Project's Symbol Source
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `UpdateProjectSymbolSource` operationId).
+	UpdateProjectSymbolSource(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *UpdateProjectSymbolSourceParams, body UpdateProjectSymbolSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// RemoveTeamFromProject Remove a Team from a Project
 	//
 	// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/teams/{team_id_or_slug}/ (the `RemoveTeamFromProject` operationId).
@@ -7655,6 +8397,16 @@ type ClientInterface interface {
 	// Corresponds with GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/ (the `GetOrganizationTeam` operationId).
 	GetOrganizationTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListOrganizationTeamMembers List a Team's Members
+	//
+	// Corresponds with GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/members/ (the `ListOrganizationTeamMembers` operationId).
+	ListOrganizationTeamMembers(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListOrganizationTeamMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTeamProjects List a Team's Projects
+	//
+	// Corresponds with GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/ (the `ListTeamProjects` operationId).
+	ListTeamProjects(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListTeamProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateOrganizationTeamProjectWithBody Create a Project
 	//
 	// Takes any type of body and a specified content type.
@@ -7783,6 +8535,149 @@ func (c *Client) UpdateOrganization(ctx context.Context, organizationIdOrSlug Or
 	return c.Client.Do(req)
 }
 
+// GetOrganizationAlertRuleDetector Retrieve the Monitor Created for a Metric Alert Rule
+//
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/alert-rule-detector/ (the `GetOrganizationAlertRuleDetector` operationId).
+func (c *Client) GetOrganizationAlertRuleDetector(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationAlertRuleDetectorParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationAlertRuleDetectorRequest(c.Server, organizationIdOrSlug, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetOrganizationAlertRuleWorkflow Retrieve the Alert Created for an Issue or Metric Alert Rule
+//
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/alert-rule-workflow/ (the `GetOrganizationAlertRuleWorkflow` operationId).
+func (c *Client) GetOrganizationAlertRuleWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationAlertRuleWorkflowParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationAlertRuleWorkflowRequest(c.Server, organizationIdOrSlug, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ListOrganizationDashboards List an Organization's Custom Dashboards
+//
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/dashboards/ (the `ListOrganizationDashboards` operationId).
+func (c *Client) ListOrganizationDashboards(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDashboardsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationDashboardsRequest(c.Server, organizationIdOrSlug, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateOrganizationDashboardWithBody Create a New Dashboard for an Organization
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/dashboards/ (the `CreateOrganizationDashboard` operationId).
+func (c *Client) CreateOrganizationDashboardWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationDashboardRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateOrganizationDashboard Create a New Dashboard for an Organization
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/dashboards/ (the `CreateOrganizationDashboard` operationId).
+func (c *Client) CreateOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationDashboardRequest(c.Server, organizationIdOrSlug, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// DeleteOrganizationDashboard Delete an Organization's Custom Dashboard
+//
+// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `DeleteOrganizationDashboard` operationId).
+func (c *Client) DeleteOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationDashboardRequest(c.Server, organizationIdOrSlug, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetOrganizationDashboard Retrieve an Organization's Custom Dashboard
+//
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `GetOrganizationDashboard` operationId).
+func (c *Client) GetOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationDashboardRequest(c.Server, organizationIdOrSlug, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// UpdateOrganizationDashboardWithBody Edit an Organization's Custom Dashboard
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with PUT /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `UpdateOrganizationDashboard` operationId).
+func (c *Client) UpdateOrganizationDashboardWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationDashboardRequestWithBody(c.Server, organizationIdOrSlug, dashboardId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// UpdateOrganizationDashboard Edit an Organization's Custom Dashboard
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with PUT /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `UpdateOrganizationDashboard` operationId).
+func (c *Client) UpdateOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, body UpdateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationDashboardRequest(c.Server, organizationIdOrSlug, dashboardId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // ListOrganizationMonitors List Monitors for an Organization
 //
 // Corresponds with GET /0/organizations/{organization_id_or_slug}/detectors/ (the `ListOrganizationMonitors` operationId).
@@ -8122,11 +9017,11 @@ func (c *Client) UpdateOrganizationMember(ctx context.Context, organizationIdOrS
 	return c.Client.Do(req)
 }
 
-// ListOrganizationProjects List Organization Projects
+// RemoveOrganizationMemberFromTeam Delete an Organization Member from a Team
 //
-// Corresponds with GET /0/organizations/{organization_id_or_slug}/projects/ (the `ListOrganizationProjects` operationId).
-func (c *Client) ListOrganizationProjects(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListOrganizationProjectsRequest(c.Server, organizationIdOrSlug, params)
+// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `RemoveOrganizationMemberFromTeam` operationId).
+func (c *Client) RemoveOrganizationMemberFromTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveOrganizationMemberFromTeamRequest(c.Server, organizationIdOrSlug, memberId, teamIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
@@ -8137,13 +9032,11 @@ func (c *Client) ListOrganizationProjects(ctx context.Context, organizationIdOrS
 	return c.Client.Do(req)
 }
 
-// CreateProjectMonitorWithBody Create a Monitor for a Project
+// AddOrganizationMemberToTeam Add an Organization Member to a Team
 //
-// Takes any type of body and a specified content type.
-//
-// Corresponds with POST /0/organizations/{organization_id_or_slug}/projects/{project_id_or_slug}/detectors/ (the `CreateProjectMonitor` operationId).
-func (c *Client) CreateProjectMonitorWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateProjectMonitorRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `AddOrganizationMemberToTeam` operationId).
+func (c *Client) AddOrganizationMemberToTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddOrganizationMemberToTeamRequest(c.Server, organizationIdOrSlug, memberId, teamIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
@@ -8154,13 +9047,13 @@ func (c *Client) CreateProjectMonitorWithBody(ctx context.Context, organizationI
 	return c.Client.Do(req)
 }
 
-// CreateProjectMonitor Create a Monitor for a Project
+// UpdateOrganizationMemberTeamRoleWithBody Update an Organization Member's Team Role
 //
-// Takes a body of the `application/json` content type.
+// Takes any type of body and a specified content type.
 //
-// Corresponds with POST /0/organizations/{organization_id_or_slug}/projects/{project_id_or_slug}/detectors/ (the `CreateProjectMonitor` operationId).
-func (c *Client) CreateProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateProjectMonitorRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
+// Corresponds with PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `UpdateOrganizationMemberTeamRole` operationId).
+func (c *Client) UpdateOrganizationMemberTeamRoleWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationMemberTeamRoleRequestWithBody(c.Server, organizationIdOrSlug, memberId, teamIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -8171,11 +9064,13 @@ func (c *Client) CreateProjectMonitor(ctx context.Context, organizationIdOrSlug
 	return c.Client.Do(req)
 }
 
-// ListSentryAppInstallations List Sentry App Installations
+// UpdateOrganizationMemberTeamRole Update an Organization Member's Team Role
 //
-// Corresponds with GET /0/organizations/{organization_id_or_slug}/sentry-app-installations/ (the `ListSentryAppInstallations` operationId).
-func (c *Client) ListSentryAppInstallations(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListSentryAppInstallationsRequest(c.Server, organizationIdOrSlug, params)
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `UpdateOrganizationMemberTeamRole` operationId).
+func (c *Client) UpdateOrganizationMemberTeamRole(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, body UpdateOrganizationMemberTeamRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationMemberTeamRoleRequest(c.Server, organizationIdOrSlug, memberId, teamIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
@@ -8186,12 +9081,238 @@ func (c *Client) ListSentryAppInstallations(ctx context.Context, organizationIdO
 	return c.Client.Do(req)
 }
 
-// DisableSpikeProtectionWithBody Disable Spike Protection
+// CreateOrganizationNotificationActionWithBody Create a Spike Protection Notification Action
 //
 // Takes any type of body and a specified content type.
 //
-// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/spike-protections/ (the `DisableSpikeProtection` operationId).
-func (c *Client) DisableSpikeProtectionWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/notifications/actions/ (the `CreateOrganizationNotificationAction` operationId).
+func (c *Client) CreateOrganizationNotificationActionWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationNotificationActionRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateOrganizationNotificationAction Create a Spike Protection Notification Action
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/notifications/actions/ (the `CreateOrganizationNotificationAction` operationId).
+func (c *Client) CreateOrganizationNotificationAction(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationNotificationActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationNotificationActionRequest(c.Server, organizationIdOrSlug, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// DeleteOrganizationNotificationAction Delete a Spike Protection Notification Action
+//
+// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `DeleteOrganizationNotificationAction` operationId).
+func (c *Client) DeleteOrganizationNotificationAction(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationNotificationActionRequest(c.Server, organizationIdOrSlug, actionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetOrganizationNotificationAction Retrieve a Spike Protection Notification Action
+//
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `GetOrganizationNotificationAction` operationId).
+func (c *Client) GetOrganizationNotificationAction(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationNotificationActionRequest(c.Server, organizationIdOrSlug, actionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// UpdateOrganizationNotificationActionWithBody Update a Spike Protection Notification Action
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with PUT /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `UpdateOrganizationNotificationAction` operationId).
+func (c *Client) UpdateOrganizationNotificationActionWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationNotificationActionRequestWithBody(c.Server, organizationIdOrSlug, actionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// UpdateOrganizationNotificationAction Update a Spike Protection Notification Action
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with PUT /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `UpdateOrganizationNotificationAction` operationId).
+func (c *Client) UpdateOrganizationNotificationAction(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, body UpdateOrganizationNotificationActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationNotificationActionRequest(c.Server, organizationIdOrSlug, actionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ListOrganizationProjects List Organization Projects
+//
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/projects/ (the `ListOrganizationProjects` operationId).
+func (c *Client) ListOrganizationProjects(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationProjectsRequest(c.Server, organizationIdOrSlug, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateProjectMonitorWithBody Create a Monitor for a Project
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/projects/{project_id_or_slug}/detectors/ (the `CreateProjectMonitor` operationId).
+func (c *Client) CreateProjectMonitorWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectMonitorRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateProjectMonitor Create a Monitor for a Project
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/projects/{project_id_or_slug}/detectors/ (the `CreateProjectMonitor` operationId).
+func (c *Client) CreateProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectMonitorRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ListOrganizationRepositories List an Organization's Repositories
+//
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/repos/ (the `ListOrganizationRepositories` operationId).
+func (c *Client) ListOrganizationRepositories(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationRepositoriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationRepositoriesRequest(c.Server, organizationIdOrSlug, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateOrganizationRepositoryWithBody Add a Repository to an Organization
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/repos/ (the `CreateOrganizationRepository` operationId).
+func (c *Client) CreateOrganizationRepositoryWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationRepositoryRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateOrganizationRepository Add a Repository to an Organization
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/repos/ (the `CreateOrganizationRepository` operationId).
+func (c *Client) CreateOrganizationRepository(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationRepositoryRequest(c.Server, organizationIdOrSlug, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// DeleteOrganizationRepository Delete a Repository
+//
+// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/repos/{repo_id}/ (the `DeleteOrganizationRepository` operationId).
+func (c *Client) DeleteOrganizationRepository(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, repoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationRepositoryRequest(c.Server, organizationIdOrSlug, repoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ListSentryAppInstallations List Sentry App Installations
+//
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/sentry-app-installations/ (the `ListSentryAppInstallations` operationId).
+func (c *Client) ListSentryAppInstallations(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSentryAppInstallationsRequest(c.Server, organizationIdOrSlug, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// DisableSpikeProtectionWithBody Disable Spike Protection
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/spike-protections/ (the `DisableSpikeProtection` operationId).
+func (c *Client) DisableSpikeProtectionWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDisableSpikeProtectionRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
@@ -8480,6 +9601,55 @@ func (c *Client) UpdateOrganizationProject(ctx context.Context, organizationIdOr
 	return c.Client.Do(req)
 }
 
+// ListProjectFilters List a Project's Inbound Data Filters
+//
+// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/ (the `ListProjectFilters` operationId).
+func (c *Client) ListProjectFilters(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectFiltersRequest(c.Server, organizationIdOrSlug, projectIdOrSlug)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// UpdateProjectFilterWithBody Update an Inbound Data Filter
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/{filter_id}/ (the `UpdateProjectFilter` operationId).
+func (c *Client) UpdateProjectFilterWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, filterId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectFilterRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, filterId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// UpdateProjectFilter Update an Inbound Data Filter
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/{filter_id}/ (the `UpdateProjectFilter` operationId).
+func (c *Client) UpdateProjectFilter(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, filterId string, body UpdateProjectFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectFilterRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, filterId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // ListProjectClientKeys List Client Keys
 //
 // Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/ (the `ListProjectClientKeys` operationId).
@@ -8595,7 +9765,7 @@ func (c *Client) UpdateProjectClientKey(ctx context.Context, organizationIdOrSlu
 
 // GetProjectOwnership Retrieve ownership configuration for a project
 //
-// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership (the `GetProjectOwnership` operationId).
+// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/ (the `GetProjectOwnership` operationId).
 func (c *Client) GetProjectOwnership(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetProjectOwnershipRequest(c.Server, organizationIdOrSlug, projectIdOrSlug)
 	if err != nil {
@@ -8612,7 +9782,7 @@ func (c *Client) GetProjectOwnership(ctx context.Context, organizationIdOrSlug O
 //
 // Takes any type of body and a specified content type.
 //
-// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership (the `UpdateProjectOwnership` operationId).
+// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/ (the `UpdateProjectOwnership` operationId).
 func (c *Client) UpdateProjectOwnershipWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewUpdateProjectOwnershipRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
 	if err != nil {
@@ -8629,7 +9799,7 @@ func (c *Client) UpdateProjectOwnershipWithBody(ctx context.Context, organizatio
 //
 // Takes a body of the `application/json` content type.
 //
-// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership (the `UpdateProjectOwnership` operationId).
+// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/ (the `UpdateProjectOwnership` operationId).
 func (c *Client) UpdateProjectOwnership(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewUpdateProjectOwnershipRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
 	if err != nil {
@@ -8740,11 +9910,11 @@ func (c *Client) UpdateProjectRule(ctx context.Context, organizationIdOrSlug Org
 	return c.Client.Do(req)
 }
 
-// RemoveTeamFromProject Remove a Team from a Project
+// DeleteProjectSymbolSource Delete a Symbol Source from a Project
 //
-// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/teams/{team_id_or_slug}/ (the `RemoveTeamFromProject` operationId).
-func (c *Client) RemoveTeamFromProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRemoveTeamFromProjectRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, teamIdOrSlug)
+// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `DeleteProjectSymbolSource` operationId).
+func (c *Client) DeleteProjectSymbolSource(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *DeleteProjectSymbolSourceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectSymbolSourceRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
@@ -8755,11 +9925,11 @@ func (c *Client) RemoveTeamFromProject(ctx context.Context, organizationIdOrSlug
 	return c.Client.Do(req)
 }
 
-// AddTeamToProject Add a Team to a Project
+// ListProjectSymbolSources Retrieve a Project's Symbol Sources
 //
-// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/teams/{team_id_or_slug}/ (the `AddTeamToProject` operationId).
-func (c *Client) AddTeamToProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddTeamToProjectRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, teamIdOrSlug)
+// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `ListProjectSymbolSources` operationId).
+func (c *Client) ListProjectSymbolSources(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectSymbolSourcesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectSymbolSourcesRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
@@ -8770,11 +9940,13 @@ func (c *Client) AddTeamToProject(ctx context.Context, organizationIdOrSlug Orga
 	return c.Client.Do(req)
 }
 
-// DeleteOrganizationTeam Delete a Team
+// CreateProjectSymbolSourceWithBody Add a Symbol Source to a Project
 //
-// Corresponds with DELETE /0/teams/{organization_id_or_slug}/{team_id_or_slug}/ (the `DeleteOrganizationTeam` operationId).
-func (c *Client) DeleteOrganizationTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteOrganizationTeamRequest(c.Server, organizationIdOrSlug, teamIdOrSlug)
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `CreateProjectSymbolSource` operationId).
+func (c *Client) CreateProjectSymbolSourceWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectSymbolSourceRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -8785,11 +9957,13 @@ func (c *Client) DeleteOrganizationTeam(ctx context.Context, organizationIdOrSlu
 	return c.Client.Do(req)
 }
 
-// GetOrganizationTeam Retrieve a Team
+// CreateProjectSymbolSource Add a Symbol Source to a Project
 //
-// Corresponds with GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/ (the `GetOrganizationTeam` operationId).
-func (c *Client) GetOrganizationTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrganizationTeamRequest(c.Server, organizationIdOrSlug, teamIdOrSlug)
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `CreateProjectSymbolSource` operationId).
+func (c *Client) CreateProjectSymbolSource(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectSymbolSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectSymbolSourceRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
@@ -8800,13 +9974,13 @@ func (c *Client) GetOrganizationTeam(ctx context.Context, organizationIdOrSlug O
 	return c.Client.Do(req)
 }
 
-// CreateOrganizationTeamProjectWithBody Create a Project
+// UpdateProjectSymbolSourceWithBody Update a Project's Symbol Source
 //
 // Takes any type of body and a specified content type.
 //
-// Corresponds with POST /0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/ (the `CreateOrganizationTeamProject` operationId).
-func (c *Client) CreateOrganizationTeamProjectWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateOrganizationTeamProjectRequestWithBody(c.Server, organizationIdOrSlug, teamIdOrSlug, contentType, body)
+// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `UpdateProjectSymbolSource` operationId).
+func (c *Client) UpdateProjectSymbolSourceWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *UpdateProjectSymbolSourceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectSymbolSourceRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -8817,13 +9991,13 @@ func (c *Client) CreateOrganizationTeamProjectWithBody(ctx context.Context, orga
 	return c.Client.Do(req)
 }
 
-// CreateOrganizationTeamProject Create a Project
+// UpdateProjectSymbolSource Update a Project's Symbol Source
 //
 // Takes a body of the `application/json` content type.
 //
-// Corresponds with POST /0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/ (the `CreateOrganizationTeamProject` operationId).
-func (c *Client) CreateOrganizationTeamProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateOrganizationTeamProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateOrganizationTeamProjectRequest(c.Server, organizationIdOrSlug, teamIdOrSlug, body)
+// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `UpdateProjectSymbolSource` operationId).
+func (c *Client) UpdateProjectSymbolSource(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *UpdateProjectSymbolSourceParams, body UpdateProjectSymbolSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectSymbolSourceRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -8834,8 +10008,132 @@ func (c *Client) CreateOrganizationTeamProject(ctx context.Context, organization
 	return c.Client.Do(req)
 }
 
-// NewHealthCheckRequest constructs an http.Request for the HealthCheck method
-func NewHealthCheckRequest(server string) (*http.Request, error) {
+// RemoveTeamFromProject Remove a Team from a Project
+//
+// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/teams/{team_id_or_slug}/ (the `RemoveTeamFromProject` operationId).
+func (c *Client) RemoveTeamFromProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveTeamFromProjectRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, teamIdOrSlug)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// AddTeamToProject Add a Team to a Project
+//
+// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/teams/{team_id_or_slug}/ (the `AddTeamToProject` operationId).
+func (c *Client) AddTeamToProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTeamToProjectRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, teamIdOrSlug)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// DeleteOrganizationTeam Delete a Team
+//
+// Corresponds with DELETE /0/teams/{organization_id_or_slug}/{team_id_or_slug}/ (the `DeleteOrganizationTeam` operationId).
+func (c *Client) DeleteOrganizationTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationTeamRequest(c.Server, organizationIdOrSlug, teamIdOrSlug)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// GetOrganizationTeam Retrieve a Team
+//
+// Corresponds with GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/ (the `GetOrganizationTeam` operationId).
+func (c *Client) GetOrganizationTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationTeamRequest(c.Server, organizationIdOrSlug, teamIdOrSlug)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ListOrganizationTeamMembers List a Team's Members
+//
+// Corresponds with GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/members/ (the `ListOrganizationTeamMembers` operationId).
+func (c *Client) ListOrganizationTeamMembers(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListOrganizationTeamMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationTeamMembersRequest(c.Server, organizationIdOrSlug, teamIdOrSlug, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ListTeamProjects List a Team's Projects
+//
+// Corresponds with GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/ (the `ListTeamProjects` operationId).
+func (c *Client) ListTeamProjects(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListTeamProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTeamProjectsRequest(c.Server, organizationIdOrSlug, teamIdOrSlug, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateOrganizationTeamProjectWithBody Create a Project
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/ (the `CreateOrganizationTeamProject` operationId).
+func (c *Client) CreateOrganizationTeamProjectWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationTeamProjectRequestWithBody(c.Server, organizationIdOrSlug, teamIdOrSlug, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateOrganizationTeamProject Create a Project
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/ (the `CreateOrganizationTeamProject` operationId).
+func (c *Client) CreateOrganizationTeamProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateOrganizationTeamProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationTeamProjectRequest(c.Server, organizationIdOrSlug, teamIdOrSlug, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewHealthCheckRequest constructs an http.Request for the HealthCheck method
+func NewHealthCheckRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -9016,8 +10314,8 @@ func NewUpdateOrganizationRequestWithBody(server string, organizationIdOrSlug Or
 	return req, nil
 }
 
-// NewListOrganizationMonitorsRequest constructs an http.Request for the ListOrganizationMonitors method
-func NewListOrganizationMonitorsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams) (*http.Request, error) {
+// NewGetOrganizationAlertRuleDetectorRequest constructs an http.Request for the GetOrganizationAlertRuleDetector method
+func NewGetOrganizationAlertRuleDetectorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationAlertRuleDetectorParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9032,7 +10330,7 @@ func NewListOrganizationMonitorsRequest(server string, organizationIdOrSlug Orga
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/", pathParam0)
+	operationPath := fmt.Sprintf("/0/organizations/%s/alert-rule-detector/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9051,9 +10349,9 @@ func NewListOrganizationMonitorsRequest(server string, organizationIdOrSlug Orga
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Cursor != nil {
+		if params.AlertRuleId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "alert_rule_id", *params.AlertRuleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -9063,9 +10361,58 @@ func NewListOrganizationMonitorsRequest(server string, organizationIdOrSlug Orga
 
 		}
 
-		if params.Project != nil {
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "project", *params.Project, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrganizationAlertRuleWorkflowRequest constructs an http.Request for the GetOrganizationAlertRuleWorkflow method
+func NewGetOrganizationAlertRuleWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationAlertRuleWorkflowParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/alert-rule-workflow/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.RuleId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "rule_id", *params.RuleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -9075,9 +10422,9 @@ func NewListOrganizationMonitorsRequest(server string, organizationIdOrSlug Orga
 
 		}
 
-		if params.Query != nil {
+		if params.AlertRuleId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "query", *params.Query, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "alert_rule_id", *params.AlertRuleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -9101,8 +10448,8 @@ func NewListOrganizationMonitorsRequest(server string, organizationIdOrSlug Orga
 	return req, nil
 }
 
-// NewDeleteProjectMonitorRequest constructs an http.Request for the DeleteProjectMonitor method
-func NewDeleteProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId) (*http.Request, error) {
+// NewListOrganizationDashboardsRequest constructs an http.Request for the ListOrganizationDashboards method
+func NewListOrganizationDashboardsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDashboardsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9112,19 +10459,12 @@ func NewDeleteProjectMonitorRequest(server string, organizationIdOrSlug Organiza
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "detector_id", detectorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/dashboards/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9134,7 +10474,34 @@ func NewDeleteProjectMonitorRequest(server string, organizationIdOrSlug Organiza
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -9142,20 +10509,24 @@ func NewDeleteProjectMonitorRequest(server string, organizationIdOrSlug Organiza
 	return req, nil
 }
 
-// NewGetProjectMonitorRequest constructs an http.Request for the GetProjectMonitor method
-func NewGetProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+// NewCreateOrganizationDashboardRequest calls the generic CreateOrganizationDashboard builder with application/json body
+func NewCreateOrganizationDashboardRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDashboardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationDashboardRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewCreateOrganizationDashboardRequestWithBody constructs an http.Request for the CreateOrganizationDashboard method, with any body, and a specified content type
+func NewCreateOrganizationDashboardRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "detector_id", detectorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9165,7 +10536,7 @@ func NewGetProjectMonitorRequest(server string, organizationIdOrSlug Organizatio
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/dashboards/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9175,27 +10546,18 @@ func NewGetProjectMonitorRequest(server string, organizationIdOrSlug Organizatio
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewUpdateProjectMonitorRequest calls the generic UpdateProjectMonitor builder with application/json body
-func NewUpdateProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectMonitorRequestWithBody(server, organizationIdOrSlug, detectorId, "application/json", bodyReader)
+	return req, nil
 }
 
-// NewUpdateProjectMonitorRequestWithBody constructs an http.Request for the UpdateProjectMonitor method, with any body, and a specified content type
-func NewUpdateProjectMonitorRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteOrganizationDashboardRequest constructs an http.Request for the DeleteOrganizationDashboard method
+func NewDeleteOrganizationDashboardRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9207,7 +10569,7 @@ func NewUpdateProjectMonitorRequestWithBody(server string, organizationIdOrSlug
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "detector_id", detectorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "dashboard_id", dashboardId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9217,7 +10579,7 @@ func NewUpdateProjectMonitorRequestWithBody(server string, organizationIdOrSlug
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/dashboards/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9227,65 +10589,16 @@ func NewUpdateProjectMonitorRequestWithBody(server string, organizationIdOrSlug
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewCreateOrganizationExternalUserRequest calls the generic CreateOrganizationExternalUser builder with application/json body
-func NewCreateOrganizationExternalUserRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationExternalUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateOrganizationExternalUserRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
-}
-
-// NewCreateOrganizationExternalUserRequestWithBody constructs an http.Request for the CreateOrganizationExternalUser method, with any body, and a specified content type
-func NewCreateOrganizationExternalUserRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/external-users/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewDeleteOrganizationExternalUserRequest constructs an http.Request for the DeleteOrganizationExternalUser method
-func NewDeleteOrganizationExternalUserRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string) (*http.Request, error) {
+// NewGetOrganizationDashboardRequest constructs an http.Request for the GetOrganizationDashboard method
+func NewGetOrganizationDashboardRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9297,7 +10610,7 @@ func NewDeleteOrganizationExternalUserRequest(server string, organizationIdOrSlu
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "external_user_id", externalUserId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "dashboard_id", dashboardId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9307,7 +10620,7 @@ func NewDeleteOrganizationExternalUserRequest(server string, organizationIdOrSlu
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/external-users/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/dashboards/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9317,7 +10630,7 @@ func NewDeleteOrganizationExternalUserRequest(server string, organizationIdOrSlu
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -9325,19 +10638,19 @@ func NewDeleteOrganizationExternalUserRequest(server string, organizationIdOrSlu
 	return req, nil
 }
 
-// NewUpdateOrganizationExternalUserRequest calls the generic UpdateOrganizationExternalUser builder with application/json body
-func NewUpdateOrganizationExternalUserRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string, body UpdateOrganizationExternalUserJSONRequestBody) (*http.Request, error) {
+// NewUpdateOrganizationDashboardRequest calls the generic UpdateOrganizationDashboard builder with application/json body
+func NewUpdateOrganizationDashboardRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, body UpdateOrganizationDashboardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateOrganizationExternalUserRequestWithBody(server, organizationIdOrSlug, externalUserId, "application/json", bodyReader)
+	return NewUpdateOrganizationDashboardRequestWithBody(server, organizationIdOrSlug, dashboardId, "application/json", bodyReader)
 }
 
-// NewUpdateOrganizationExternalUserRequestWithBody constructs an http.Request for the UpdateOrganizationExternalUser method, with any body, and a specified content type
-func NewUpdateOrganizationExternalUserRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateOrganizationDashboardRequestWithBody constructs an http.Request for the UpdateOrganizationDashboard method, with any body, and a specified content type
+func NewUpdateOrganizationDashboardRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9349,7 +10662,7 @@ func NewUpdateOrganizationExternalUserRequestWithBody(server string, organizatio
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "external_user_id", externalUserId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "dashboard_id", dashboardId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9359,7 +10672,7 @@ func NewUpdateOrganizationExternalUserRequestWithBody(server string, organizatio
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/external-users/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/dashboards/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9379,8 +10692,8 @@ func NewUpdateOrganizationExternalUserRequestWithBody(server string, organizatio
 	return req, nil
 }
 
-// NewListOrganizationIntegrationsRequest constructs an http.Request for the ListOrganizationIntegrations method
-func NewListOrganizationIntegrationsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationIntegrationsParams) (*http.Request, error) {
+// NewListOrganizationMonitorsRequest constructs an http.Request for the ListOrganizationMonitors method
+func NewListOrganizationMonitorsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9395,7 +10708,7 @@ func NewListOrganizationIntegrationsRequest(server string, organizationIdOrSlug
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/integrations/", pathParam0)
+	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9426,9 +10739,21 @@ func NewListOrganizationIntegrationsRequest(server string, organizationIdOrSlug
 
 		}
 
-		if params.ProviderKey != nil {
+		if params.Project != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "provider_key", *params.ProviderKey, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "project", *params.Project, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Query != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "query", *params.Query, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -9452,8 +10777,8 @@ func NewListOrganizationIntegrationsRequest(server string, organizationIdOrSlug
 	return req, nil
 }
 
-// NewGetOrganizationIntegrationRequest constructs an http.Request for the GetOrganizationIntegration method
-func NewGetOrganizationIntegrationRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId) (*http.Request, error) {
+// NewDeleteProjectMonitorRequest constructs an http.Request for the DeleteProjectMonitor method
+func NewDeleteProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9465,7 +10790,7 @@ func NewGetOrganizationIntegrationRequest(server string, organizationIdOrSlug Or
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "integration_id", integrationId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "detector_id", detectorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9475,7 +10800,7 @@ func NewGetOrganizationIntegrationRequest(server string, organizationIdOrSlug Or
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/integrations/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9485,7 +10810,7 @@ func NewGetOrganizationIntegrationRequest(server string, organizationIdOrSlug Or
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -9493,19 +10818,8 @@ func NewGetOrganizationIntegrationRequest(server string, organizationIdOrSlug Or
 	return req, nil
 }
 
-// NewUpdateOrganizationIntegrationRequest calls the generic UpdateOrganizationIntegration builder with application/json body
-func NewUpdateOrganizationIntegrationRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, body UpdateOrganizationIntegrationJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateOrganizationIntegrationRequestWithBody(server, organizationIdOrSlug, integrationId, "application/json", bodyReader)
-}
-
-// NewUpdateOrganizationIntegrationRequestWithBody constructs an http.Request for the UpdateOrganizationIntegration method, with any body, and a specified content type
-func NewUpdateOrganizationIntegrationRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetProjectMonitorRequest constructs an http.Request for the GetProjectMonitor method
+func NewGetProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9517,7 +10831,7 @@ func NewUpdateOrganizationIntegrationRequestWithBody(server string, organization
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "integration_id", integrationId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "detector_id", detectorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9527,7 +10841,7 @@ func NewUpdateOrganizationIntegrationRequestWithBody(server string, organization
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/integrations/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9537,18 +10851,27 @@ func NewUpdateOrganizationIntegrationRequestWithBody(server string, organization
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewListOrganizationMembersRequest constructs an http.Request for the ListOrganizationMembers method
-func NewListOrganizationMembersRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMembersParams) (*http.Request, error) {
+// NewUpdateProjectMonitorRequest calls the generic UpdateProjectMonitor builder with application/json body
+func NewUpdateProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectMonitorRequestWithBody(server, organizationIdOrSlug, detectorId, "application/json", bodyReader)
+}
+
+// NewUpdateProjectMonitorRequestWithBody constructs an http.Request for the UpdateProjectMonitor method, with any body, and a specified content type
+func NewUpdateProjectMonitorRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9558,12 +10881,19 @@ func NewListOrganizationMembersRequest(server string, organizationIdOrSlug Organ
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "detector_id", detectorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/members/", pathParam0)
+	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9573,54 +10903,29 @@ func NewListOrganizationMembersRequest(server string, organizationIdOrSlug Organ
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Cursor != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewCreateOrganizationMemberRequest calls the generic CreateOrganizationMember builder with application/json body
-func NewCreateOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationMemberJSONRequestBody) (*http.Request, error) {
+// NewCreateOrganizationExternalUserRequest calls the generic CreateOrganizationExternalUser builder with application/json body
+func NewCreateOrganizationExternalUserRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationExternalUserJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateOrganizationMemberRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+	return NewCreateOrganizationExternalUserRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
 }
 
-// NewCreateOrganizationMemberRequestWithBody constructs an http.Request for the CreateOrganizationMember method, with any body, and a specified content type
-func NewCreateOrganizationMemberRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateOrganizationExternalUserRequestWithBody constructs an http.Request for the CreateOrganizationExternalUser method, with any body, and a specified content type
+func NewCreateOrganizationExternalUserRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9635,7 +10940,7 @@ func NewCreateOrganizationMemberRequestWithBody(server string, organizationIdOrS
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/members/", pathParam0)
+	operationPath := fmt.Sprintf("/0/organizations/%s/external-users/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9655,8 +10960,8 @@ func NewCreateOrganizationMemberRequestWithBody(server string, organizationIdOrS
 	return req, nil
 }
 
-// NewDeleteOrganizationMemberRequest constructs an http.Request for the DeleteOrganizationMember method
-func NewDeleteOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId) (*http.Request, error) {
+// NewDeleteOrganizationExternalUserRequest constructs an http.Request for the DeleteOrganizationExternalUser method
+func NewDeleteOrganizationExternalUserRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9668,7 +10973,7 @@ func NewDeleteOrganizationMemberRequest(server string, organizationIdOrSlug Orga
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "external_user_id", externalUserId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9678,7 +10983,7 @@ func NewDeleteOrganizationMemberRequest(server string, organizationIdOrSlug Orga
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/external-users/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9696,60 +11001,19 @@ func NewDeleteOrganizationMemberRequest(server string, organizationIdOrSlug Orga
 	return req, nil
 }
 
-// NewGetOrganizationMemberRequest constructs an http.Request for the GetOrganizationMember method
-func NewGetOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewUpdateOrganizationMemberRequest calls the generic UpdateOrganizationMember builder with application/json body
-func NewUpdateOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, body UpdateOrganizationMemberJSONRequestBody) (*http.Request, error) {
+// NewUpdateOrganizationExternalUserRequest calls the generic UpdateOrganizationExternalUser builder with application/json body
+func NewUpdateOrganizationExternalUserRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string, body UpdateOrganizationExternalUserJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateOrganizationMemberRequestWithBody(server, organizationIdOrSlug, memberId, "application/json", bodyReader)
+	return NewUpdateOrganizationExternalUserRequestWithBody(server, organizationIdOrSlug, externalUserId, "application/json", bodyReader)
 }
 
-// NewUpdateOrganizationMemberRequestWithBody constructs an http.Request for the UpdateOrganizationMember method, with any body, and a specified content type
-func NewUpdateOrganizationMemberRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateOrganizationExternalUserRequestWithBody constructs an http.Request for the UpdateOrganizationExternalUser method, with any body, and a specified content type
+func NewUpdateOrganizationExternalUserRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9761,7 +11025,7 @@ func NewUpdateOrganizationMemberRequestWithBody(server string, organizationIdOrS
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "external_user_id", externalUserId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9771,7 +11035,7 @@ func NewUpdateOrganizationMemberRequestWithBody(server string, organizationIdOrS
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/external-users/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9791,8 +11055,8 @@ func NewUpdateOrganizationMemberRequestWithBody(server string, organizationIdOrS
 	return req, nil
 }
 
-// NewListOrganizationProjectsRequest constructs an http.Request for the ListOrganizationProjects method
-func NewListOrganizationProjectsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams) (*http.Request, error) {
+// NewListOrganizationIntegrationsRequest constructs an http.Request for the ListOrganizationIntegrations method
+func NewListOrganizationIntegrationsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationIntegrationsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9807,7 +11071,7 @@ func NewListOrganizationProjectsRequest(server string, organizationIdOrSlug Orga
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/projects/", pathParam0)
+	operationPath := fmt.Sprintf("/0/organizations/%s/integrations/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9838,9 +11102,9 @@ func NewListOrganizationProjectsRequest(server string, organizationIdOrSlug Orga
 
 		}
 
-		if params.Options != nil {
+		if params.ProviderKey != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "options", *params.Options, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "provider_key", *params.ProviderKey, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -9864,19 +11128,60 @@ func NewListOrganizationProjectsRequest(server string, organizationIdOrSlug Orga
 	return req, nil
 }
 
-// NewCreateProjectMonitorRequest calls the generic CreateProjectMonitor builder with application/json body
-func NewCreateProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetOrganizationIntegrationRequest constructs an http.Request for the GetOrganizationIntegration method
+func NewGetOrganizationIntegrationRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "integration_id", integrationId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/integrations/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOrganizationIntegrationRequest calls the generic UpdateOrganizationIntegration builder with application/json body
+func NewUpdateOrganizationIntegrationRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, body UpdateOrganizationIntegrationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectMonitorRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+	return NewUpdateOrganizationIntegrationRequestWithBody(server, organizationIdOrSlug, integrationId, "application/json", bodyReader)
 }
 
-// NewCreateProjectMonitorRequestWithBody constructs an http.Request for the CreateProjectMonitor method, with any body, and a specified content type
-func NewCreateProjectMonitorRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateOrganizationIntegrationRequestWithBody constructs an http.Request for the UpdateOrganizationIntegration method, with any body, and a specified content type
+func NewUpdateOrganizationIntegrationRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9888,7 +11193,7 @@ func NewCreateProjectMonitorRequestWithBody(server string, organizationIdOrSlug
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "integration_id", integrationId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9898,7 +11203,7 @@ func NewCreateProjectMonitorRequestWithBody(server string, organizationIdOrSlug
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/projects/%s/detectors/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/integrations/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9918,8 +11223,8 @@ func NewCreateProjectMonitorRequestWithBody(server string, organizationIdOrSlug
 	return req, nil
 }
 
-// NewListSentryAppInstallationsRequest constructs an http.Request for the ListSentryAppInstallations method
-func NewListSentryAppInstallationsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams) (*http.Request, error) {
+// NewListOrganizationMembersRequest constructs an http.Request for the ListOrganizationMembers method
+func NewListOrganizationMembersRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMembersParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9934,7 +11239,7 @@ func NewListSentryAppInstallationsRequest(server string, organizationIdOrSlug Or
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/sentry-app-installations/", pathParam0)
+	operationPath := fmt.Sprintf("/0/organizations/%s/members/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9979,19 +11284,19 @@ func NewListSentryAppInstallationsRequest(server string, organizationIdOrSlug Or
 	return req, nil
 }
 
-// NewDisableSpikeProtectionRequest calls the generic DisableSpikeProtection builder with application/json body
-func NewDisableSpikeProtectionRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body DisableSpikeProtectionJSONRequestBody) (*http.Request, error) {
+// NewCreateOrganizationMemberRequest calls the generic CreateOrganizationMember builder with application/json body
+func NewCreateOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationMemberJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDisableSpikeProtectionRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+	return NewCreateOrganizationMemberRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
 }
 
-// NewDisableSpikeProtectionRequestWithBody constructs an http.Request for the DisableSpikeProtection method, with any body, and a specified content type
-func NewDisableSpikeProtectionRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateOrganizationMemberRequestWithBody constructs an http.Request for the CreateOrganizationMember method, with any body, and a specified content type
+func NewCreateOrganizationMemberRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10006,7 +11311,7 @@ func NewDisableSpikeProtectionRequestWithBody(server string, organizationIdOrSlu
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/spike-protections/", pathParam0)
+	operationPath := fmt.Sprintf("/0/organizations/%s/members/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10016,7 +11321,7 @@ func NewDisableSpikeProtectionRequestWithBody(server string, organizationIdOrSlu
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -10026,19 +11331,8 @@ func NewDisableSpikeProtectionRequestWithBody(server string, organizationIdOrSlu
 	return req, nil
 }
 
-// NewEnableSpikeProtectionRequest calls the generic EnableSpikeProtection builder with application/json body
-func NewEnableSpikeProtectionRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body EnableSpikeProtectionJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewEnableSpikeProtectionRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
-}
-
-// NewEnableSpikeProtectionRequestWithBody constructs an http.Request for the EnableSpikeProtection method, with any body, and a specified content type
-func NewEnableSpikeProtectionRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteOrganizationMemberRequest constructs an http.Request for the DeleteOrganizationMember method
+func NewDeleteOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10048,12 +11342,19 @@ func NewEnableSpikeProtectionRequestWithBody(server string, organizationIdOrSlug
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/spike-protections/", pathParam0)
+	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10063,18 +11364,16 @@ func NewEnableSpikeProtectionRequestWithBody(server string, organizationIdOrSlug
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewListOrganizationTeamsRequest constructs an http.Request for the ListOrganizationTeams method
-func NewListOrganizationTeamsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationTeamsParams) (*http.Request, error) {
+// NewGetOrganizationMemberRequest constructs an http.Request for the GetOrganizationMember method
+func NewGetOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10084,12 +11383,19 @@ func NewListOrganizationTeamsRequest(server string, organizationIdOrSlug Organiz
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/teams/", pathParam0)
+	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10099,33 +11405,6 @@ func NewListOrganizationTeamsRequest(server string, organizationIdOrSlug Organiz
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Cursor != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -10134,19 +11413,19 @@ func NewListOrganizationTeamsRequest(server string, organizationIdOrSlug Organiz
 	return req, nil
 }
 
-// NewCreateOrganizationTeamRequest calls the generic CreateOrganizationTeam builder with application/json body
-func NewCreateOrganizationTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationTeamJSONRequestBody) (*http.Request, error) {
+// NewUpdateOrganizationMemberRequest calls the generic UpdateOrganizationMember builder with application/json body
+func NewUpdateOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, body UpdateOrganizationMemberJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateOrganizationTeamRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+	return NewUpdateOrganizationMemberRequestWithBody(server, organizationIdOrSlug, memberId, "application/json", bodyReader)
 }
 
-// NewCreateOrganizationTeamRequestWithBody constructs an http.Request for the CreateOrganizationTeam method, with any body, and a specified content type
-func NewCreateOrganizationTeamRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateOrganizationMemberRequestWithBody constructs an http.Request for the UpdateOrganizationMember method, with any body, and a specified content type
+func NewUpdateOrganizationMemberRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10156,12 +11435,19 @@ func NewCreateOrganizationTeamRequestWithBody(server string, organizationIdOrSlu
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/teams/", pathParam0)
+	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10171,7 +11457,7 @@ func NewCreateOrganizationTeamRequestWithBody(server string, organizationIdOrSlu
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -10181,8 +11467,8 @@ func NewCreateOrganizationTeamRequestWithBody(server string, organizationIdOrSlu
 	return req, nil
 }
 
-// NewListOrganizationWorkflowsRequest constructs an http.Request for the ListOrganizationWorkflows method
-func NewListOrganizationWorkflowsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationWorkflowsParams) (*http.Request, error) {
+// NewRemoveOrganizationMemberFromTeamRequest constructs an http.Request for the RemoveOrganizationMemberFromTeam method
+func NewRemoveOrganizationMemberFromTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10192,12 +11478,26 @@ func NewListOrganizationWorkflowsRequest(server string, organizationIdOrSlug Org
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/", pathParam0)
+	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/teams/%s/", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10207,59 +11507,35 @@ func NewListOrganizationWorkflowsRequest(server string, organizationIdOrSlug Org
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Cursor != nil {
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewAddOrganizationMemberToTeamRequest constructs an http.Request for the AddOrganizationMemberToTeam method
+func NewAddOrganizationMemberToTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+	var err error
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
+	var pathParam0 string
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	var pathParam1 string
 
-// NewCreateOrganizationWorkflowRequest calls the generic CreateOrganizationWorkflow builder with application/json body
-func NewCreateOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationWorkflowJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateOrganizationWorkflowRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
-}
-
-// NewCreateOrganizationWorkflowRequestWithBody constructs an http.Request for the CreateOrganizationWorkflow method, with any body, and a specified content type
-func NewCreateOrganizationWorkflowRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
-	var pathParam0 string
+	var pathParam2 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10269,7 +11545,7 @@ func NewCreateOrganizationWorkflowRequestWithBody(server string, organizationIdO
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/", pathParam0)
+	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/teams/%s/", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10279,18 +11555,27 @@ func NewCreateOrganizationWorkflowRequestWithBody(server string, organizationIdO
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteOrganizationWorkflowRequest constructs an http.Request for the DeleteOrganizationWorkflow method
-func NewDeleteOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string) (*http.Request, error) {
+// NewUpdateOrganizationMemberTeamRoleRequest calls the generic UpdateOrganizationMemberTeamRole builder with application/json body
+func NewUpdateOrganizationMemberTeamRoleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, body UpdateOrganizationMemberTeamRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizationMemberTeamRoleRequestWithBody(server, organizationIdOrSlug, memberId, teamIdOrSlug, "application/json", bodyReader)
+}
+
+// NewUpdateOrganizationMemberTeamRoleRequestWithBody constructs an http.Request for the UpdateOrganizationMemberTeamRole method, with any body, and a specified content type
+func NewUpdateOrganizationMemberTeamRoleRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10302,48 +11587,14 @@ func NewDeleteOrganizationWorkflowRequest(server string, organizationIdOrSlug Or
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "workflow_id", workflowId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/%s/", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewGetOrganizationWorkflowRequest constructs an http.Request for the GetOrganizationWorkflow method
-func NewGetOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "workflow_id", workflowId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10353,7 +11604,7 @@ func NewGetOrganizationWorkflowRequest(server string, organizationIdOrSlug Organ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/teams/%s/", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10363,27 +11614,29 @@ func NewGetOrganizationWorkflowRequest(server string, organizationIdOrSlug Organ
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewUpdateOrganizationWorkflowRequest calls the generic UpdateOrganizationWorkflow builder with application/json body
-func NewUpdateOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, body UpdateOrganizationWorkflowJSONRequestBody) (*http.Request, error) {
+// NewCreateOrganizationNotificationActionRequest calls the generic CreateOrganizationNotificationAction builder with application/json body
+func NewCreateOrganizationNotificationActionRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationNotificationActionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateOrganizationWorkflowRequestWithBody(server, organizationIdOrSlug, workflowId, "application/json", bodyReader)
+	return NewCreateOrganizationNotificationActionRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
 }
 
-// NewUpdateOrganizationWorkflowRequestWithBody constructs an http.Request for the UpdateOrganizationWorkflow method, with any body, and a specified content type
-func NewUpdateOrganizationWorkflowRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateOrganizationNotificationActionRequestWithBody constructs an http.Request for the CreateOrganizationNotificationAction method, with any body, and a specified content type
+func NewCreateOrganizationNotificationActionRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10393,19 +11646,12 @@ func NewUpdateOrganizationWorkflowRequestWithBody(server string, organizationIdO
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "workflow_id", workflowId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/notifications/actions/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10415,7 +11661,7 @@ func NewUpdateOrganizationWorkflowRequestWithBody(server string, organizationIdO
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -10425,8 +11671,8 @@ func NewUpdateOrganizationWorkflowRequestWithBody(server string, organizationIdO
 	return req, nil
 }
 
-// NewDeleteOrganizationProjectRequest constructs an http.Request for the DeleteOrganizationProject method
-func NewDeleteOrganizationProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+// NewDeleteOrganizationNotificationActionRequest constructs an http.Request for the DeleteOrganizationNotificationAction method
+func NewDeleteOrganizationNotificationActionRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, actionId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10438,7 +11684,7 @@ func NewDeleteOrganizationProjectRequest(server string, organizationIdOrSlug Org
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "action_id", actionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10448,7 +11694,7 @@ func NewDeleteOrganizationProjectRequest(server string, organizationIdOrSlug Org
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/notifications/actions/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10466,8 +11712,8 @@ func NewDeleteOrganizationProjectRequest(server string, organizationIdOrSlug Org
 	return req, nil
 }
 
-// NewGetOrganizationProjectRequest constructs an http.Request for the GetOrganizationProject method
-func NewGetOrganizationProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+// NewGetOrganizationNotificationActionRequest constructs an http.Request for the GetOrganizationNotificationAction method
+func NewGetOrganizationNotificationActionRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, actionId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10479,7 +11725,7 @@ func NewGetOrganizationProjectRequest(server string, organizationIdOrSlug Organi
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "action_id", actionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10489,7 +11735,7 @@ func NewGetOrganizationProjectRequest(server string, organizationIdOrSlug Organi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/notifications/actions/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10507,19 +11753,19 @@ func NewGetOrganizationProjectRequest(server string, organizationIdOrSlug Organi
 	return req, nil
 }
 
-// NewUpdateOrganizationProjectRequest calls the generic UpdateOrganizationProject builder with application/json body
-func NewUpdateOrganizationProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateOrganizationProjectJSONRequestBody) (*http.Request, error) {
+// NewUpdateOrganizationNotificationActionRequest calls the generic UpdateOrganizationNotificationAction builder with application/json body
+func NewUpdateOrganizationNotificationActionRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, actionId string, body UpdateOrganizationNotificationActionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateOrganizationProjectRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+	return NewUpdateOrganizationNotificationActionRequestWithBody(server, organizationIdOrSlug, actionId, "application/json", bodyReader)
 }
 
-// NewUpdateOrganizationProjectRequestWithBody constructs an http.Request for the UpdateOrganizationProject method, with any body, and a specified content type
-func NewUpdateOrganizationProjectRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateOrganizationNotificationActionRequestWithBody constructs an http.Request for the UpdateOrganizationNotificationAction method, with any body, and a specified content type
+func NewUpdateOrganizationNotificationActionRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, actionId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10531,7 +11777,7 @@ func NewUpdateOrganizationProjectRequestWithBody(server string, organizationIdOr
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "action_id", actionId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10541,7 +11787,7 @@ func NewUpdateOrganizationProjectRequestWithBody(server string, organizationIdOr
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/notifications/actions/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10561,8 +11807,8 @@ func NewUpdateOrganizationProjectRequestWithBody(server string, organizationIdOr
 	return req, nil
 }
 
-// NewListProjectClientKeysRequest constructs an http.Request for the ListProjectClientKeys method
-func NewListProjectClientKeysRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectClientKeysParams) (*http.Request, error) {
+// NewListOrganizationProjectsRequest constructs an http.Request for the ListOrganizationProjects method
+func NewListOrganizationProjectsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10572,19 +11818,12 @@ func NewListProjectClientKeysRequest(server string, organizationIdOrSlug Organiz
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/projects/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10615,9 +11854,9 @@ func NewListProjectClientKeysRequest(server string, organizationIdOrSlug Organiz
 
 		}
 
-		if params.Status != nil {
+		if params.Options != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "status", *params.Status, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "options", *params.Options, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -10641,19 +11880,19 @@ func NewListProjectClientKeysRequest(server string, organizationIdOrSlug Organiz
 	return req, nil
 }
 
-// NewCreateProjectClientKeyRequest calls the generic CreateProjectClientKey builder with application/json body
-func NewCreateProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectClientKeyJSONRequestBody) (*http.Request, error) {
+// NewCreateProjectMonitorRequest calls the generic CreateProjectMonitor builder with application/json body
+func NewCreateProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectClientKeyRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+	return NewCreateProjectMonitorRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
 }
 
-// NewCreateProjectClientKeyRequestWithBody constructs an http.Request for the CreateProjectClientKey method, with any body, and a specified content type
-func NewCreateProjectClientKeyRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateProjectMonitorRequestWithBody constructs an http.Request for the CreateProjectMonitor method, with any body, and a specified content type
+func NewCreateProjectMonitorRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10675,7 +11914,7 @@ func NewCreateProjectClientKeyRequestWithBody(server string, organizationIdOrSlu
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/projects/%s/detectors/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10695,8 +11934,8 @@ func NewCreateProjectClientKeyRequestWithBody(server string, organizationIdOrSlu
 	return req, nil
 }
 
-// NewDeleteProjectClientKeyRequest constructs an http.Request for the DeleteProjectClientKey method
-func NewDeleteProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string) (*http.Request, error) {
+// NewListOrganizationRepositoriesRequest constructs an http.Request for the ListOrganizationRepositories method
+func NewListOrganizationRepositoriesRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationRepositoriesParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10706,26 +11945,12 @@ func NewDeleteProjectClientKeyRequest(server string, organizationIdOrSlug Organi
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "key_id", keyId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/repos/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10735,52 +11960,55 @@ func NewDeleteProjectClientKeyRequest(server string, organizationIdOrSlug Organi
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-// NewGetProjectClientKeyRequest constructs an http.Request for the GetProjectClientKey method
-func NewGetProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string) (*http.Request, error) {
-	var err error
+		if params.Cursor != nil {
 
-	var pathParam0 string
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	var pathParam1 string
+		if params.IntegrationId != nil {
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "integration_id", *params.IntegrationId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	var pathParam2 string
+		}
 
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "key_id", keyId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
+		if params.Status != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "status", *params.Status, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/%s/", pathParam0, pathParam1, pathParam2)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
 
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
@@ -10791,19 +12019,19 @@ func NewGetProjectClientKeyRequest(server string, organizationIdOrSlug Organizat
 	return req, nil
 }
 
-// NewUpdateProjectClientKeyRequest calls the generic UpdateProjectClientKey builder with application/json body
-func NewUpdateProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, body UpdateProjectClientKeyJSONRequestBody) (*http.Request, error) {
+// NewCreateOrganizationRepositoryRequest calls the generic CreateOrganizationRepository builder with application/json body
+func NewCreateOrganizationRepositoryRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationRepositoryJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectClientKeyRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, keyId, "application/json", bodyReader)
+	return NewCreateOrganizationRepositoryRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
 }
 
-// NewUpdateProjectClientKeyRequestWithBody constructs an http.Request for the UpdateProjectClientKey method, with any body, and a specified content type
-func NewUpdateProjectClientKeyRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateOrganizationRepositoryRequestWithBody constructs an http.Request for the CreateOrganizationRepository method, with any body, and a specified content type
+func NewCreateOrganizationRepositoryRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10813,26 +12041,12 @@ func NewUpdateProjectClientKeyRequestWithBody(server string, organizationIdOrSlu
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "key_id", keyId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/repos/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10842,7 +12056,7 @@ func NewUpdateProjectClientKeyRequestWithBody(server string, organizationIdOrSlu
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -10852,8 +12066,8 @@ func NewUpdateProjectClientKeyRequestWithBody(server string, organizationIdOrSlu
 	return req, nil
 }
 
-// NewGetProjectOwnershipRequest constructs an http.Request for the GetProjectOwnership method
-func NewGetProjectOwnershipRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+// NewDeleteOrganizationRepositoryRequest constructs an http.Request for the DeleteOrganizationRepository method
+func NewDeleteOrganizationRepositoryRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, repoId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10865,7 +12079,7 @@ func NewGetProjectOwnershipRequest(server string, organizationIdOrSlug Organizat
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "repo_id", repoId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10875,7 +12089,7 @@ func NewGetProjectOwnershipRequest(server string, organizationIdOrSlug Organizat
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/ownership", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/repos/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10885,7 +12099,7 @@ func NewGetProjectOwnershipRequest(server string, organizationIdOrSlug Organizat
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -10893,19 +12107,8 @@ func NewGetProjectOwnershipRequest(server string, organizationIdOrSlug Organizat
 	return req, nil
 }
 
-// NewUpdateProjectOwnershipRequest calls the generic UpdateProjectOwnership builder with application/json body
-func NewUpdateProjectOwnershipRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectOwnershipRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
-}
-
-// NewUpdateProjectOwnershipRequestWithBody constructs an http.Request for the UpdateProjectOwnership method, with any body, and a specified content type
-func NewUpdateProjectOwnershipRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewListSentryAppInstallationsRequest constructs an http.Request for the ListSentryAppInstallations method
+func NewListSentryAppInstallationsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10915,19 +12118,12 @@ func NewUpdateProjectOwnershipRequestWithBody(server string, organizationIdOrSlu
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/ownership", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/sentry-app-installations/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10937,29 +12133,54 @@ func NewUpdateProjectOwnershipRequestWithBody(server string, organizationIdOrSlu
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	return req, nil
-}
+		if params.Cursor != nil {
 
-// NewCreateProjectRuleRequest calls the generic CreateProjectRule builder with application/json body
-func NewCreateProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectRuleJSONRequestBody) (*http.Request, error) {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDisableSpikeProtectionRequest calls the generic DisableSpikeProtection builder with application/json body
+func NewDisableSpikeProtectionRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body DisableSpikeProtectionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectRuleRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+	return NewDisableSpikeProtectionRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
 }
 
-// NewCreateProjectRuleRequestWithBody constructs an http.Request for the CreateProjectRule method, with any body, and a specified content type
-func NewCreateProjectRuleRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewDisableSpikeProtectionRequestWithBody constructs an http.Request for the DisableSpikeProtection method, with any body, and a specified content type
+func NewDisableSpikeProtectionRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10969,19 +12190,12 @@ func NewCreateProjectRuleRequestWithBody(server string, organizationIdOrSlug Org
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/spike-protections/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10991,7 +12205,7 @@ func NewCreateProjectRuleRequestWithBody(server string, organizationIdOrSlug Org
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -11001,27 +12215,24 @@ func NewCreateProjectRuleRequestWithBody(server string, organizationIdOrSlug Org
 	return req, nil
 }
 
-// NewDeleteProjectRuleRequest constructs an http.Request for the DeleteProjectRule method
-func NewDeleteProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+// NewEnableSpikeProtectionRequest calls the generic EnableSpikeProtection builder with application/json body
+func NewEnableSpikeProtectionRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body EnableSpikeProtectionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEnableSpikeProtectionRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
+// NewEnableSpikeProtectionRequestWithBody constructs an http.Request for the EnableSpikeProtection method, with any body, and a specified content type
+func NewEnableSpikeProtectionRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	var pathParam2 string
+	var pathParam0 string
 
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "rule_id", ruleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11031,7 +12242,7 @@ func NewDeleteProjectRuleRequest(server string, organizationIdOrSlug Organizatio
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/spike-protections/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11041,16 +12252,18 @@ func NewDeleteProjectRuleRequest(server string, organizationIdOrSlug Organizatio
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetProjectRuleRequest constructs an http.Request for the GetProjectRule method
-func NewGetProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string) (*http.Request, error) {
+// NewListOrganizationTeamsRequest constructs an http.Request for the ListOrganizationTeams method
+func NewListOrganizationTeamsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationTeamsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11060,26 +12273,12 @@ func NewGetProjectRuleRequest(server string, organizationIdOrSlug OrganizationId
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "rule_id", ruleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/teams/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11089,6 +12288,33 @@ func NewGetProjectRuleRequest(server string, organizationIdOrSlug OrganizationId
 		return nil, err
 	}
 
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -11097,19 +12323,19 @@ func NewGetProjectRuleRequest(server string, organizationIdOrSlug OrganizationId
 	return req, nil
 }
 
-// NewUpdateProjectRuleRequest calls the generic UpdateProjectRule builder with application/json body
-func NewUpdateProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody) (*http.Request, error) {
+// NewCreateOrganizationTeamRequest calls the generic CreateOrganizationTeam builder with application/json body
+func NewCreateOrganizationTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationTeamJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectRuleRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, ruleId, "application/json", bodyReader)
+	return NewCreateOrganizationTeamRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
 }
 
-// NewUpdateProjectRuleRequestWithBody constructs an http.Request for the UpdateProjectRule method, with any body, and a specified content type
-func NewUpdateProjectRuleRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateOrganizationTeamRequestWithBody constructs an http.Request for the CreateOrganizationTeam method, with any body, and a specified content type
+func NewCreateOrganizationTeamRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11119,26 +12345,12 @@ func NewUpdateProjectRuleRequestWithBody(server string, organizationIdOrSlug Org
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "rule_id", ruleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/teams/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11148,7 +12360,7 @@ func NewUpdateProjectRuleRequestWithBody(server string, organizationIdOrSlug Org
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -11158,8 +12370,8 @@ func NewUpdateProjectRuleRequestWithBody(server string, organizationIdOrSlug Org
 	return req, nil
 }
 
-// NewRemoveTeamFromProjectRequest constructs an http.Request for the RemoveTeamFromProject method
-func NewRemoveTeamFromProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+// NewListOrganizationWorkflowsRequest constructs an http.Request for the ListOrganizationWorkflows method
+func NewListOrganizationWorkflowsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationWorkflowsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11169,26 +12381,12 @@ func NewRemoveTeamFromProjectRequest(server string, organizationIdOrSlug Organiz
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/teams/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11198,35 +12396,59 @@ func NewRemoveTeamFromProjectRequest(server string, organizationIdOrSlug Organiz
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	return req, nil
-}
+		if params.Cursor != nil {
 
-// NewAddTeamToProjectRequest constructs an http.Request for the AddTeamToProject method
-func NewAddTeamToProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	return req, nil
+}
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+// NewCreateOrganizationWorkflowRequest calls the generic CreateOrganizationWorkflow builder with application/json body
+func NewCreateOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationWorkflowJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationWorkflowRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
 
-	var pathParam2 string
+// NewCreateOrganizationWorkflowRequestWithBody constructs an http.Request for the CreateOrganizationWorkflow method, with any body, and a specified content type
+func NewCreateOrganizationWorkflowRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11236,7 +12458,7 @@ func NewAddTeamToProjectRequest(server string, organizationIdOrSlug Organization
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/teams/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11246,16 +12468,18 @@ func NewAddTeamToProjectRequest(server string, organizationIdOrSlug Organization
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDeleteOrganizationTeamRequest constructs an http.Request for the DeleteOrganizationTeam method
-func NewDeleteOrganizationTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+// NewDeleteOrganizationWorkflowRequest constructs an http.Request for the DeleteOrganizationWorkflow method
+func NewDeleteOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11267,7 +12491,7 @@ func NewDeleteOrganizationTeamRequest(server string, organizationIdOrSlug Organi
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "workflow_id", workflowId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11277,7 +12501,7 @@ func NewDeleteOrganizationTeamRequest(server string, organizationIdOrSlug Organi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/teams/%s/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11295,8 +12519,8 @@ func NewDeleteOrganizationTeamRequest(server string, organizationIdOrSlug Organi
 	return req, nil
 }
 
-// NewGetOrganizationTeamRequest constructs an http.Request for the GetOrganizationTeam method
-func NewGetOrganizationTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+// NewGetOrganizationWorkflowRequest constructs an http.Request for the GetOrganizationWorkflow method
+func NewGetOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11308,7 +12532,7 @@ func NewGetOrganizationTeamRequest(server string, organizationIdOrSlug Organizat
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "workflow_id", workflowId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11318,7 +12542,7 @@ func NewGetOrganizationTeamRequest(server string, organizationIdOrSlug Organizat
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/teams/%s/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11336,19 +12560,19 @@ func NewGetOrganizationTeamRequest(server string, organizationIdOrSlug Organizat
 	return req, nil
 }
 
-// NewCreateOrganizationTeamProjectRequest calls the generic CreateOrganizationTeamProject builder with application/json body
-func NewCreateOrganizationTeamProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateOrganizationTeamProjectJSONRequestBody) (*http.Request, error) {
+// NewUpdateOrganizationWorkflowRequest calls the generic UpdateOrganizationWorkflow builder with application/json body
+func NewUpdateOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, body UpdateOrganizationWorkflowJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateOrganizationTeamProjectRequestWithBody(server, organizationIdOrSlug, teamIdOrSlug, "application/json", bodyReader)
+	return NewUpdateOrganizationWorkflowRequestWithBody(server, organizationIdOrSlug, workflowId, "application/json", bodyReader)
 }
 
-// NewCreateOrganizationTeamProjectRequestWithBody constructs an http.Request for the CreateOrganizationTeamProject method, with any body, and a specified content type
-func NewCreateOrganizationTeamProjectRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateOrganizationWorkflowRequestWithBody constructs an http.Request for the UpdateOrganizationWorkflow method, with any body, and a specified content type
+func NewUpdateOrganizationWorkflowRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11360,7 +12584,7 @@ func NewCreateOrganizationTeamProjectRequestWithBody(server string, organization
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "workflow_id", workflowId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11370,7 +12594,7 @@ func NewCreateOrganizationTeamProjectRequestWithBody(server string, organization
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/teams/%s/%s/projects/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11380,7 +12604,7 @@ func NewCreateOrganizationTeamProjectRequestWithBody(server string, organization
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -11390,567 +12614,2940 @@ func NewCreateOrganizationTeamProjectRequestWithBody(server string, organization
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewDeleteOrganizationProjectRequest constructs an http.Request for the DeleteOrganizationProject method
+func NewDeleteOrganizationProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
+
+	return req, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
+// NewGetOrganizationProjectRequest constructs an http.Request for the GetOrganizationProject method
+func NewGetOrganizationProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+	var err error
 
-	// HealthCheckWithResponse Health Check
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/internal/health/ (the `HealthCheck` operationId).
-	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
+	var pathParam0 string
 
-	// CreateOrganizationWithBodyWithResponse Create a New Organization
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/ (the `CreateOrganization` operationId).
-	CreateOrganizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateOrganizationWithResponse Create a New Organization
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/ (the `CreateOrganization` operationId).
-	CreateOrganizationWithResponse(ctx context.Context, body CreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationResponse, error)
+	var pathParam1 string
 
-	// DeleteOrganizationWithResponse Delete an Organization
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/ (the `DeleteOrganization` operationId).
-	DeleteOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// GetOrganizationWithResponse Retrieve an Organization
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/organizations/{organization_id_or_slug}/ (the `GetOrganization` operationId).
-	GetOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateOrganizationWithBodyWithResponse Update an Organization
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/ (the `UpdateOrganization` operationId).
-	UpdateOrganizationWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationResponse, error)
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UpdateOrganizationWithResponse Update an Organization
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/ (the `UpdateOrganization` operationId).
-	UpdateOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListOrganizationMonitorsWithResponse List Monitors for an Organization
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/organizations/{organization_id_or_slug}/detectors/ (the `ListOrganizationMonitors` operationId).
-	ListOrganizationMonitorsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams, reqEditors ...RequestEditorFn) (*ListOrganizationMonitorsResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteProjectMonitorWithResponse Delete a Monitor
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `DeleteProjectMonitor` operationId).
-	DeleteProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*DeleteProjectMonitorResponse, error)
+	return req, nil
+}
 
-	// GetProjectMonitorWithResponse Retrieve a Monitor
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `GetProjectMonitor` operationId).
-	GetProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*GetProjectMonitorResponse, error)
+// NewUpdateOrganizationProjectRequest calls the generic UpdateOrganizationProject builder with application/json body
+func NewUpdateOrganizationProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateOrganizationProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizationProjectRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+}
 
-	// UpdateProjectMonitorWithBodyWithResponse Update a Monitor
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `UpdateProjectMonitor` operationId).
-	UpdateProjectMonitorWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectMonitorResponse, error)
+// NewUpdateOrganizationProjectRequestWithBody constructs an http.Request for the UpdateOrganizationProject method, with any body, and a specified content type
+func NewUpdateOrganizationProjectRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// UpdateProjectMonitorWithResponse Update a Monitor
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `UpdateProjectMonitor` operationId).
-	UpdateProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectMonitorResponse, error)
+	var pathParam0 string
 
-	// CreateOrganizationExternalUserWithBodyWithResponse Create an External User
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/external-users/ (the `CreateOrganizationExternalUser` operationId).
-	CreateOrganizationExternalUserWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationExternalUserResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateOrganizationExternalUserWithResponse Create an External User
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/external-users/ (the `CreateOrganizationExternalUser` operationId).
-	CreateOrganizationExternalUserWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationExternalUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationExternalUserResponse, error)
+	var pathParam1 string
 
-	// DeleteOrganizationExternalUserWithResponse Delete an External User
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/external-users/{external_user_id}/ (the `DeleteOrganizationExternalUser` operationId).
-	DeleteOrganizationExternalUserWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationExternalUserResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateOrganizationExternalUserWithBodyWithResponse Update an External User
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/external-users/{external_user_id}/ (the `UpdateOrganizationExternalUser` operationId).
-	UpdateOrganizationExternalUserWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationExternalUserResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateOrganizationExternalUserWithResponse Update an External User
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/external-users/{external_user_id}/ (the `UpdateOrganizationExternalUser` operationId).
-	UpdateOrganizationExternalUserWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string, body UpdateOrganizationExternalUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationExternalUserResponse, error)
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListOrganizationIntegrationsWithResponse List Organization Integrations
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/organizations/{organization_id_or_slug}/integrations/ (the `ListOrganizationIntegrations` operationId).
-	ListOrganizationIntegrationsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationIntegrationsParams, reqEditors ...RequestEditorFn) (*ListOrganizationIntegrationsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetOrganizationIntegrationWithResponse Retrieve an Organization Integration
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/organizations/{organization_id_or_slug}/integrations/{integration_id}/ (the `GetOrganizationIntegration` operationId).
-	GetOrganizationIntegrationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, reqEditors ...RequestEditorFn) (*GetOrganizationIntegrationResponse, error)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateOrganizationIntegrationWithBodyWithResponse Update an Organization Integration
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/integrations/{integration_id}/ (the `UpdateOrganizationIntegration` operationId).
-	UpdateOrganizationIntegrationWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationIntegrationResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// UpdateOrganizationIntegrationWithResponse Update an Organization Integration
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/integrations/{integration_id}/ (the `UpdateOrganizationIntegration` operationId).
-	UpdateOrganizationIntegrationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, body UpdateOrganizationIntegrationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationIntegrationResponse, error)
+	return req, nil
+}
 
-	// ListOrganizationMembersWithResponse List Organization Members
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/organizations/{organization_id_or_slug}/members/ (the `ListOrganizationMembers` operationId).
-	ListOrganizationMembersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMembersParams, reqEditors ...RequestEditorFn) (*ListOrganizationMembersResponse, error)
+// NewListProjectFiltersRequest constructs an http.Request for the ListProjectFilters method
+func NewListProjectFiltersRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+	var err error
 
-	// CreateOrganizationMemberWithBodyWithResponse Create an Organization Member
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/members/ (the `CreateOrganizationMember` operationId).
-	CreateOrganizationMemberWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationMemberResponse, error)
+	var pathParam0 string
 
-	// CreateOrganizationMemberWithResponse Create an Organization Member
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/members/ (the `CreateOrganizationMember` operationId).
-	CreateOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationMemberResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteOrganizationMemberWithResponse Delete an Organization Member
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/members/{member_id}/ (the `DeleteOrganizationMember` operationId).
-	DeleteOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*DeleteOrganizationMemberResponse, error)
+	var pathParam1 string
 
-	// GetOrganizationMemberWithResponse Retrieve an Organization Member
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/organizations/{organization_id_or_slug}/members/{member_id}/ (the `GetOrganizationMember` operationId).
-	GetOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*GetOrganizationMemberResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateOrganizationMemberWithBodyWithResponse Update an Organization Member
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/ (the `UpdateOrganizationMember` operationId).
-	UpdateOrganizationMemberWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateOrganizationMemberWithResponse Update an Organization Member
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/ (the `UpdateOrganizationMember` operationId).
-	UpdateOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, body UpdateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberResponse, error)
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/filters/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListOrganizationProjectsWithResponse List Organization Projects
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/organizations/{organization_id_or_slug}/projects/ (the `ListOrganizationProjects` operationId).
-	ListOrganizationProjectsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams, reqEditors ...RequestEditorFn) (*ListOrganizationProjectsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateProjectMonitorWithBodyWithResponse Create a Monitor for a Project
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/projects/{project_id_or_slug}/detectors/ (the `CreateProjectMonitor` operationId).
-	CreateProjectMonitorWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectMonitorResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateProjectMonitorWithResponse Create a Monitor for a Project
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/projects/{project_id_or_slug}/detectors/ (the `CreateProjectMonitor` operationId).
-	CreateProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectMonitorResponse, error)
+	return req, nil
+}
 
-	// ListSentryAppInstallationsWithResponse List Sentry App Installations
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/organizations/{organization_id_or_slug}/sentry-app-installations/ (the `ListSentryAppInstallations` operationId).
-	ListSentryAppInstallationsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams, reqEditors ...RequestEditorFn) (*ListSentryAppInstallationsResponse, error)
+// NewUpdateProjectFilterRequest calls the generic UpdateProjectFilter builder with application/json body
+func NewUpdateProjectFilterRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, filterId string, body UpdateProjectFilterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectFilterRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, filterId, "application/json", bodyReader)
+}
 
-	// DisableSpikeProtectionWithBodyWithResponse Disable Spike Protection
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/spike-protections/ (the `DisableSpikeProtection` operationId).
-	DisableSpikeProtectionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableSpikeProtectionResponse, error)
+// NewUpdateProjectFilterRequestWithBody constructs an http.Request for the UpdateProjectFilter method, with any body, and a specified content type
+func NewUpdateProjectFilterRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, filterId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// DisableSpikeProtectionWithResponse Disable Spike Protection
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/spike-protections/ (the `DisableSpikeProtection` operationId).
-	DisableSpikeProtectionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body DisableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableSpikeProtectionResponse, error)
+	var pathParam0 string
 
-	// EnableSpikeProtectionWithBodyWithResponse Enable Spike Protection
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/spike-protections/ (the `EnableSpikeProtection` operationId).
-	EnableSpikeProtectionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnableSpikeProtectionResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// EnableSpikeProtectionWithResponse Enable Spike Protection
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/spike-protections/ (the `EnableSpikeProtection` operationId).
-	EnableSpikeProtectionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body EnableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*EnableSpikeProtectionResponse, error)
+	var pathParam1 string
 
-	// ListOrganizationTeamsWithResponse List Teams
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/organizations/{organization_id_or_slug}/teams/ (the `ListOrganizationTeams` operationId).
-	ListOrganizationTeamsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationTeamsParams, reqEditors ...RequestEditorFn) (*ListOrganizationTeamsResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateOrganizationTeamWithBodyWithResponse Create a New Team
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/teams/ (the `CreateOrganizationTeam` operationId).
-	CreateOrganizationTeamWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamResponse, error)
+	var pathParam2 string
 
-	// CreateOrganizationTeamWithResponse Create a New Team
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/teams/ (the `CreateOrganizationTeam` operationId).
-	CreateOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamResponse, error)
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "filter_id", filterId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// ListOrganizationWorkflowsWithResponse List Organization Alerts
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/organizations/{organization_id_or_slug}/workflows/ (the `ListOrganizationWorkflows` operationId).
-	ListOrganizationWorkflowsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationWorkflowsParams, reqEditors ...RequestEditorFn) (*ListOrganizationWorkflowsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateOrganizationWorkflowWithBodyWithResponse Create an Alert for an Organization
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/workflows/ (the `CreateOrganizationWorkflow` operationId).
-	CreateOrganizationWorkflowWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationWorkflowResponse, error)
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/filters/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CreateOrganizationWorkflowWithResponse Create an Alert for an Organization
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/organizations/{organization_id_or_slug}/workflows/ (the `CreateOrganizationWorkflow` operationId).
-	CreateOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationWorkflowResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteOrganizationWorkflowWithResponse Delete an Alert for an Organization
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/workflows/{workflow_id}/ (the `DeleteOrganizationWorkflow` operationId).
-	DeleteOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationWorkflowResponse, error)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetOrganizationWorkflowWithResponse Retrieve an Alert for an Organization
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/organizations/{organization_id_or_slug}/workflows/{workflow_id}/ (the `GetOrganizationWorkflow` operationId).
-	GetOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*GetOrganizationWorkflowResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// UpdateOrganizationWorkflowWithBodyWithResponse Update an Alert for an Organization
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/workflows/{workflow_id}/ (the `UpdateOrganizationWorkflow` operationId).
-	UpdateOrganizationWorkflowWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationWorkflowResponse, error)
+	return req, nil
+}
 
-	// UpdateOrganizationWorkflowWithResponse Update an Alert for an Organization
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/workflows/{workflow_id}/ (the `UpdateOrganizationWorkflow` operationId).
-	UpdateOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, body UpdateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationWorkflowResponse, error)
+// NewListProjectClientKeysRequest constructs an http.Request for the ListProjectClientKeys method
+func NewListProjectClientKeysRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectClientKeysParams) (*http.Request, error) {
+	var err error
 
-	// DeleteOrganizationProjectWithResponse Delete a Project
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ (the `DeleteOrganizationProject` operationId).
-	DeleteOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationProjectResponse, error)
+	var pathParam0 string
 
-	// GetOrganizationProjectWithResponse Retrieve a Project
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ (the `GetOrganizationProject` operationId).
-	GetOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationProjectResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateOrganizationProjectWithBodyWithResponse Update a Project
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ (the `UpdateOrganizationProject` operationId).
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "status", *params.Status, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateProjectClientKeyRequest calls the generic CreateProjectClientKey builder with application/json body
+func NewCreateProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectClientKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectClientKeyRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateProjectClientKeyRequestWithBody constructs an http.Request for the CreateProjectClientKey method, with any body, and a specified content type
+func NewCreateProjectClientKeyRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectClientKeyRequest constructs an http.Request for the DeleteProjectClientKey method
+func NewDeleteProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "key_id", keyId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectClientKeyRequest constructs an http.Request for the GetProjectClientKey method
+func NewGetProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "key_id", keyId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectClientKeyRequest calls the generic UpdateProjectClientKey builder with application/json body
+func NewUpdateProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, body UpdateProjectClientKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectClientKeyRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, keyId, "application/json", bodyReader)
+}
+
+// NewUpdateProjectClientKeyRequestWithBody constructs an http.Request for the UpdateProjectClientKey method, with any body, and a specified content type
+func NewUpdateProjectClientKeyRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "key_id", keyId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetProjectOwnershipRequest constructs an http.Request for the GetProjectOwnership method
+func NewGetProjectOwnershipRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/ownership/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectOwnershipRequest calls the generic UpdateProjectOwnership builder with application/json body
+func NewUpdateProjectOwnershipRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectOwnershipRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+}
+
+// NewUpdateProjectOwnershipRequestWithBody constructs an http.Request for the UpdateProjectOwnership method, with any body, and a specified content type
+func NewUpdateProjectOwnershipRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/ownership/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateProjectRuleRequest calls the generic CreateProjectRule builder with application/json body
+func NewCreateProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectRuleRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateProjectRuleRequestWithBody constructs an http.Request for the CreateProjectRule method, with any body, and a specified content type
+func NewCreateProjectRuleRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectRuleRequest constructs an http.Request for the DeleteProjectRule method
+func NewDeleteProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "rule_id", ruleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectRuleRequest constructs an http.Request for the GetProjectRule method
+func NewGetProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "rule_id", ruleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectRuleRequest calls the generic UpdateProjectRule builder with application/json body
+func NewUpdateProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectRuleRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, ruleId, "application/json", bodyReader)
+}
+
+// NewUpdateProjectRuleRequestWithBody constructs an http.Request for the UpdateProjectRule method, with any body, and a specified content type
+func NewUpdateProjectRuleRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "rule_id", ruleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectSymbolSourceRequest constructs an http.Request for the DeleteProjectSymbolSource method
+func NewDeleteProjectSymbolSourceRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *DeleteProjectSymbolSourceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/symbol-sources/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "id", params.Id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListProjectSymbolSourcesRequest constructs an http.Request for the ListProjectSymbolSources method
+func NewListProjectSymbolSourcesRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectSymbolSourcesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/symbol-sources/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "id", *params.Id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateProjectSymbolSourceRequest calls the generic CreateProjectSymbolSource builder with application/json body
+func NewCreateProjectSymbolSourceRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectSymbolSourceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectSymbolSourceRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateProjectSymbolSourceRequestWithBody constructs an http.Request for the CreateProjectSymbolSource method, with any body, and a specified content type
+func NewCreateProjectSymbolSourceRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/symbol-sources/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateProjectSymbolSourceRequest calls the generic UpdateProjectSymbolSource builder with application/json body
+func NewUpdateProjectSymbolSourceRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *UpdateProjectSymbolSourceParams, body UpdateProjectSymbolSourceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectSymbolSourceRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, params, "application/json", bodyReader)
+}
+
+// NewUpdateProjectSymbolSourceRequestWithBody constructs an http.Request for the UpdateProjectSymbolSource method, with any body, and a specified content type
+func NewUpdateProjectSymbolSourceRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *UpdateProjectSymbolSourceParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/symbol-sources/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "id", params.Id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveTeamFromProjectRequest constructs an http.Request for the RemoveTeamFromProject method
+func NewRemoveTeamFromProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/teams/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddTeamToProjectRequest constructs an http.Request for the AddTeamToProject method
+func NewAddTeamToProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/teams/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteOrganizationTeamRequest constructs an http.Request for the DeleteOrganizationTeam method
+func NewDeleteOrganizationTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/teams/%s/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrganizationTeamRequest constructs an http.Request for the GetOrganizationTeam method
+func NewGetOrganizationTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/teams/%s/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListOrganizationTeamMembersRequest constructs an http.Request for the ListOrganizationTeamMembers method
+func NewListOrganizationTeamMembersRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListOrganizationTeamMembersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/teams/%s/%s/members/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTeamProjectsRequest constructs an http.Request for the ListTeamProjects method
+func NewListTeamProjectsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListTeamProjectsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/teams/%s/%s/projects/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOrganizationTeamProjectRequest calls the generic CreateOrganizationTeamProject builder with application/json body
+func NewCreateOrganizationTeamProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateOrganizationTeamProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationTeamProjectRequestWithBody(server, organizationIdOrSlug, teamIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateOrganizationTeamProjectRequestWithBody constructs an http.Request for the CreateOrganizationTeamProject method, with any body, and a specified content type
+func NewCreateOrganizationTeamProjectRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/teams/%s/%s/projects/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+
+	// HealthCheckWithResponse Health Check
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/internal/health/ (the `HealthCheck` operationId).
+	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
+
+	// CreateOrganizationWithBodyWithResponse Create a New Organization
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/ (the `CreateOrganization` operationId).
+	CreateOrganizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationResponse, error)
+
+	// CreateOrganizationWithResponse Create a New Organization
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/ (the `CreateOrganization` operationId).
+	CreateOrganizationWithResponse(ctx context.Context, body CreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationResponse, error)
+
+	// DeleteOrganizationWithResponse Delete an Organization
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/ (the `DeleteOrganization` operationId).
+	DeleteOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationResponse, error)
+
+	// GetOrganizationWithResponse Retrieve an Organization
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/ (the `GetOrganization` operationId).
+	GetOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error)
+
+	// UpdateOrganizationWithBodyWithResponse Update an Organization
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/ (the `UpdateOrganization` operationId).
+	UpdateOrganizationWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationResponse, error)
+
+	// UpdateOrganizationWithResponse Update an Organization
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/ (the `UpdateOrganization` operationId).
+	UpdateOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationResponse, error)
+
+	// GetOrganizationAlertRuleDetectorWithResponse Retrieve the Monitor Created for a Metric Alert Rule
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/alert-rule-detector/ (the `GetOrganizationAlertRuleDetector` operationId).
+	GetOrganizationAlertRuleDetectorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationAlertRuleDetectorParams, reqEditors ...RequestEditorFn) (*GetOrganizationAlertRuleDetectorResponse, error)
+
+	// GetOrganizationAlertRuleWorkflowWithResponse Retrieve the Alert Created for an Issue or Metric Alert Rule
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/alert-rule-workflow/ (the `GetOrganizationAlertRuleWorkflow` operationId).
+	GetOrganizationAlertRuleWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationAlertRuleWorkflowParams, reqEditors ...RequestEditorFn) (*GetOrganizationAlertRuleWorkflowResponse, error)
+
+	// ListOrganizationDashboardsWithResponse List an Organization's Custom Dashboards
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/dashboards/ (the `ListOrganizationDashboards` operationId).
+	ListOrganizationDashboardsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDashboardsParams, reqEditors ...RequestEditorFn) (*ListOrganizationDashboardsResponse, error)
+
+	// CreateOrganizationDashboardWithBodyWithResponse Create a New Dashboard for an Organization
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/dashboards/ (the `CreateOrganizationDashboard` operationId).
+	CreateOrganizationDashboardWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationDashboardResponse, error)
+
+	// CreateOrganizationDashboardWithResponse Create a New Dashboard for an Organization
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/dashboards/ (the `CreateOrganizationDashboard` operationId).
+	CreateOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationDashboardResponse, error)
+
+	// DeleteOrganizationDashboardWithResponse Delete an Organization's Custom Dashboard
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `DeleteOrganizationDashboard` operationId).
+	DeleteOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*DeleteOrganizationDashboardResponse, error)
+
+	// GetOrganizationDashboardWithResponse Retrieve an Organization's Custom Dashboard
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `GetOrganizationDashboard` operationId).
+	GetOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*GetOrganizationDashboardResponse, error)
+
+	// UpdateOrganizationDashboardWithBodyWithResponse Edit an Organization's Custom Dashboard
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `UpdateOrganizationDashboard` operationId).
+	UpdateOrganizationDashboardWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationDashboardResponse, error)
+
+	// UpdateOrganizationDashboardWithResponse Edit an Organization's Custom Dashboard
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `UpdateOrganizationDashboard` operationId).
+	UpdateOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, body UpdateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationDashboardResponse, error)
+
+	// ListOrganizationMonitorsWithResponse List Monitors for an Organization
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/detectors/ (the `ListOrganizationMonitors` operationId).
+	ListOrganizationMonitorsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams, reqEditors ...RequestEditorFn) (*ListOrganizationMonitorsResponse, error)
+
+	// DeleteProjectMonitorWithResponse Delete a Monitor
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `DeleteProjectMonitor` operationId).
+	DeleteProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*DeleteProjectMonitorResponse, error)
+
+	// GetProjectMonitorWithResponse Retrieve a Monitor
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `GetProjectMonitor` operationId).
+	GetProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*GetProjectMonitorResponse, error)
+
+	// UpdateProjectMonitorWithBodyWithResponse Update a Monitor
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `UpdateProjectMonitor` operationId).
+	UpdateProjectMonitorWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectMonitorResponse, error)
+
+	// UpdateProjectMonitorWithResponse Update a Monitor
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/ (the `UpdateProjectMonitor` operationId).
+	UpdateProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectMonitorResponse, error)
+
+	// CreateOrganizationExternalUserWithBodyWithResponse Create an External User
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/external-users/ (the `CreateOrganizationExternalUser` operationId).
+	CreateOrganizationExternalUserWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationExternalUserResponse, error)
+
+	// CreateOrganizationExternalUserWithResponse Create an External User
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/external-users/ (the `CreateOrganizationExternalUser` operationId).
+	CreateOrganizationExternalUserWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationExternalUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationExternalUserResponse, error)
+
+	// DeleteOrganizationExternalUserWithResponse Delete an External User
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/external-users/{external_user_id}/ (the `DeleteOrganizationExternalUser` operationId).
+	DeleteOrganizationExternalUserWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationExternalUserResponse, error)
+
+	// UpdateOrganizationExternalUserWithBodyWithResponse Update an External User
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/external-users/{external_user_id}/ (the `UpdateOrganizationExternalUser` operationId).
+	UpdateOrganizationExternalUserWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationExternalUserResponse, error)
+
+	// UpdateOrganizationExternalUserWithResponse Update an External User
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/external-users/{external_user_id}/ (the `UpdateOrganizationExternalUser` operationId).
+	UpdateOrganizationExternalUserWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string, body UpdateOrganizationExternalUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationExternalUserResponse, error)
+
+	// ListOrganizationIntegrationsWithResponse List Organization Integrations
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/integrations/ (the `ListOrganizationIntegrations` operationId).
+	ListOrganizationIntegrationsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationIntegrationsParams, reqEditors ...RequestEditorFn) (*ListOrganizationIntegrationsResponse, error)
+
+	// GetOrganizationIntegrationWithResponse Retrieve an Organization Integration
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/integrations/{integration_id}/ (the `GetOrganizationIntegration` operationId).
+	GetOrganizationIntegrationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, reqEditors ...RequestEditorFn) (*GetOrganizationIntegrationResponse, error)
+
+	// UpdateOrganizationIntegrationWithBodyWithResponse Update an Organization Integration
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/integrations/{integration_id}/ (the `UpdateOrganizationIntegration` operationId).
+	UpdateOrganizationIntegrationWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationIntegrationResponse, error)
+
+	// UpdateOrganizationIntegrationWithResponse Update an Organization Integration
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/integrations/{integration_id}/ (the `UpdateOrganizationIntegration` operationId).
+	UpdateOrganizationIntegrationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, body UpdateOrganizationIntegrationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationIntegrationResponse, error)
+
+	// ListOrganizationMembersWithResponse List Organization Members
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/members/ (the `ListOrganizationMembers` operationId).
+	ListOrganizationMembersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMembersParams, reqEditors ...RequestEditorFn) (*ListOrganizationMembersResponse, error)
+
+	// CreateOrganizationMemberWithBodyWithResponse Create an Organization Member
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/members/ (the `CreateOrganizationMember` operationId).
+	CreateOrganizationMemberWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationMemberResponse, error)
+
+	// CreateOrganizationMemberWithResponse Create an Organization Member
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/members/ (the `CreateOrganizationMember` operationId).
+	CreateOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationMemberResponse, error)
+
+	// DeleteOrganizationMemberWithResponse Delete an Organization Member
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/members/{member_id}/ (the `DeleteOrganizationMember` operationId).
+	DeleteOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*DeleteOrganizationMemberResponse, error)
+
+	// GetOrganizationMemberWithResponse Retrieve an Organization Member
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/members/{member_id}/ (the `GetOrganizationMember` operationId).
+	GetOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*GetOrganizationMemberResponse, error)
+
+	// UpdateOrganizationMemberWithBodyWithResponse Update an Organization Member
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/ (the `UpdateOrganizationMember` operationId).
+	UpdateOrganizationMemberWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberResponse, error)
+
+	// UpdateOrganizationMemberWithResponse Update an Organization Member
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/ (the `UpdateOrganizationMember` operationId).
+	UpdateOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, body UpdateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberResponse, error)
+
+	// RemoveOrganizationMemberFromTeamWithResponse Delete an Organization Member from a Team
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `RemoveOrganizationMemberFromTeam` operationId).
+	RemoveOrganizationMemberFromTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*RemoveOrganizationMemberFromTeamResponse, error)
+
+	// AddOrganizationMemberToTeamWithResponse Add an Organization Member to a Team
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `AddOrganizationMemberToTeam` operationId).
+	AddOrganizationMemberToTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*AddOrganizationMemberToTeamResponse, error)
+
+	// UpdateOrganizationMemberTeamRoleWithBodyWithResponse Update an Organization Member's Team Role
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `UpdateOrganizationMemberTeamRole` operationId).
+	UpdateOrganizationMemberTeamRoleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberTeamRoleResponse, error)
+
+	// UpdateOrganizationMemberTeamRoleWithResponse Update an Organization Member's Team Role
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `UpdateOrganizationMemberTeamRole` operationId).
+	UpdateOrganizationMemberTeamRoleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, body UpdateOrganizationMemberTeamRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberTeamRoleResponse, error)
+
+	// CreateOrganizationNotificationActionWithBodyWithResponse Create a Spike Protection Notification Action
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/notifications/actions/ (the `CreateOrganizationNotificationAction` operationId).
+	CreateOrganizationNotificationActionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationNotificationActionResponse, error)
+
+	// CreateOrganizationNotificationActionWithResponse Create a Spike Protection Notification Action
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/notifications/actions/ (the `CreateOrganizationNotificationAction` operationId).
+	CreateOrganizationNotificationActionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationNotificationActionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationNotificationActionResponse, error)
+
+	// DeleteOrganizationNotificationActionWithResponse Delete a Spike Protection Notification Action
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `DeleteOrganizationNotificationAction` operationId).
+	DeleteOrganizationNotificationActionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationNotificationActionResponse, error)
+
+	// GetOrganizationNotificationActionWithResponse Retrieve a Spike Protection Notification Action
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `GetOrganizationNotificationAction` operationId).
+	GetOrganizationNotificationActionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, reqEditors ...RequestEditorFn) (*GetOrganizationNotificationActionResponse, error)
+
+	// UpdateOrganizationNotificationActionWithBodyWithResponse Update a Spike Protection Notification Action
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `UpdateOrganizationNotificationAction` operationId).
+	UpdateOrganizationNotificationActionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationNotificationActionResponse, error)
+
+	// UpdateOrganizationNotificationActionWithResponse Update a Spike Protection Notification Action
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `UpdateOrganizationNotificationAction` operationId).
+	UpdateOrganizationNotificationActionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, body UpdateOrganizationNotificationActionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationNotificationActionResponse, error)
+
+	// ListOrganizationProjectsWithResponse List Organization Projects
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/projects/ (the `ListOrganizationProjects` operationId).
+	ListOrganizationProjectsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams, reqEditors ...RequestEditorFn) (*ListOrganizationProjectsResponse, error)
+
+	// CreateProjectMonitorWithBodyWithResponse Create a Monitor for a Project
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/projects/{project_id_or_slug}/detectors/ (the `CreateProjectMonitor` operationId).
+	CreateProjectMonitorWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectMonitorResponse, error)
+
+	// CreateProjectMonitorWithResponse Create a Monitor for a Project
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/projects/{project_id_or_slug}/detectors/ (the `CreateProjectMonitor` operationId).
+	CreateProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectMonitorResponse, error)
+
+	// ListOrganizationRepositoriesWithResponse List an Organization's Repositories
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/repos/ (the `ListOrganizationRepositories` operationId).
+	ListOrganizationRepositoriesWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationRepositoriesParams, reqEditors ...RequestEditorFn) (*ListOrganizationRepositoriesResponse, error)
+
+	// CreateOrganizationRepositoryWithBodyWithResponse Add a Repository to an Organization
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/repos/ (the `CreateOrganizationRepository` operationId).
+	CreateOrganizationRepositoryWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationRepositoryResponse, error)
+
+	// CreateOrganizationRepositoryWithResponse Add a Repository to an Organization
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/repos/ (the `CreateOrganizationRepository` operationId).
+	CreateOrganizationRepositoryWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationRepositoryResponse, error)
+
+	// DeleteOrganizationRepositoryWithResponse Delete a Repository
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/repos/{repo_id}/ (the `DeleteOrganizationRepository` operationId).
+	DeleteOrganizationRepositoryWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, repoId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationRepositoryResponse, error)
+
+	// ListSentryAppInstallationsWithResponse List Sentry App Installations
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/sentry-app-installations/ (the `ListSentryAppInstallations` operationId).
+	ListSentryAppInstallationsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams, reqEditors ...RequestEditorFn) (*ListSentryAppInstallationsResponse, error)
+
+	// DisableSpikeProtectionWithBodyWithResponse Disable Spike Protection
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/spike-protections/ (the `DisableSpikeProtection` operationId).
+	DisableSpikeProtectionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableSpikeProtectionResponse, error)
+
+	// DisableSpikeProtectionWithResponse Disable Spike Protection
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/spike-protections/ (the `DisableSpikeProtection` operationId).
+	DisableSpikeProtectionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body DisableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableSpikeProtectionResponse, error)
+
+	// EnableSpikeProtectionWithBodyWithResponse Enable Spike Protection
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/spike-protections/ (the `EnableSpikeProtection` operationId).
+	EnableSpikeProtectionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnableSpikeProtectionResponse, error)
+
+	// EnableSpikeProtectionWithResponse Enable Spike Protection
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/spike-protections/ (the `EnableSpikeProtection` operationId).
+	EnableSpikeProtectionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body EnableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*EnableSpikeProtectionResponse, error)
+
+	// ListOrganizationTeamsWithResponse List Teams
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/teams/ (the `ListOrganizationTeams` operationId).
+	ListOrganizationTeamsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationTeamsParams, reqEditors ...RequestEditorFn) (*ListOrganizationTeamsResponse, error)
+
+	// CreateOrganizationTeamWithBodyWithResponse Create a New Team
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/teams/ (the `CreateOrganizationTeam` operationId).
+	CreateOrganizationTeamWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamResponse, error)
+
+	// CreateOrganizationTeamWithResponse Create a New Team
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/teams/ (the `CreateOrganizationTeam` operationId).
+	CreateOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamResponse, error)
+
+	// ListOrganizationWorkflowsWithResponse List Organization Alerts
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/workflows/ (the `ListOrganizationWorkflows` operationId).
+	ListOrganizationWorkflowsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationWorkflowsParams, reqEditors ...RequestEditorFn) (*ListOrganizationWorkflowsResponse, error)
+
+	// CreateOrganizationWorkflowWithBodyWithResponse Create an Alert for an Organization
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/workflows/ (the `CreateOrganizationWorkflow` operationId).
+	CreateOrganizationWorkflowWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationWorkflowResponse, error)
+
+	// CreateOrganizationWorkflowWithResponse Create an Alert for an Organization
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/organizations/{organization_id_or_slug}/workflows/ (the `CreateOrganizationWorkflow` operationId).
+	CreateOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationWorkflowResponse, error)
+
+	// DeleteOrganizationWorkflowWithResponse Delete an Alert for an Organization
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/workflows/{workflow_id}/ (the `DeleteOrganizationWorkflow` operationId).
+	DeleteOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationWorkflowResponse, error)
+
+	// GetOrganizationWorkflowWithResponse Retrieve an Alert for an Organization
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/organizations/{organization_id_or_slug}/workflows/{workflow_id}/ (the `GetOrganizationWorkflow` operationId).
+	GetOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*GetOrganizationWorkflowResponse, error)
+
+	// UpdateOrganizationWorkflowWithBodyWithResponse Update an Alert for an Organization
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/workflows/{workflow_id}/ (the `UpdateOrganizationWorkflow` operationId).
+	UpdateOrganizationWorkflowWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationWorkflowResponse, error)
+
+	// UpdateOrganizationWorkflowWithResponse Update an Alert for an Organization
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/organizations/{organization_id_or_slug}/workflows/{workflow_id}/ (the `UpdateOrganizationWorkflow` operationId).
+	UpdateOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, body UpdateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationWorkflowResponse, error)
+
+	// DeleteOrganizationProjectWithResponse Delete a Project
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ (the `DeleteOrganizationProject` operationId).
+	DeleteOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationProjectResponse, error)
+
+	// GetOrganizationProjectWithResponse Retrieve a Project
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ (the `GetOrganizationProject` operationId).
+	GetOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationProjectResponse, error)
+
+	// UpdateOrganizationProjectWithBodyWithResponse Update a Project
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ (the `UpdateOrganizationProject` operationId).
 	UpdateOrganizationProjectWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectResponse, error)
 
-	// UpdateOrganizationProjectWithResponse Update a Project
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ (the `UpdateOrganizationProject` operationId).
-	UpdateOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateOrganizationProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectResponse, error)
+	// UpdateOrganizationProjectWithResponse Update a Project
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ (the `UpdateOrganizationProject` operationId).
+	UpdateOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateOrganizationProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectResponse, error)
+
+	// ListProjectFiltersWithResponse List a Project's Inbound Data Filters
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/ (the `ListProjectFilters` operationId).
+	ListProjectFiltersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*ListProjectFiltersResponse, error)
+
+	// UpdateProjectFilterWithBodyWithResponse Update an Inbound Data Filter
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/{filter_id}/ (the `UpdateProjectFilter` operationId).
+	UpdateProjectFilterWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, filterId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectFilterResponse, error)
+
+	// UpdateProjectFilterWithResponse Update an Inbound Data Filter
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/{filter_id}/ (the `UpdateProjectFilter` operationId).
+	UpdateProjectFilterWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, filterId string, body UpdateProjectFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectFilterResponse, error)
+
+	// ListProjectClientKeysWithResponse List Client Keys
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/ (the `ListProjectClientKeys` operationId).
+	ListProjectClientKeysWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectClientKeysParams, reqEditors ...RequestEditorFn) (*ListProjectClientKeysResponse, error)
+
+	// CreateProjectClientKeyWithBodyWithResponse Create a Client Key
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/ (the `CreateProjectClientKey` operationId).
+	CreateProjectClientKeyWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectClientKeyResponse, error)
+
+	// CreateProjectClientKeyWithResponse Create a Client Key
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/ (the `CreateProjectClientKey` operationId).
+	CreateProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectClientKeyResponse, error)
+
+	// DeleteProjectClientKeyWithResponse Delete a Client Key
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{key_id}/ (the `DeleteProjectClientKey` operationId).
+	DeleteProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*DeleteProjectClientKeyResponse, error)
+
+	// GetProjectClientKeyWithResponse Retrieve a Client Key
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{key_id}/ (the `GetProjectClientKey` operationId).
+	GetProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*GetProjectClientKeyResponse, error)
+
+	// UpdateProjectClientKeyWithBodyWithResponse Update a Client Key
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{key_id}/ (the `UpdateProjectClientKey` operationId).
+	UpdateProjectClientKeyWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectClientKeyResponse, error)
+
+	// UpdateProjectClientKeyWithResponse Update a Client Key
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{key_id}/ (the `UpdateProjectClientKey` operationId).
+	UpdateProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, body UpdateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectClientKeyResponse, error)
+
+	// GetProjectOwnershipWithResponse Retrieve ownership configuration for a project
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/ (the `GetProjectOwnership` operationId).
+	GetProjectOwnershipWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetProjectOwnershipResponse, error)
+
+	// UpdateProjectOwnershipWithBodyWithResponse Update ownership configuration for a project
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/ (the `UpdateProjectOwnership` operationId).
+	UpdateProjectOwnershipWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectOwnershipResponse, error)
+
+	// UpdateProjectOwnershipWithResponse Update ownership configuration for a project
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/ (the `UpdateProjectOwnership` operationId).
+	UpdateProjectOwnershipWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectOwnershipResponse, error)
+
+	// CreateProjectRuleWithBodyWithResponse Create a Rule
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/ (the `CreateProjectRule` operationId).
+	CreateProjectRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectRuleResponse, error)
+
+	// CreateProjectRuleWithResponse Create a Rule
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/ (the `CreateProjectRule` operationId).
+	CreateProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectRuleResponse, error)
+
+	// DeleteProjectRuleWithResponse Delete a Rule
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `DeleteProjectRule` operationId).
+	DeleteProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*DeleteProjectRuleResponse, error)
+
+	// GetProjectRuleWithResponse Retrieve a Rule
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `GetProjectRule` operationId).
+	GetProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*GetProjectRuleResponse, error)
+
+	// UpdateProjectRuleWithBodyWithResponse Update a Rule
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `UpdateProjectRule` operationId).
+	UpdateProjectRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error)
+
+	// UpdateProjectRuleWithResponse Update a Rule
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `UpdateProjectRule` operationId).
+	UpdateProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error)
+
+	// DeleteProjectSymbolSourceWithResponse Delete a Symbol Source from a Project
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `DeleteProjectSymbolSource` operationId).
+	DeleteProjectSymbolSourceWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *DeleteProjectSymbolSourceParams, reqEditors ...RequestEditorFn) (*DeleteProjectSymbolSourceResponse, error)
+
+	// ListProjectSymbolSourcesWithResponse Retrieve a Project's Symbol Sources
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `ListProjectSymbolSources` operationId).
+	ListProjectSymbolSourcesWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectSymbolSourcesParams, reqEditors ...RequestEditorFn) (*ListProjectSymbolSourcesResponse, error)
+
+	// CreateProjectSymbolSourceWithBodyWithResponse Add a Symbol Source to a Project
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `CreateProjectSymbolSource` operationId).
+	CreateProjectSymbolSourceWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectSymbolSourceResponse, error)
+
+	// CreateProjectSymbolSourceWithResponse Add a Symbol Source to a Project
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `CreateProjectSymbolSource` operationId).
+	CreateProjectSymbolSourceWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectSymbolSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectSymbolSourceResponse, error)
+
+	// UpdateProjectSymbolSourceWithBodyWithResponse Update a Project's Symbol Source
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `UpdateProjectSymbolSource` operationId).
+	UpdateProjectSymbolSourceWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *UpdateProjectSymbolSourceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectSymbolSourceResponse, error)
+
+	// UpdateProjectSymbolSourceWithResponse Update a Project's Symbol Source
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `UpdateProjectSymbolSource` operationId).
+	UpdateProjectSymbolSourceWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *UpdateProjectSymbolSourceParams, body UpdateProjectSymbolSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectSymbolSourceResponse, error)
+
+	// RemoveTeamFromProjectWithResponse Remove a Team from a Project
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/teams/{team_id_or_slug}/ (the `RemoveTeamFromProject` operationId).
+	RemoveTeamFromProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*RemoveTeamFromProjectResponse, error)
+
+	// AddTeamToProjectWithResponse Add a Team to a Project
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/teams/{team_id_or_slug}/ (the `AddTeamToProject` operationId).
+	AddTeamToProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*AddTeamToProjectResponse, error)
+
+	// DeleteOrganizationTeamWithResponse Delete a Team
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /0/teams/{organization_id_or_slug}/{team_id_or_slug}/ (the `DeleteOrganizationTeam` operationId).
+	DeleteOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationTeamResponse, error)
+
+	// GetOrganizationTeamWithResponse Retrieve a Team
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/ (the `GetOrganizationTeam` operationId).
+	GetOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationTeamResponse, error)
+
+	// ListOrganizationTeamMembersWithResponse List a Team's Members
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/members/ (the `ListOrganizationTeamMembers` operationId).
+	ListOrganizationTeamMembersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListOrganizationTeamMembersParams, reqEditors ...RequestEditorFn) (*ListOrganizationTeamMembersResponse, error)
+
+	// ListTeamProjectsWithResponse List a Team's Projects
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/ (the `ListTeamProjects` operationId).
+	ListTeamProjectsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListTeamProjectsParams, reqEditors ...RequestEditorFn) (*ListTeamProjectsResponse, error)
+
+	// CreateOrganizationTeamProjectWithBodyWithResponse Create a Project
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/ (the `CreateOrganizationTeamProject` operationId).
+	CreateOrganizationTeamProjectWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamProjectResponse, error)
+
+	// CreateOrganizationTeamProjectWithResponse Create a Project
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/ (the `CreateOrganizationTeamProject` operationId).
+	CreateOrganizationTeamProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateOrganizationTeamProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamProjectResponse, error)
+}
+
+type HealthCheckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// GetBody returns the raw response body bytes
+func (r HealthCheckResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthCheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthCheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r HealthCheckResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *Organization
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreateOrganizationResponse) GetJSON201() *Organization {
+	return r.JSON201
+}
+
+// GetBody returns the raw response body bytes
+func (r CreateOrganizationResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// GetBody returns the raw response body bytes
+func (r DeleteOrganizationResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteOrganizationResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Organization
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetOrganizationResponse) GetJSON200() *Organization {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r GetOrganizationResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *Organization
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r UpdateOrganizationResponse) GetJSON200() *Organization {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r UpdateOrganizationResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateOrganizationResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationAlertRuleDetectorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *OrganizationAlertRuleDetector
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetOrganizationAlertRuleDetectorResponse) GetJSON200() *OrganizationAlertRuleDetector {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r GetOrganizationAlertRuleDetectorResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationAlertRuleDetectorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationAlertRuleDetectorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationAlertRuleDetectorResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationAlertRuleWorkflowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *OrganizationAlertRuleWorkflow
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetOrganizationAlertRuleWorkflowResponse) GetJSON200() *OrganizationAlertRuleWorkflow {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r GetOrganizationAlertRuleWorkflowResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationAlertRuleWorkflowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationAlertRuleWorkflowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// ListProjectClientKeysWithResponse List Client Keys
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/ (the `ListProjectClientKeys` operationId).
-	ListProjectClientKeysWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectClientKeysParams, reqEditors ...RequestEditorFn) (*ListProjectClientKeysResponse, error)
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationAlertRuleWorkflowResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	// CreateProjectClientKeyWithBodyWithResponse Create a Client Key
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/ (the `CreateProjectClientKey` operationId).
-	CreateProjectClientKeyWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectClientKeyResponse, error)
+type ListOrganizationDashboardsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]OrganizationDashboardListItem
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListOrganizationDashboardsResponse) GetJSON200() *[]OrganizationDashboardListItem {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r ListOrganizationDashboardsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationDashboardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationDashboardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationDashboardsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateOrganizationDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *OrganizationDashboard
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreateOrganizationDashboardResponse) GetJSON201() *OrganizationDashboard {
+	return r.JSON201
+}
+
+// GetBody returns the raw response body bytes
+func (r CreateOrganizationDashboardResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationDashboardResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteOrganizationDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// GetBody returns the raw response body bytes
+func (r DeleteOrganizationDashboardResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrganizationDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrganizationDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteOrganizationDashboardResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *OrganizationDashboard
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetOrganizationDashboardResponse) GetJSON200() *OrganizationDashboard {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r GetOrganizationDashboardResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationDashboardResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateOrganizationDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *OrganizationDashboard
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r UpdateOrganizationDashboardResponse) GetJSON200() *OrganizationDashboard {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r UpdateOrganizationDashboardResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateOrganizationDashboardResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListOrganizationMonitorsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]ProjectMonitor
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListOrganizationMonitorsResponse) GetJSON200() *[]ProjectMonitor {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r ListOrganizationMonitorsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationMonitorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationMonitorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationMonitorsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteProjectMonitorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// GetBody returns the raw response body bytes
+func (r DeleteProjectMonitorResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectMonitorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// CreateProjectClientKeyWithResponse Create a Client Key
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/ (the `CreateProjectClientKey` operationId).
-	CreateProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectClientKeyResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectMonitorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DeleteProjectClientKeyWithResponse Delete a Client Key
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{key_id}/ (the `DeleteProjectClientKey` operationId).
-	DeleteProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*DeleteProjectClientKeyResponse, error)
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteProjectMonitorResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	// GetProjectClientKeyWithResponse Retrieve a Client Key
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{key_id}/ (the `GetProjectClientKey` operationId).
-	GetProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*GetProjectClientKeyResponse, error)
+type GetProjectMonitorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ProjectMonitor
+}
 
-	// UpdateProjectClientKeyWithBodyWithResponse Update a Client Key
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{key_id}/ (the `UpdateProjectClientKey` operationId).
-	UpdateProjectClientKeyWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectClientKeyResponse, error)
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetProjectMonitorResponse) GetJSON200() *ProjectMonitor {
+	return r.JSON200
+}
 
-	// UpdateProjectClientKeyWithResponse Update a Client Key
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{key_id}/ (the `UpdateProjectClientKey` operationId).
-	UpdateProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, body UpdateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectClientKeyResponse, error)
+// GetBody returns the raw response body bytes
+func (r GetProjectMonitorResponse) GetBody() []byte {
+	return r.Body
+}
 
-	// GetProjectOwnershipWithResponse Retrieve ownership configuration for a project
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership (the `GetProjectOwnership` operationId).
-	GetProjectOwnershipWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetProjectOwnershipResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetProjectMonitorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UpdateProjectOwnershipWithBodyWithResponse Update ownership configuration for a project
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership (the `UpdateProjectOwnership` operationId).
-	UpdateProjectOwnershipWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectOwnershipResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectMonitorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// UpdateProjectOwnershipWithResponse Update ownership configuration for a project
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership (the `UpdateProjectOwnership` operationId).
-	UpdateProjectOwnershipWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectOwnershipResponse, error)
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetProjectMonitorResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	// CreateProjectRuleWithBodyWithResponse Create a Rule
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/ (the `CreateProjectRule` operationId).
-	CreateProjectRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectRuleResponse, error)
+type UpdateProjectMonitorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ProjectMonitor
+}
 
-	// CreateProjectRuleWithResponse Create a Rule
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/ (the `CreateProjectRule` operationId).
-	CreateProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectRuleResponse, error)
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r UpdateProjectMonitorResponse) GetJSON200() *ProjectMonitor {
+	return r.JSON200
+}
 
-	// DeleteProjectRuleWithResponse Delete a Rule
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `DeleteProjectRule` operationId).
-	DeleteProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*DeleteProjectRuleResponse, error)
+// GetBody returns the raw response body bytes
+func (r UpdateProjectMonitorResponse) GetBody() []byte {
+	return r.Body
+}
 
-	// GetProjectRuleWithResponse Retrieve a Rule
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `GetProjectRule` operationId).
-	GetProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*GetProjectRuleResponse, error)
+// Status returns HTTPResponse.Status
+func (r UpdateProjectMonitorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UpdateProjectRuleWithBodyWithResponse Update a Rule
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `UpdateProjectRule` operationId).
-	UpdateProjectRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectMonitorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// UpdateProjectRuleWithResponse Update a Rule
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `UpdateProjectRule` operationId).
-	UpdateProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error)
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProjectMonitorResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
 
-	// RemoveTeamFromProjectWithResponse Remove a Team from a Project
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/teams/{team_id_or_slug}/ (the `RemoveTeamFromProject` operationId).
-	RemoveTeamFromProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*RemoveTeamFromProjectResponse, error)
+type CreateOrganizationExternalUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *ExternalUser
+}
 
-	// AddTeamToProjectWithResponse Add a Team to a Project
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/teams/{team_id_or_slug}/ (the `AddTeamToProject` operationId).
-	AddTeamToProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*AddTeamToProjectResponse, error)
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreateOrganizationExternalUserResponse) GetJSON201() *ExternalUser {
+	return r.JSON201
+}
 
-	// DeleteOrganizationTeamWithResponse Delete a Team
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with DELETE /0/teams/{organization_id_or_slug}/{team_id_or_slug}/ (the `DeleteOrganizationTeam` operationId).
-	DeleteOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationTeamResponse, error)
+// GetBody returns the raw response body bytes
+func (r CreateOrganizationExternalUserResponse) GetBody() []byte {
+	return r.Body
+}
 
-	// GetOrganizationTeamWithResponse Retrieve a Team
-	//
-	// Returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/ (the `GetOrganizationTeam` operationId).
-	GetOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationTeamResponse, error)
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationExternalUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// CreateOrganizationTeamProjectWithBodyWithResponse Create a Project
-	//
-	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/ (the `CreateOrganizationTeamProject` operationId).
-	CreateOrganizationTeamProjectWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamProjectResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationExternalUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CreateOrganizationTeamProjectWithResponse Create a Project
-	//
-	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-	//
-	// Corresponds with POST /0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/ (the `CreateOrganizationTeamProject` operationId).
-	CreateOrganizationTeamProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateOrganizationTeamProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamProjectResponse, error)
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationExternalUserResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
 }
 
-type HealthCheckResponse struct {
+type DeleteOrganizationExternalUserResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // GetBody returns the raw response body bytes
-func (r HealthCheckResponse) GetBody() []byte {
+func (r DeleteOrganizationExternalUserResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r HealthCheckResponse) Status() string {
+func (r DeleteOrganizationExternalUserResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11958,7 +15555,7 @@ func (r HealthCheckResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r HealthCheckResponse) StatusCode() int {
+func (r DeleteOrganizationExternalUserResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -11966,32 +15563,32 @@ func (r HealthCheckResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r HealthCheckResponse) ContentType() string {
+func (r DeleteOrganizationExternalUserResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type CreateOrganizationResponse struct {
+type UpdateOrganizationExternalUserResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	// JSON201 the response for an HTTP 201 `application/json` response
-	JSON201 *Organization
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ExternalUser
 }
 
-// GetJSON201 returns the response for an HTTP 201 `application/json` response
-func (r CreateOrganizationResponse) GetJSON201() *Organization {
-	return r.JSON201
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r UpdateOrganizationExternalUserResponse) GetJSON200() *ExternalUser {
+	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r CreateOrganizationResponse) GetBody() []byte {
+func (r UpdateOrganizationExternalUserResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateOrganizationResponse) Status() string {
+func (r UpdateOrganizationExternalUserResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -11999,7 +15596,7 @@ func (r CreateOrganizationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateOrganizationResponse) StatusCode() int {
+func (r UpdateOrganizationExternalUserResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12007,25 +15604,32 @@ func (r CreateOrganizationResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateOrganizationResponse) ContentType() string {
+func (r UpdateOrganizationExternalUserResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type DeleteOrganizationResponse struct {
+type ListOrganizationIntegrationsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]OrganizationIntegration
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListOrganizationIntegrationsResponse) GetJSON200() *[]OrganizationIntegration {
+	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r DeleteOrganizationResponse) GetBody() []byte {
+func (r ListOrganizationIntegrationsResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteOrganizationResponse) Status() string {
+func (r ListOrganizationIntegrationsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12033,7 +15637,7 @@ func (r DeleteOrganizationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteOrganizationResponse) StatusCode() int {
+func (r ListOrganizationIntegrationsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12041,32 +15645,32 @@ func (r DeleteOrganizationResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteOrganizationResponse) ContentType() string {
+func (r ListOrganizationIntegrationsResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetOrganizationResponse struct {
+type GetOrganizationIntegrationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *Organization
+	JSON200 *OrganizationIntegration
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r GetOrganizationResponse) GetJSON200() *Organization {
+func (r GetOrganizationIntegrationResponse) GetJSON200() *OrganizationIntegration {
 	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r GetOrganizationResponse) GetBody() []byte {
+func (r GetOrganizationIntegrationResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r GetOrganizationResponse) Status() string {
+func (r GetOrganizationIntegrationResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12074,7 +15678,7 @@ func (r GetOrganizationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetOrganizationResponse) StatusCode() int {
+func (r GetOrganizationIntegrationResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12082,32 +15686,25 @@ func (r GetOrganizationResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetOrganizationResponse) ContentType() string {
+func (r GetOrganizationIntegrationResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type UpdateOrganizationResponse struct {
+type UpdateOrganizationIntegrationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *Organization
-}
-
-// GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r UpdateOrganizationResponse) GetJSON200() *Organization {
-	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r UpdateOrganizationResponse) GetBody() []byte {
+func (r UpdateOrganizationIntegrationResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateOrganizationResponse) Status() string {
+func (r UpdateOrganizationIntegrationResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12115,7 +15712,7 @@ func (r UpdateOrganizationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateOrganizationResponse) StatusCode() int {
+func (r UpdateOrganizationIntegrationResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12123,32 +15720,32 @@ func (r UpdateOrganizationResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateOrganizationResponse) ContentType() string {
+func (r UpdateOrganizationIntegrationResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type ListOrganizationMonitorsResponse struct {
+type ListOrganizationMembersResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *[]ProjectMonitor
+	JSON200 *[]OrganizationMember
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r ListOrganizationMonitorsResponse) GetJSON200() *[]ProjectMonitor {
+func (r ListOrganizationMembersResponse) GetJSON200() *[]OrganizationMember {
 	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r ListOrganizationMonitorsResponse) GetBody() []byte {
+func (r ListOrganizationMembersResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r ListOrganizationMonitorsResponse) Status() string {
+func (r ListOrganizationMembersResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12156,7 +15753,7 @@ func (r ListOrganizationMonitorsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListOrganizationMonitorsResponse) StatusCode() int {
+func (r ListOrganizationMembersResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12164,25 +15761,32 @@ func (r ListOrganizationMonitorsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListOrganizationMonitorsResponse) ContentType() string {
+func (r ListOrganizationMembersResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type DeleteProjectMonitorResponse struct {
+type CreateOrganizationMemberResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *OrganizationMember
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreateOrganizationMemberResponse) GetJSON201() *OrganizationMember {
+	return r.JSON201
 }
 
 // GetBody returns the raw response body bytes
-func (r DeleteProjectMonitorResponse) GetBody() []byte {
+func (r CreateOrganizationMemberResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteProjectMonitorResponse) Status() string {
+func (r CreateOrganizationMemberResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12190,7 +15794,7 @@ func (r DeleteProjectMonitorResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProjectMonitorResponse) StatusCode() int {
+func (r CreateOrganizationMemberResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12198,32 +15802,25 @@ func (r DeleteProjectMonitorResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteProjectMonitorResponse) ContentType() string {
+func (r CreateOrganizationMemberResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetProjectMonitorResponse struct {
+type DeleteOrganizationMemberResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *ProjectMonitor
-}
-
-// GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r GetProjectMonitorResponse) GetJSON200() *ProjectMonitor {
-	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r GetProjectMonitorResponse) GetBody() []byte {
+func (r DeleteOrganizationMemberResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r GetProjectMonitorResponse) Status() string {
+func (r DeleteOrganizationMemberResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12231,7 +15828,7 @@ func (r GetProjectMonitorResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectMonitorResponse) StatusCode() int {
+func (r DeleteOrganizationMemberResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12239,32 +15836,32 @@ func (r GetProjectMonitorResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetProjectMonitorResponse) ContentType() string {
+func (r DeleteOrganizationMemberResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type UpdateProjectMonitorResponse struct {
+type GetOrganizationMemberResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *ProjectMonitor
+	JSON200 *OrganizationMemberWithRoles
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r UpdateProjectMonitorResponse) GetJSON200() *ProjectMonitor {
+func (r GetOrganizationMemberResponse) GetJSON200() *OrganizationMemberWithRoles {
 	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r UpdateProjectMonitorResponse) GetBody() []byte {
+func (r GetOrganizationMemberResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateProjectMonitorResponse) Status() string {
+func (r GetOrganizationMemberResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12272,7 +15869,7 @@ func (r UpdateProjectMonitorResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateProjectMonitorResponse) StatusCode() int {
+func (r GetOrganizationMemberResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12280,32 +15877,32 @@ func (r UpdateProjectMonitorResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateProjectMonitorResponse) ContentType() string {
+func (r GetOrganizationMemberResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type CreateOrganizationExternalUserResponse struct {
+type UpdateOrganizationMemberResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	// JSON201 the response for an HTTP 201 `application/json` response
-	JSON201 *ExternalUser
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *OrganizationMemberWithRoles
 }
 
-// GetJSON201 returns the response for an HTTP 201 `application/json` response
-func (r CreateOrganizationExternalUserResponse) GetJSON201() *ExternalUser {
-	return r.JSON201
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r UpdateOrganizationMemberResponse) GetJSON200() *OrganizationMemberWithRoles {
+	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r CreateOrganizationExternalUserResponse) GetBody() []byte {
+func (r UpdateOrganizationMemberResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateOrganizationExternalUserResponse) Status() string {
+func (r UpdateOrganizationMemberResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12313,7 +15910,7 @@ func (r CreateOrganizationExternalUserResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateOrganizationExternalUserResponse) StatusCode() int {
+func (r UpdateOrganizationMemberResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12321,25 +15918,25 @@ func (r CreateOrganizationExternalUserResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateOrganizationExternalUserResponse) ContentType() string {
+func (r UpdateOrganizationMemberResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type DeleteOrganizationExternalUserResponse struct {
+type RemoveOrganizationMemberFromTeamResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // GetBody returns the raw response body bytes
-func (r DeleteOrganizationExternalUserResponse) GetBody() []byte {
+func (r RemoveOrganizationMemberFromTeamResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteOrganizationExternalUserResponse) Status() string {
+func (r RemoveOrganizationMemberFromTeamResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12347,7 +15944,7 @@ func (r DeleteOrganizationExternalUserResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteOrganizationExternalUserResponse) StatusCode() int {
+func (r RemoveOrganizationMemberFromTeamResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12355,32 +15952,25 @@ func (r DeleteOrganizationExternalUserResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteOrganizationExternalUserResponse) ContentType() string {
+func (r RemoveOrganizationMemberFromTeamResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type UpdateOrganizationExternalUserResponse struct {
+type AddOrganizationMemberToTeamResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *ExternalUser
-}
-
-// GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r UpdateOrganizationExternalUserResponse) GetJSON200() *ExternalUser {
-	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r UpdateOrganizationExternalUserResponse) GetBody() []byte {
+func (r AddOrganizationMemberToTeamResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateOrganizationExternalUserResponse) Status() string {
+func (r AddOrganizationMemberToTeamResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12388,7 +15978,7 @@ func (r UpdateOrganizationExternalUserResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateOrganizationExternalUserResponse) StatusCode() int {
+func (r AddOrganizationMemberToTeamResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12396,32 +15986,32 @@ func (r UpdateOrganizationExternalUserResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateOrganizationExternalUserResponse) ContentType() string {
+func (r AddOrganizationMemberToTeamResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type ListOrganizationIntegrationsResponse struct {
+type UpdateOrganizationMemberTeamRoleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *[]OrganizationIntegration
+	JSON200 *OrganizationMemberTeam
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r ListOrganizationIntegrationsResponse) GetJSON200() *[]OrganizationIntegration {
+func (r UpdateOrganizationMemberTeamRoleResponse) GetJSON200() *OrganizationMemberTeam {
 	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r ListOrganizationIntegrationsResponse) GetBody() []byte {
+func (r UpdateOrganizationMemberTeamRoleResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r ListOrganizationIntegrationsResponse) Status() string {
+func (r UpdateOrganizationMemberTeamRoleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12429,7 +16019,7 @@ func (r ListOrganizationIntegrationsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListOrganizationIntegrationsResponse) StatusCode() int {
+func (r UpdateOrganizationMemberTeamRoleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12437,32 +16027,32 @@ func (r ListOrganizationIntegrationsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListOrganizationIntegrationsResponse) ContentType() string {
+func (r UpdateOrganizationMemberTeamRoleResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetOrganizationIntegrationResponse struct {
+type CreateOrganizationNotificationActionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *OrganizationIntegration
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *NotificationAction
 }
 
-// GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r GetOrganizationIntegrationResponse) GetJSON200() *OrganizationIntegration {
-	return r.JSON200
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreateOrganizationNotificationActionResponse) GetJSON201() *NotificationAction {
+	return r.JSON201
 }
 
 // GetBody returns the raw response body bytes
-func (r GetOrganizationIntegrationResponse) GetBody() []byte {
+func (r CreateOrganizationNotificationActionResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r GetOrganizationIntegrationResponse) Status() string {
+func (r CreateOrganizationNotificationActionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12470,7 +16060,7 @@ func (r GetOrganizationIntegrationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetOrganizationIntegrationResponse) StatusCode() int {
+func (r CreateOrganizationNotificationActionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12478,25 +16068,25 @@ func (r GetOrganizationIntegrationResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetOrganizationIntegrationResponse) ContentType() string {
+func (r CreateOrganizationNotificationActionResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type UpdateOrganizationIntegrationResponse struct {
+type DeleteOrganizationNotificationActionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // GetBody returns the raw response body bytes
-func (r UpdateOrganizationIntegrationResponse) GetBody() []byte {
+func (r DeleteOrganizationNotificationActionResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateOrganizationIntegrationResponse) Status() string {
+func (r DeleteOrganizationNotificationActionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12504,7 +16094,7 @@ func (r UpdateOrganizationIntegrationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateOrganizationIntegrationResponse) StatusCode() int {
+func (r DeleteOrganizationNotificationActionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12512,32 +16102,32 @@ func (r UpdateOrganizationIntegrationResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateOrganizationIntegrationResponse) ContentType() string {
+func (r DeleteOrganizationNotificationActionResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type ListOrganizationMembersResponse struct {
+type GetOrganizationNotificationActionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *[]OrganizationMember
+	JSON200 *NotificationAction
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r ListOrganizationMembersResponse) GetJSON200() *[]OrganizationMember {
+func (r GetOrganizationNotificationActionResponse) GetJSON200() *NotificationAction {
 	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r ListOrganizationMembersResponse) GetBody() []byte {
+func (r GetOrganizationNotificationActionResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r ListOrganizationMembersResponse) Status() string {
+func (r GetOrganizationNotificationActionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12545,7 +16135,7 @@ func (r ListOrganizationMembersResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListOrganizationMembersResponse) StatusCode() int {
+func (r GetOrganizationNotificationActionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12553,32 +16143,32 @@ func (r ListOrganizationMembersResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListOrganizationMembersResponse) ContentType() string {
+func (r GetOrganizationNotificationActionResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type CreateOrganizationMemberResponse struct {
+type UpdateOrganizationNotificationActionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	// JSON201 the response for an HTTP 201 `application/json` response
-	JSON201 *OrganizationMember
+	// JSON202 the response for an HTTP 202 `application/json` response
+	JSON202 *NotificationAction
 }
 
-// GetJSON201 returns the response for an HTTP 201 `application/json` response
-func (r CreateOrganizationMemberResponse) GetJSON201() *OrganizationMember {
-	return r.JSON201
+// GetJSON202 returns the response for an HTTP 202 `application/json` response
+func (r UpdateOrganizationNotificationActionResponse) GetJSON202() *NotificationAction {
+	return r.JSON202
 }
 
 // GetBody returns the raw response body bytes
-func (r CreateOrganizationMemberResponse) GetBody() []byte {
+func (r UpdateOrganizationNotificationActionResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateOrganizationMemberResponse) Status() string {
+func (r UpdateOrganizationNotificationActionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12586,7 +16176,7 @@ func (r CreateOrganizationMemberResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateOrganizationMemberResponse) StatusCode() int {
+func (r UpdateOrganizationNotificationActionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12594,25 +16184,32 @@ func (r CreateOrganizationMemberResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateOrganizationMemberResponse) ContentType() string {
+func (r UpdateOrganizationNotificationActionResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type DeleteOrganizationMemberResponse struct {
+type ListOrganizationProjectsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]Project
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListOrganizationProjectsResponse) GetJSON200() *[]Project {
+	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r DeleteOrganizationMemberResponse) GetBody() []byte {
+func (r ListOrganizationProjectsResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteOrganizationMemberResponse) Status() string {
+func (r ListOrganizationProjectsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12620,7 +16217,7 @@ func (r DeleteOrganizationMemberResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteOrganizationMemberResponse) StatusCode() int {
+func (r ListOrganizationProjectsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12628,32 +16225,32 @@ func (r DeleteOrganizationMemberResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteOrganizationMemberResponse) ContentType() string {
+func (r ListOrganizationProjectsResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetOrganizationMemberResponse struct {
+type CreateProjectMonitorResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *OrganizationMemberWithRoles
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *ProjectMonitor
 }
 
-// GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r GetOrganizationMemberResponse) GetJSON200() *OrganizationMemberWithRoles {
-	return r.JSON200
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreateProjectMonitorResponse) GetJSON201() *ProjectMonitor {
+	return r.JSON201
 }
 
 // GetBody returns the raw response body bytes
-func (r GetOrganizationMemberResponse) GetBody() []byte {
+func (r CreateProjectMonitorResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r GetOrganizationMemberResponse) Status() string {
+func (r CreateProjectMonitorResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12661,7 +16258,7 @@ func (r GetOrganizationMemberResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetOrganizationMemberResponse) StatusCode() int {
+func (r CreateProjectMonitorResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12669,32 +16266,32 @@ func (r GetOrganizationMemberResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetOrganizationMemberResponse) ContentType() string {
+func (r CreateProjectMonitorResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type UpdateOrganizationMemberResponse struct {
+type ListOrganizationRepositoriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *OrganizationMemberWithRoles
+	JSON200 *[]OrganizationRepository
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r UpdateOrganizationMemberResponse) GetJSON200() *OrganizationMemberWithRoles {
+func (r ListOrganizationRepositoriesResponse) GetJSON200() *[]OrganizationRepository {
 	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r UpdateOrganizationMemberResponse) GetBody() []byte {
+func (r ListOrganizationRepositoriesResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateOrganizationMemberResponse) Status() string {
+func (r ListOrganizationRepositoriesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12702,7 +16299,7 @@ func (r UpdateOrganizationMemberResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateOrganizationMemberResponse) StatusCode() int {
+func (r ListOrganizationRepositoriesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12710,32 +16307,32 @@ func (r UpdateOrganizationMemberResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateOrganizationMemberResponse) ContentType() string {
+func (r ListOrganizationRepositoriesResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type ListOrganizationProjectsResponse struct {
+type CreateOrganizationRepositoryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *[]Project
-}
-
-// GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r ListOrganizationProjectsResponse) GetJSON200() *[]Project {
-	return r.JSON200
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *OrganizationRepository
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreateOrganizationRepositoryResponse) GetJSON201() *OrganizationRepository {
+	return r.JSON201
 }
 
 // GetBody returns the raw response body bytes
-func (r ListOrganizationProjectsResponse) GetBody() []byte {
+func (r CreateOrganizationRepositoryResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r ListOrganizationProjectsResponse) Status() string {
+func (r CreateOrganizationRepositoryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12743,7 +16340,7 @@ func (r ListOrganizationProjectsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListOrganizationProjectsResponse) StatusCode() int {
+func (r CreateOrganizationRepositoryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12751,32 +16348,25 @@ func (r ListOrganizationProjectsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListOrganizationProjectsResponse) ContentType() string {
+func (r CreateOrganizationRepositoryResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type CreateProjectMonitorResponse struct {
+type DeleteOrganizationRepositoryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	// JSON201 the response for an HTTP 201 `application/json` response
-	JSON201 *ProjectMonitor
-}
-
-// GetJSON201 returns the response for an HTTP 201 `application/json` response
-func (r CreateProjectMonitorResponse) GetJSON201() *ProjectMonitor {
-	return r.JSON201
 }
 
 // GetBody returns the raw response body bytes
-func (r CreateProjectMonitorResponse) GetBody() []byte {
+func (r DeleteOrganizationRepositoryResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateProjectMonitorResponse) Status() string {
+func (r DeleteOrganizationRepositoryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -12784,7 +16374,7 @@ func (r CreateProjectMonitorResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateProjectMonitorResponse) StatusCode() int {
+func (r DeleteOrganizationRepositoryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -12792,7 +16382,7 @@ func (r CreateProjectMonitorResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateProjectMonitorResponse) ContentType() string {
+func (r DeleteOrganizationRepositoryResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
@@ -13304,6 +16894,81 @@ func (r UpdateOrganizationProjectResponse) ContentType() string {
 	return ""
 }
 
+type ListProjectFiltersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]ProjectFilter
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListProjectFiltersResponse) GetJSON200() *[]ProjectFilter {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r ListProjectFiltersResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectFiltersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectFiltersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListProjectFiltersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateProjectFilterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// GetBody returns the raw response body bytes
+func (r UpdateProjectFilterResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectFilterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectFilterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProjectFilterResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
 type ListProjectClientKeysResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -13515,12 +17180,187 @@ func (r GetProjectOwnershipResponse) GetJSON200() *ProjectOwnership {
 }
 
 // GetBody returns the raw response body bytes
-func (r GetProjectOwnershipResponse) GetBody() []byte {
+func (r GetProjectOwnershipResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectOwnershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectOwnershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetProjectOwnershipResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateProjectOwnershipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ProjectOwnership
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r UpdateProjectOwnershipResponse) GetJSON200() *ProjectOwnership {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r UpdateProjectOwnershipResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectOwnershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectOwnershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProjectOwnershipResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateProjectRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ProjectRule
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *ProjectRule
+	// JSON202 the response for an HTTP 202 `application/json` response
+	JSON202 *struct {
+		Uuid string `json:"uuid"`
+	}
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r CreateProjectRuleResponse) GetJSON200() *ProjectRule {
+	return r.JSON200
+}
+
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreateProjectRuleResponse) GetJSON201() *ProjectRule {
+	return r.JSON201
+}
+
+// GetJSON202 returns the response for an HTTP 202 `application/json` response
+func (r CreateProjectRuleResponse) GetJSON202() *struct {
+	Uuid string `json:"uuid"`
+} {
+	return r.JSON202
+}
+
+// GetBody returns the raw response body bytes
+func (r CreateProjectRuleResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateProjectRuleResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteProjectRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// GetBody returns the raw response body bytes
+func (r DeleteProjectRuleResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteProjectRuleResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetProjectRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ProjectRule
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r GetProjectRuleResponse) GetJSON200() *ProjectRule {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r GetProjectRuleResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r GetProjectOwnershipResponse) Status() string {
+func (r GetProjectRuleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13528,7 +17368,7 @@ func (r GetProjectOwnershipResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectOwnershipResponse) StatusCode() int {
+func (r GetProjectRuleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13536,14 +17376,14 @@ func (r GetProjectOwnershipResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetProjectOwnershipResponse) ContentType() string {
+func (r GetProjectRuleResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type UpdateProjectOwnershipResponse struct {
+type UpdateProjectRuleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
@@ -13551,17 +17391,17 @@ type UpdateProjectOwnershipResponse struct {
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r UpdateProjectOwnershipResponse) GetJSON200() *ProjectRule {
+func (r UpdateProjectRuleResponse) GetJSON200() *ProjectRule {
 	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r UpdateProjectOwnershipResponse) GetBody() []byte {
+func (r UpdateProjectRuleResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateProjectOwnershipResponse) Status() string {
+func (r UpdateProjectRuleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13569,7 +17409,7 @@ func (r UpdateProjectOwnershipResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateProjectOwnershipResponse) StatusCode() int {
+func (r UpdateProjectRuleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13577,50 +17417,25 @@ func (r UpdateProjectOwnershipResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateProjectOwnershipResponse) ContentType() string {
+func (r UpdateProjectRuleResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type CreateProjectRuleResponse struct {
+type DeleteProjectSymbolSourceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *ProjectRule
-	// JSON201 the response for an HTTP 201 `application/json` response
-	JSON201 *ProjectRule
-	// JSON202 the response for an HTTP 202 `application/json` response
-	JSON202 *struct {
-		Uuid string `json:"uuid"`
-	}
-}
-
-// GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r CreateProjectRuleResponse) GetJSON200() *ProjectRule {
-	return r.JSON200
-}
-
-// GetJSON201 returns the response for an HTTP 201 `application/json` response
-func (r CreateProjectRuleResponse) GetJSON201() *ProjectRule {
-	return r.JSON201
-}
-
-// GetJSON202 returns the response for an HTTP 202 `application/json` response
-func (r CreateProjectRuleResponse) GetJSON202() *struct {
-	Uuid string `json:"uuid"`
-} {
-	return r.JSON202
 }
 
 // GetBody returns the raw response body bytes
-func (r CreateProjectRuleResponse) GetBody() []byte {
+func (r DeleteProjectSymbolSourceResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateProjectRuleResponse) Status() string {
+func (r DeleteProjectSymbolSourceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13628,7 +17443,7 @@ func (r CreateProjectRuleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateProjectRuleResponse) StatusCode() int {
+func (r DeleteProjectSymbolSourceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13636,25 +17451,32 @@ func (r CreateProjectRuleResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateProjectRuleResponse) ContentType() string {
+func (r DeleteProjectSymbolSourceResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type DeleteProjectRuleResponse struct {
+type ListProjectSymbolSourcesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]ProjectSymbolSource
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListProjectSymbolSourcesResponse) GetJSON200() *[]ProjectSymbolSource {
+	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r DeleteProjectRuleResponse) GetBody() []byte {
+func (r ListProjectSymbolSourcesResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteProjectRuleResponse) Status() string {
+func (r ListProjectSymbolSourcesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13662,7 +17484,7 @@ func (r DeleteProjectRuleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProjectRuleResponse) StatusCode() int {
+func (r ListProjectSymbolSourcesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13670,32 +17492,32 @@ func (r DeleteProjectRuleResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteProjectRuleResponse) ContentType() string {
+func (r ListProjectSymbolSourcesResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type GetProjectRuleResponse struct {
+type CreateProjectSymbolSourceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *ProjectRule
+	// JSON201 the response for an HTTP 201 `application/json` response
+	JSON201 *ProjectSymbolSource
 }
 
-// GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r GetProjectRuleResponse) GetJSON200() *ProjectRule {
-	return r.JSON200
+// GetJSON201 returns the response for an HTTP 201 `application/json` response
+func (r CreateProjectSymbolSourceResponse) GetJSON201() *ProjectSymbolSource {
+	return r.JSON201
 }
 
 // GetBody returns the raw response body bytes
-func (r GetProjectRuleResponse) GetBody() []byte {
+func (r CreateProjectSymbolSourceResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r GetProjectRuleResponse) Status() string {
+func (r CreateProjectSymbolSourceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13703,7 +17525,7 @@ func (r GetProjectRuleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectRuleResponse) StatusCode() int {
+func (r CreateProjectSymbolSourceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13711,32 +17533,32 @@ func (r GetProjectRuleResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetProjectRuleResponse) ContentType() string {
+func (r CreateProjectSymbolSourceResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type UpdateProjectRuleResponse struct {
+type UpdateProjectSymbolSourceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *ProjectRule
+	JSON200 *ProjectSymbolSource
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r UpdateProjectRuleResponse) GetJSON200() *ProjectRule {
+func (r UpdateProjectSymbolSourceResponse) GetJSON200() *ProjectSymbolSource {
 	return r.JSON200
 }
 
 // GetBody returns the raw response body bytes
-func (r UpdateProjectRuleResponse) GetBody() []byte {
+func (r UpdateProjectSymbolSourceResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateProjectRuleResponse) Status() string {
+func (r UpdateProjectSymbolSourceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -13744,7 +17566,7 @@ func (r UpdateProjectRuleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateProjectRuleResponse) StatusCode() int {
+func (r UpdateProjectSymbolSourceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -13752,7 +17574,7 @@ func (r UpdateProjectRuleResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateProjectRuleResponse) ContentType() string {
+func (r UpdateProjectSymbolSourceResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
@@ -13916,6 +17738,88 @@ func (r GetOrganizationTeamResponse) ContentType() string {
 	return ""
 }
 
+type ListOrganizationTeamMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]TeamMember
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListOrganizationTeamMembersResponse) GetJSON200() *[]TeamMember {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r ListOrganizationTeamMembersResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationTeamMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationTeamMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationTeamMembersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListTeamProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *[]Project
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListTeamProjectsResponse) GetJSON200() *[]Project {
+	return r.JSON200
+}
+
+// GetBody returns the raw response body bytes
+func (r ListTeamProjectsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTeamProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTeamProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListTeamProjectsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
 type CreateOrganizationTeamProjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -13938,114 +17842,231 @@ func (r CreateOrganizationTeamProjectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationTeamProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationTeamProjectResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// HealthCheckWithResponse Health Check
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /0/internal/health/ (the `HealthCheck` operationId).
+func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
+	rsp, err := c.HealthCheck(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthCheckResponse(rsp)
+}
+
+// CreateOrganizationWithBodyWithResponse Create a New Organization
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /0/organizations/ (the `CreateOrganization` operationId).
+func (c *ClientWithResponses) CreateOrganizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationResponse, error) {
+	rsp, err := c.CreateOrganizationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationResponse(rsp)
+}
+
+// CreateOrganizationWithResponse Create a New Organization
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /0/organizations/ (the `CreateOrganization` operationId).
+func (c *ClientWithResponses) CreateOrganizationWithResponse(ctx context.Context, body CreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationResponse, error) {
+	rsp, err := c.CreateOrganization(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationResponse(rsp)
+}
+
+// DeleteOrganizationWithResponse Delete an Organization
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/ (the `DeleteOrganization` operationId).
+func (c *ClientWithResponses) DeleteOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationResponse, error) {
+	rsp, err := c.DeleteOrganization(ctx, organizationIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationResponse(rsp)
+}
+
+// GetOrganizationWithResponse Retrieve an Organization
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/ (the `GetOrganization` operationId).
+func (c *ClientWithResponses) GetOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error) {
+	rsp, err := c.GetOrganization(ctx, organizationIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationResponse(rsp)
+}
+
+// UpdateOrganizationWithBodyWithResponse Update an Organization
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /0/organizations/{organization_id_or_slug}/ (the `UpdateOrganization` operationId).
+func (c *ClientWithResponses) UpdateOrganizationWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationResponse, error) {
+	rsp, err := c.UpdateOrganizationWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationResponse(rsp)
+}
+
+// UpdateOrganizationWithResponse Update an Organization
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /0/organizations/{organization_id_or_slug}/ (the `UpdateOrganization` operationId).
+func (c *ClientWithResponses) UpdateOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationResponse, error) {
+	rsp, err := c.UpdateOrganization(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateOrganizationTeamProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetOrganizationAlertRuleDetectorWithResponse Retrieve the Monitor Created for a Metric Alert Rule
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/alert-rule-detector/ (the `GetOrganizationAlertRuleDetector` operationId).
+func (c *ClientWithResponses) GetOrganizationAlertRuleDetectorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationAlertRuleDetectorParams, reqEditors ...RequestEditorFn) (*GetOrganizationAlertRuleDetectorResponse, error) {
+	rsp, err := c.GetOrganizationAlertRuleDetector(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetOrganizationAlertRuleDetectorResponse(rsp)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateOrganizationTeamProjectResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// GetOrganizationAlertRuleWorkflowWithResponse Retrieve the Alert Created for an Issue or Metric Alert Rule
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/alert-rule-workflow/ (the `GetOrganizationAlertRuleWorkflow` operationId).
+func (c *ClientWithResponses) GetOrganizationAlertRuleWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationAlertRuleWorkflowParams, reqEditors ...RequestEditorFn) (*GetOrganizationAlertRuleWorkflowResponse, error) {
+	rsp, err := c.GetOrganizationAlertRuleWorkflow(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return ParseGetOrganizationAlertRuleWorkflowResponse(rsp)
 }
 
-// HealthCheckWithResponse Health Check
+// ListOrganizationDashboardsWithResponse List an Organization's Custom Dashboards
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /0/internal/health/ (the `HealthCheck` operationId).
-func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
-	rsp, err := c.HealthCheck(ctx, reqEditors...)
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/dashboards/ (the `ListOrganizationDashboards` operationId).
+func (c *ClientWithResponses) ListOrganizationDashboardsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDashboardsParams, reqEditors ...RequestEditorFn) (*ListOrganizationDashboardsResponse, error) {
+	rsp, err := c.ListOrganizationDashboards(ctx, organizationIdOrSlug, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseHealthCheckResponse(rsp)
+	return ParseListOrganizationDashboardsResponse(rsp)
 }
 
-// CreateOrganizationWithBodyWithResponse Create a New Organization
+// CreateOrganizationDashboardWithBodyWithResponse Create a New Dashboard for an Organization
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /0/organizations/ (the `CreateOrganization` operationId).
-func (c *ClientWithResponses) CreateOrganizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationResponse, error) {
-	rsp, err := c.CreateOrganizationWithBody(ctx, contentType, body, reqEditors...)
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/dashboards/ (the `CreateOrganizationDashboard` operationId).
+func (c *ClientWithResponses) CreateOrganizationDashboardWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationDashboardResponse, error) {
+	rsp, err := c.CreateOrganizationDashboardWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateOrganizationResponse(rsp)
+	return ParseCreateOrganizationDashboardResponse(rsp)
 }
 
-// CreateOrganizationWithResponse Create a New Organization
+// CreateOrganizationDashboardWithResponse Create a New Dashboard for an Organization
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /0/organizations/ (the `CreateOrganization` operationId).
-func (c *ClientWithResponses) CreateOrganizationWithResponse(ctx context.Context, body CreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationResponse, error) {
-	rsp, err := c.CreateOrganization(ctx, body, reqEditors...)
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/dashboards/ (the `CreateOrganizationDashboard` operationId).
+func (c *ClientWithResponses) CreateOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationDashboardResponse, error) {
+	rsp, err := c.CreateOrganizationDashboard(ctx, organizationIdOrSlug, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateOrganizationResponse(rsp)
+	return ParseCreateOrganizationDashboardResponse(rsp)
 }
 
-// DeleteOrganizationWithResponse Delete an Organization
+// DeleteOrganizationDashboardWithResponse Delete an Organization's Custom Dashboard
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/ (the `DeleteOrganization` operationId).
-func (c *ClientWithResponses) DeleteOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationResponse, error) {
-	rsp, err := c.DeleteOrganization(ctx, organizationIdOrSlug, reqEditors...)
+// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `DeleteOrganizationDashboard` operationId).
+func (c *ClientWithResponses) DeleteOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*DeleteOrganizationDashboardResponse, error) {
+	rsp, err := c.DeleteOrganizationDashboard(ctx, organizationIdOrSlug, dashboardId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteOrganizationResponse(rsp)
+	return ParseDeleteOrganizationDashboardResponse(rsp)
 }
 
-// GetOrganizationWithResponse Retrieve an Organization
+// GetOrganizationDashboardWithResponse Retrieve an Organization's Custom Dashboard
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /0/organizations/{organization_id_or_slug}/ (the `GetOrganization` operationId).
-func (c *ClientWithResponses) GetOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error) {
-	rsp, err := c.GetOrganization(ctx, organizationIdOrSlug, reqEditors...)
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `GetOrganizationDashboard` operationId).
+func (c *ClientWithResponses) GetOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*GetOrganizationDashboardResponse, error) {
+	rsp, err := c.GetOrganizationDashboard(ctx, organizationIdOrSlug, dashboardId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetOrganizationResponse(rsp)
+	return ParseGetOrganizationDashboardResponse(rsp)
 }
 
-// UpdateOrganizationWithBodyWithResponse Update an Organization
+// UpdateOrganizationDashboardWithBodyWithResponse Edit an Organization's Custom Dashboard
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /0/organizations/{organization_id_or_slug}/ (the `UpdateOrganization` operationId).
-func (c *ClientWithResponses) UpdateOrganizationWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationResponse, error) {
-	rsp, err := c.UpdateOrganizationWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+// Corresponds with PUT /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `UpdateOrganizationDashboard` operationId).
+func (c *ClientWithResponses) UpdateOrganizationDashboardWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationDashboardResponse, error) {
+	rsp, err := c.UpdateOrganizationDashboardWithBody(ctx, organizationIdOrSlug, dashboardId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateOrganizationResponse(rsp)
+	return ParseUpdateOrganizationDashboardResponse(rsp)
 }
 
-// UpdateOrganizationWithResponse Update an Organization
+// UpdateOrganizationDashboardWithResponse Edit an Organization's Custom Dashboard
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /0/organizations/{organization_id_or_slug}/ (the `UpdateOrganization` operationId).
-func (c *ClientWithResponses) UpdateOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationResponse, error) {
-	rsp, err := c.UpdateOrganization(ctx, organizationIdOrSlug, body, reqEditors...)
+// Corresponds with PUT /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/ (the `UpdateOrganizationDashboard` operationId).
+func (c *ClientWithResponses) UpdateOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, body UpdateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationDashboardResponse, error) {
+	rsp, err := c.UpdateOrganizationDashboard(ctx, organizationIdOrSlug, dashboardId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateOrganizationResponse(rsp)
+	return ParseUpdateOrganizationDashboardResponse(rsp)
 }
 
 // ListOrganizationMonitorsWithResponse List Monitors for an Organization
@@ -14321,6 +18342,136 @@ func (c *ClientWithResponses) UpdateOrganizationMemberWithResponse(ctx context.C
 	return ParseUpdateOrganizationMemberResponse(rsp)
 }
 
+// RemoveOrganizationMemberFromTeamWithResponse Delete an Organization Member from a Team
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `RemoveOrganizationMemberFromTeam` operationId).
+func (c *ClientWithResponses) RemoveOrganizationMemberFromTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*RemoveOrganizationMemberFromTeamResponse, error) {
+	rsp, err := c.RemoveOrganizationMemberFromTeam(ctx, organizationIdOrSlug, memberId, teamIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveOrganizationMemberFromTeamResponse(rsp)
+}
+
+// AddOrganizationMemberToTeamWithResponse Add an Organization Member to a Team
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `AddOrganizationMemberToTeam` operationId).
+func (c *ClientWithResponses) AddOrganizationMemberToTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*AddOrganizationMemberToTeamResponse, error) {
+	rsp, err := c.AddOrganizationMemberToTeam(ctx, organizationIdOrSlug, memberId, teamIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddOrganizationMemberToTeamResponse(rsp)
+}
+
+// UpdateOrganizationMemberTeamRoleWithBodyWithResponse Update an Organization Member's Team Role
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `UpdateOrganizationMemberTeamRole` operationId).
+func (c *ClientWithResponses) UpdateOrganizationMemberTeamRoleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberTeamRoleResponse, error) {
+	rsp, err := c.UpdateOrganizationMemberTeamRoleWithBody(ctx, organizationIdOrSlug, memberId, teamIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationMemberTeamRoleResponse(rsp)
+}
+
+// UpdateOrganizationMemberTeamRoleWithResponse Update an Organization Member's Team Role
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/ (the `UpdateOrganizationMemberTeamRole` operationId).
+func (c *ClientWithResponses) UpdateOrganizationMemberTeamRoleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug, body UpdateOrganizationMemberTeamRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberTeamRoleResponse, error) {
+	rsp, err := c.UpdateOrganizationMemberTeamRole(ctx, organizationIdOrSlug, memberId, teamIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationMemberTeamRoleResponse(rsp)
+}
+
+// CreateOrganizationNotificationActionWithBodyWithResponse Create a Spike Protection Notification Action
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/notifications/actions/ (the `CreateOrganizationNotificationAction` operationId).
+func (c *ClientWithResponses) CreateOrganizationNotificationActionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationNotificationActionResponse, error) {
+	rsp, err := c.CreateOrganizationNotificationActionWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationNotificationActionResponse(rsp)
+}
+
+// CreateOrganizationNotificationActionWithResponse Create a Spike Protection Notification Action
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/notifications/actions/ (the `CreateOrganizationNotificationAction` operationId).
+func (c *ClientWithResponses) CreateOrganizationNotificationActionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationNotificationActionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationNotificationActionResponse, error) {
+	rsp, err := c.CreateOrganizationNotificationAction(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationNotificationActionResponse(rsp)
+}
+
+// DeleteOrganizationNotificationActionWithResponse Delete a Spike Protection Notification Action
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `DeleteOrganizationNotificationAction` operationId).
+func (c *ClientWithResponses) DeleteOrganizationNotificationActionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationNotificationActionResponse, error) {
+	rsp, err := c.DeleteOrganizationNotificationAction(ctx, organizationIdOrSlug, actionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationNotificationActionResponse(rsp)
+}
+
+// GetOrganizationNotificationActionWithResponse Retrieve a Spike Protection Notification Action
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `GetOrganizationNotificationAction` operationId).
+func (c *ClientWithResponses) GetOrganizationNotificationActionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, reqEditors ...RequestEditorFn) (*GetOrganizationNotificationActionResponse, error) {
+	rsp, err := c.GetOrganizationNotificationAction(ctx, organizationIdOrSlug, actionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationNotificationActionResponse(rsp)
+}
+
+// UpdateOrganizationNotificationActionWithBodyWithResponse Update a Spike Protection Notification Action
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `UpdateOrganizationNotificationAction` operationId).
+func (c *ClientWithResponses) UpdateOrganizationNotificationActionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationNotificationActionResponse, error) {
+	rsp, err := c.UpdateOrganizationNotificationActionWithBody(ctx, organizationIdOrSlug, actionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationNotificationActionResponse(rsp)
+}
+
+// UpdateOrganizationNotificationActionWithResponse Update a Spike Protection Notification Action
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/ (the `UpdateOrganizationNotificationAction` operationId).
+func (c *ClientWithResponses) UpdateOrganizationNotificationActionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, actionId string, body UpdateOrganizationNotificationActionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationNotificationActionResponse, error) {
+	rsp, err := c.UpdateOrganizationNotificationAction(ctx, organizationIdOrSlug, actionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationNotificationActionResponse(rsp)
+}
+
 // ListOrganizationProjectsWithResponse List Organization Projects
 //
 // Returns a wrapper object for the known response body format(s).
@@ -14360,6 +18511,58 @@ func (c *ClientWithResponses) CreateProjectMonitorWithResponse(ctx context.Conte
 	return ParseCreateProjectMonitorResponse(rsp)
 }
 
+// ListOrganizationRepositoriesWithResponse List an Organization's Repositories
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /0/organizations/{organization_id_or_slug}/repos/ (the `ListOrganizationRepositories` operationId).
+func (c *ClientWithResponses) ListOrganizationRepositoriesWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationRepositoriesParams, reqEditors ...RequestEditorFn) (*ListOrganizationRepositoriesResponse, error) {
+	rsp, err := c.ListOrganizationRepositories(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationRepositoriesResponse(rsp)
+}
+
+// CreateOrganizationRepositoryWithBodyWithResponse Add a Repository to an Organization
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/repos/ (the `CreateOrganizationRepository` operationId).
+func (c *ClientWithResponses) CreateOrganizationRepositoryWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationRepositoryResponse, error) {
+	rsp, err := c.CreateOrganizationRepositoryWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationRepositoryResponse(rsp)
+}
+
+// CreateOrganizationRepositoryWithResponse Add a Repository to an Organization
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /0/organizations/{organization_id_or_slug}/repos/ (the `CreateOrganizationRepository` operationId).
+func (c *ClientWithResponses) CreateOrganizationRepositoryWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationRepositoryResponse, error) {
+	rsp, err := c.CreateOrganizationRepository(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationRepositoryResponse(rsp)
+}
+
+// DeleteOrganizationRepositoryWithResponse Delete a Repository
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /0/organizations/{organization_id_or_slug}/repos/{repo_id}/ (the `DeleteOrganizationRepository` operationId).
+func (c *ClientWithResponses) DeleteOrganizationRepositoryWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, repoId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationRepositoryResponse, error) {
+	rsp, err := c.DeleteOrganizationRepository(ctx, organizationIdOrSlug, repoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationRepositoryResponse(rsp)
+}
+
 // ListSentryAppInstallationsWithResponse List Sentry App Installations
 //
 // Returns a wrapper object for the known response body format(s).
@@ -14607,6 +18810,45 @@ func (c *ClientWithResponses) UpdateOrganizationProjectWithResponse(ctx context.
 	return ParseUpdateOrganizationProjectResponse(rsp)
 }
 
+// ListProjectFiltersWithResponse List a Project's Inbound Data Filters
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/ (the `ListProjectFilters` operationId).
+func (c *ClientWithResponses) ListProjectFiltersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*ListProjectFiltersResponse, error) {
+	rsp, err := c.ListProjectFilters(ctx, organizationIdOrSlug, projectIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectFiltersResponse(rsp)
+}
+
+// UpdateProjectFilterWithBodyWithResponse Update an Inbound Data Filter
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/{filter_id}/ (the `UpdateProjectFilter` operationId).
+func (c *ClientWithResponses) UpdateProjectFilterWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, filterId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectFilterResponse, error) {
+	rsp, err := c.UpdateProjectFilterWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, filterId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectFilterResponse(rsp)
+}
+
+// UpdateProjectFilterWithResponse Update an Inbound Data Filter
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/{filter_id}/ (the `UpdateProjectFilter` operationId).
+func (c *ClientWithResponses) UpdateProjectFilterWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, filterId string, body UpdateProjectFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectFilterResponse, error) {
+	rsp, err := c.UpdateProjectFilter(ctx, organizationIdOrSlug, projectIdOrSlug, filterId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectFilterResponse(rsp)
+}
+
 // ListProjectClientKeysWithResponse List Client Keys
 //
 // Returns a wrapper object for the known response body format(s).
@@ -14702,7 +18944,7 @@ func (c *ClientWithResponses) UpdateProjectClientKeyWithResponse(ctx context.Con
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership (the `GetProjectOwnership` operationId).
+// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/ (the `GetProjectOwnership` operationId).
 func (c *ClientWithResponses) GetProjectOwnershipWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetProjectOwnershipResponse, error) {
 	rsp, err := c.GetProjectOwnership(ctx, organizationIdOrSlug, projectIdOrSlug, reqEditors...)
 	if err != nil {
@@ -14715,7 +18957,7 @@ func (c *ClientWithResponses) GetProjectOwnershipWithResponse(ctx context.Contex
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership (the `UpdateProjectOwnership` operationId).
+// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/ (the `UpdateProjectOwnership` operationId).
 func (c *ClientWithResponses) UpdateProjectOwnershipWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectOwnershipResponse, error) {
 	rsp, err := c.UpdateProjectOwnershipWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
 	if err != nil {
@@ -14728,7 +18970,7 @@ func (c *ClientWithResponses) UpdateProjectOwnershipWithBodyWithResponse(ctx con
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership (the `UpdateProjectOwnership` operationId).
+// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/ (the `UpdateProjectOwnership` operationId).
 func (c *ClientWithResponses) UpdateProjectOwnershipWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectOwnershipResponse, error) {
 	rsp, err := c.UpdateProjectOwnership(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
 	if err != nil {
@@ -14773,46 +19015,124 @@ func (c *ClientWithResponses) DeleteProjectRuleWithResponse(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectRuleResponse(rsp)
+	return ParseDeleteProjectRuleResponse(rsp)
+}
+
+// GetProjectRuleWithResponse Retrieve a Rule
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `GetProjectRule` operationId).
+func (c *ClientWithResponses) GetProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*GetProjectRuleResponse, error) {
+	rsp, err := c.GetProjectRule(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectRuleResponse(rsp)
+}
+
+// UpdateProjectRuleWithBodyWithResponse Update a Rule
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `UpdateProjectRule` operationId).
+func (c *ClientWithResponses) UpdateProjectRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error) {
+	rsp, err := c.UpdateProjectRuleWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectRuleResponse(rsp)
+}
+
+// UpdateProjectRuleWithResponse Update a Rule
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `UpdateProjectRule` operationId).
+func (c *ClientWithResponses) UpdateProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error) {
+	rsp, err := c.UpdateProjectRule(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectRuleResponse(rsp)
+}
+
+// DeleteProjectSymbolSourceWithResponse Delete a Symbol Source from a Project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `DeleteProjectSymbolSource` operationId).
+func (c *ClientWithResponses) DeleteProjectSymbolSourceWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *DeleteProjectSymbolSourceParams, reqEditors ...RequestEditorFn) (*DeleteProjectSymbolSourceResponse, error) {
+	rsp, err := c.DeleteProjectSymbolSource(ctx, organizationIdOrSlug, projectIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectSymbolSourceResponse(rsp)
+}
+
+// ListProjectSymbolSourcesWithResponse Retrieve a Project's Symbol Sources
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `ListProjectSymbolSources` operationId).
+func (c *ClientWithResponses) ListProjectSymbolSourcesWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectSymbolSourcesParams, reqEditors ...RequestEditorFn) (*ListProjectSymbolSourcesResponse, error) {
+	rsp, err := c.ListProjectSymbolSources(ctx, organizationIdOrSlug, projectIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectSymbolSourcesResponse(rsp)
+}
+
+// CreateProjectSymbolSourceWithBodyWithResponse Add a Symbol Source to a Project
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `CreateProjectSymbolSource` operationId).
+func (c *ClientWithResponses) CreateProjectSymbolSourceWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectSymbolSourceResponse, error) {
+	rsp, err := c.CreateProjectSymbolSourceWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectSymbolSourceResponse(rsp)
 }
 
-// GetProjectRuleWithResponse Retrieve a Rule
+// CreateProjectSymbolSourceWithResponse Add a Symbol Source to a Project
 //
-// Returns a wrapper object for the known response body format(s).
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `GetProjectRule` operationId).
-func (c *ClientWithResponses) GetProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*GetProjectRuleResponse, error) {
-	rsp, err := c.GetProjectRule(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, reqEditors...)
+// Corresponds with POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `CreateProjectSymbolSource` operationId).
+func (c *ClientWithResponses) CreateProjectSymbolSourceWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectSymbolSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectSymbolSourceResponse, error) {
+	rsp, err := c.CreateProjectSymbolSource(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetProjectRuleResponse(rsp)
+	return ParseCreateProjectSymbolSourceResponse(rsp)
 }
 
-// UpdateProjectRuleWithBodyWithResponse Update a Rule
+// UpdateProjectSymbolSourceWithBodyWithResponse Update a Project's Symbol Source
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `UpdateProjectRule` operationId).
-func (c *ClientWithResponses) UpdateProjectRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error) {
-	rsp, err := c.UpdateProjectRuleWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, contentType, body, reqEditors...)
+// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `UpdateProjectSymbolSource` operationId).
+func (c *ClientWithResponses) UpdateProjectSymbolSourceWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *UpdateProjectSymbolSourceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectSymbolSourceResponse, error) {
+	rsp, err := c.UpdateProjectSymbolSourceWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectRuleResponse(rsp)
+	return ParseUpdateProjectSymbolSourceResponse(rsp)
 }
 
-// UpdateProjectRuleWithResponse Update a Rule
+// UpdateProjectSymbolSourceWithResponse Update a Project's Symbol Source
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/ (the `UpdateProjectRule` operationId).
-func (c *ClientWithResponses) UpdateProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error) {
-	rsp, err := c.UpdateProjectRule(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, body, reqEditors...)
+// Corresponds with PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/ (the `UpdateProjectSymbolSource` operationId).
+func (c *ClientWithResponses) UpdateProjectSymbolSourceWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *UpdateProjectSymbolSourceParams, body UpdateProjectSymbolSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectSymbolSourceResponse, error) {
+	rsp, err := c.UpdateProjectSymbolSource(ctx, organizationIdOrSlug, projectIdOrSlug, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectRuleResponse(rsp)
+	return ParseUpdateProjectSymbolSourceResponse(rsp)
 }
 
 // RemoveTeamFromProjectWithResponse Remove a Team from a Project
@@ -14867,6 +19187,32 @@ func (c *ClientWithResponses) GetOrganizationTeamWithResponse(ctx context.Contex
 	return ParseGetOrganizationTeamResponse(rsp)
 }
 
+// ListOrganizationTeamMembersWithResponse List a Team's Members
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/members/ (the `ListOrganizationTeamMembers` operationId).
+func (c *ClientWithResponses) ListOrganizationTeamMembersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListOrganizationTeamMembersParams, reqEditors ...RequestEditorFn) (*ListOrganizationTeamMembersResponse, error) {
+	rsp, err := c.ListOrganizationTeamMembers(ctx, organizationIdOrSlug, teamIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationTeamMembersResponse(rsp)
+}
+
+// ListTeamProjectsWithResponse List a Team's Projects
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/ (the `ListTeamProjects` operationId).
+func (c *ClientWithResponses) ListTeamProjectsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListTeamProjectsParams, reqEditors ...RequestEditorFn) (*ListTeamProjectsResponse, error) {
+	rsp, err := c.ListTeamProjects(ctx, organizationIdOrSlug, teamIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTeamProjectsResponse(rsp)
+}
+
 // CreateOrganizationTeamProjectWithBodyWithResponse Create a Project
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
@@ -14917,14 +19263,249 @@ func ParseCreateOrganizationResponse(rsp *http.Response) (*CreateOrganizationRes
 		return nil, err
 	}
 
-	response := &CreateOrganizationResponse{
+	response := &CreateOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case rsp.StatusCode == 400:
+		break // No content-type
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteOrganizationResponse parses an HTTP response from a DeleteOrganizationWithResponse call
+func ParseDeleteOrganizationResponse(rsp *http.Response) (*DeleteOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetOrganizationResponse parses an HTTP response from a GetOrganizationWithResponse call
+func ParseGetOrganizationResponse(rsp *http.Response) (*GetOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateOrganizationResponse parses an HTTP response from a UpdateOrganizationWithResponse call
+func ParseUpdateOrganizationResponse(rsp *http.Response) (*UpdateOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 400:
+		break // No content-type
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrganizationAlertRuleDetectorResponse parses an HTTP response from a GetOrganizationAlertRuleDetectorWithResponse call
+func ParseGetOrganizationAlertRuleDetectorResponse(rsp *http.Response) (*GetOrganizationAlertRuleDetectorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrganizationAlertRuleDetectorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationAlertRuleDetector
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 400:
+		break // No content-type
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrganizationAlertRuleWorkflowResponse parses an HTTP response from a GetOrganizationAlertRuleWorkflowWithResponse call
+func ParseGetOrganizationAlertRuleWorkflowResponse(rsp *http.Response) (*GetOrganizationAlertRuleWorkflowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrganizationAlertRuleWorkflowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationAlertRuleWorkflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 400:
+		break // No content-type
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseListOrganizationDashboardsResponse parses an HTTP response from a ListOrganizationDashboardsWithResponse call
+func ParseListOrganizationDashboardsResponse(rsp *http.Response) (*ListOrganizationDashboardsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOrganizationDashboardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OrganizationDashboardListItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateOrganizationDashboardResponse parses an HTTP response from a CreateOrganizationDashboardWithResponse call
+func ParseCreateOrganizationDashboardResponse(rsp *http.Response) (*CreateOrganizationDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateOrganizationDashboardResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Organization
+		var dest OrganizationDashboard
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14933,10 +19514,10 @@ func ParseCreateOrganizationResponse(rsp *http.Response) (*CreateOrganizationRes
 	case rsp.StatusCode == 400:
 		break // No content-type
 
-	case rsp.StatusCode == 401:
+	case rsp.StatusCode == 403:
 		break // No content-type
 
-	case rsp.StatusCode == 403:
+	case rsp.StatusCode == 404:
 		break // No content-type
 
 	}
@@ -14944,15 +19525,15 @@ func ParseCreateOrganizationResponse(rsp *http.Response) (*CreateOrganizationRes
 	return response, nil
 }
 
-// ParseDeleteOrganizationResponse parses an HTTP response from a DeleteOrganizationWithResponse call
-func ParseDeleteOrganizationResponse(rsp *http.Response) (*DeleteOrganizationResponse, error) {
+// ParseDeleteOrganizationDashboardResponse parses an HTTP response from a DeleteOrganizationDashboardWithResponse call
+func ParseDeleteOrganizationDashboardResponse(rsp *http.Response) (*DeleteOrganizationDashboardResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteOrganizationResponse{
+	response := &DeleteOrganizationDashboardResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -14960,22 +19541,22 @@ func ParseDeleteOrganizationResponse(rsp *http.Response) (*DeleteOrganizationRes
 	return response, nil
 }
 
-// ParseGetOrganizationResponse parses an HTTP response from a GetOrganizationWithResponse call
-func ParseGetOrganizationResponse(rsp *http.Response) (*GetOrganizationResponse, error) {
+// ParseGetOrganizationDashboardResponse parses an HTTP response from a GetOrganizationDashboardWithResponse call
+func ParseGetOrganizationDashboardResponse(rsp *http.Response) (*GetOrganizationDashboardResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOrganizationResponse{
+	response := &GetOrganizationDashboardResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Organization
+		var dest OrganizationDashboard
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14995,22 +19576,22 @@ func ParseGetOrganizationResponse(rsp *http.Response) (*GetOrganizationResponse,
 	return response, nil
 }
 
-// ParseUpdateOrganizationResponse parses an HTTP response from a UpdateOrganizationWithResponse call
-func ParseUpdateOrganizationResponse(rsp *http.Response) (*UpdateOrganizationResponse, error) {
+// ParseUpdateOrganizationDashboardResponse parses an HTTP response from a UpdateOrganizationDashboardWithResponse call
+func ParseUpdateOrganizationDashboardResponse(rsp *http.Response) (*UpdateOrganizationDashboardResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateOrganizationResponse{
+	response := &UpdateOrganizationDashboardResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Organization
+		var dest OrganizationDashboard
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15019,9 +19600,6 @@ func ParseUpdateOrganizationResponse(rsp *http.Response) (*UpdateOrganizationRes
 	case rsp.StatusCode == 400:
 		break // No content-type
 
-	case rsp.StatusCode == 401:
-		break // No content-type
-
 	case rsp.StatusCode == 403:
 		break // No content-type
 
@@ -15455,20 +20033,284 @@ func ParseUpdateOrganizationMemberResponse(rsp *http.Response) (*UpdateOrganizat
 		return nil, err
 	}
 
-	response := &UpdateOrganizationMemberResponse{
+	response := &UpdateOrganizationMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationMemberWithRoles
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveOrganizationMemberFromTeamResponse parses an HTTP response from a RemoveOrganizationMemberFromTeamWithResponse call
+func ParseRemoveOrganizationMemberFromTeamResponse(rsp *http.Response) (*RemoveOrganizationMemberFromTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveOrganizationMemberFromTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAddOrganizationMemberToTeamResponse parses an HTTP response from a AddOrganizationMemberToTeamWithResponse call
+func ParseAddOrganizationMemberToTeamResponse(rsp *http.Response) (*AddOrganizationMemberToTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddOrganizationMemberToTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUpdateOrganizationMemberTeamRoleResponse parses an HTTP response from a UpdateOrganizationMemberTeamRoleWithResponse call
+func ParseUpdateOrganizationMemberTeamRoleResponse(rsp *http.Response) (*UpdateOrganizationMemberTeamRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateOrganizationMemberTeamRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationMemberTeam
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 400:
+		break // No content-type
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateOrganizationNotificationActionResponse parses an HTTP response from a CreateOrganizationNotificationActionWithResponse call
+func ParseCreateOrganizationNotificationActionResponse(rsp *http.Response) (*CreateOrganizationNotificationActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateOrganizationNotificationActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest NotificationAction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case rsp.StatusCode == 400:
+		break // No content-type
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteOrganizationNotificationActionResponse parses an HTTP response from a DeleteOrganizationNotificationActionWithResponse call
+func ParseDeleteOrganizationNotificationActionResponse(rsp *http.Response) (*DeleteOrganizationNotificationActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteOrganizationNotificationActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetOrganizationNotificationActionResponse parses an HTTP response from a GetOrganizationNotificationActionWithResponse call
+func ParseGetOrganizationNotificationActionResponse(rsp *http.Response) (*GetOrganizationNotificationActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrganizationNotificationActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationAction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateOrganizationNotificationActionResponse parses an HTTP response from a UpdateOrganizationNotificationActionWithResponse call
+func ParseUpdateOrganizationNotificationActionResponse(rsp *http.Response) (*UpdateOrganizationNotificationActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateOrganizationNotificationActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest NotificationAction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case rsp.StatusCode == 400:
+		break // No content-type
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseListOrganizationProjectsResponse parses an HTTP response from a ListOrganizationProjectsWithResponse call
+func ParseListOrganizationProjectsResponse(rsp *http.Response) (*ListOrganizationProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOrganizationProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateProjectMonitorResponse parses an HTTP response from a CreateProjectMonitorWithResponse call
+func ParseCreateProjectMonitorResponse(rsp *http.Response) (*CreateProjectMonitorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateProjectMonitorResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrganizationMemberWithRoles
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ProjectMonitor
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
-	case rsp.StatusCode == 401:
+	case rsp.StatusCode == 400:
 		break // No content-type
 
 	case rsp.StatusCode == 403:
@@ -15482,22 +20324,22 @@ func ParseUpdateOrganizationMemberResponse(rsp *http.Response) (*UpdateOrganizat
 	return response, nil
 }
 
-// ParseListOrganizationProjectsResponse parses an HTTP response from a ListOrganizationProjectsWithResponse call
-func ParseListOrganizationProjectsResponse(rsp *http.Response) (*ListOrganizationProjectsResponse, error) {
+// ParseListOrganizationRepositoriesResponse parses an HTTP response from a ListOrganizationRepositoriesWithResponse call
+func ParseListOrganizationRepositoriesResponse(rsp *http.Response) (*ListOrganizationRepositoriesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListOrganizationProjectsResponse{
+	response := &ListOrganizationRepositoriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Project
+		var dest []OrganizationRepository
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15517,22 +20359,22 @@ func ParseListOrganizationProjectsResponse(rsp *http.Response) (*ListOrganizatio
 	return response, nil
 }
 
-// ParseCreateProjectMonitorResponse parses an HTTP response from a CreateProjectMonitorWithResponse call
-func ParseCreateProjectMonitorResponse(rsp *http.Response) (*CreateProjectMonitorResponse, error) {
+// ParseCreateOrganizationRepositoryResponse parses an HTTP response from a CreateOrganizationRepositoryWithResponse call
+func ParseCreateOrganizationRepositoryResponse(rsp *http.Response) (*CreateOrganizationRepositoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectMonitorResponse{
+	response := &CreateOrganizationRepositoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ProjectMonitor
+		var dest OrganizationRepository
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15541,10 +20383,10 @@ func ParseCreateProjectMonitorResponse(rsp *http.Response) (*CreateProjectMonito
 	case rsp.StatusCode == 400:
 		break // No content-type
 
-	case rsp.StatusCode == 403:
+	case rsp.StatusCode == 401:
 		break // No content-type
 
-	case rsp.StatusCode == 404:
+	case rsp.StatusCode == 403:
 		break // No content-type
 
 	}
@@ -15552,6 +20394,22 @@ func ParseCreateProjectMonitorResponse(rsp *http.Response) (*CreateProjectMonito
 	return response, nil
 }
 
+// ParseDeleteOrganizationRepositoryResponse parses an HTTP response from a DeleteOrganizationRepositoryWithResponse call
+func ParseDeleteOrganizationRepositoryResponse(rsp *http.Response) (*DeleteOrganizationRepositoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteOrganizationRepositoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
 // ParseListSentryAppInstallationsResponse parses an HTTP response from a ListSentryAppInstallationsWithResponse call
 func ParseListSentryAppInstallationsResponse(rsp *http.Response) (*ListSentryAppInstallationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -15928,6 +20786,57 @@ func ParseUpdateOrganizationProjectResponse(rsp *http.Response) (*UpdateOrganiza
 	return response, nil
 }
 
+// ParseListProjectFiltersResponse parses an HTTP response from a ListProjectFiltersWithResponse call
+func ParseListProjectFiltersResponse(rsp *http.Response) (*ListProjectFiltersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProjectFiltersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProjectFilter
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateProjectFilterResponse parses an HTTP response from a UpdateProjectFilterWithResponse call
+func ParseUpdateProjectFilterResponse(rsp *http.Response) (*UpdateProjectFilterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateProjectFilterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
 // ParseListProjectClientKeysResponse parses an HTTP response from a ListProjectClientKeysWithResponse call
 func ParseListProjectClientKeysResponse(rsp *http.Response) (*ListProjectClientKeysResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -16134,7 +21043,7 @@ func ParseUpdateProjectOwnershipResponse(rsp *http.Response) (*UpdateProjectOwne
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectRule
+		var dest ProjectOwnership
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -16291,6 +21200,130 @@ func ParseUpdateProjectRuleResponse(rsp *http.Response) (*UpdateProjectRuleRespo
 	return response, nil
 }
 
+// ParseDeleteProjectSymbolSourceResponse parses an HTTP response from a DeleteProjectSymbolSourceWithResponse call
+func ParseDeleteProjectSymbolSourceResponse(rsp *http.Response) (*DeleteProjectSymbolSourceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteProjectSymbolSourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListProjectSymbolSourcesResponse parses an HTTP response from a ListProjectSymbolSourcesWithResponse call
+func ParseListProjectSymbolSourcesResponse(rsp *http.Response) (*ListProjectSymbolSourcesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProjectSymbolSourcesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProjectSymbolSource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateProjectSymbolSourceResponse parses an HTTP response from a CreateProjectSymbolSourceWithResponse call
+func ParseCreateProjectSymbolSourceResponse(rsp *http.Response) (*CreateProjectSymbolSourceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateProjectSymbolSourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ProjectSymbolSource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case rsp.StatusCode == 400:
+		break // No content-type
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateProjectSymbolSourceResponse parses an HTTP response from a UpdateProjectSymbolSourceWithResponse call
+func ParseUpdateProjectSymbolSourceResponse(rsp *http.Response) (*UpdateProjectSymbolSourceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateProjectSymbolSourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectSymbolSource
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 400:
+		break // No content-type
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
 // ParseRemoveTeamFromProjectResponse parses an HTTP response from a RemoveTeamFromProjectWithResponse call
 func ParseRemoveTeamFromProjectResponse(rsp *http.Response) (*RemoveTeamFromProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -16412,6 +21445,76 @@ func ParseGetOrganizationTeamResponse(rsp *http.Response) (*GetOrganizationTeamR
 	return response, nil
 }
 
+// ParseListOrganizationTeamMembersResponse parses an HTTP response from a ListOrganizationTeamMembersWithResponse call
+func ParseListOrganizationTeamMembersResponse(rsp *http.Response) (*ListOrganizationTeamMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOrganizationTeamMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TeamMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ParseListTeamProjectsResponse parses an HTTP response from a ListTeamProjectsWithResponse call
+func ParseListTeamProjectsResponse(rsp *http.Response) (*ListTeamProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTeamProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 401:
+		break // No content-type
+
+	case rsp.StatusCode == 403:
+		break // No content-type
+
+	case rsp.StatusCode == 404:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
 // ParseCreateOrganizationTeamProjectResponse parses an HTTP response from a CreateOrganizationTeamProjectWithResponse call
 func ParseCreateOrganizationTeamProjectResponse(rsp *http.Response) (*CreateOrganizationTeamProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
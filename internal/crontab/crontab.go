@@ -0,0 +1,323 @@
+// Package crontab parses the crontab schedules of Sentry cron monitors and
+// computes when they run.
+//
+// Schedules have five fields (minute, hour, day of month, month and day of
+// week) made of values, ranges, steps and lists, as in `*/15 9-17 * * MON-FRI`,
+// or are one of the macros @yearly, @annually, @monthly, @weekly, @daily,
+// @midnight and @hourly. As in Vixie cron, a schedule that restricts both the
+// day of month and the day of week runs on days matching either.
+//
+// Importing this package embeds the time zone database, so that schedules can
+// be evaluated in any time zone regardless of the host.
+package crontab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata"
+)
+
+// Schedule is a parsed crontab schedule.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// domStar and dowStar are true when the day of month or day of week
+	// field starts with `*`, in which case days must match both fields.
+	domStar, dowStar bool
+}
+
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Both 0 and 7 are Sunday.
+	dowField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// searchYears limits how far ahead Next searches, so that schedules such as
+// `0 0 29 2 *` are found and impossible ones such as `0 0 30 2 *` are not
+// searched forever.
+const searchYears = 8
+
+// Parse parses a crontab schedule.
+func Parse(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "@") {
+		expanded, ok := macros[strings.ToLower(spec)]
+		if !ok {
+			return nil, fmt.Errorf("unknown macro %q", spec)
+		}
+		spec = expanded
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields (minute, hour, day of month, month and day of week), got %d", len(fields))
+	}
+
+	s := &Schedule{}
+	var err error
+	if s.minute, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if s.hour, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if s.dom, err = domField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if s.month, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if s.dow, err = dowField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	s.domStar = strings.HasPrefix(fields[2], "*")
+	s.dowStar = strings.HasPrefix(fields[4], "*")
+
+	if s.Next(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+		return nil, fmt.Errorf("the schedule %q never runs", spec)
+	}
+
+	return s, nil
+}
+
+// parse parses a field into a bit set of the values it matches.
+func (f field) parse(spec string) (uint64, error) {
+	var set uint64
+	for item := range strings.SplitSeq(spec, ",") {
+		bits, err := f.parseItem(item)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s field %q: %w", f.name, spec, err)
+		}
+		set |= bits
+	}
+	return set, nil
+}
+
+// parseItem parses `*`, a value or a range, optionally followed by a step.
+func (f field) parseItem(item string) (uint64, error) {
+	if item == "" {
+		return 0, fmt.Errorf("empty list item")
+	}
+
+	rangeSpec, stepSpec, hasStep := strings.Cut(item, "/")
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepSpec)
+		if err != nil || step < 1 {
+			return 0, fmt.Errorf("invalid step %q", stepSpec)
+		}
+	}
+
+	var start, end int
+	switch {
+	case rangeSpec == "*":
+		start, end = f.min, f.max
+	case strings.Contains(rangeSpec, "-"):
+		startSpec, endSpec, _ := strings.Cut(rangeSpec, "-")
+		var err error
+		if start, err = f.parseValue(startSpec); err != nil {
+			return 0, err
+		}
+		if end, err = f.parseValue(endSpec); err != nil {
+			return 0, err
+		}
+		if start > end {
+			return 0, fmt.Errorf("range %q is backwards", rangeSpec)
+		}
+	default:
+		var err error
+		if start, err = f.parseValue(rangeSpec); err != nil {
+			return 0, err
+		}
+		end = start
+		// A value with a step, as in `5/15`, runs from the value to the end
+		// of the range.
+		if hasStep {
+			end = f.max
+		}
+	}
+
+	if hasStep && step > f.max-f.min {
+		return 0, fmt.Errorf("step %d is larger than the range %d-%d", step, f.min, f.max)
+	}
+
+	var set uint64
+	for v := start; v <= end; v += step {
+		set |= 1 << v
+	}
+	return set, nil
+}
+
+func (f field) parseValue(spec string) (int, error) {
+	if v, ok := f.names[strings.ToLower(spec)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(spec)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", spec)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", v, f.min, f.max)
+	}
+	return v, nil
+}
+
+func has(set uint64, v int) bool {
+	return set&(1<<v) != 0
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := has(s.dom, t.Day())
+	dowMatch := has(s.dow, int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// Next returns the first time after t that the schedule runs, in the location
+// of t. It returns the zero time if the schedule does not run in the
+// following years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Year() + searchYears
+
+	// Each loop advances the field to the next matching value, resetting the
+	// smaller fields, and starts over when a larger field wraps around.
+wrap:
+	if t.Year() > limit {
+		return time.Time{}
+	}
+
+	for !has(s.month, int(t.Month())) {
+		t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		if t.Month() == time.January {
+			goto wrap
+		}
+	}
+
+	for !s.dayMatches(t) {
+		month := t.Month()
+		t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		if t.Month() != month {
+			goto wrap
+		}
+	}
+
+	for !has(s.hour, t.Hour()) {
+		day := t.Day()
+		// Adding time rather than setting the hour steps over the hours
+		// skipped or repeated by daylight saving time changes.
+		t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+		if t.Day() != day {
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+			goto wrap
+		}
+	}
+
+	for !has(s.minute, t.Minute()) {
+		hour := t.Hour()
+		t = t.Add(time.Minute)
+		if t.Hour() != hour {
+			goto wrap
+		}
+	}
+
+	return t
+}
+
+// NextN returns the next n times after t that the schedule runs.
+func (s *Schedule) NextN(t time.Time, n int) []time.Time {
+	runs := make([]time.Time, 0, n)
+	for range n {
+		t = s.Next(t)
+		if t.IsZero() {
+			break
+		}
+		runs = append(runs, t)
+	}
+	return runs
+}
+
+// dayCycleYears is the number of years after which the days of the week fall
+// on the same dates again, for the years 1901 to 2099.
+const dayCycleYears = 28
+
+// Period returns the shortest time between two consecutive runs of the
+// schedule, evaluated in UTC so that daylight saving time changes do not
+// shorten it. Schedules that run only once in the days of the week cycle
+// return the length of the cycle.
+func (s *Schedule) Period() time.Duration {
+	// The times of day of the runs, in minutes after midnight.
+	var times []int
+	for hour := range 24 {
+		if !has(s.hour, hour) {
+			continue
+		}
+		for minute := range 60 {
+			if has(s.minute, minute) {
+				times = append(times, hour*60+minute)
+			}
+		}
+	}
+
+	start := time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(dayCycleYears+1, 0, 0)
+	period := end.Sub(start)
+
+	for i := 1; i < len(times); i++ {
+		period = min(period, time.Duration(times[i]-times[i-1])*time.Minute)
+	}
+	if period == time.Minute {
+		return period
+	}
+
+	// Days are irregular, so find the shortest gap between the days with runs
+	// by walking through a whole cycle of the days of the week and the leap
+	// years, plus a year to include the gap that wraps around the cycle.
+	var prevDay time.Time
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		if !has(s.month, int(day.Month())) || !s.dayMatches(day) {
+			continue
+		}
+		if !prevDay.IsZero() {
+			// The last run of the previous day to the first run of this day
+			gap := day.Sub(prevDay) - time.Duration(times[len(times)-1]-times[0])*time.Minute
+			period = min(period, gap)
+		}
+		prevDay = day
+	}
+	return period
+}
@@ -0,0 +1,146 @@
+package crontab
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse_invalid(t *testing.T) {
+	testCases := []struct {
+		spec    string
+		wantErr string
+	}{
+		{"", "expected 5 fields"},
+		{"* * * *", "expected 5 fields"},
+		{"* * * * * *", "expected 5 fields"},
+		{"@every 5m", `unknown macro "@every 5m"`},
+		{"60 * * * *", `invalid minute field "60": value 60 out of range 0-59`},
+		{"* 24 * * *", `invalid hour field "24": value 24 out of range 0-23`},
+		{"* * 0 * *", `invalid day of month field "0": value 0 out of range 1-31`},
+		{"* * * 13 *", `invalid month field "13": value 13 out of range 1-12`},
+		{"* * * * 8", `invalid day of week field "8": value 8 out of range 0-7`},
+		{"* * * foo *", `invalid month field "foo": invalid value "foo"`},
+		{"5-1 * * * *", `range "5-1" is backwards`},
+		{"*/0 * * * *", `invalid step "0"`},
+		{"*/60 * * * *", "step 60 is larger than the range 0-59"},
+		{"1,,2 * * * *", "empty list item"},
+		{"0 0 30 2 *", "never runs"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			_, err := Parse(tc.spec)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("error = %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchedule_NextN(t *testing.T) {
+	from := time.Date(2024, time.January, 30, 10, 7, 30, 0, time.UTC)
+
+	testCases := []struct {
+		spec string
+		want []string
+	}{
+		{"* * * * *", []string{"2024-01-30T10:08:00Z", "2024-01-30T10:09:00Z"}},
+		{"*/15 * * * *", []string{"2024-01-30T10:15:00Z", "2024-01-30T10:30:00Z", "2024-01-30T10:45:00Z", "2024-01-30T11:00:00Z"}},
+		{"5/20 9-10 * * *", []string{"2024-01-30T10:25:00Z", "2024-01-30T10:45:00Z", "2024-01-31T09:05:00Z"}},
+		{"0 9 * * MON-FRI", []string{"2024-01-31T09:00:00Z", "2024-02-01T09:00:00Z", "2024-02-02T09:00:00Z", "2024-02-05T09:00:00Z"}},
+		{"0 0 * * 7", []string{"2024-02-04T00:00:00Z", "2024-02-11T00:00:00Z"}},
+		{"@daily", []string{"2024-01-31T00:00:00Z", "2024-02-01T00:00:00Z"}},
+		{"@hourly", []string{"2024-01-30T11:00:00Z", "2024-01-30T12:00:00Z"}},
+		{"@monthly", []string{"2024-02-01T00:00:00Z", "2024-03-01T00:00:00Z"}},
+		{"@yearly", []string{"2025-01-01T00:00:00Z", "2026-01-01T00:00:00Z"}},
+		{"0 0 31 * *", []string{"2024-01-31T00:00:00Z", "2024-03-31T00:00:00Z", "2024-05-31T00:00:00Z"}},
+		{"0 0 29 feb *", []string{"2024-02-29T00:00:00Z", "2028-02-29T00:00:00Z"}},
+		// Either the day of month or the day of week matches.
+		{"0 0 1 * FRI", []string{"2024-02-01T00:00:00Z", "2024-02-02T00:00:00Z", "2024-02-09T00:00:00Z"}},
+		// Both match when either field starts with a star.
+		{"0 0 */2 * FRI", []string{"2024-02-09T00:00:00Z", "2024-02-23T00:00:00Z"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			s, err := Parse(tc.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, run := range s.NextN(from, len(tc.want)) {
+				got = append(got, run.Format(time.RFC3339))
+			}
+			if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+				t.Errorf("NextN() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSchedule_NextN_timezone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		spec string
+		from time.Time
+		want []string
+	}{
+		// 02:30 does not exist when daylight saving time starts.
+		{"30 2 * * *", time.Date(2024, time.March, 9, 12, 0, 0, 0, loc), []string{"2024-03-11T02:30:00-04:00", "2024-03-12T02:30:00-04:00"}},
+		// 01:30 happens twice when daylight saving time ends.
+		{"30 1 * * *", time.Date(2024, time.November, 3, 0, 0, 0, 0, loc), []string{"2024-11-03T01:30:00-04:00", "2024-11-03T01:30:00-05:00", "2024-11-04T01:30:00-05:00"}},
+		{"0 9 * * *", time.Date(2024, time.July, 1, 12, 0, 0, 0, loc), []string{"2024-07-02T09:00:00-04:00"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			s, err := Parse(tc.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, run := range s.NextN(tc.from, len(tc.want)) {
+				got = append(got, run.Format(time.RFC3339))
+			}
+			if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+				t.Errorf("NextN() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSchedule_Period(t *testing.T) {
+	testCases := []struct {
+		spec string
+		want time.Duration
+	}{
+		{"* * * * *", time.Minute},
+		{"*/5 * * * *", 5 * time.Minute},
+		{"*/7 * * * *", 4 * time.Minute},
+		{"0 9-17 * * *", time.Hour},
+		{"@daily", 24 * time.Hour},
+		{"0 9 * * MON-FRI", 24 * time.Hour},
+		{"@weekly", 7 * 24 * time.Hour},
+		{"@monthly", 28 * 24 * time.Hour},
+		{"@yearly", 365 * 24 * time.Hour},
+		{"0 0 29 feb *", (4*365 + 1) * 24 * time.Hour},
+		{"0 0 1 * FRI", 24 * time.Hour},
+		{"0 0 13 * FRI", 24 * time.Hour},
+		{"10,50 * * * *", 20 * time.Minute},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			s, err := Parse(tc.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := s.Period(); got != tc.want {
+				t.Errorf("Period() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
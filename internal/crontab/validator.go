@@ -0,0 +1,40 @@
+package crontab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Validator returns a validator which ensures that a string is a valid
+// crontab schedule.
+func Validator() validator.String {
+	return crontabValidator{}
+}
+
+var _ validator.String = crontabValidator{}
+
+type crontabValidator struct{}
+
+func (v crontabValidator) Description(ctx context.Context) string {
+	return "value must be a valid crontab schedule"
+}
+
+func (v crontabValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v crontabValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := Parse(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid crontab schedule",
+			fmt.Sprintf("Attribute %s %s, got %s: %s.", req.Path, v.Description(ctx), req.ConfigValue, err),
+		)
+	}
+}
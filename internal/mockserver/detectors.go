@@ -0,0 +1,239 @@
+package mockserver
+
+import (
+	"net/http"
+	"strings"
+)
+
+// dataSourceTypes maps monitor types to the type of the data sources that
+// Sentry returns for them. Monitor types without data sources map to an empty
+// string.
+var dataSourceTypes = map[string]string{
+	"error":                    "",
+	"metric_issue":             "snuba_query_subscription",
+	"monitor_check_in_failure": "cron_monitor",
+	"uptime_domain_failure":    "uptime_subscription",
+}
+
+// updateDetector applies the writable fields of a request body to a monitor,
+// converting them to the shape of the response. The caller must hold s.mu.
+func (s *Server) updateDetector(state *organizationState, detector, body object) {
+	merge(detector, body, "name", "description", "config")
+	if enabled, ok := body["enabled"].(bool); ok {
+		detector["enabled"] = enabled
+	}
+
+	if owner, ok := body["owner"]; ok {
+		detector["owner"] = renderOwner(state, owner)
+	}
+
+	if dataSources, ok := body["dataSources"].([]any); ok {
+		wrapped := []any{}
+		for _, dataSource := range dataSources {
+			queryObj := dataSource
+			typ := dataSourceTypes[detector["type"].(string)]
+			if typ == "snuba_query_subscription" {
+				queryObj = object{"snubaQuery": dataSource}
+			}
+			wrapped = append(wrapped, object{
+				"id":       s.newID(),
+				"type":     typ,
+				"queryObj": queryObj,
+			})
+		}
+		detector["dataSources"] = wrapped
+	}
+
+	if conditionGroup, ok := body["conditionGroup"].(object); ok {
+		conditionGroup["id"] = s.newID()
+		if conditions, ok := conditionGroup["conditions"].([]any); ok {
+			for _, condition := range conditions {
+				if condition, ok := condition.(object); ok && condition["id"] == nil {
+					condition["id"] = s.newNumericID()
+				}
+			}
+		}
+		detector["conditionGroup"] = conditionGroup
+	}
+
+	detector["dateUpdated"] = now()
+}
+
+// renderOwner converts an actor such as "team:1" to the owner object of a
+// monitor.
+func renderOwner(state *organizationState, owner any) any {
+	actor, _ := owner.(string)
+	typ, id, ok := strings.Cut(actor, ":")
+	if !ok {
+		return nil
+	}
+
+	switch typ {
+	case "team":
+		name := ""
+		if team := state.teams.get(id); team != nil {
+			name, _ = team["slug"].(string)
+		}
+		return object{"type": "team", "id": id, "name": name}
+	case "user":
+		return object{"type": "user", "id": id, "name": "", "email": ""}
+	default:
+		return nil
+	}
+}
+
+// matchesQuery reports whether an object matches a search query such as
+// "type:error my monitor". Free text matches the name.
+func matchesQuery(o object, query string) bool {
+	for _, term := range strings.Fields(query) {
+		if key, value, ok := strings.Cut(term, ":"); ok {
+			if v, _ := o[key].(string); v != value {
+				return false
+			}
+			continue
+		}
+
+		name, _ := o["name"].(string)
+		if !strings.Contains(strings.ToLower(name), strings.ToLower(term)) {
+			return false
+		}
+	}
+	return true
+}
+
+// ListOrganizationMonitors implements ServerInterface.
+func (s *Server) ListOrganizationMonitors(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationMonitorsParams) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	detectors := state.detectors.filter(func(detector object) bool {
+		if params.Project != nil && detector["projectId"] != *params.Project {
+			return false
+		}
+		return params.Query == nil || matchesQuery(detector, *params.Query)
+	})
+
+	s.writePage(w, r, params.Cursor, detectors)
+}
+
+// CreateProjectMonitor implements ServerInterface.
+func (s *Server) CreateProjectMonitor(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) {
+	body, err := decodeObject(r)
+	if err != nil {
+		writeDetail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	typ, _ := body["type"].(string)
+	if _, ok := dataSourceTypes[typ]; !ok {
+		writeJSON(w, http.StatusBadRequest, object{"type": []string{"Invalid monitor type"}})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, state, project := s.project(organizationIdOrSlug, projectIdOrSlug)
+	if project == nil {
+		writeNotFound(w)
+		return
+	}
+
+	detector := object{
+		"id":             s.newID(),
+		"projectId":      project["id"],
+		"type":           typ,
+		"enabled":        true,
+		"description":    nil,
+		"owner":          nil,
+		"config":         object{},
+		"dataSources":    []any{},
+		"conditionGroup": nil,
+		"dateCreated":    now(),
+	}
+	s.updateDetector(state, detector, body)
+	state.detectors.add(detector)
+
+	writeJSON(w, http.StatusCreated, clone(detector))
+}
+
+// GetProjectMonitor implements ServerInterface.
+func (s *Server) GetProjectMonitor(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	detector := state.detectors.get(detectorId)
+	if detector == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, clone(detector))
+}
+
+// UpdateProjectMonitor implements ServerInterface.
+func (s *Server) UpdateProjectMonitor(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId) {
+	body, err := decodeObject(r)
+	if err != nil {
+		writeDetail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	detector := state.detectors.get(detectorId)
+	if detector == nil {
+		writeNotFound(w)
+		return
+	}
+
+	s.updateDetector(state, detector, body)
+
+	writeJSON(w, http.StatusOK, clone(detector))
+}
+
+// DeleteProjectMonitor implements ServerInterface.
+func (s *Server) DeleteProjectMonitor(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	detector := state.detectors.get(detectorId)
+	if detector == nil {
+		writeNotFound(w)
+		return
+	}
+
+	state.detectors.remove(detector["id"])
+	for _, workflow := range state.workflows.items {
+		if ids, ok := workflow["detectorIds"].([]any); ok {
+			workflow["detectorIds"] = removeValue(ids, detector["id"])
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
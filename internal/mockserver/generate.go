@@ -0,0 +1,3 @@
+package mockserver
+
+//go:generate go tool oapi-codegen -config config.yaml ../apiclient/api.yaml
@@ -0,0 +1,182 @@
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// createProjectKey adds a client key to a project. The caller must hold s.mu.
+func (s *Server) createProjectKey(r *http.Request, state *organizationState, project object, body object) object {
+	projectID := project["id"].(string)
+	projectNumber, _ := strconv.Atoi(projectID)
+	public, secret := randomHex(16), randomHex(16)
+
+	host := r.Host
+	dsn := fmt.Sprintf("http://%s@%s/%s", public, host, projectID)
+
+	name, _ := body["name"].(string)
+	key := object{
+		"id":                public,
+		"name":              name,
+		"label":             name,
+		"public":            public,
+		"secret":            secret,
+		"projectId":         projectNumber,
+		"isActive":          true,
+		"rateLimit":         nil,
+		"dateCreated":       now(),
+		"browserSdkVersion": "latest",
+		"browserSdk": object{
+			"choices": []any{[]any{"latest", "latest"}},
+		},
+		"dynamicSdkLoaderOptions": object{
+			"hasReplay":      true,
+			"hasPerformance": true,
+			"hasDebug":       false,
+		},
+		"useCase": "user",
+		"dsn": object{
+			"secret":      fmt.Sprintf("http://%s:%s@%s/%s", public, secret, host, projectID),
+			"public":      dsn,
+			"csp":         fmt.Sprintf("http://%s/api/%s/csp-report/?sentry_key=%s", host, projectID, public),
+			"security":    fmt.Sprintf("http://%s/api/%s/security/?sentry_key=%s", host, projectID, public),
+			"minidump":    fmt.Sprintf("http://%s/api/%s/minidump/?sentry_key=%s", host, projectID, public),
+			"nel":         fmt.Sprintf("http://%s/api/%s/nel/?sentry_key=%s", host, projectID, public),
+			"unreal":      fmt.Sprintf("http://%s/api/%s/unreal/%s/", host, projectID, public),
+			"crons":       fmt.Sprintf("http://%s/api/%s/cron/___MONITOR_SLUG___/%s/", host, projectID, public),
+			"cdn":         fmt.Sprintf("http://%s/js-sdk-loader/%s.min.js", host, public),
+			"playstation": fmt.Sprintf("http://%s/api/%s/playstation/?sentry_key=%s", host, projectID, public),
+			"otlp_traces": fmt.Sprintf("http://%s/api/%s/integration/otlp/v1/traces", host, projectID),
+			"otlp_logs":   fmt.Sprintf("http://%s/api/%s/integration/otlp/v1/logs", host, projectID),
+		},
+	}
+	updateProjectKey(key, body)
+
+	state.keys[projectID].add(key)
+	return key
+}
+
+// updateProjectKey applies the writable fields of a request body to a client
+// key.
+func updateProjectKey(key, body object) {
+	merge(key, body, "name", "isActive", "rateLimit", "browserSdkVersion")
+	key["label"] = key["name"]
+
+	if options, ok := body["dynamicSdkLoaderOptions"].(object); ok {
+		current := key["dynamicSdkLoaderOptions"].(object)
+		merge(current, options, "hasReplay", "hasPerformance", "hasDebug")
+	}
+}
+
+// ListProjectClientKeys implements ServerInterface.
+func (s *Server) ListProjectClientKeys(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params ListProjectClientKeysParams) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, state, project := s.project(organizationIdOrSlug, projectIdOrSlug)
+	if project == nil {
+		writeNotFound(w)
+		return
+	}
+
+	keys := state.keys[project["id"].(string)].filter(func(key object) bool {
+		if params.Status == nil {
+			return true
+		}
+		return key["isActive"] == (*params.Status == "active")
+	})
+
+	s.writePage(w, r, params.Cursor, keys)
+}
+
+// CreateProjectClientKey implements ServerInterface.
+func (s *Server) CreateProjectClientKey(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) {
+	body, err := decodeObject(r)
+	if err != nil {
+		writeDetail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, state, project := s.project(organizationIdOrSlug, projectIdOrSlug)
+	if project == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, clone(s.createProjectKey(r, state, project, body)))
+}
+
+// GetProjectClientKey implements ServerInterface.
+func (s *Server) GetProjectClientKey(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, state, project := s.project(organizationIdOrSlug, projectIdOrSlug)
+	if project == nil {
+		writeNotFound(w)
+		return
+	}
+
+	key := state.keys[project["id"].(string)].get(keyId)
+	if key == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, clone(key))
+}
+
+// UpdateProjectClientKey implements ServerInterface.
+func (s *Server) UpdateProjectClientKey(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string) {
+	body, err := decodeObject(r)
+	if err != nil {
+		writeDetail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, state, project := s.project(organizationIdOrSlug, projectIdOrSlug)
+	if project == nil {
+		writeNotFound(w)
+		return
+	}
+
+	key := state.keys[project["id"].(string)].get(keyId)
+	if key == nil {
+		writeNotFound(w)
+		return
+	}
+
+	updateProjectKey(key, body)
+
+	writeJSON(w, http.StatusOK, clone(key))
+}
+
+// DeleteProjectClientKey implements ServerInterface.
+func (s *Server) DeleteProjectClientKey(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, state, project := s.project(organizationIdOrSlug, projectIdOrSlug)
+	if project == nil {
+		writeNotFound(w)
+		return
+	}
+
+	keys := state.keys[project["id"].(string)]
+	key := keys.get(keyId)
+	if key == nil {
+		writeNotFound(w)
+		return
+	}
+
+	keys.remove(key["id"])
+
+	w.WriteHeader(http.StatusNoContent)
+}
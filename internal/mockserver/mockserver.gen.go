@@ -0,0 +1,11031 @@
+//go:build go1.22
+
+// Package mockserver provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.8.0 DO NOT EDIT.
+package mockserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/oapi-codegen/nullable"
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for OrganizationIntegrationOpsgenieProviderKey.
+const (
+	OrganizationIntegrationOpsgenieProviderKeyOpsgenie OrganizationIntegrationOpsgenieProviderKey = "opsgenie"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationIntegrationOpsgenieProviderKey enum.
+func (e OrganizationIntegrationOpsgenieProviderKey) Valid() bool {
+	switch e {
+	case OrganizationIntegrationOpsgenieProviderKeyOpsgenie:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationIntegrationPagerDutyProviderKey.
+const (
+	OrganizationIntegrationPagerDutyProviderKeyPagerduty OrganizationIntegrationPagerDutyProviderKey = "pagerduty"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationIntegrationPagerDutyProviderKey enum.
+func (e OrganizationIntegrationPagerDutyProviderKey) Valid() bool {
+	switch e {
+	case OrganizationIntegrationPagerDutyProviderKeyPagerduty:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterLogicType.
+const (
+	OrganizationWorkflowActionFilterLogicTypeAll      OrganizationWorkflowActionFilterLogicType = "all"
+	OrganizationWorkflowActionFilterLogicTypeAny      OrganizationWorkflowActionFilterLogicType = "any"
+	OrganizationWorkflowActionFilterLogicTypeAnyShort OrganizationWorkflowActionFilterLogicType = "any-short"
+	OrganizationWorkflowActionFilterLogicTypeNone     OrganizationWorkflowActionFilterLogicType = "none"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterLogicType enum.
+func (e OrganizationWorkflowActionFilterLogicType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterLogicTypeAll:
+		return true
+	case OrganizationWorkflowActionFilterLogicTypeAny:
+		return true
+	case OrganizationWorkflowActionFilterLogicTypeAnyShort:
+		return true
+	case OrganizationWorkflowActionFilterLogicTypeNone:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionDiscordConfigTargetType.
+const (
+	OrganizationWorkflowActionFilterActionDiscordConfigTargetTypeSpecific OrganizationWorkflowActionFilterActionDiscordConfigTargetType = "specific"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionDiscordConfigTargetType enum.
+func (e OrganizationWorkflowActionFilterActionDiscordConfigTargetType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionDiscordConfigTargetTypeSpecific:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionDiscordType.
+const (
+	Discord OrganizationWorkflowActionFilterActionDiscordType = "discord"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionDiscordType enum.
+func (e OrganizationWorkflowActionFilterActionDiscordType) Valid() bool {
+	switch e {
+	case Discord:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionEmailConfigTargetType.
+const (
+	OrganizationWorkflowActionFilterActionEmailConfigTargetTypeIssueOwners OrganizationWorkflowActionFilterActionEmailConfigTargetType = "issue_owners"
+	OrganizationWorkflowActionFilterActionEmailConfigTargetTypeTeam        OrganizationWorkflowActionFilterActionEmailConfigTargetType = "team"
+	OrganizationWorkflowActionFilterActionEmailConfigTargetTypeUser        OrganizationWorkflowActionFilterActionEmailConfigTargetType = "user"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionEmailConfigTargetType enum.
+func (e OrganizationWorkflowActionFilterActionEmailConfigTargetType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionEmailConfigTargetTypeIssueOwners:
+		return true
+	case OrganizationWorkflowActionFilterActionEmailConfigTargetTypeTeam:
+		return true
+	case OrganizationWorkflowActionFilterActionEmailConfigTargetTypeUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionEmailDataFallthroughType.
+const (
+	OrganizationWorkflowActionFilterActionEmailDataFallthroughTypeActiveMembers OrganizationWorkflowActionFilterActionEmailDataFallthroughType = "ActiveMembers"
+	OrganizationWorkflowActionFilterActionEmailDataFallthroughTypeAllMembers    OrganizationWorkflowActionFilterActionEmailDataFallthroughType = "AllMembers"
+	OrganizationWorkflowActionFilterActionEmailDataFallthroughTypeNoOne         OrganizationWorkflowActionFilterActionEmailDataFallthroughType = "NoOne"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionEmailDataFallthroughType enum.
+func (e OrganizationWorkflowActionFilterActionEmailDataFallthroughType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionEmailDataFallthroughTypeActiveMembers:
+		return true
+	case OrganizationWorkflowActionFilterActionEmailDataFallthroughTypeAllMembers:
+		return true
+	case OrganizationWorkflowActionFilterActionEmailDataFallthroughTypeNoOne:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionEmailType.
+const (
+	Email OrganizationWorkflowActionFilterActionEmailType = "email"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionEmailType enum.
+func (e OrganizationWorkflowActionFilterActionEmailType) Valid() bool {
+	switch e {
+	case Email:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionGitHubConfigTargetType.
+const (
+	OrganizationWorkflowActionFilterActionGitHubConfigTargetTypeSpecific OrganizationWorkflowActionFilterActionGitHubConfigTargetType = "specific"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionGitHubConfigTargetType enum.
+func (e OrganizationWorkflowActionFilterActionGitHubConfigTargetType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionGitHubConfigTargetTypeSpecific:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionGitHubType.
+const (
+	Github OrganizationWorkflowActionFilterActionGitHubType = "github"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionGitHubType enum.
+func (e OrganizationWorkflowActionFilterActionGitHubType) Valid() bool {
+	switch e {
+	case Github:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionJiraConfigTargetType.
+const (
+	OrganizationWorkflowActionFilterActionJiraConfigTargetTypeSpecific OrganizationWorkflowActionFilterActionJiraConfigTargetType = "specific"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionJiraConfigTargetType enum.
+func (e OrganizationWorkflowActionFilterActionJiraConfigTargetType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionJiraConfigTargetTypeSpecific:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionJiraType.
+const (
+	Jira OrganizationWorkflowActionFilterActionJiraType = "jira"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionJiraType enum.
+func (e OrganizationWorkflowActionFilterActionJiraType) Valid() bool {
+	switch e {
+	case Jira:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionJiraServerConfigTargetType.
+const (
+	OrganizationWorkflowActionFilterActionJiraServerConfigTargetTypeSpecific OrganizationWorkflowActionFilterActionJiraServerConfigTargetType = "specific"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionJiraServerConfigTargetType enum.
+func (e OrganizationWorkflowActionFilterActionJiraServerConfigTargetType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionJiraServerConfigTargetTypeSpecific:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionJiraServerType.
+const (
+	JiraServer OrganizationWorkflowActionFilterActionJiraServerType = "jira_server"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionJiraServerType enum.
+func (e OrganizationWorkflowActionFilterActionJiraServerType) Valid() bool {
+	switch e {
+	case JiraServer:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionMsTeamsConfigTargetType.
+const (
+	OrganizationWorkflowActionFilterActionMsTeamsConfigTargetTypeSpecific OrganizationWorkflowActionFilterActionMsTeamsConfigTargetType = "specific"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionMsTeamsConfigTargetType enum.
+func (e OrganizationWorkflowActionFilterActionMsTeamsConfigTargetType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionMsTeamsConfigTargetTypeSpecific:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionMsTeamsType.
+const (
+	Msteams OrganizationWorkflowActionFilterActionMsTeamsType = "msteams"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionMsTeamsType enum.
+func (e OrganizationWorkflowActionFilterActionMsTeamsType) Valid() bool {
+	switch e {
+	case Msteams:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionOpsgenieConfigTargetType.
+const (
+	OrganizationWorkflowActionFilterActionOpsgenieConfigTargetTypeSpecific OrganizationWorkflowActionFilterActionOpsgenieConfigTargetType = "specific"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionOpsgenieConfigTargetType enum.
+func (e OrganizationWorkflowActionFilterActionOpsgenieConfigTargetType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionOpsgenieConfigTargetTypeSpecific:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionOpsgenieType.
+const (
+	OrganizationWorkflowActionFilterActionOpsgenieTypeOpsgenie OrganizationWorkflowActionFilterActionOpsgenieType = "opsgenie"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionOpsgenieType enum.
+func (e OrganizationWorkflowActionFilterActionOpsgenieType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionOpsgenieTypeOpsgenie:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionPagerDutyConfigTargetType.
+const (
+	OrganizationWorkflowActionFilterActionPagerDutyConfigTargetTypeSpecific OrganizationWorkflowActionFilterActionPagerDutyConfigTargetType = "specific"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionPagerDutyConfigTargetType enum.
+func (e OrganizationWorkflowActionFilterActionPagerDutyConfigTargetType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionPagerDutyConfigTargetTypeSpecific:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionPagerDutyType.
+const (
+	OrganizationWorkflowActionFilterActionPagerDutyTypePagerduty OrganizationWorkflowActionFilterActionPagerDutyType = "pagerduty"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionPagerDutyType enum.
+func (e OrganizationWorkflowActionFilterActionPagerDutyType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionPagerDutyTypePagerduty:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionPluginType.
+const (
+	Plugin OrganizationWorkflowActionFilterActionPluginType = "plugin"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionPluginType enum.
+func (e OrganizationWorkflowActionFilterActionPluginType) Valid() bool {
+	switch e {
+	case Plugin:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionSentryAppConfigTargetType.
+const (
+	OrganizationWorkflowActionFilterActionSentryAppConfigTargetTypeSentryApp OrganizationWorkflowActionFilterActionSentryAppConfigTargetType = "sentry_app"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionSentryAppConfigTargetType enum.
+func (e OrganizationWorkflowActionFilterActionSentryAppConfigTargetType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionSentryAppConfigTargetTypeSentryApp:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionSentryAppType.
+const (
+	OrganizationWorkflowActionFilterActionSentryAppTypeSentryApp OrganizationWorkflowActionFilterActionSentryAppType = "sentry_app"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionSentryAppType enum.
+func (e OrganizationWorkflowActionFilterActionSentryAppType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionSentryAppTypeSentryApp:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionSlackConfigTargetType.
+const (
+	OrganizationWorkflowActionFilterActionSlackConfigTargetTypeSpecific OrganizationWorkflowActionFilterActionSlackConfigTargetType = "specific"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionSlackConfigTargetType enum.
+func (e OrganizationWorkflowActionFilterActionSlackConfigTargetType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionSlackConfigTargetTypeSpecific:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionSlackType.
+const (
+	Slack OrganizationWorkflowActionFilterActionSlackType = "slack"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionSlackType enum.
+func (e OrganizationWorkflowActionFilterActionSlackType) Valid() bool {
+	switch e {
+	case Slack:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionVstsConfigTargetType.
+const (
+	OrganizationWorkflowActionFilterActionVstsConfigTargetTypeSpecific OrganizationWorkflowActionFilterActionVstsConfigTargetType = "specific"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionVstsConfigTargetType enum.
+func (e OrganizationWorkflowActionFilterActionVstsConfigTargetType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterActionVstsConfigTargetTypeSpecific:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionVstsType.
+const (
+	Vsts OrganizationWorkflowActionFilterActionVstsType = "vsts"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionVstsType enum.
+func (e OrganizationWorkflowActionFilterActionVstsType) Valid() bool {
+	switch e {
+	case Vsts:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterActionWebhookType.
+const (
+	Webhook OrganizationWorkflowActionFilterActionWebhookType = "webhook"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterActionWebhookType enum.
+func (e OrganizationWorkflowActionFilterActionWebhookType) Valid() bool {
+	switch e {
+	case Webhook:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionAgeComparisonComparisonComparisonType.
+const (
+	Newer OrganizationWorkflowActionFilterConditionAgeComparisonComparisonComparisonType = "newer"
+	Older OrganizationWorkflowActionFilterConditionAgeComparisonComparisonComparisonType = "older"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionAgeComparisonComparisonComparisonType enum.
+func (e OrganizationWorkflowActionFilterConditionAgeComparisonComparisonComparisonType) Valid() bool {
+	switch e {
+	case Newer:
+		return true
+	case Older:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime.
+const (
+	Day    OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime = "day"
+	Hour   OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime = "hour"
+	Minute OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime = "minute"
+	Week   OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime = "week"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime enum.
+func (e OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime) Valid() bool {
+	switch e {
+	case Day:
+		return true
+	case Hour:
+		return true
+	case Minute:
+		return true
+	case Week:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionAgeComparisonType.
+const (
+	AgeComparison OrganizationWorkflowActionFilterConditionAgeComparisonType = "age_comparison"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionAgeComparisonType enum.
+func (e OrganizationWorkflowActionFilterConditionAgeComparisonType) Valid() bool {
+	switch e {
+	case AgeComparison:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetType.
+const (
+	OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetTypeMember OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetType = "Member"
+	OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetTypeNoOne  OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetType = "NoOne"
+	OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetTypeTeam   OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetType = "Team"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetType enum.
+func (e OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetTypeMember:
+		return true
+	case OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetTypeNoOne:
+		return true
+	case OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetTypeTeam:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionAssignedToType.
+const (
+	AssignedTo OrganizationWorkflowActionFilterConditionAssignedToType = "assigned_to"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionAssignedToType enum.
+func (e OrganizationWorkflowActionFilterConditionAssignedToType) Valid() bool {
+	switch e {
+	case AssignedTo:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionEventAttributeType.
+const (
+	EventAttribute OrganizationWorkflowActionFilterConditionEventAttributeType = "event_attribute"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionEventAttributeType enum.
+func (e OrganizationWorkflowActionFilterConditionEventAttributeType) Valid() bool {
+	switch e {
+	case EventAttribute:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionEventFrequencyCountType.
+const (
+	EventFrequencyCount OrganizationWorkflowActionFilterConditionEventFrequencyCountType = "event_frequency_count"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionEventFrequencyCountType enum.
+func (e OrganizationWorkflowActionFilterConditionEventFrequencyCountType) Valid() bool {
+	switch e {
+	case EventFrequencyCount:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionEventFrequencyPercentType.
+const (
+	EventFrequencyPercent OrganizationWorkflowActionFilterConditionEventFrequencyPercentType = "event_frequency_percent"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionEventFrequencyPercentType enum.
+func (e OrganizationWorkflowActionFilterConditionEventFrequencyPercentType) Valid() bool {
+	switch e {
+	case EventFrequencyPercent:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountType.
+const (
+	EventUniqueUserFrequencyCount OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountType = "event_unique_user_frequency_count"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountType enum.
+func (e OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountType) Valid() bool {
+	switch e {
+	case EventUniqueUserFrequencyCount:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionIssueCategoryType.
+const (
+	IssueCategory OrganizationWorkflowActionFilterConditionIssueCategoryType = "issue_category"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionIssueCategoryType enum.
+func (e OrganizationWorkflowActionFilterConditionIssueCategoryType) Valid() bool {
+	switch e {
+	case IssueCategory:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionIssueOccurrencesType.
+const (
+	IssueOccurrences OrganizationWorkflowActionFilterConditionIssueOccurrencesType = "issue_occurrences"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionIssueOccurrencesType enum.
+func (e OrganizationWorkflowActionFilterConditionIssueOccurrencesType) Valid() bool {
+	switch e {
+	case IssueOccurrences:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionIssuePriorityDeescalatingType.
+const (
+	IssuePriorityDeescalating OrganizationWorkflowActionFilterConditionIssuePriorityDeescalatingType = "issue_priority_deescalating"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionIssuePriorityDeescalatingType enum.
+func (e OrganizationWorkflowActionFilterConditionIssuePriorityDeescalatingType) Valid() bool {
+	switch e {
+	case IssuePriorityDeescalating:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqualType.
+const (
+	IssuePriorityGreaterOrEqual OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqualType = "issue_priority_greater_or_equal"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqualType enum.
+func (e OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqualType) Valid() bool {
+	switch e {
+	case IssuePriorityGreaterOrEqual:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionIssueTypeType.
+const (
+	IssueType OrganizationWorkflowActionFilterConditionIssueTypeType = "issue_type"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionIssueTypeType enum.
+func (e OrganizationWorkflowActionFilterConditionIssueTypeType) Valid() bool {
+	switch e {
+	case IssueType:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionLatestAdoptedReleaseType.
+const (
+	LatestAdoptedRelease OrganizationWorkflowActionFilterConditionLatestAdoptedReleaseType = "latest_adopted_release"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionLatestAdoptedReleaseType enum.
+func (e OrganizationWorkflowActionFilterConditionLatestAdoptedReleaseType) Valid() bool {
+	switch e {
+	case LatestAdoptedRelease:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionLatestReleaseType.
+const (
+	LatestRelease OrganizationWorkflowActionFilterConditionLatestReleaseType = "latest_release"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionLatestReleaseType enum.
+func (e OrganizationWorkflowActionFilterConditionLatestReleaseType) Valid() bool {
+	switch e {
+	case LatestRelease:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionLevelType.
+const (
+	Level OrganizationWorkflowActionFilterConditionLevelType = "level"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionLevelType enum.
+func (e OrganizationWorkflowActionFilterConditionLevelType) Valid() bool {
+	switch e {
+	case Level:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionPercentSessionsCountType.
+const (
+	PercentSessionsCount OrganizationWorkflowActionFilterConditionPercentSessionsCountType = "percent_sessions_count"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionPercentSessionsCountType enum.
+func (e OrganizationWorkflowActionFilterConditionPercentSessionsCountType) Valid() bool {
+	switch e {
+	case PercentSessionsCount:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionPercentSessionsPercentType.
+const (
+	PercentSessionsPercent OrganizationWorkflowActionFilterConditionPercentSessionsPercentType = "percent_sessions_percent"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionPercentSessionsPercentType enum.
+func (e OrganizationWorkflowActionFilterConditionPercentSessionsPercentType) Valid() bool {
+	switch e {
+	case PercentSessionsPercent:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionTaggedEventType.
+const (
+	TaggedEvent OrganizationWorkflowActionFilterConditionTaggedEventType = "tagged_event"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionTaggedEventType enum.
+func (e OrganizationWorkflowActionFilterConditionTaggedEventType) Valid() bool {
+	switch e {
+	case TaggedEvent:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowTriggerLogicType.
+const (
+	OrganizationWorkflowTriggerLogicTypeAnyShort OrganizationWorkflowTriggerLogicType = "any-short"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowTriggerLogicType enum.
+func (e OrganizationWorkflowTriggerLogicType) Valid() bool {
+	switch e {
+	case OrganizationWorkflowTriggerLogicTypeAnyShort:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectMonitorRequestMetricIssueType.
+const (
+	MetricIssue ProjectMonitorRequestMetricIssueType = "metric_issue"
+)
+
+// Valid indicates whether the value is a known member of the ProjectMonitorRequestMetricIssueType enum.
+func (e ProjectMonitorRequestMetricIssueType) Valid() bool {
+	switch e {
+	case MetricIssue:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectMonitorRequestMonitorCheckInFailureType.
+const (
+	MonitorCheckInFailure ProjectMonitorRequestMonitorCheckInFailureType = "monitor_check_in_failure"
+)
+
+// Valid indicates whether the value is a known member of the ProjectMonitorRequestMonitorCheckInFailureType enum.
+func (e ProjectMonitorRequestMonitorCheckInFailureType) Valid() bool {
+	switch e {
+	case MonitorCheckInFailure:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectMonitorRequestUptimeDomainFailureType.
+const (
+	UptimeDomainFailure ProjectMonitorRequestUptimeDomainFailureType = "uptime_domain_failure"
+)
+
+// Valid indicates whether the value is a known member of the ProjectMonitorRequestUptimeDomainFailureType enum.
+func (e ProjectMonitorRequestUptimeDomainFailureType) Valid() bool {
+	switch e {
+	case UptimeDomainFailure:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectMonitorConditionGroupLogicType.
+const (
+	ProjectMonitorConditionGroupLogicTypeAll      ProjectMonitorConditionGroupLogicType = "all"
+	ProjectMonitorConditionGroupLogicTypeAny      ProjectMonitorConditionGroupLogicType = "any"
+	ProjectMonitorConditionGroupLogicTypeAnyShort ProjectMonitorConditionGroupLogicType = "any-short"
+	ProjectMonitorConditionGroupLogicTypeNone     ProjectMonitorConditionGroupLogicType = "none"
+)
+
+// Valid indicates whether the value is a known member of the ProjectMonitorConditionGroupLogicType enum.
+func (e ProjectMonitorConditionGroupLogicType) Valid() bool {
+	switch e {
+	case ProjectMonitorConditionGroupLogicTypeAll:
+		return true
+	case ProjectMonitorConditionGroupLogicTypeAny:
+		return true
+	case ProjectMonitorConditionGroupLogicTypeAnyShort:
+		return true
+	case ProjectMonitorConditionGroupLogicTypeNone:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectMonitorConfigUptimeDomainFailureMode.
+const (
+	N1 ProjectMonitorConfigUptimeDomainFailureMode = 1
+	N2 ProjectMonitorConfigUptimeDomainFailureMode = 2
+	N3 ProjectMonitorConfigUptimeDomainFailureMode = 3
+)
+
+// Valid indicates whether the value is a known member of the ProjectMonitorConfigUptimeDomainFailureMode enum.
+func (e ProjectMonitorConfigUptimeDomainFailureMode) Valid() bool {
+	switch e {
+	case N1:
+		return true
+	case N2:
+		return true
+	case N3:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectMonitorDataSourceConfigCronCrontabScheduleType.
+const (
+	Crontab ProjectMonitorDataSourceConfigCronCrontabScheduleType = "crontab"
+)
+
+// Valid indicates whether the value is a known member of the ProjectMonitorDataSourceConfigCronCrontabScheduleType enum.
+func (e ProjectMonitorDataSourceConfigCronCrontabScheduleType) Valid() bool {
+	switch e {
+	case Crontab:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectMonitorDataSourceConfigCronIntervalScheduleType.
+const (
+	Interval ProjectMonitorDataSourceConfigCronIntervalScheduleType = "interval"
+)
+
+// Valid indicates whether the value is a known member of the ProjectMonitorDataSourceConfigCronIntervalScheduleType enum.
+func (e ProjectMonitorDataSourceConfigCronIntervalScheduleType) Valid() bool {
+	switch e {
+	case Interval:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectMonitorDataSourceWrapperCronMonitorType.
+const (
+	CronMonitor ProjectMonitorDataSourceWrapperCronMonitorType = "cron_monitor"
+)
+
+// Valid indicates whether the value is a known member of the ProjectMonitorDataSourceWrapperCronMonitorType enum.
+func (e ProjectMonitorDataSourceWrapperCronMonitorType) Valid() bool {
+	switch e {
+	case CronMonitor:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectMonitorDataSourceWrapperSnubaQuerySubscriptionType.
+const (
+	SnubaQuerySubscription ProjectMonitorDataSourceWrapperSnubaQuerySubscriptionType = "snuba_query_subscription"
+)
+
+// Valid indicates whether the value is a known member of the ProjectMonitorDataSourceWrapperSnubaQuerySubscriptionType enum.
+func (e ProjectMonitorDataSourceWrapperSnubaQuerySubscriptionType) Valid() bool {
+	switch e {
+	case SnubaQuerySubscription:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectMonitorDataSourceWrapperUptimeSubscriptionType.
+const (
+	UptimeSubscription ProjectMonitorDataSourceWrapperUptimeSubscriptionType = "uptime_subscription"
+)
+
+// Valid indicates whether the value is a known member of the ProjectMonitorDataSourceWrapperUptimeSubscriptionType enum.
+func (e ProjectMonitorDataSourceWrapperUptimeSubscriptionType) Valid() bool {
+	switch e {
+	case UptimeSubscription:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectMonitorOwnerTeamType.
+const (
+	ProjectMonitorOwnerTeamTypeTeam ProjectMonitorOwnerTeamType = "team"
+)
+
+// Valid indicates whether the value is a known member of the ProjectMonitorOwnerTeamType enum.
+func (e ProjectMonitorOwnerTeamType) Valid() bool {
+	switch e {
+	case ProjectMonitorOwnerTeamTypeTeam:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectMonitorOwnerUserType.
+const (
+	ProjectMonitorOwnerUserTypeUser ProjectMonitorOwnerUserType = "user"
+)
+
+// Valid indicates whether the value is a known member of the ProjectMonitorOwnerUserType enum.
+func (e ProjectMonitorOwnerUserType) Valid() bool {
+	switch e {
+	case ProjectMonitorOwnerUserTypeUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleActionAzureDevopsCreateTicketId.
+const (
+	SentryIntegrationsVstsNotifyActionAzureDevopsCreateTicketAction ProjectRuleActionAzureDevopsCreateTicketId = "sentry.integrations.vsts.notify_action.AzureDevopsCreateTicketAction"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleActionAzureDevopsCreateTicketId enum.
+func (e ProjectRuleActionAzureDevopsCreateTicketId) Valid() bool {
+	switch e {
+	case SentryIntegrationsVstsNotifyActionAzureDevopsCreateTicketAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleActionDiscordNotifyServiceId.
+const (
+	SentryIntegrationsDiscordNotifyActionDiscordNotifyServiceAction ProjectRuleActionDiscordNotifyServiceId = "sentry.integrations.discord.notify_action.DiscordNotifyServiceAction"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleActionDiscordNotifyServiceId enum.
+func (e ProjectRuleActionDiscordNotifyServiceId) Valid() bool {
+	switch e {
+	case SentryIntegrationsDiscordNotifyActionDiscordNotifyServiceAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleActionGitHubCreateTicketId.
+const (
+	SentryIntegrationsGithubNotifyActionGitHubCreateTicketAction ProjectRuleActionGitHubCreateTicketId = "sentry.integrations.github.notify_action.GitHubCreateTicketAction"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleActionGitHubCreateTicketId enum.
+func (e ProjectRuleActionGitHubCreateTicketId) Valid() bool {
+	switch e {
+	case SentryIntegrationsGithubNotifyActionGitHubCreateTicketAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleActionGitHubEnterpriseCreateTicketId.
+const (
+	SentryIntegrationsGithubEnterpriseNotifyActionGitHubEnterpriseCreateTicketAction ProjectRuleActionGitHubEnterpriseCreateTicketId = "sentry.integrations.github_enterprise.notify_action.GitHubEnterpriseCreateTicketAction"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleActionGitHubEnterpriseCreateTicketId enum.
+func (e ProjectRuleActionGitHubEnterpriseCreateTicketId) Valid() bool {
+	switch e {
+	case SentryIntegrationsGithubEnterpriseNotifyActionGitHubEnterpriseCreateTicketAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleActionJiraCreateTicketId.
+const (
+	ProjectRuleActionJiraCreateTicketIdSentryIntegrationsJiraNotifyActionJiraCreateTicketAction ProjectRuleActionJiraCreateTicketId = "sentry.integrations.jira.notify_action.JiraCreateTicketAction"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleActionJiraCreateTicketId enum.
+func (e ProjectRuleActionJiraCreateTicketId) Valid() bool {
+	switch e {
+	case ProjectRuleActionJiraCreateTicketIdSentryIntegrationsJiraNotifyActionJiraCreateTicketAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleActionJiraServerCreateTicketId.
+const (
+	ProjectRuleActionJiraServerCreateTicketIdSentryIntegrationsJiraNotifyActionJiraCreateTicketAction ProjectRuleActionJiraServerCreateTicketId = "sentry.integrations.jira.notify_action.JiraCreateTicketAction"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleActionJiraServerCreateTicketId enum.
+func (e ProjectRuleActionJiraServerCreateTicketId) Valid() bool {
+	switch e {
+	case ProjectRuleActionJiraServerCreateTicketIdSentryIntegrationsJiraNotifyActionJiraCreateTicketAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleActionMsTeamsNotifyServiceId.
+const (
+	SentryIntegrationsMsteamsNotifyActionMsTeamsNotifyServiceAction ProjectRuleActionMsTeamsNotifyServiceId = "sentry.integrations.msteams.notify_action.MsTeamsNotifyServiceAction"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleActionMsTeamsNotifyServiceId enum.
+func (e ProjectRuleActionMsTeamsNotifyServiceId) Valid() bool {
+	switch e {
+	case SentryIntegrationsMsteamsNotifyActionMsTeamsNotifyServiceAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleActionNotifyEmailId.
+const (
+	SentryMailActionsNotifyEmailAction ProjectRuleActionNotifyEmailId = "sentry.mail.actions.NotifyEmailAction"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleActionNotifyEmailId enum.
+func (e ProjectRuleActionNotifyEmailId) Valid() bool {
+	switch e {
+	case SentryMailActionsNotifyEmailAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleActionNotifyEventId.
+const (
+	SentryRulesActionsNotifyEventNotifyEventAction ProjectRuleActionNotifyEventId = "sentry.rules.actions.notify_event.NotifyEventAction"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleActionNotifyEventId enum.
+func (e ProjectRuleActionNotifyEventId) Valid() bool {
+	switch e {
+	case SentryRulesActionsNotifyEventNotifyEventAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleActionNotifyEventSentryAppId.
+const (
+	SentryRulesActionsNotifyEventSentryAppNotifyEventSentryAppAction ProjectRuleActionNotifyEventSentryAppId = "sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleActionNotifyEventSentryAppId enum.
+func (e ProjectRuleActionNotifyEventSentryAppId) Valid() bool {
+	switch e {
+	case SentryRulesActionsNotifyEventSentryAppNotifyEventSentryAppAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleActionNotifyEventServiceId.
+const (
+	SentryRulesActionsNotifyEventServiceNotifyEventServiceAction ProjectRuleActionNotifyEventServiceId = "sentry.rules.actions.notify_event_service.NotifyEventServiceAction"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleActionNotifyEventServiceId enum.
+func (e ProjectRuleActionNotifyEventServiceId) Valid() bool {
+	switch e {
+	case SentryRulesActionsNotifyEventServiceNotifyEventServiceAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleActionOpsgenieNotifyTeamId.
+const (
+	SentryIntegrationsOpsgenieNotifyActionOpsgenieNotifyTeamAction ProjectRuleActionOpsgenieNotifyTeamId = "sentry.integrations.opsgenie.notify_action.OpsgenieNotifyTeamAction"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleActionOpsgenieNotifyTeamId enum.
+func (e ProjectRuleActionOpsgenieNotifyTeamId) Valid() bool {
+	switch e {
+	case SentryIntegrationsOpsgenieNotifyActionOpsgenieNotifyTeamAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleActionPagerDutyNotifyServiceId.
+const (
+	SentryIntegrationsPagerdutyNotifyActionPagerDutyNotifyServiceAction ProjectRuleActionPagerDutyNotifyServiceId = "sentry.integrations.pagerduty.notify_action.PagerDutyNotifyServiceAction"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleActionPagerDutyNotifyServiceId enum.
+func (e ProjectRuleActionPagerDutyNotifyServiceId) Valid() bool {
+	switch e {
+	case SentryIntegrationsPagerdutyNotifyActionPagerDutyNotifyServiceAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleActionSlackNotifyServiceId.
+const (
+	SentryIntegrationsSlackNotifyActionSlackNotifyServiceAction ProjectRuleActionSlackNotifyServiceId = "sentry.integrations.slack.notify_action.SlackNotifyServiceAction"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleActionSlackNotifyServiceId enum.
+func (e ProjectRuleActionSlackNotifyServiceId) Valid() bool {
+	switch e {
+	case SentryIntegrationsSlackNotifyActionSlackNotifyServiceAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleConditionEventFrequencyId.
+const (
+	SentryRulesConditionsEventFrequencyEventFrequencyCondition ProjectRuleConditionEventFrequencyId = "sentry.rules.conditions.event_frequency.EventFrequencyCondition"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleConditionEventFrequencyId enum.
+func (e ProjectRuleConditionEventFrequencyId) Valid() bool {
+	switch e {
+	case SentryRulesConditionsEventFrequencyEventFrequencyCondition:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleConditionEventFrequencyPercentId.
+const (
+	SentryRulesConditionsEventFrequencyEventFrequencyPercentCondition ProjectRuleConditionEventFrequencyPercentId = "sentry.rules.conditions.event_frequency.EventFrequencyPercentCondition"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleConditionEventFrequencyPercentId enum.
+func (e ProjectRuleConditionEventFrequencyPercentId) Valid() bool {
+	switch e {
+	case SentryRulesConditionsEventFrequencyEventFrequencyPercentCondition:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleConditionEventUniqueUserFrequencyId.
+const (
+	SentryRulesConditionsEventFrequencyEventUniqueUserFrequencyCondition ProjectRuleConditionEventUniqueUserFrequencyId = "sentry.rules.conditions.event_frequency.EventUniqueUserFrequencyCondition"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleConditionEventUniqueUserFrequencyId enum.
+func (e ProjectRuleConditionEventUniqueUserFrequencyId) Valid() bool {
+	switch e {
+	case SentryRulesConditionsEventFrequencyEventUniqueUserFrequencyCondition:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleConditionExistingHighPriorityIssueId.
+const (
+	SentryRulesConditionsHighPriorityIssueExistingHighPriorityIssueCondition ProjectRuleConditionExistingHighPriorityIssueId = "sentry.rules.conditions.high_priority_issue.ExistingHighPriorityIssueCondition"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleConditionExistingHighPriorityIssueId enum.
+func (e ProjectRuleConditionExistingHighPriorityIssueId) Valid() bool {
+	switch e {
+	case SentryRulesConditionsHighPriorityIssueExistingHighPriorityIssueCondition:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleConditionFirstSeenEventId.
+const (
+	SentryRulesConditionsFirstSeenEventFirstSeenEventCondition ProjectRuleConditionFirstSeenEventId = "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleConditionFirstSeenEventId enum.
+func (e ProjectRuleConditionFirstSeenEventId) Valid() bool {
+	switch e {
+	case SentryRulesConditionsFirstSeenEventFirstSeenEventCondition:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleConditionNewHighPriorityIssueId.
+const (
+	SentryRulesConditionsHighPriorityIssueNewHighPriorityIssueCondition ProjectRuleConditionNewHighPriorityIssueId = "sentry.rules.conditions.high_priority_issue.NewHighPriorityIssueCondition"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleConditionNewHighPriorityIssueId enum.
+func (e ProjectRuleConditionNewHighPriorityIssueId) Valid() bool {
+	switch e {
+	case SentryRulesConditionsHighPriorityIssueNewHighPriorityIssueCondition:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleConditionReappearedEventId.
+const (
+	SentryRulesConditionsReappearedEventReappearedEventCondition ProjectRuleConditionReappearedEventId = "sentry.rules.conditions.reappeared_event.ReappearedEventCondition"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleConditionReappearedEventId enum.
+func (e ProjectRuleConditionReappearedEventId) Valid() bool {
+	switch e {
+	case SentryRulesConditionsReappearedEventReappearedEventCondition:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleConditionRegressionEventId.
+const (
+	SentryRulesConditionsRegressionEventRegressionEventCondition ProjectRuleConditionRegressionEventId = "sentry.rules.conditions.regression_event.RegressionEventCondition"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleConditionRegressionEventId enum.
+func (e ProjectRuleConditionRegressionEventId) Valid() bool {
+	switch e {
+	case SentryRulesConditionsRegressionEventRegressionEventCondition:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleFilterAgeComparisonId.
+const (
+	SentryRulesFiltersAgeComparisonAgeComparisonFilter ProjectRuleFilterAgeComparisonId = "sentry.rules.filters.age_comparison.AgeComparisonFilter"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleFilterAgeComparisonId enum.
+func (e ProjectRuleFilterAgeComparisonId) Valid() bool {
+	switch e {
+	case SentryRulesFiltersAgeComparisonAgeComparisonFilter:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleFilterAssignedToId.
+const (
+	SentryRulesFiltersAssignedToAssignedToFilter ProjectRuleFilterAssignedToId = "sentry.rules.filters.assigned_to.AssignedToFilter"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleFilterAssignedToId enum.
+func (e ProjectRuleFilterAssignedToId) Valid() bool {
+	switch e {
+	case SentryRulesFiltersAssignedToAssignedToFilter:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleFilterEventAttributeId.
+const (
+	SentryRulesFiltersEventAttributeEventAttributeFilter ProjectRuleFilterEventAttributeId = "sentry.rules.filters.event_attribute.EventAttributeFilter"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleFilterEventAttributeId enum.
+func (e ProjectRuleFilterEventAttributeId) Valid() bool {
+	switch e {
+	case SentryRulesFiltersEventAttributeEventAttributeFilter:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleFilterIssueCategoryId.
+const (
+	SentryRulesFiltersIssueCategoryIssueCategoryFilter ProjectRuleFilterIssueCategoryId = "sentry.rules.filters.issue_category.IssueCategoryFilter"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleFilterIssueCategoryId enum.
+func (e ProjectRuleFilterIssueCategoryId) Valid() bool {
+	switch e {
+	case SentryRulesFiltersIssueCategoryIssueCategoryFilter:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleFilterIssueOccurrencesId.
+const (
+	SentryRulesFiltersIssueOccurrencesIssueOccurrencesFilter ProjectRuleFilterIssueOccurrencesId = "sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleFilterIssueOccurrencesId enum.
+func (e ProjectRuleFilterIssueOccurrencesId) Valid() bool {
+	switch e {
+	case SentryRulesFiltersIssueOccurrencesIssueOccurrencesFilter:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleFilterLatestAdoptedReleaseId.
+const (
+	SentryRulesFiltersLatestAdoptedReleaseFilterLatestAdoptedReleaseFilter ProjectRuleFilterLatestAdoptedReleaseId = "sentry.rules.filters.latest_adopted_release_filter.LatestAdoptedReleaseFilter"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleFilterLatestAdoptedReleaseId enum.
+func (e ProjectRuleFilterLatestAdoptedReleaseId) Valid() bool {
+	switch e {
+	case SentryRulesFiltersLatestAdoptedReleaseFilterLatestAdoptedReleaseFilter:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleFilterLatestReleaseId.
+const (
+	SentryRulesFiltersLatestReleaseLatestReleaseFilter ProjectRuleFilterLatestReleaseId = "sentry.rules.filters.latest_release.LatestReleaseFilter"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleFilterLatestReleaseId enum.
+func (e ProjectRuleFilterLatestReleaseId) Valid() bool {
+	switch e {
+	case SentryRulesFiltersLatestReleaseLatestReleaseFilter:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleFilterLevelId.
+const (
+	SentryRulesFiltersLevelLevelFilter ProjectRuleFilterLevelId = "sentry.rules.filters.level.LevelFilter"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleFilterLevelId enum.
+func (e ProjectRuleFilterLevelId) Valid() bool {
+	switch e {
+	case SentryRulesFiltersLevelLevelFilter:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ProjectRuleFilterTaggedEventId.
+const (
+	SentryRulesFiltersTaggedEventTaggedEventFilter ProjectRuleFilterTaggedEventId = "sentry.rules.filters.tagged_event.TaggedEventFilter"
+)
+
+// Valid indicates whether the value is a known member of the ProjectRuleFilterTaggedEventId enum.
+func (e ProjectRuleFilterTaggedEventId) Valid() bool {
+	switch e {
+	case SentryRulesFiltersTaggedEventTaggedEventFilter:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ListProjectClientKeysParamsStatus.
+const (
+	Active   ListProjectClientKeysParamsStatus = "active"
+	Inactive ListProjectClientKeysParamsStatus = "inactive"
+)
+
+// Valid indicates whether the value is a known member of the ListProjectClientKeysParamsStatus enum.
+func (e ListProjectClientKeysParamsStatus) Valid() bool {
+	switch e {
+	case Active:
+		return true
+	case Inactive:
+		return true
+	default:
+		return false
+	}
+}
+
+// CreateExternalUser defines model for CreateExternalUser.
+type CreateExternalUser struct {
+	ExternalId    *string `json:"external_id,omitempty"`
+	ExternalName  string  `json:"external_name"`
+	Id            int     `json:"id"`
+	IntegrationId int     `json:"integration_id"`
+	Provider      string  `json:"provider"`
+	UserId        int     `json:"user_id"`
+}
+
+// CreateOrganization defines model for CreateOrganization.
+type CreateOrganization struct {
+	AgreeTerms bool    `json:"agreeTerms"`
+	Name       string  `json:"name"`
+	Slug       *string `json:"slug,omitempty"`
+}
+
+// ExternalUser defines model for ExternalUser.
+type ExternalUser struct {
+	ExternalName  string `json:"externalName"`
+	Id            string `json:"id"`
+	IntegrationId string `json:"integrationId"`
+	Provider      string `json:"provider"`
+	UserId        string `json:"userId"`
+}
+
+// NotificationAction defines model for NotificationAction.
+type NotificationAction struct {
+	Id               json.Number                          `json:"id"`
+	IntegrationId    *json.Number                         `json:"integrationId,omitempty"`
+	Projects         []json.Number                        `json:"projects"`
+	ServiceType      string                               `json:"serviceType"`
+	TargetDisplay    *string                              `json:"targetDisplay,omitempty"`
+	TargetIdentifier *NotificationAction_TargetIdentifier `json:"targetIdentifier,omitempty"`
+	TargetType       *string                              `json:"targetType,omitempty"`
+	TriggerType      string                               `json:"triggerType"`
+}
+
+// NotificationActionTargetIdentifier0 defines model for NotificationAction.TargetIdentifier.0.
+type NotificationActionTargetIdentifier0 = string
+
+// NotificationActionTargetIdentifier1 defines model for NotificationAction.TargetIdentifier.1.
+type NotificationActionTargetIdentifier1 = json.Number
+
+// NotificationAction_TargetIdentifier defines model for NotificationAction.TargetIdentifier.
+type NotificationAction_TargetIdentifier struct {
+	union json.RawMessage
+}
+
+// NotificationActionRequest defines model for NotificationActionRequest.
+type NotificationActionRequest struct {
+	IntegrationId    *json.Number `json:"integrationId,omitempty"`
+	Projects         []string     `json:"projects"`
+	ServiceType      string       `json:"serviceType"`
+	TargetDisplay    *string      `json:"targetDisplay,omitempty"`
+	TargetIdentifier *string      `json:"targetIdentifier,omitempty"`
+	TargetType       *string      `json:"targetType,omitempty"`
+	TriggerType      string       `json:"triggerType"`
+}
+
+// Organization defines model for Organization.
+type Organization struct {
+	AlertsMemberWrite          *bool                      `json:"alertsMemberWrite,omitempty"`
+	AllowJoinRequests          *bool                      `json:"allowJoinRequests,omitempty"`
+	AllowMemberProjectCreation *bool                      `json:"allowMemberProjectCreation,omitempty"`
+	AllowSharedIssues          *bool                      `json:"allowSharedIssues,omitempty"`
+	AttachmentsRole            *string                    `json:"attachmentsRole,omitempty"`
+	Avatar                     *OrganizationAvatar        `json:"avatar,omitempty"`
+	CodecovAccess              *bool                      `json:"codecovAccess,omitempty"`
+	DataScrubber               *bool                      `json:"dataScrubber,omitempty"`
+	DataScrubberDefaults       *bool                      `json:"dataScrubberDefaults,omitempty"`
+	DebugFilesRole             *string                    `json:"debugFilesRole,omitempty"`
+	DefaultRole                *string                    `json:"defaultRole,omitempty"`
+	EnhancedPrivacy            *bool                      `json:"enhancedPrivacy,omitempty"`
+	EventsMemberAdmin          *bool                      `json:"eventsMemberAdmin,omitempty"`
+	Features                   *[]string                  `json:"features,omitempty"`
+	GithubNudgeInvite          *bool                      `json:"githubNudgeInvite,omitempty"`
+	GithubOpenPRBot            *bool                      `json:"githubOpenPRBot,omitempty"`
+	GithubPRBot                *bool                      `json:"githubPRBot,omitempty"`
+	GitlabPRBot                *bool                      `json:"gitlabPRBot,omitempty"`
+	HideAiFeatures             *bool                      `json:"hideAiFeatures,omitempty"`
+	Id                         string                     `json:"id"`
+	IsEarlyAdopter             *bool                      `json:"isEarlyAdopter,omitempty"`
+	Name                       string                     `json:"name"`
+	OpenMembership             *bool                      `json:"openMembership,omitempty"`
+	OrgRoleList                []OrganizationRoleListItem `json:"orgRoleList"`
+	RelayPiiConfig             nullable.Nullable[string]  `json:"relayPiiConfig,omitempty"`
+	Require2FA                 *bool                      `json:"require2FA,omitempty"`
+	SafeFields                 *[]string                  `json:"safeFields,omitempty"`
+	ScrapeJavaScript           *bool                      `json:"scrapeJavaScript,omitempty"`
+	ScrubIPAddresses           *bool                      `json:"scrubIPAddresses,omitempty"`
+	SensitiveFields            *[]string                  `json:"sensitiveFields,omitempty"`
+	Slug                       string                     `json:"slug"`
+	StoreCrashReports          *int                       `json:"storeCrashReports,omitempty"`
+	TeamRoleList               []TeamRoleListItem         `json:"teamRoleList"`
+	TrustedRelays              *[]TrustedRelay            `json:"trustedRelays,omitempty"`
+}
+
+// OrganizationAlertRuleDetector defines model for OrganizationAlertRuleDetector.
+type OrganizationAlertRuleDetector struct {
+	AlertRuleId nullable.Nullable[string] `json:"alertRuleId,omitempty"`
+	DetectorId  string                    `json:"detectorId"`
+	RuleId      nullable.Nullable[string] `json:"ruleId,omitempty"`
+}
+
+// OrganizationAlertRuleWorkflow defines model for OrganizationAlertRuleWorkflow.
+type OrganizationAlertRuleWorkflow struct {
+	AlertRuleId nullable.Nullable[string] `json:"alertRuleId,omitempty"`
+	RuleId      nullable.Nullable[string] `json:"ruleId,omitempty"`
+	WorkflowId  string                    `json:"workflowId"`
+}
+
+// OrganizationAvatar defines model for OrganizationAvatar.
+type OrganizationAvatar struct {
+	AvatarType *string                   `json:"avatarType,omitempty"`
+	AvatarUuid nullable.Nullable[string] `json:"avatarUuid,omitempty"`
+}
+
+// OrganizationDashboard defines model for OrganizationDashboard.
+type OrganizationDashboard struct {
+	Environment []string                      `json:"environment"`
+	Id          string                        `json:"id"`
+	Period      nullable.Nullable[string]     `json:"period,omitempty"`
+	Projects    []int64                       `json:"projects"`
+	Title       string                        `json:"title"`
+	Widgets     []OrganizationDashboardWidget `json:"widgets"`
+}
+
+// OrganizationDashboardListItem defines model for OrganizationDashboardListItem.
+type OrganizationDashboardListItem struct {
+	Id    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// OrganizationDashboardRequest defines model for OrganizationDashboardRequest.
+type OrganizationDashboardRequest struct {
+	Environment *[]string                     `json:"environment,omitempty"`
+	Period      nullable.Nullable[string]     `json:"period,omitempty"`
+	Projects    *[]int64                      `json:"projects,omitempty"`
+	Title       string                        `json:"title"`
+	Widgets     []OrganizationDashboardWidget `json:"widgets"`
+}
+
+// OrganizationDashboardWidget defines model for OrganizationDashboard_Widget.
+type OrganizationDashboardWidget struct {
+	Description nullable.Nullable[string]          `json:"description,omitempty"`
+	DisplayType string                             `json:"displayType"`
+	Id          *string                            `json:"id,omitempty"`
+	Interval    *string                            `json:"interval,omitempty"`
+	Layout      *OrganizationDashboardWidgetLayout `json:"layout,omitempty"`
+	Limit       nullable.Nullable[int64]           `json:"limit,omitempty"`
+	Queries     []OrganizationDashboardWidgetQuery `json:"queries"`
+	Title       string                             `json:"title"`
+	WidgetType  *string                            `json:"widgetType,omitempty"`
+}
+
+// OrganizationDashboardWidgetLayout defines model for OrganizationDashboard_Widget_Layout.
+type OrganizationDashboardWidgetLayout struct {
+	H    int64 `json:"h"`
+	MinH int64 `json:"minH"`
+	W    int64 `json:"w"`
+	X    int64 `json:"x"`
+	Y    int64 `json:"y"`
+}
+
+// OrganizationDashboardWidgetQuery defines model for OrganizationDashboard_Widget_Query.
+type OrganizationDashboardWidgetQuery struct {
+	Aggregates   []string `json:"aggregates"`
+	Columns      []string `json:"columns"`
+	Conditions   string   `json:"conditions"`
+	FieldAliases []string `json:"fieldAliases"`
+	Fields       []string `json:"fields"`
+	Id           *string  `json:"id,omitempty"`
+	Name         string   `json:"name"`
+	Orderby      string   `json:"orderby"`
+}
+
+// OrganizationIntegration defines model for OrganizationIntegration.
+type OrganizationIntegration struct {
+	AccountType                   nullable.Nullable[string] `json:"accountType"`
+	ConfigData                    interface{}               `json:"configData"`
+	ConfigOrganization            interface{}               `json:"configOrganization"`
+	DomainName                    nullable.Nullable[string] `json:"domainName"`
+	GracePeriodEnd                nullable.Nullable[string] `json:"gracePeriodEnd"`
+	Icon                          nullable.Nullable[string] `json:"icon"`
+	Id                            string                    `json:"id"`
+	Name                          string                    `json:"name"`
+	OrganizationId                int                       `json:"organizationId"`
+	OrganizationIntegrationStatus string                    `json:"organizationIntegrationStatus"`
+	Provider                      struct {
+		Key string `json:"key"`
+	} `json:"provider"`
+	Scopes nullable.Nullable[[]string] `json:"scopes"`
+	Status string                      `json:"status"`
+	union  json.RawMessage
+}
+
+// OrganizationIntegrationOpsgenie defines model for OrganizationIntegration_Opsgenie.
+type OrganizationIntegrationOpsgenie struct {
+	ConfigData OrganizationIntegrationOpsgenieConfigData `json:"configData"`
+	Provider   struct {
+		Key OrganizationIntegrationOpsgenieProviderKey `json:"key"`
+	} `json:"provider"`
+}
+
+// OrganizationIntegrationOpsgenieProviderKey defines model for OrganizationIntegrationOpsgenie.Provider.Key.
+type OrganizationIntegrationOpsgenieProviderKey string
+
+// OrganizationIntegrationOpsgenieConfigData defines model for OrganizationIntegration_Opsgenie_ConfigData.
+type OrganizationIntegrationOpsgenieConfigData struct {
+	TeamTable []OrganizationIntegrationOpsgenieTeamTableItem `json:"team_table"`
+}
+
+// OrganizationIntegrationOpsgenieTeamTableItem defines model for OrganizationIntegration_Opsgenie_TeamTableItem.
+type OrganizationIntegrationOpsgenieTeamTableItem struct {
+	Id             string `json:"id"`
+	IntegrationKey string `json:"integration_key"`
+	Team           string `json:"team"`
+}
+
+// OrganizationIntegrationPagerDuty defines model for OrganizationIntegration_PagerDuty.
+type OrganizationIntegrationPagerDuty struct {
+	ConfigData OrganizationIntegrationPagerDutyConfigData `json:"configData"`
+	Provider   struct {
+		Key OrganizationIntegrationPagerDutyProviderKey `json:"key"`
+	} `json:"provider"`
+}
+
+// OrganizationIntegrationPagerDutyProviderKey defines model for OrganizationIntegrationPagerDuty.Provider.Key.
+type OrganizationIntegrationPagerDutyProviderKey string
+
+// OrganizationIntegrationPagerDutyConfigData defines model for OrganizationIntegration_PagerDuty_ConfigData.
+type OrganizationIntegrationPagerDutyConfigData struct {
+	ServiceTable []OrganizationIntegrationPagerDutyServiceTableItem `json:"service_table"`
+}
+
+// OrganizationIntegrationPagerDutyServiceTableItem defines model for OrganizationIntegration_PagerDuty_ServiceTableItem.
+type OrganizationIntegrationPagerDutyServiceTableItem struct {
+	Id             json.Number `json:"id"`
+	IntegrationKey string      `json:"integration_key"`
+	Service        string      `json:"service"`
+}
+
+// OrganizationMember defines model for OrganizationMember.
+type OrganizationMember struct {
+	Email   string `json:"email"`
+	Expired bool   `json:"expired"`
+	Id      string `json:"id"`
+	Name    string `json:"name"`
+	OrgRole string `json:"orgRole"`
+	Pending bool   `json:"pending"`
+	User    struct {
+		Id string `json:"id"`
+	} `json:"user"`
+}
+
+// OrganizationMemberTeam defines model for OrganizationMemberTeam.
+type OrganizationMemberTeam struct {
+	IsActive bool                      `json:"isActive"`
+	TeamRole nullable.Nullable[string] `json:"teamRole"`
+}
+
+// OrganizationMemberWithRoles defines model for OrganizationMemberWithRoles.
+type OrganizationMemberWithRoles struct {
+	Email        string                     `json:"email"`
+	Expired      bool                       `json:"expired"`
+	Id           string                     `json:"id"`
+	Name         string                     `json:"name"`
+	OrgRole      string                     `json:"orgRole"`
+	OrgRoleList  []OrganizationRoleListItem `json:"orgRoleList"`
+	Pending      bool                       `json:"pending"`
+	TeamRoleList []TeamRoleListItem         `json:"teamRoleList"`
+	TeamRoles    []TeamRole                 `json:"teamRoles"`
+}
+
+// OrganizationRepository defines model for OrganizationRepository.
+type OrganizationRepository struct {
+	DateCreated   *time.Time                          `json:"dateCreated,omitempty"`
+	ExternalId    nullable.Nullable[string]           `json:"externalId,omitempty"`
+	ExternalSlug  OrganizationRepository_ExternalSlug `json:"externalSlug"`
+	Id            string                              `json:"id"`
+	IntegrationId string                              `json:"integrationId"`
+	Name          string                              `json:"name"`
+	Provider      struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"provider"`
+	Status string                    `json:"status"`
+	Url    nullable.Nullable[string] `json:"url,omitempty"`
+}
+
+// OrganizationRepositoryExternalSlug0 defines model for OrganizationRepository.ExternalSlug.0.
+type OrganizationRepositoryExternalSlug0 = string
+
+// OrganizationRepositoryExternalSlug1 defines model for OrganizationRepository.ExternalSlug.1.
+type OrganizationRepositoryExternalSlug1 = json.Number
+
+// OrganizationRepository_ExternalSlug defines model for OrganizationRepository.ExternalSlug.
+type OrganizationRepository_ExternalSlug struct {
+	union json.RawMessage
+}
+
+// OrganizationRoleListItem defines model for OrganizationRoleListItem.
+type OrganizationRoleListItem struct {
+	Desc            string   `json:"desc"`
+	Id              string   `json:"id"`
+	IsAllowed       bool     `json:"isAllowed"`
+	IsGlobal        bool     `json:"isGlobal"`
+	IsRetired       bool     `json:"isRetired"`
+	MinimumTeamRole string   `json:"minimumTeamRole"`
+	Name            string   `json:"name"`
+	Scopes          []string `json:"scopes"`
+}
+
+// OrganizationWorkflow defines model for OrganizationWorkflow.
+type OrganizationWorkflow struct {
+	ActionFilters OrganizationWorkflow_ActionFilters `json:"actionFilters"`
+	Config        OrganizationWorkflowConfig         `json:"config"`
+	DetectorIds   []string                           `json:"detectorIds"`
+	Enabled       bool                               `json:"enabled"`
+	Environment   nullable.Nullable[string]          `json:"environment,omitempty"`
+	Id            string                             `json:"id"`
+	Name          string                             `json:"name"`
+	Triggers      OrganizationWorkflow_Triggers      `json:"triggers"`
+}
+
+// OrganizationWorkflowActionFilters0 defines model for OrganizationWorkflow.ActionFilters.0.
+type OrganizationWorkflowActionFilters0 = []OrganizationWorkflowActionFilter
+
+// OrganizationWorkflowActionFilters1 defines model for OrganizationWorkflow.ActionFilters.1.
+type OrganizationWorkflowActionFilters1 = interface{}
+
+// OrganizationWorkflow_ActionFilters defines model for OrganizationWorkflow.ActionFilters.
+type OrganizationWorkflow_ActionFilters struct {
+	union json.RawMessage
+}
+
+// OrganizationWorkflowTriggers1 defines model for OrganizationWorkflow.Triggers.1.
+type OrganizationWorkflowTriggers1 = interface{}
+
+// OrganizationWorkflow_Triggers defines model for OrganizationWorkflow.Triggers.
+type OrganizationWorkflow_Triggers struct {
+	union json.RawMessage
+}
+
+// OrganizationWorkflowRequest defines model for OrganizationWorkflowRequest.
+type OrganizationWorkflowRequest struct {
+	ActionFilters []OrganizationWorkflowActionFilter `json:"actionFilters"`
+	Config        OrganizationWorkflowConfig         `json:"config"`
+	DetectorIds   []string                           `json:"detectorIds"`
+	Enabled       bool                               `json:"enabled"`
+	Environment   nullable.Nullable[string]          `json:"environment,omitempty"`
+	Name          string                             `json:"name"`
+	Triggers      OrganizationWorkflowTrigger        `json:"triggers"`
+}
+
+// OrganizationWorkflowActionFilter defines model for OrganizationWorkflow_ActionFilter.
+type OrganizationWorkflowActionFilter struct {
+	Actions    []OrganizationWorkflowActionFilterAction    `json:"actions"`
+	Conditions []OrganizationWorkflowActionFilterCondition `json:"conditions"`
+	LogicType  OrganizationWorkflowActionFilterLogicType   `json:"logicType"`
+}
+
+// OrganizationWorkflowActionFilterLogicType defines model for OrganizationWorkflowActionFilter.LogicType.
+type OrganizationWorkflowActionFilterLogicType string
+
+// OrganizationWorkflowActionFilterAction defines model for OrganizationWorkflow_ActionFilter_Action.
+type OrganizationWorkflowActionFilterAction struct {
+	union json.RawMessage
+}
+
+// OrganizationWorkflowActionFilterActionDiscord defines model for OrganizationWorkflow_ActionFilter_Action_Discord.
+type OrganizationWorkflowActionFilterActionDiscord struct {
+	Config struct {
+		TargetDisplay    nullable.Nullable[string]                                     `json:"targetDisplay"`
+		TargetIdentifier string                                                        `json:"targetIdentifier"`
+		TargetType       OrganizationWorkflowActionFilterActionDiscordConfigTargetType `json:"targetType"`
+	} `json:"config"`
+	Data struct {
+		Tags *string `json:"tags,omitempty"`
+	} `json:"data"`
+	IntegrationId string                                            `json:"integrationId"`
+	Type          OrganizationWorkflowActionFilterActionDiscordType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterActionDiscordConfigTargetType defines model for OrganizationWorkflowActionFilterActionDiscord.Config.TargetType.
+type OrganizationWorkflowActionFilterActionDiscordConfigTargetType string
+
+// OrganizationWorkflowActionFilterActionDiscordType defines model for OrganizationWorkflowActionFilterActionDiscord.Type.
+type OrganizationWorkflowActionFilterActionDiscordType string
+
+// OrganizationWorkflowActionFilterActionEmail defines model for OrganizationWorkflow_ActionFilter_Action_Email.
+type OrganizationWorkflowActionFilterActionEmail struct {
+	Config struct {
+		TargetIdentifier *string                                                     `json:"targetIdentifier,omitempty"`
+		TargetType       OrganizationWorkflowActionFilterActionEmailConfigTargetType `json:"targetType"`
+	} `json:"config"`
+	Data struct {
+		FallthroughType *OrganizationWorkflowActionFilterActionEmailDataFallthroughType `json:"fallthroughType,omitempty"`
+	} `json:"data"`
+	Type OrganizationWorkflowActionFilterActionEmailType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterActionEmailConfigTargetType defines model for OrganizationWorkflowActionFilterActionEmail.Config.TargetType.
+type OrganizationWorkflowActionFilterActionEmailConfigTargetType string
+
+// OrganizationWorkflowActionFilterActionEmailDataFallthroughType defines model for OrganizationWorkflowActionFilterActionEmail.Data.FallthroughType.
+type OrganizationWorkflowActionFilterActionEmailDataFallthroughType string
+
+// OrganizationWorkflowActionFilterActionEmailType defines model for OrganizationWorkflowActionFilterActionEmail.Type.
+type OrganizationWorkflowActionFilterActionEmailType string
+
+// OrganizationWorkflowActionFilterActionGitHub defines model for OrganizationWorkflow_ActionFilter_Action_GitHub.
+type OrganizationWorkflowActionFilterActionGitHub struct {
+	Config struct {
+		TargetType OrganizationWorkflowActionFilterActionGitHubConfigTargetType `json:"targetType"`
+	} `json:"config"`
+	Data struct {
+		AdditionalFields struct {
+			Assignee    string   `json:"assignee"`
+			Integration string   `json:"integration"`
+			Labels      []string `json:"labels"`
+			Repo        string   `json:"repo"`
+		} `json:"additionalFields"`
+	} `json:"data"`
+	IntegrationId string                                           `json:"integrationId"`
+	Type          OrganizationWorkflowActionFilterActionGitHubType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterActionGitHubConfigTargetType defines model for OrganizationWorkflowActionFilterActionGitHub.Config.TargetType.
+type OrganizationWorkflowActionFilterActionGitHubConfigTargetType string
+
+// OrganizationWorkflowActionFilterActionGitHubType defines model for OrganizationWorkflowActionFilterActionGitHub.Type.
+type OrganizationWorkflowActionFilterActionGitHubType string
+
+// OrganizationWorkflowActionFilterActionJira defines model for OrganizationWorkflow_ActionFilter_Action_Jira.
+type OrganizationWorkflowActionFilterActionJira struct {
+	Config struct {
+		TargetType OrganizationWorkflowActionFilterActionJiraConfigTargetType `json:"targetType"`
+	} `json:"config"`
+	Data struct {
+		AdditionalFields struct {
+			Issuetype string `json:"issuetype"`
+			Project   string `json:"project"`
+		} `json:"additionalFields"`
+	} `json:"data"`
+	IntegrationId string                                         `json:"integrationId"`
+	Type          OrganizationWorkflowActionFilterActionJiraType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterActionJiraConfigTargetType defines model for OrganizationWorkflowActionFilterActionJira.Config.TargetType.
+type OrganizationWorkflowActionFilterActionJiraConfigTargetType string
+
+// OrganizationWorkflowActionFilterActionJiraType defines model for OrganizationWorkflowActionFilterActionJira.Type.
+type OrganizationWorkflowActionFilterActionJiraType string
+
+// OrganizationWorkflowActionFilterActionJiraServer defines model for OrganizationWorkflow_ActionFilter_Action_JiraServer.
+type OrganizationWorkflowActionFilterActionJiraServer struct {
+	Config struct {
+		TargetType OrganizationWorkflowActionFilterActionJiraServerConfigTargetType `json:"targetType"`
+	} `json:"config"`
+	Data struct {
+		AdditionalFields struct {
+			Issuetype string `json:"issuetype"`
+			Project   string `json:"project"`
+		} `json:"additionalFields"`
+	} `json:"data"`
+	IntegrationId string                                               `json:"integrationId"`
+	Type          OrganizationWorkflowActionFilterActionJiraServerType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterActionJiraServerConfigTargetType defines model for OrganizationWorkflowActionFilterActionJiraServer.Config.TargetType.
+type OrganizationWorkflowActionFilterActionJiraServerConfigTargetType string
+
+// OrganizationWorkflowActionFilterActionJiraServerType defines model for OrganizationWorkflowActionFilterActionJiraServer.Type.
+type OrganizationWorkflowActionFilterActionJiraServerType string
+
+// OrganizationWorkflowActionFilterActionMsTeams defines model for OrganizationWorkflow_ActionFilter_Action_MsTeams.
+type OrganizationWorkflowActionFilterActionMsTeams struct {
+	Config struct {
+		TargetDisplay    string                                                        `json:"targetDisplay"`
+		TargetIdentifier string                                                        `json:"targetIdentifier"`
+		TargetType       OrganizationWorkflowActionFilterActionMsTeamsConfigTargetType `json:"targetType"`
+	} `json:"config"`
+	Data          map[string]interface{}                            `json:"data"`
+	IntegrationId string                                            `json:"integrationId"`
+	Type          OrganizationWorkflowActionFilterActionMsTeamsType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterActionMsTeamsConfigTargetType defines model for OrganizationWorkflowActionFilterActionMsTeams.Config.TargetType.
+type OrganizationWorkflowActionFilterActionMsTeamsConfigTargetType string
+
+// OrganizationWorkflowActionFilterActionMsTeamsType defines model for OrganizationWorkflowActionFilterActionMsTeams.Type.
+type OrganizationWorkflowActionFilterActionMsTeamsType string
+
+// OrganizationWorkflowActionFilterActionOpsgenie defines model for OrganizationWorkflow_ActionFilter_Action_Opsgenie.
+type OrganizationWorkflowActionFilterActionOpsgenie struct {
+	Config struct {
+		TargetDisplay    string                                                         `json:"targetDisplay"`
+		TargetIdentifier string                                                         `json:"targetIdentifier"`
+		TargetType       OrganizationWorkflowActionFilterActionOpsgenieConfigTargetType `json:"targetType"`
+	} `json:"config"`
+	Data struct {
+		Priority *string `json:"priority,omitempty"`
+	} `json:"data"`
+	IntegrationId string                                             `json:"integrationId"`
+	Type          OrganizationWorkflowActionFilterActionOpsgenieType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterActionOpsgenieConfigTargetType defines model for OrganizationWorkflowActionFilterActionOpsgenie.Config.TargetType.
+type OrganizationWorkflowActionFilterActionOpsgenieConfigTargetType string
+
+// OrganizationWorkflowActionFilterActionOpsgenieType defines model for OrganizationWorkflowActionFilterActionOpsgenie.Type.
+type OrganizationWorkflowActionFilterActionOpsgenieType string
+
+// OrganizationWorkflowActionFilterActionPagerDuty defines model for OrganizationWorkflow_ActionFilter_Action_PagerDuty.
+type OrganizationWorkflowActionFilterActionPagerDuty struct {
+	Config struct {
+		TargetDisplay    string                                                          `json:"targetDisplay"`
+		TargetIdentifier string                                                          `json:"targetIdentifier"`
+		TargetType       OrganizationWorkflowActionFilterActionPagerDutyConfigTargetType `json:"targetType"`
+	} `json:"config"`
+	Data struct {
+		Priority *string `json:"priority,omitempty"`
+	} `json:"data"`
+	IntegrationId string                                              `json:"integrationId"`
+	Type          OrganizationWorkflowActionFilterActionPagerDutyType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterActionPagerDutyConfigTargetType defines model for OrganizationWorkflowActionFilterActionPagerDuty.Config.TargetType.
+type OrganizationWorkflowActionFilterActionPagerDutyConfigTargetType string
+
+// OrganizationWorkflowActionFilterActionPagerDutyType defines model for OrganizationWorkflowActionFilterActionPagerDuty.Type.
+type OrganizationWorkflowActionFilterActionPagerDutyType string
+
+// OrganizationWorkflowActionFilterActionPlugin defines model for OrganizationWorkflow_ActionFilter_Action_Plugin.
+type OrganizationWorkflowActionFilterActionPlugin struct {
+	Config map[string]interface{}                           `json:"config"`
+	Data   map[string]interface{}                           `json:"data"`
+	Type   OrganizationWorkflowActionFilterActionPluginType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterActionPluginType defines model for OrganizationWorkflowActionFilterActionPlugin.Type.
+type OrganizationWorkflowActionFilterActionPluginType string
+
+// OrganizationWorkflowActionFilterActionSentryApp defines model for OrganizationWorkflow_ActionFilter_Action_SentryApp.
+type OrganizationWorkflowActionFilterActionSentryApp struct {
+	Config struct {
+		TargetDisplay    *string                                                         `json:"targetDisplay,omitempty"`
+		TargetIdentifier string                                                          `json:"targetIdentifier"`
+		TargetType       OrganizationWorkflowActionFilterActionSentryAppConfigTargetType `json:"targetType"`
+	} `json:"config"`
+	Data struct {
+		Settings *[]struct {
+			Label *string `json:"label,omitempty"`
+			Name  string  `json:"name"`
+			Value string  `json:"value"`
+		} `json:"settings,omitempty"`
+	} `json:"data"`
+	Type OrganizationWorkflowActionFilterActionSentryAppType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterActionSentryAppConfigTargetType defines model for OrganizationWorkflowActionFilterActionSentryApp.Config.TargetType.
+type OrganizationWorkflowActionFilterActionSentryAppConfigTargetType string
+
+// OrganizationWorkflowActionFilterActionSentryAppType defines model for OrganizationWorkflowActionFilterActionSentryApp.Type.
+type OrganizationWorkflowActionFilterActionSentryAppType string
+
+// OrganizationWorkflowActionFilterActionSlack defines model for OrganizationWorkflow_ActionFilter_Action_Slack.
+type OrganizationWorkflowActionFilterActionSlack struct {
+	Config struct {
+		TargetDisplay    string                                                      `json:"targetDisplay"`
+		TargetIdentifier string                                                      `json:"targetIdentifier"`
+		TargetType       OrganizationWorkflowActionFilterActionSlackConfigTargetType `json:"targetType"`
+	} `json:"config"`
+	Data struct {
+		Notes *string `json:"notes,omitempty"`
+		Tags  *string `json:"tags,omitempty"`
+	} `json:"data"`
+	IntegrationId string                                          `json:"integrationId"`
+	Type          OrganizationWorkflowActionFilterActionSlackType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterActionSlackConfigTargetType defines model for OrganizationWorkflowActionFilterActionSlack.Config.TargetType.
+type OrganizationWorkflowActionFilterActionSlackConfigTargetType string
+
+// OrganizationWorkflowActionFilterActionSlackType defines model for OrganizationWorkflowActionFilterActionSlack.Type.
+type OrganizationWorkflowActionFilterActionSlackType string
+
+// OrganizationWorkflowActionFilterActionVsts defines model for OrganizationWorkflow_ActionFilter_Action_Vsts.
+type OrganizationWorkflowActionFilterActionVsts struct {
+	Config struct {
+		TargetType OrganizationWorkflowActionFilterActionVstsConfigTargetType `json:"targetType"`
+	} `json:"config"`
+	Data struct {
+		AdditionalFields struct {
+			Project      string `json:"project"`
+			WorkItemType string `json:"work_item_type"`
+		} `json:"additionalFields"`
+	} `json:"data"`
+	IntegrationId string                                         `json:"integrationId"`
+	Type          OrganizationWorkflowActionFilterActionVstsType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterActionVstsConfigTargetType defines model for OrganizationWorkflowActionFilterActionVsts.Config.TargetType.
+type OrganizationWorkflowActionFilterActionVstsConfigTargetType string
+
+// OrganizationWorkflowActionFilterActionVstsType defines model for OrganizationWorkflowActionFilterActionVsts.Type.
+type OrganizationWorkflowActionFilterActionVstsType string
+
+// OrganizationWorkflowActionFilterActionWebhook defines model for OrganizationWorkflow_ActionFilter_Action_Webhook.
+type OrganizationWorkflowActionFilterActionWebhook struct {
+	Config struct {
+		TargetIdentifier string `json:"targetIdentifier"`
+	} `json:"config"`
+	Data map[string]interface{}                            `json:"data"`
+	Type OrganizationWorkflowActionFilterActionWebhookType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterActionWebhookType defines model for OrganizationWorkflowActionFilterActionWebhook.Type.
+type OrganizationWorkflowActionFilterActionWebhookType string
+
+// OrganizationWorkflowActionFilterCondition defines model for OrganizationWorkflow_ActionFilter_Condition.
+type OrganizationWorkflowActionFilterCondition struct {
+	union json.RawMessage
+}
+
+// OrganizationWorkflowActionFilterConditionAgeComparison defines model for OrganizationWorkflow_ActionFilter_Condition_AgeComparison.
+type OrganizationWorkflowActionFilterConditionAgeComparison struct {
+	Comparison struct {
+		ComparisonType OrganizationWorkflowActionFilterConditionAgeComparisonComparisonComparisonType `json:"comparisonType"`
+		Time           OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime           `json:"time"`
+		Value          int64                                                                          `json:"value"`
+	} `json:"comparison"`
+	ConditionResult bool                                                       `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionAgeComparisonType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionAgeComparisonComparisonComparisonType defines model for OrganizationWorkflowActionFilterConditionAgeComparison.Comparison.ComparisonType.
+type OrganizationWorkflowActionFilterConditionAgeComparisonComparisonComparisonType string
+
+// OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime defines model for OrganizationWorkflowActionFilterConditionAgeComparison.Comparison.Time.
+type OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime string
+
+// OrganizationWorkflowActionFilterConditionAgeComparisonType defines model for OrganizationWorkflowActionFilterConditionAgeComparison.Type.
+type OrganizationWorkflowActionFilterConditionAgeComparisonType string
+
+// OrganizationWorkflowActionFilterConditionAssignedTo defines model for OrganizationWorkflow_ActionFilter_Condition_AssignedTo.
+type OrganizationWorkflowActionFilterConditionAssignedTo struct {
+	Comparison struct {
+		TargetIdentifier OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier `json:"targetIdentifier"`
+		TargetType       OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetType         `json:"targetType"`
+	} `json:"comparison"`
+	ConditionResult bool                                                    `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionAssignedToType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0 defines model for OrganizationWorkflowActionFilterConditionAssignedTo.Comparison.TargetIdentifier.0.
+type OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0 = string
+
+// OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1 defines model for OrganizationWorkflowActionFilterConditionAssignedTo.Comparison.TargetIdentifier.1.
+type OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1 = int64
+
+// OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier defines model for OrganizationWorkflowActionFilterConditionAssignedTo.Comparison.TargetIdentifier.
+type OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier struct {
+	union json.RawMessage
+}
+
+// OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetType defines model for OrganizationWorkflowActionFilterConditionAssignedTo.Comparison.TargetType.
+type OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetType string
+
+// OrganizationWorkflowActionFilterConditionAssignedToType defines model for OrganizationWorkflowActionFilterConditionAssignedTo.Type.
+type OrganizationWorkflowActionFilterConditionAssignedToType string
+
+// OrganizationWorkflowActionFilterConditionEventAttribute defines model for OrganizationWorkflow_ActionFilter_Condition_EventAttribute.
+type OrganizationWorkflowActionFilterConditionEventAttribute struct {
+	Comparison struct {
+		Attribute string  `json:"attribute"`
+		Match     string  `json:"match"`
+		Value     *string `json:"value,omitempty"`
+	} `json:"comparison"`
+	ConditionResult bool                                                        `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionEventAttributeType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionEventAttributeType defines model for OrganizationWorkflowActionFilterConditionEventAttribute.Type.
+type OrganizationWorkflowActionFilterConditionEventAttributeType string
+
+// OrganizationWorkflowActionFilterConditionEventFrequencyCount defines model for OrganizationWorkflow_ActionFilter_Condition_EventFrequencyCount.
+type OrganizationWorkflowActionFilterConditionEventFrequencyCount struct {
+	Comparison struct {
+		Filters  *[]OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountFilter `json:"filters,omitempty"`
+		Interval string                                                                          `json:"interval"`
+		Value    int64                                                                           `json:"value"`
+	} `json:"comparison"`
+	ConditionResult bool                                                             `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionEventFrequencyCountType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionEventFrequencyCountType defines model for OrganizationWorkflowActionFilterConditionEventFrequencyCount.Type.
+type OrganizationWorkflowActionFilterConditionEventFrequencyCountType string
+
+// OrganizationWorkflowActionFilterConditionEventFrequencyPercent defines model for OrganizationWorkflow_ActionFilter_Condition_EventFrequencyPercent.
+type OrganizationWorkflowActionFilterConditionEventFrequencyPercent struct {
+	Comparison struct {
+		ComparisonInterval string                                                                          `json:"comparisonInterval"`
+		Filters            *[]OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountFilter `json:"filters,omitempty"`
+		Interval           string                                                                          `json:"interval"`
+		Value              int64                                                                           `json:"value"`
+	} `json:"comparison"`
+	ConditionResult bool                                                               `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionEventFrequencyPercentType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionEventFrequencyPercentType defines model for OrganizationWorkflowActionFilterConditionEventFrequencyPercent.Type.
+type OrganizationWorkflowActionFilterConditionEventFrequencyPercentType string
+
+// OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount defines model for OrganizationWorkflow_ActionFilter_Condition_EventUniqueUserFrequencyCount.
+type OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount struct {
+	Comparison struct {
+		Filters  []OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountFilter `json:"filters"`
+		Interval string                                                                         `json:"interval"`
+		Value    int64                                                                          `json:"value"`
+	} `json:"comparison"`
+	ConditionResult bool                                                                       `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountType defines model for OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount.Type.
+type OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountType string
+
+// OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountFilter defines model for OrganizationWorkflow_ActionFilter_Condition_EventUniqueUserFrequencyCount_Filter.
+type OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountFilter struct {
+	Attribute *string `json:"attribute,omitempty"`
+	Key       *string `json:"key,omitempty"`
+	Match     *string `json:"match,omitempty"`
+	Value     *string `json:"value,omitempty"`
+}
+
+// OrganizationWorkflowActionFilterConditionIssueCategory defines model for OrganizationWorkflow_ActionFilter_Condition_IssueCategory.
+type OrganizationWorkflowActionFilterConditionIssueCategory struct {
+	Comparison struct {
+		Include *bool `json:"include,omitempty"`
+		Value   int64 `json:"value"`
+	} `json:"comparison"`
+	ConditionResult bool                                                       `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionIssueCategoryType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionIssueCategoryType defines model for OrganizationWorkflowActionFilterConditionIssueCategory.Type.
+type OrganizationWorkflowActionFilterConditionIssueCategoryType string
+
+// OrganizationWorkflowActionFilterConditionIssueOccurrences defines model for OrganizationWorkflow_ActionFilter_Condition_IssueOccurrences.
+type OrganizationWorkflowActionFilterConditionIssueOccurrences struct {
+	Comparison struct {
+		Value int64 `json:"value"`
+	} `json:"comparison"`
+	ConditionResult bool                                                          `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionIssueOccurrencesType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionIssueOccurrencesType defines model for OrganizationWorkflowActionFilterConditionIssueOccurrences.Type.
+type OrganizationWorkflowActionFilterConditionIssueOccurrencesType string
+
+// OrganizationWorkflowActionFilterConditionIssuePriorityDeescalating defines model for OrganizationWorkflow_ActionFilter_Condition_IssuePriorityDeescalating.
+type OrganizationWorkflowActionFilterConditionIssuePriorityDeescalating struct {
+	Comparison      int64                                                                  `json:"comparison"`
+	ConditionResult bool                                                                   `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionIssuePriorityDeescalatingType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionIssuePriorityDeescalatingType defines model for OrganizationWorkflowActionFilterConditionIssuePriorityDeescalating.Type.
+type OrganizationWorkflowActionFilterConditionIssuePriorityDeescalatingType string
+
+// OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual defines model for OrganizationWorkflow_ActionFilter_Condition_IssuePriorityGreaterOrEqual.
+type OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual struct {
+	Comparison      int64                                                                    `json:"comparison"`
+	ConditionResult bool                                                                     `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqualType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqualType defines model for OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual.Type.
+type OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqualType string
+
+// OrganizationWorkflowActionFilterConditionIssueType defines model for OrganizationWorkflow_ActionFilter_Condition_IssueType.
+type OrganizationWorkflowActionFilterConditionIssueType struct {
+	Comparison struct {
+		Include bool   `json:"include"`
+		Value   string `json:"value"`
+	} `json:"comparison"`
+	ConditionResult bool                                                   `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionIssueTypeType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionIssueTypeType defines model for OrganizationWorkflowActionFilterConditionIssueType.Type.
+type OrganizationWorkflowActionFilterConditionIssueTypeType string
+
+// OrganizationWorkflowActionFilterConditionLatestAdoptedRelease defines model for OrganizationWorkflow_ActionFilter_Condition_LatestAdoptedRelease.
+type OrganizationWorkflowActionFilterConditionLatestAdoptedRelease struct {
+	Comparison struct {
+		AgeComparison  string `json:"ageComparison"`
+		Environment    string `json:"environment"`
+		ReleaseAgeType string `json:"releaseAgeType"`
+	} `json:"comparison"`
+	ConditionResult bool                                                              `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionLatestAdoptedReleaseType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionLatestAdoptedReleaseType defines model for OrganizationWorkflowActionFilterConditionLatestAdoptedRelease.Type.
+type OrganizationWorkflowActionFilterConditionLatestAdoptedReleaseType string
+
+// OrganizationWorkflowActionFilterConditionLatestRelease defines model for OrganizationWorkflow_ActionFilter_Condition_LatestRelease.
+type OrganizationWorkflowActionFilterConditionLatestRelease struct {
+	Comparison      bool                                                       `json:"comparison"`
+	ConditionResult bool                                                       `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionLatestReleaseType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionLatestReleaseType defines model for OrganizationWorkflowActionFilterConditionLatestRelease.Type.
+type OrganizationWorkflowActionFilterConditionLatestReleaseType string
+
+// OrganizationWorkflowActionFilterConditionLevel defines model for OrganizationWorkflow_ActionFilter_Condition_Level.
+type OrganizationWorkflowActionFilterConditionLevel struct {
+	Comparison struct {
+		Level int64  `json:"level"`
+		Match string `json:"match"`
+	} `json:"comparison"`
+	ConditionResult bool                                               `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionLevelType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionLevelType defines model for OrganizationWorkflowActionFilterConditionLevel.Type.
+type OrganizationWorkflowActionFilterConditionLevelType string
+
+// OrganizationWorkflowActionFilterConditionPercentSessionsCount defines model for OrganizationWorkflow_ActionFilter_Condition_PercentSessionsCount.
+type OrganizationWorkflowActionFilterConditionPercentSessionsCount struct {
+	Comparison struct {
+		Interval string `json:"interval"`
+		Value    int64  `json:"value"`
+	} `json:"comparison"`
+	ConditionResult bool                                                              `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionPercentSessionsCountType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionPercentSessionsCountType defines model for OrganizationWorkflowActionFilterConditionPercentSessionsCount.Type.
+type OrganizationWorkflowActionFilterConditionPercentSessionsCountType string
+
+// OrganizationWorkflowActionFilterConditionPercentSessionsPercent defines model for OrganizationWorkflow_ActionFilter_Condition_PercentSessionsPercent.
+type OrganizationWorkflowActionFilterConditionPercentSessionsPercent struct {
+	Comparison struct {
+		ComparisonInterval string                                                                          `json:"comparisonInterval"`
+		Filters            *[]OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountFilter `json:"filters,omitempty"`
+		Interval           string                                                                          `json:"interval"`
+		Value              int64                                                                           `json:"value"`
+	} `json:"comparison"`
+	ConditionResult bool                                                                `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionPercentSessionsPercentType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionPercentSessionsPercentType defines model for OrganizationWorkflowActionFilterConditionPercentSessionsPercent.Type.
+type OrganizationWorkflowActionFilterConditionPercentSessionsPercentType string
+
+// OrganizationWorkflowActionFilterConditionTaggedEvent defines model for OrganizationWorkflow_ActionFilter_Condition_TaggedEvent.
+type OrganizationWorkflowActionFilterConditionTaggedEvent struct {
+	Comparison struct {
+		Key   string  `json:"key"`
+		Match string  `json:"match"`
+		Value *string `json:"value,omitempty"`
+	} `json:"comparison"`
+	ConditionResult bool                                                     `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionTaggedEventType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionTaggedEventType defines model for OrganizationWorkflowActionFilterConditionTaggedEvent.Type.
+type OrganizationWorkflowActionFilterConditionTaggedEventType string
+
+// OrganizationWorkflowConfig defines model for OrganizationWorkflow_Config.
+type OrganizationWorkflowConfig struct {
+	Frequency int64 `json:"frequency"`
+}
+
+// OrganizationWorkflowTrigger defines model for OrganizationWorkflow_Trigger.
+type OrganizationWorkflowTrigger struct {
+	Conditions []OrganizationWorkflowTriggerCondition `json:"conditions"`
+	LogicType  OrganizationWorkflowTriggerLogicType   `json:"logicType"`
+}
+
+// OrganizationWorkflowTriggerLogicType defines model for OrganizationWorkflowTrigger.LogicType.
+type OrganizationWorkflowTriggerLogicType string
+
+// OrganizationWorkflowTriggerCondition defines model for OrganizationWorkflow_Trigger_Condition.
+type OrganizationWorkflowTriggerCondition struct {
+	Comparison      OrganizationWorkflowTriggerCondition_Comparison `json:"comparison"`
+	ConditionResult bool                                            `json:"conditionResult"`
+	Id              *json.Number                                    `json:"id,omitempty"`
+	Type            string                                          `json:"type"`
+}
+
+// OrganizationWorkflowTriggerConditionComparison0 defines model for OrganizationWorkflowTriggerCondition.Comparison.0.
+type OrganizationWorkflowTriggerConditionComparison0 = bool
+
+// OrganizationWorkflowTriggerConditionComparison1 defines model for OrganizationWorkflowTriggerCondition.Comparison.1.
+type OrganizationWorkflowTriggerConditionComparison1 = map[string]interface{}
+
+// OrganizationWorkflowTriggerCondition_Comparison defines model for OrganizationWorkflowTriggerCondition.Comparison.
+type OrganizationWorkflowTriggerCondition_Comparison struct {
+	union json.RawMessage
+}
+
+// Project defines model for Project.
+type Project struct {
+	AllowedDomains       []string                  `json:"allowedDomains"`
+	Color                string                    `json:"color"`
+	DateCreated          time.Time                 `json:"dateCreated"`
+	DigestsMaxDelay      int64                     `json:"digestsMaxDelay"`
+	DigestsMinDelay      int64                     `json:"digestsMinDelay"`
+	Features             []string                  `json:"features"`
+	FingerprintingRules  string                    `json:"fingerprintingRules"`
+	GroupingEnhancements string                    `json:"groupingEnhancements"`
+	HighlightTags        *[]string                 `json:"highlightTags,omitempty"`
+	Id                   string                    `json:"id"`
+	IsPublic             bool                      `json:"isPublic"`
+	Name                 string                    `json:"name"`
+	Options              map[string]interface{}    `json:"options"`
+	Organization         Organization              `json:"organization"`
+	Platform             string                    `json:"platform"`
+	ResolveAge           int64                     `json:"resolveAge"`
+	ScrapeJavaScript     bool                      `json:"scrapeJavaScript"`
+	SecurityToken        string                    `json:"securityToken"`
+	SecurityTokenHeader  nullable.Nullable[string] `json:"securityTokenHeader"`
+	Slug                 string                    `json:"slug"`
+	SubjectTemplate      string                    `json:"subjectTemplate"`
+	Teams                []Team                    `json:"teams"`
+	VerifySSL            bool                      `json:"verifySSL"`
+}
+
+// ProjectFilter defines model for ProjectFilter.
+type ProjectFilter struct {
+	Active ProjectFilter_Active `json:"active"`
+	Id     string               `json:"id"`
+}
+
+// ProjectFilterActive0 defines model for ProjectFilter.Active.0.
+type ProjectFilterActive0 = bool
+
+// ProjectFilterActive1 defines model for ProjectFilter.Active.1.
+type ProjectFilterActive1 = []string
+
+// ProjectFilter_Active defines model for ProjectFilter.Active.
+type ProjectFilter_Active struct {
+	union json.RawMessage
+}
+
+// ProjectKey defines model for ProjectKey.
+type ProjectKey struct {
+	BrowserSdkVersion       string            `json:"browserSdkVersion"`
+	DateCreated             time.Time         `json:"dateCreated"`
+	Dsn                     map[string]string `json:"dsn"`
+	DynamicSdkLoaderOptions struct {
+		HasDebug       bool `json:"hasDebug"`
+		HasPerformance bool `json:"hasPerformance"`
+		HasReplay      bool `json:"hasReplay"`
+	} `json:"dynamicSdkLoaderOptions"`
+	Id        string      `json:"id"`
+	IsActive  bool        `json:"isActive"`
+	Label     string      `json:"label"`
+	Name      string      `json:"name"`
+	ProjectId json.Number `json:"projectId"`
+	Public    string      `json:"public"`
+	RateLimit nullable.Nullable[struct {
+		Count  int64 `json:"count"`
+		Window int64 `json:"window"`
+	}] `json:"rateLimit"`
+	Secret string `json:"secret"`
+}
+
+// ProjectMonitor defines model for ProjectMonitor.
+type ProjectMonitor struct {
+	ConditionGroup ProjectMonitorConditionGroup           `json:"conditionGroup"`
+	Config         ProjectMonitorConfig                   `json:"config"`
+	DataSources    []ProjectMonitorDataSourceWrapper      `json:"dataSources"`
+	DateCreated    time.Time                              `json:"dateCreated"`
+	DateUpdated    time.Time                              `json:"dateUpdated"`
+	Description    nullable.Nullable[string]              `json:"description"`
+	Enabled        bool                                   `json:"enabled"`
+	Id             string                                 `json:"id"`
+	Name           string                                 `json:"name"`
+	Owner          nullable.Nullable[ProjectMonitorOwner] `json:"owner"`
+	ProjectId      string                                 `json:"projectId"`
+	Type           string                                 `json:"type"`
+}
+
+// ProjectMonitorRequest defines model for ProjectMonitorRequest.
+type ProjectMonitorRequest struct {
+	union json.RawMessage
+}
+
+// ProjectMonitorRequestBase defines model for ProjectMonitorRequest_Base.
+type ProjectMonitorRequestBase struct {
+	Config      *ProjectMonitorConfig     `json:"config,omitempty"`
+	Description nullable.Nullable[string] `json:"description"`
+	Enabled     nullable.Nullable[bool]   `json:"enabled,omitempty"`
+	Name        string                    `json:"name"`
+	Owner       nullable.Nullable[string] `json:"owner"`
+	ProjectId   string                    `json:"projectId"`
+}
+
+// ProjectMonitorRequestGeneric defines model for ProjectMonitorRequest_Generic.
+type ProjectMonitorRequestGeneric struct {
+	ConditionGroup *json.RawMessage          `json:"conditionGroup,omitempty"`
+	Config         *ProjectMonitorConfig     `json:"config,omitempty"`
+	DataSources    *[]json.RawMessage        `json:"dataSources,omitempty"`
+	Description    nullable.Nullable[string] `json:"description"`
+	Enabled        nullable.Nullable[bool]   `json:"enabled,omitempty"`
+	Name           string                    `json:"name"`
+	Owner          nullable.Nullable[string] `json:"owner"`
+	ProjectId      string                    `json:"projectId"`
+	Type           string                    `json:"type"`
+}
+
+// ProjectMonitorRequestMetricIssue defines model for ProjectMonitorRequest_MetricIssue.
+type ProjectMonitorRequestMetricIssue struct {
+	ConditionGroup ProjectMonitorConditionGroup                     `json:"conditionGroup"`
+	Config         *ProjectMonitorConfig                            `json:"config,omitempty"`
+	DataSources    []ProjectMonitorDataSourceSnubaQuerySubscription `json:"dataSources"`
+	Description    nullable.Nullable[string]                        `json:"description"`
+	Enabled        nullable.Nullable[bool]                          `json:"enabled,omitempty"`
+	Name           string                                           `json:"name"`
+	Owner          nullable.Nullable[string]                        `json:"owner"`
+	ProjectId      string                                           `json:"projectId"`
+	Type           ProjectMonitorRequestMetricIssueType             `json:"type"`
+}
+
+// ProjectMonitorRequestMetricIssueType defines model for ProjectMonitorRequestMetricIssue.Type.
+type ProjectMonitorRequestMetricIssueType string
+
+// ProjectMonitorRequestMonitorCheckInFailure defines model for ProjectMonitorRequest_MonitorCheckInFailure.
+type ProjectMonitorRequestMonitorCheckInFailure struct {
+	Config      *ProjectMonitorConfig                          `json:"config,omitempty"`
+	DataSources []ProjectMonitorDataSourceCron                 `json:"dataSources"`
+	Description nullable.Nullable[string]                      `json:"description"`
+	Enabled     nullable.Nullable[bool]                        `json:"enabled,omitempty"`
+	Name        string                                         `json:"name"`
+	Owner       nullable.Nullable[string]                      `json:"owner"`
+	ProjectId   string                                         `json:"projectId"`
+	Type        ProjectMonitorRequestMonitorCheckInFailureType `json:"type"`
+}
+
+// ProjectMonitorRequestMonitorCheckInFailureType defines model for ProjectMonitorRequestMonitorCheckInFailure.Type.
+type ProjectMonitorRequestMonitorCheckInFailureType string
+
+// ProjectMonitorRequestUptimeDomainFailure defines model for ProjectMonitorRequest_UptimeDomainFailure.
+type ProjectMonitorRequestUptimeDomainFailure struct {
+	Config      *ProjectMonitorConfig                         `json:"config,omitempty"`
+	DataSources []ProjectMonitorDataSourceUptimeDomainFailure `json:"dataSources"`
+	Description nullable.Nullable[string]                     `json:"description"`
+	Enabled     nullable.Nullable[bool]                       `json:"enabled,omitempty"`
+	Name        string                                        `json:"name"`
+	Owner       nullable.Nullable[string]                     `json:"owner"`
+	ProjectId   string                                        `json:"projectId"`
+	Type        ProjectMonitorRequestUptimeDomainFailureType  `json:"type"`
+}
+
+// ProjectMonitorRequestUptimeDomainFailureType defines model for ProjectMonitorRequestUptimeDomainFailure.Type.
+type ProjectMonitorRequestUptimeDomainFailureType string
+
+// ProjectMonitorConditionGroup defines model for ProjectMonitor_ConditionGroup.
+type ProjectMonitorConditionGroup struct {
+	Conditions []ProjectMonitorConditionGroupCondition `json:"conditions"`
+	LogicType  ProjectMonitorConditionGroupLogicType   `json:"logicType"`
+}
+
+// ProjectMonitorConditionGroupLogicType defines model for ProjectMonitorConditionGroup.LogicType.
+type ProjectMonitorConditionGroupLogicType string
+
+// ProjectMonitorConditionGroupCondition defines model for ProjectMonitor_ConditionGroup_Condition.
+type ProjectMonitorConditionGroupCondition struct {
+	Comparison      ProjectMonitorConditionGroupCondition_Comparison `json:"comparison"`
+	ConditionResult int64                                            `json:"conditionResult"`
+	Id              *json.Number                                     `json:"id,omitempty"`
+	Type            string                                           `json:"type"`
+}
+
+// ProjectMonitorConditionGroupConditionComparison0 defines model for ProjectMonitorConditionGroupCondition.Comparison.0.
+type ProjectMonitorConditionGroupConditionComparison0 = string
+
+// ProjectMonitorConditionGroupConditionComparison1 defines model for ProjectMonitorConditionGroupCondition.Comparison.1.
+type ProjectMonitorConditionGroupConditionComparison1 = json.Number
+
+// ProjectMonitorConditionGroupConditionComparison2 defines model for ProjectMonitorConditionGroupCondition.Comparison.2.
+type ProjectMonitorConditionGroupConditionComparison2 struct {
+	Seasonality   string `json:"seasonality"`
+	Sensitivity   string `json:"sensitivity"`
+	ThresholdType int64  `json:"thresholdType"`
+}
+
+// ProjectMonitorConditionGroupCondition_Comparison defines model for ProjectMonitorConditionGroupCondition.Comparison.
+type ProjectMonitorConditionGroupCondition_Comparison struct {
+	union json.RawMessage
+}
+
+// ProjectMonitorConfig defines model for ProjectMonitor_Config.
+type ProjectMonitorConfig struct {
+	union json.RawMessage
+}
+
+// ProjectMonitorConfigMetricIssue defines model for ProjectMonitor_Config_MetricIssue.
+type ProjectMonitorConfigMetricIssue struct {
+	ComparisonDelta *int64  `json:"comparisonDelta,omitempty"`
+	DetectionType   *string `json:"detectionType,omitempty"`
+}
+
+// ProjectMonitorConfigUptimeDomainFailure defines model for ProjectMonitor_Config_UptimeDomainFailure.
+type ProjectMonitorConfigUptimeDomainFailure struct {
+	DowntimeThreshold int64                                       `json:"downtimeThreshold"`
+	Environment       string                                      `json:"environment"`
+	Mode              ProjectMonitorConfigUptimeDomainFailureMode `json:"mode"`
+	RecoveryThreshold int64                                       `json:"recoveryThreshold"`
+}
+
+// ProjectMonitorConfigUptimeDomainFailureMode defines model for ProjectMonitorConfigUptimeDomainFailure.Mode.
+type ProjectMonitorConfigUptimeDomainFailureMode int64
+
+// ProjectMonitorDataSourceConfigCron defines model for ProjectMonitor_DataSource_Config_Cron.
+type ProjectMonitorDataSourceConfigCron struct {
+	union json.RawMessage
+}
+
+// ProjectMonitorDataSourceConfigCronBase defines model for ProjectMonitor_DataSource_Config_Cron_Base.
+type ProjectMonitorDataSourceConfigCronBase struct {
+	CheckinMargin         int64  `json:"checkin_margin"`
+	FailureIssueThreshold int64  `json:"failure_issue_threshold"`
+	MaxRuntime            int64  `json:"max_runtime"`
+	RecoveryThreshold     int64  `json:"recovery_threshold"`
+	Timezone              string `json:"timezone"`
+}
+
+// ProjectMonitorDataSourceConfigCronCrontab defines model for ProjectMonitor_DataSource_Config_Cron_Crontab.
+type ProjectMonitorDataSourceConfigCronCrontab struct {
+	CheckinMargin         int64                                                 `json:"checkin_margin"`
+	FailureIssueThreshold int64                                                 `json:"failure_issue_threshold"`
+	MaxRuntime            int64                                                 `json:"max_runtime"`
+	RecoveryThreshold     int64                                                 `json:"recovery_threshold"`
+	Schedule              string                                                `json:"schedule"`
+	ScheduleType          ProjectMonitorDataSourceConfigCronCrontabScheduleType `json:"schedule_type"`
+	Timezone              string                                                `json:"timezone"`
+}
+
+// ProjectMonitorDataSourceConfigCronCrontabScheduleType defines model for ProjectMonitorDataSourceConfigCronCrontab.ScheduleType.
+type ProjectMonitorDataSourceConfigCronCrontabScheduleType string
+
+// ProjectMonitorDataSourceConfigCronInterval defines model for ProjectMonitor_DataSource_Config_Cron_Interval.
+type ProjectMonitorDataSourceConfigCronInterval struct {
+	CheckinMargin         int64                                                      `json:"checkin_margin"`
+	FailureIssueThreshold int64                                                      `json:"failure_issue_threshold"`
+	MaxRuntime            int64                                                      `json:"max_runtime"`
+	RecoveryThreshold     int64                                                      `json:"recovery_threshold"`
+	Schedule              []ProjectMonitorDataSourceConfigCronInterval_Schedule_Item `json:"schedule"`
+	ScheduleType          ProjectMonitorDataSourceConfigCronIntervalScheduleType     `json:"schedule_type"`
+	Timezone              string                                                     `json:"timezone"`
+}
+
+// ProjectMonitorDataSourceConfigCronInterval_Schedule_Item defines model for ProjectMonitor_DataSource_Config_Cron_Interval.schedule.Item.
+type ProjectMonitorDataSourceConfigCronInterval_Schedule_Item struct {
+	union json.RawMessage
+}
+
+// ProjectMonitorDataSourceConfigCronIntervalScheduleType defines model for ProjectMonitorDataSourceConfigCronInterval.ScheduleType.
+type ProjectMonitorDataSourceConfigCronIntervalScheduleType string
+
+// ProjectMonitorDataSourceConfigCronIntervalUnit defines model for ProjectMonitor_DataSource_Config_Cron_Interval_Unit.
+type ProjectMonitorDataSourceConfigCronIntervalUnit = string
+
+// ProjectMonitorDataSourceConfigCronIntervalValue defines model for ProjectMonitor_DataSource_Config_Cron_Interval_Value.
+type ProjectMonitorDataSourceConfigCronIntervalValue = int64
+
+// ProjectMonitorDataSourceCron defines model for ProjectMonitor_DataSource_Cron.
+type ProjectMonitorDataSourceCron struct {
+	Config ProjectMonitorDataSourceConfigCron `json:"config"`
+	Name   string                             `json:"name"`
+}
+
+// ProjectMonitorDataSourceSnubaQuerySubscription defines model for ProjectMonitor_DataSource_SnubaQuerySubscription.
+type ProjectMonitorDataSourceSnubaQuerySubscription struct {
+	Aggregate         string                    `json:"aggregate"`
+	Dataset           string                    `json:"dataset"`
+	Environment       nullable.Nullable[string] `json:"environment"`
+	EventTypes        []string                  `json:"eventTypes"`
+	ExtrapolationMode nullable.Nullable[string] `json:"extrapolationMode"`
+	Query             nullable.Nullable[string] `json:"query"`
+	QueryType         nullable.Nullable[int64]  `json:"queryType,omitempty"`
+	TimeWindow        nullable.Nullable[int64]  `json:"timeWindow"`
+}
+
+// ProjectMonitorDataSourceUptimeDomainFailure defines model for ProjectMonitor_DataSource_UptimeDomainFailure.
+type ProjectMonitorDataSourceUptimeDomainFailure struct {
+	Assertion       nullable.Nullable[json.RawMessage] `json:"assertion"`
+	Body            nullable.Nullable[string]          `json:"body"`
+	FollowRedirects *bool                              `json:"followRedirects,omitempty"`
+	Headers         [][]string                         `json:"headers"`
+	IntervalSeconds int64                              `json:"intervalSeconds"`
+	Method          string                             `json:"method"`
+	RegionMode      *string                            `json:"regionMode,omitempty"`
+	Regions         *[]string                          `json:"regions,omitempty"`
+	TimeoutMs       int64                              `json:"timeoutMs"`
+	TraceSampling   bool                               `json:"traceSampling"`
+	Url             string                             `json:"url"`
+}
+
+// ProjectMonitorDataSourceWrapper defines model for ProjectMonitor_DataSource_Wrapper.
+type ProjectMonitorDataSourceWrapper struct {
+	union json.RawMessage
+}
+
+// ProjectMonitorDataSourceWrapperCronMonitor defines model for ProjectMonitor_DataSource_Wrapper_CronMonitor.
+type ProjectMonitorDataSourceWrapperCronMonitor struct {
+	QueryObj ProjectMonitorDataSourceCron                   `json:"queryObj"`
+	Type     ProjectMonitorDataSourceWrapperCronMonitorType `json:"type"`
+}
+
+// ProjectMonitorDataSourceWrapperCronMonitorType defines model for ProjectMonitorDataSourceWrapperCronMonitor.Type.
+type ProjectMonitorDataSourceWrapperCronMonitorType string
+
+// ProjectMonitorDataSourceWrapperSnubaQuerySubscription defines model for ProjectMonitor_DataSource_Wrapper_SnubaQuerySubscription.
+type ProjectMonitorDataSourceWrapperSnubaQuerySubscription struct {
+	QueryObj struct {
+		SnubaQuery ProjectMonitorDataSourceSnubaQuerySubscription `json:"snubaQuery"`
+	} `json:"queryObj"`
+	Type ProjectMonitorDataSourceWrapperSnubaQuerySubscriptionType `json:"type"`
+}
+
+// ProjectMonitorDataSourceWrapperSnubaQuerySubscriptionType defines model for ProjectMonitorDataSourceWrapperSnubaQuerySubscription.Type.
+type ProjectMonitorDataSourceWrapperSnubaQuerySubscriptionType string
+
+// ProjectMonitorDataSourceWrapperUptimeSubscription defines model for ProjectMonitor_DataSource_Wrapper_UptimeSubscription.
+type ProjectMonitorDataSourceWrapperUptimeSubscription struct {
+	QueryObj ProjectMonitorDataSourceUptimeDomainFailure           `json:"queryObj"`
+	Type     ProjectMonitorDataSourceWrapperUptimeSubscriptionType `json:"type"`
+}
+
+// ProjectMonitorDataSourceWrapperUptimeSubscriptionType defines model for ProjectMonitorDataSourceWrapperUptimeSubscription.Type.
+type ProjectMonitorDataSourceWrapperUptimeSubscriptionType string
+
+// ProjectMonitorOwner defines model for ProjectMonitor_Owner.
+type ProjectMonitorOwner struct {
+	union json.RawMessage
+}
+
+// ProjectMonitorOwnerTeam defines model for ProjectMonitor_Owner_Team.
+type ProjectMonitorOwnerTeam struct {
+	Id   string                      `json:"id"`
+	Name string                      `json:"name"`
+	Type ProjectMonitorOwnerTeamType `json:"type"`
+}
+
+// ProjectMonitorOwnerTeamType defines model for ProjectMonitorOwnerTeam.Type.
+type ProjectMonitorOwnerTeamType string
+
+// ProjectMonitorOwnerUser defines model for ProjectMonitor_Owner_User.
+type ProjectMonitorOwnerUser struct {
+	Email string                      `json:"email"`
+	Id    string                      `json:"id"`
+	Name  string                      `json:"name"`
+	Type  ProjectMonitorOwnerUserType `json:"type"`
+}
+
+// ProjectMonitorOwnerUserType defines model for ProjectMonitorOwnerUser.Type.
+type ProjectMonitorOwnerUserType string
+
+// ProjectOwnership defines model for ProjectOwnership.
+type ProjectOwnership struct {
+	AutoAssignment     string    `json:"autoAssignment"`
+	CodeownersAutoSync *bool     `json:"codeownersAutoSync,omitempty"`
+	DateCreated        time.Time `json:"dateCreated"`
+	Fallthrough        bool      `json:"fallthrough"`
+	LastUpdated        time.Time `json:"lastUpdated"`
+	Raw                string    `json:"raw"`
+}
+
+// ProjectRule defines model for ProjectRule.
+type ProjectRule struct {
+	ActionMatch string                    `json:"actionMatch"`
+	Actions     []ProjectRuleAction       `json:"actions"`
+	Conditions  []ProjectRuleCondition    `json:"conditions"`
+	Environment nullable.Nullable[string] `json:"environment"`
+	FilterMatch string                    `json:"filterMatch"`
+	Filters     []ProjectRuleFilter       `json:"filters"`
+	Frequency   int64                     `json:"frequency"`
+	Id          string                    `json:"id"`
+	Name        string                    `json:"name"`
+	Owner       nullable.Nullable[string] `json:"owner"`
+	Projects    []string                  `json:"projects"`
+}
+
+// ProjectRuleAction defines model for ProjectRuleAction.
+type ProjectRuleAction struct {
+	union json.RawMessage
+}
+
+// ProjectRuleActionAzureDevopsCreateTicket defines model for ProjectRuleActionAzureDevopsCreateTicket.
+type ProjectRuleActionAzureDevopsCreateTicket struct {
+	DynamicFormFields []map[string]interface{}                   `json:"dynamic_form_fields"`
+	Id                ProjectRuleActionAzureDevopsCreateTicketId `json:"id"`
+	Integration       json.Number                                `json:"integration"`
+	Name              *string                                    `json:"name,omitempty"`
+	Project           string                                     `json:"project"`
+	Uuid              *string                                    `json:"uuid,omitempty"`
+	WorkItemType      string                                     `json:"work_item_type"`
+}
+
+// ProjectRuleActionAzureDevopsCreateTicketId defines model for ProjectRuleActionAzureDevopsCreateTicket.Id.
+type ProjectRuleActionAzureDevopsCreateTicketId string
+
+// ProjectRuleActionDiscordNotifyService defines model for ProjectRuleActionDiscordNotifyService.
+type ProjectRuleActionDiscordNotifyService struct {
+	ChannelId string                                  `json:"channel_id"`
+	Id        ProjectRuleActionDiscordNotifyServiceId `json:"id"`
+	Name      *string                                 `json:"name,omitempty"`
+	Server    json.Number                             `json:"server"`
+	Tags      *string                                 `json:"tags,omitempty"`
+	Uuid      *string                                 `json:"uuid,omitempty"`
+}
+
+// ProjectRuleActionDiscordNotifyServiceId defines model for ProjectRuleActionDiscordNotifyService.Id.
+type ProjectRuleActionDiscordNotifyServiceId string
+
+// ProjectRuleActionGitHubCreateTicket defines model for ProjectRuleActionGitHubCreateTicket.
+type ProjectRuleActionGitHubCreateTicket struct {
+	Assignee          *string                               `json:"assignee,omitempty"`
+	DynamicFormFields []map[string]interface{}              `json:"dynamic_form_fields"`
+	Id                ProjectRuleActionGitHubCreateTicketId `json:"id"`
+	Integration       json.Number                           `json:"integration"`
+	Labels            *[]string                             `json:"labels,omitempty"`
+	Name              *string                               `json:"name,omitempty"`
+	Repo              string                                `json:"repo"`
+	Uuid              *string                               `json:"uuid,omitempty"`
+}
+
+// ProjectRuleActionGitHubCreateTicketId defines model for ProjectRuleActionGitHubCreateTicket.Id.
+type ProjectRuleActionGitHubCreateTicketId string
+
+// ProjectRuleActionGitHubEnterpriseCreateTicket defines model for ProjectRuleActionGitHubEnterpriseCreateTicket.
+type ProjectRuleActionGitHubEnterpriseCreateTicket struct {
+	Assignee          *string                                         `json:"assignee,omitempty"`
+	DynamicFormFields []map[string]interface{}                        `json:"dynamic_form_fields"`
+	Id                ProjectRuleActionGitHubEnterpriseCreateTicketId `json:"id"`
+	Integration       json.Number                                     `json:"integration"`
+	Labels            *[]string                                       `json:"labels,omitempty"`
+	Name              *string                                         `json:"name,omitempty"`
+	Repo              string                                          `json:"repo"`
+	Uuid              *string                                         `json:"uuid,omitempty"`
+}
+
+// ProjectRuleActionGitHubEnterpriseCreateTicketId defines model for ProjectRuleActionGitHubEnterpriseCreateTicket.Id.
+type ProjectRuleActionGitHubEnterpriseCreateTicketId string
+
+// ProjectRuleActionJiraCreateTicket defines model for ProjectRuleActionJiraCreateTicket.
+type ProjectRuleActionJiraCreateTicket struct {
+	DynamicFormFields []map[string]interface{}            `json:"dynamic_form_fields"`
+	Id                ProjectRuleActionJiraCreateTicketId `json:"id"`
+	Integration       json.Number                         `json:"integration"`
+	IssueType         string                              `json:"issueType"`
+	Name              *string                             `json:"name,omitempty"`
+	Project           string                              `json:"project"`
+	Uuid              *string                             `json:"uuid,omitempty"`
+}
+
+// ProjectRuleActionJiraCreateTicketId defines model for ProjectRuleActionJiraCreateTicket.Id.
+type ProjectRuleActionJiraCreateTicketId string
+
+// ProjectRuleActionJiraServerCreateTicket defines model for ProjectRuleActionJiraServerCreateTicket.
+type ProjectRuleActionJiraServerCreateTicket struct {
+	DynamicFormFields []map[string]interface{}                  `json:"dynamic_form_fields"`
+	Id                ProjectRuleActionJiraServerCreateTicketId `json:"id"`
+	Integration       string                                    `json:"integration"`
+	IssueType         string                                    `json:"issueType"`
+	Name              *string                                   `json:"name,omitempty"`
+	Project           string                                    `json:"project"`
+	Uuid              *string                                   `json:"uuid,omitempty"`
+}
+
+// ProjectRuleActionJiraServerCreateTicketId defines model for ProjectRuleActionJiraServerCreateTicket.Id.
+type ProjectRuleActionJiraServerCreateTicketId string
+
+// ProjectRuleActionMsTeamsNotifyService defines model for ProjectRuleActionMsTeamsNotifyService.
+type ProjectRuleActionMsTeamsNotifyService struct {
+	Channel   string                                  `json:"channel"`
+	ChannelId *string                                 `json:"channel_id,omitempty"`
+	Id        ProjectRuleActionMsTeamsNotifyServiceId `json:"id"`
+	Name      *string                                 `json:"name,omitempty"`
+	Team      json.Number                             `json:"team"`
+	Uuid      *string                                 `json:"uuid,omitempty"`
+}
+
+// ProjectRuleActionMsTeamsNotifyServiceId defines model for ProjectRuleActionMsTeamsNotifyService.Id.
+type ProjectRuleActionMsTeamsNotifyServiceId string
+
+// ProjectRuleActionNotifyEmail defines model for ProjectRuleActionNotifyEmail.
+type ProjectRuleActionNotifyEmail struct {
+	FallthroughType  *string                                        `json:"fallthroughType,omitempty"`
+	Id               ProjectRuleActionNotifyEmailId                 `json:"id"`
+	Name             *string                                        `json:"name,omitempty"`
+	TargetIdentifier *ProjectRuleActionNotifyEmail_TargetIdentifier `json:"targetIdentifier,omitempty"`
+	TargetType       string                                         `json:"targetType"`
+	Uuid             *string                                        `json:"uuid,omitempty"`
+}
+
+// ProjectRuleActionNotifyEmailId defines model for ProjectRuleActionNotifyEmail.Id.
+type ProjectRuleActionNotifyEmailId string
+
+// ProjectRuleActionNotifyEmailTargetIdentifier0 defines model for ProjectRuleActionNotifyEmail.TargetIdentifier.0.
+type ProjectRuleActionNotifyEmailTargetIdentifier0 = string
+
+// ProjectRuleActionNotifyEmailTargetIdentifier1 defines model for ProjectRuleActionNotifyEmail.TargetIdentifier.1.
+type ProjectRuleActionNotifyEmailTargetIdentifier1 = json.Number
+
+// ProjectRuleActionNotifyEmail_TargetIdentifier defines model for ProjectRuleActionNotifyEmail.TargetIdentifier.
+type ProjectRuleActionNotifyEmail_TargetIdentifier struct {
+	union json.RawMessage
+}
+
+// ProjectRuleActionNotifyEvent defines model for ProjectRuleActionNotifyEvent.
+type ProjectRuleActionNotifyEvent struct {
+	Id   ProjectRuleActionNotifyEventId `json:"id"`
+	Name *string                        `json:"name,omitempty"`
+	Uuid *string                        `json:"uuid,omitempty"`
+}
+
+// ProjectRuleActionNotifyEventId defines model for ProjectRuleActionNotifyEvent.Id.
+type ProjectRuleActionNotifyEventId string
+
+// ProjectRuleActionNotifyEventSentryApp defines model for ProjectRuleActionNotifyEventSentryApp.
+type ProjectRuleActionNotifyEventSentryApp struct {
+	HasSchemaFormConfig       bool                                    `json:"hasSchemaFormConfig"`
+	Id                        ProjectRuleActionNotifyEventSentryAppId `json:"id"`
+	Name                      *string                                 `json:"name,omitempty"`
+	SentryAppInstallationUuid string                                  `json:"sentryAppInstallationUuid"`
+	Settings                  *[]struct {
+		Label *string `json:"label,omitempty"`
+		Name  string  `json:"name"`
+		Value string  `json:"value"`
+	} `json:"settings,omitempty"`
+	Uuid *string `json:"uuid,omitempty"`
+}
+
+// ProjectRuleActionNotifyEventSentryAppId defines model for ProjectRuleActionNotifyEventSentryApp.Id.
+type ProjectRuleActionNotifyEventSentryAppId string
+
+// ProjectRuleActionNotifyEventService defines model for ProjectRuleActionNotifyEventService.
+type ProjectRuleActionNotifyEventService struct {
+	Id      ProjectRuleActionNotifyEventServiceId `json:"id"`
+	Name    *string                               `json:"name,omitempty"`
+	Service string                                `json:"service"`
+	Uuid    *string                               `json:"uuid,omitempty"`
+}
+
+// ProjectRuleActionNotifyEventServiceId defines model for ProjectRuleActionNotifyEventService.Id.
+type ProjectRuleActionNotifyEventServiceId string
+
+// ProjectRuleActionOpsgenieNotifyTeam defines model for ProjectRuleActionOpsgenieNotifyTeam.
+type ProjectRuleActionOpsgenieNotifyTeam struct {
+	Account  json.Number                           `json:"account"`
+	Id       ProjectRuleActionOpsgenieNotifyTeamId `json:"id"`
+	Name     *string                               `json:"name,omitempty"`
+	Priority string                                `json:"priority"`
+	Team     string                                `json:"team"`
+	Uuid     *string                               `json:"uuid,omitempty"`
+}
+
+// ProjectRuleActionOpsgenieNotifyTeamId defines model for ProjectRuleActionOpsgenieNotifyTeam.Id.
+type ProjectRuleActionOpsgenieNotifyTeamId string
+
+// ProjectRuleActionPagerDutyNotifyService defines model for ProjectRuleActionPagerDutyNotifyService.
+type ProjectRuleActionPagerDutyNotifyService struct {
+	Account  json.Number                               `json:"account"`
+	Id       ProjectRuleActionPagerDutyNotifyServiceId `json:"id"`
+	Name     *string                                   `json:"name,omitempty"`
+	Service  string                                    `json:"service"`
+	Severity string                                    `json:"severity"`
+	Uuid     *string                                   `json:"uuid,omitempty"`
+}
+
+// ProjectRuleActionPagerDutyNotifyServiceId defines model for ProjectRuleActionPagerDutyNotifyService.Id.
+type ProjectRuleActionPagerDutyNotifyServiceId string
+
+// ProjectRuleActionSlackNotifyService defines model for ProjectRuleActionSlackNotifyService.
+type ProjectRuleActionSlackNotifyService struct {
+	Channel   string                                `json:"channel"`
+	ChannelId *string                               `json:"channel_id,omitempty"`
+	Id        ProjectRuleActionSlackNotifyServiceId `json:"id"`
+	Name      *string                               `json:"name,omitempty"`
+	Notes     *string                               `json:"notes,omitempty"`
+	Tags      *string                               `json:"tags,omitempty"`
+	Uuid      *string                               `json:"uuid,omitempty"`
+	Workspace string                                `json:"workspace"`
+}
+
+// ProjectRuleActionSlackNotifyServiceId defines model for ProjectRuleActionSlackNotifyService.Id.
+type ProjectRuleActionSlackNotifyServiceId string
+
+// ProjectRuleCondition defines model for ProjectRuleCondition.
+type ProjectRuleCondition struct {
+	union json.RawMessage
+}
+
+// ProjectRuleConditionEventFrequency defines model for ProjectRuleConditionEventFrequency.
+type ProjectRuleConditionEventFrequency struct {
+	ComparisonInterval *string                              `json:"comparisonInterval,omitempty"`
+	ComparisonType     string                               `json:"comparisonType"`
+	Id                 ProjectRuleConditionEventFrequencyId `json:"id"`
+	Interval           string                               `json:"interval"`
+	Name               *string                              `json:"name,omitempty"`
+	Value              int64                                `json:"value"`
+}
+
+// ProjectRuleConditionEventFrequencyId defines model for ProjectRuleConditionEventFrequency.Id.
+type ProjectRuleConditionEventFrequencyId string
+
+// ProjectRuleConditionEventFrequencyPercent defines model for ProjectRuleConditionEventFrequencyPercent.
+type ProjectRuleConditionEventFrequencyPercent struct {
+	ComparisonInterval *string                                     `json:"comparisonInterval,omitempty"`
+	ComparisonType     string                                      `json:"comparisonType"`
+	Id                 ProjectRuleConditionEventFrequencyPercentId `json:"id"`
+	Interval           string                                      `json:"interval"`
+	Name               *string                                     `json:"name,omitempty"`
+	Value              float64                                     `json:"value"`
+}
+
+// ProjectRuleConditionEventFrequencyPercentId defines model for ProjectRuleConditionEventFrequencyPercent.Id.
+type ProjectRuleConditionEventFrequencyPercentId string
+
+// ProjectRuleConditionEventUniqueUserFrequency defines model for ProjectRuleConditionEventUniqueUserFrequency.
+type ProjectRuleConditionEventUniqueUserFrequency struct {
+	ComparisonInterval *string                                        `json:"comparisonInterval,omitempty"`
+	ComparisonType     string                                         `json:"comparisonType"`
+	Id                 ProjectRuleConditionEventUniqueUserFrequencyId `json:"id"`
+	Interval           string                                         `json:"interval"`
+	Name               *string                                        `json:"name,omitempty"`
+	Value              int64                                          `json:"value"`
+}
+
+// ProjectRuleConditionEventUniqueUserFrequencyId defines model for ProjectRuleConditionEventUniqueUserFrequency.Id.
+type ProjectRuleConditionEventUniqueUserFrequencyId string
+
+// ProjectRuleConditionExistingHighPriorityIssue defines model for ProjectRuleConditionExistingHighPriorityIssue.
+type ProjectRuleConditionExistingHighPriorityIssue struct {
+	Id   ProjectRuleConditionExistingHighPriorityIssueId `json:"id"`
+	Name *string                                         `json:"name,omitempty"`
+}
+
+// ProjectRuleConditionExistingHighPriorityIssueId defines model for ProjectRuleConditionExistingHighPriorityIssue.Id.
+type ProjectRuleConditionExistingHighPriorityIssueId string
+
+// ProjectRuleConditionFirstSeenEvent defines model for ProjectRuleConditionFirstSeenEvent.
+type ProjectRuleConditionFirstSeenEvent struct {
+	Id   ProjectRuleConditionFirstSeenEventId `json:"id"`
+	Name *string                              `json:"name,omitempty"`
+}
+
+// ProjectRuleConditionFirstSeenEventId defines model for ProjectRuleConditionFirstSeenEvent.Id.
+type ProjectRuleConditionFirstSeenEventId string
+
+// ProjectRuleConditionNewHighPriorityIssue defines model for ProjectRuleConditionNewHighPriorityIssue.
+type ProjectRuleConditionNewHighPriorityIssue struct {
+	Id   ProjectRuleConditionNewHighPriorityIssueId `json:"id"`
+	Name *string                                    `json:"name,omitempty"`
+}
+
+// ProjectRuleConditionNewHighPriorityIssueId defines model for ProjectRuleConditionNewHighPriorityIssue.Id.
+type ProjectRuleConditionNewHighPriorityIssueId string
+
+// ProjectRuleConditionReappearedEvent defines model for ProjectRuleConditionReappearedEvent.
+type ProjectRuleConditionReappearedEvent struct {
+	Id   ProjectRuleConditionReappearedEventId `json:"id"`
+	Name *string                               `json:"name,omitempty"`
+}
+
+// ProjectRuleConditionReappearedEventId defines model for ProjectRuleConditionReappearedEvent.Id.
+type ProjectRuleConditionReappearedEventId string
+
+// ProjectRuleConditionRegressionEvent defines model for ProjectRuleConditionRegressionEvent.
+type ProjectRuleConditionRegressionEvent struct {
+	Id   ProjectRuleConditionRegressionEventId `json:"id"`
+	Name *string                               `json:"name,omitempty"`
+}
+
+// ProjectRuleConditionRegressionEventId defines model for ProjectRuleConditionRegressionEvent.Id.
+type ProjectRuleConditionRegressionEventId string
+
+// ProjectRuleFilter defines model for ProjectRuleFilter.
+type ProjectRuleFilter struct {
+	union json.RawMessage
+}
+
+// ProjectRuleFilterAgeComparison defines model for ProjectRuleFilterAgeComparison.
+type ProjectRuleFilterAgeComparison struct {
+	ComparisonType string                           `json:"comparison_type"`
+	Id             ProjectRuleFilterAgeComparisonId `json:"id"`
+	Name           *string                          `json:"name,omitempty"`
+	Time           string                           `json:"time"`
+	Value          int64                            `json:"value"`
+}
+
+// ProjectRuleFilterAgeComparisonId defines model for ProjectRuleFilterAgeComparison.Id.
+type ProjectRuleFilterAgeComparisonId string
+
+// ProjectRuleFilterAssignedTo defines model for ProjectRuleFilterAssignedTo.
+type ProjectRuleFilterAssignedTo struct {
+	Id               ProjectRuleFilterAssignedToId                 `json:"id"`
+	Name             *string                                       `json:"name,omitempty"`
+	TargetIdentifier *ProjectRuleFilterAssignedTo_TargetIdentifier `json:"targetIdentifier,omitempty"`
+	TargetType       string                                        `json:"targetType"`
+}
+
+// ProjectRuleFilterAssignedToId defines model for ProjectRuleFilterAssignedTo.Id.
+type ProjectRuleFilterAssignedToId string
+
+// ProjectRuleFilterAssignedToTargetIdentifier0 defines model for ProjectRuleFilterAssignedTo.TargetIdentifier.0.
+type ProjectRuleFilterAssignedToTargetIdentifier0 = string
+
+// ProjectRuleFilterAssignedToTargetIdentifier1 defines model for ProjectRuleFilterAssignedTo.TargetIdentifier.1.
+type ProjectRuleFilterAssignedToTargetIdentifier1 = json.Number
+
+// ProjectRuleFilterAssignedTo_TargetIdentifier defines model for ProjectRuleFilterAssignedTo.TargetIdentifier.
+type ProjectRuleFilterAssignedTo_TargetIdentifier struct {
+	union json.RawMessage
+}
+
+// ProjectRuleFilterEventAttribute defines model for ProjectRuleFilterEventAttribute.
+type ProjectRuleFilterEventAttribute struct {
+	Attribute string                                 `json:"attribute"`
+	Id        ProjectRuleFilterEventAttributeId      `json:"id"`
+	Match     string                                 `json:"match"`
+	Name      *string                                `json:"name,omitempty"`
+	Value     *ProjectRuleFilterEventAttribute_Value `json:"value,omitempty"`
+}
+
+// ProjectRuleFilterEventAttributeId defines model for ProjectRuleFilterEventAttribute.Id.
+type ProjectRuleFilterEventAttributeId string
+
+// ProjectRuleFilterEventAttributeValue0 defines model for ProjectRuleFilterEventAttribute.Value.0.
+type ProjectRuleFilterEventAttributeValue0 = string
+
+// ProjectRuleFilterEventAttributeValue1 defines model for ProjectRuleFilterEventAttribute.Value.1.
+type ProjectRuleFilterEventAttributeValue1 = json.Number
+
+// ProjectRuleFilterEventAttribute_Value defines model for ProjectRuleFilterEventAttribute.Value.
+type ProjectRuleFilterEventAttribute_Value struct {
+	union json.RawMessage
+}
+
+// ProjectRuleFilterIssueCategory defines model for ProjectRuleFilterIssueCategory.
+type ProjectRuleFilterIssueCategory struct {
+	Id    ProjectRuleFilterIssueCategoryId `json:"id"`
+	Name  *string                          `json:"name,omitempty"`
+	Value string                           `json:"value"`
+}
+
+// ProjectRuleFilterIssueCategoryId defines model for ProjectRuleFilterIssueCategory.Id.
+type ProjectRuleFilterIssueCategoryId string
+
+// ProjectRuleFilterIssueOccurrences defines model for ProjectRuleFilterIssueOccurrences.
+type ProjectRuleFilterIssueOccurrences struct {
+	Id    ProjectRuleFilterIssueOccurrencesId `json:"id"`
+	Name  *string                             `json:"name,omitempty"`
+	Value int64                               `json:"value"`
+}
+
+// ProjectRuleFilterIssueOccurrencesId defines model for ProjectRuleFilterIssueOccurrences.Id.
+type ProjectRuleFilterIssueOccurrencesId string
+
+// ProjectRuleFilterLatestAdoptedRelease defines model for ProjectRuleFilterLatestAdoptedRelease.
+type ProjectRuleFilterLatestAdoptedRelease struct {
+	Environment    string                                  `json:"environment"`
+	Id             ProjectRuleFilterLatestAdoptedReleaseId `json:"id"`
+	Name           *string                                 `json:"name,omitempty"`
+	OlderOrNewer   string                                  `json:"older_or_newer"`
+	OldestOrNewest string                                  `json:"oldest_or_newest"`
+}
+
+// ProjectRuleFilterLatestAdoptedReleaseId defines model for ProjectRuleFilterLatestAdoptedRelease.Id.
+type ProjectRuleFilterLatestAdoptedReleaseId string
+
+// ProjectRuleFilterLatestRelease defines model for ProjectRuleFilterLatestRelease.
+type ProjectRuleFilterLatestRelease struct {
+	Id   ProjectRuleFilterLatestReleaseId `json:"id"`
+	Name *string                          `json:"name,omitempty"`
+}
+
+// ProjectRuleFilterLatestReleaseId defines model for ProjectRuleFilterLatestRelease.Id.
+type ProjectRuleFilterLatestReleaseId string
+
+// ProjectRuleFilterLevel defines model for ProjectRuleFilterLevel.
+type ProjectRuleFilterLevel struct {
+	Id    ProjectRuleFilterLevelId `json:"id"`
+	Level string                   `json:"level"`
+	Match string                   `json:"match"`
+	Name  *string                  `json:"name,omitempty"`
+}
+
+// ProjectRuleFilterLevelId defines model for ProjectRuleFilterLevel.Id.
+type ProjectRuleFilterLevelId string
+
+// ProjectRuleFilterTaggedEvent defines model for ProjectRuleFilterTaggedEvent.
+type ProjectRuleFilterTaggedEvent struct {
+	Id    ProjectRuleFilterTaggedEventId `json:"id"`
+	Key   string                         `json:"key"`
+	Match string                         `json:"match"`
+	Name  *string                        `json:"name,omitempty"`
+	Value *string                        `json:"value,omitempty"`
+}
+
+// ProjectRuleFilterTaggedEventId defines model for ProjectRuleFilterTaggedEvent.Id.
+type ProjectRuleFilterTaggedEventId string
+
+// ProjectSymbolSource defines model for ProjectSymbolSource.
+type ProjectSymbolSource struct {
+	AccessKey        *string                    `json:"access_key,omitempty"`
+	AppId            *string                    `json:"appId,omitempty"`
+	AppconnectIssuer *string                    `json:"appconnectIssuer,omitempty"`
+	Bucket           *string                    `json:"bucket,omitempty"`
+	ClientEmail      *string                    `json:"client_email,omitempty"`
+	Id               string                     `json:"id"`
+	Layout           *ProjectSymbolSourceLayout `json:"layout,omitempty"`
+	Name             string                     `json:"name"`
+	Prefix           *string                    `json:"prefix,omitempty"`
+	Region           *string                    `json:"region,omitempty"`
+	Type             string                     `json:"type"`
+	Url              *string                    `json:"url,omitempty"`
+	Username         *string                    `json:"username,omitempty"`
+}
+
+// ProjectSymbolSourceLayout defines model for ProjectSymbolSourceLayout.
+type ProjectSymbolSourceLayout struct {
+	Casing string `json:"casing"`
+	Type   string `json:"type"`
+}
+
+// ProjectSymbolSourceRequest defines model for ProjectSymbolSourceRequest.
+type ProjectSymbolSourceRequest struct {
+	AccessKey            *string                    `json:"access_key,omitempty"`
+	AppId                *string                    `json:"appId,omitempty"`
+	AppconnectIssuer     *string                    `json:"appconnectIssuer,omitempty"`
+	AppconnectPrivateKey *string                    `json:"appconnectPrivateKey,omitempty"`
+	Bucket               *string                    `json:"bucket,omitempty"`
+	ClientEmail          *string                    `json:"client_email,omitempty"`
+	Id                   *string                    `json:"id,omitempty"`
+	Layout               *ProjectSymbolSourceLayout `json:"layout,omitempty"`
+	Name                 string                     `json:"name"`
+	Password             *string                    `json:"password,omitempty"`
+	Prefix               *string                    `json:"prefix,omitempty"`
+	PrivateKey           *string                    `json:"private_key,omitempty"`
+	Region               *string                    `json:"region,omitempty"`
+	SecretKey            *string                    `json:"secret_key,omitempty"`
+	Type                 string                     `json:"type"`
+	Url                  *string                    `json:"url,omitempty"`
+	Username             *string                    `json:"username,omitempty"`
+}
+
+// SentryAppInstallation defines model for SentryAppInstallation.
+type SentryAppInstallation struct {
+	App struct {
+		SentryAppId int    `json:"sentryAppId"`
+		Slug        string `json:"slug"`
+		Uuid        string `json:"uuid"`
+	} `json:"app"`
+	Organization struct {
+		Slug string `json:"slug"`
+	} `json:"organization"`
+	Status string `json:"status"`
+	Uuid   string `json:"uuid"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	HasAccess *bool  `json:"hasAccess,omitempty"`
+	Id        string `json:"id"`
+	IsMember  *bool  `json:"isMember,omitempty"`
+	IsPending *bool  `json:"isPending,omitempty"`
+	Name      string `json:"name"`
+	Slug      string `json:"slug"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	Email    string                    `json:"email"`
+	Id       string                    `json:"id"`
+	Name     *string                   `json:"name,omitempty"`
+	OrgRole  *string                   `json:"orgRole,omitempty"`
+	Pending  *bool                     `json:"pending,omitempty"`
+	TeamRole nullable.Nullable[string] `json:"teamRole,omitempty"`
+	TeamSlug *string                   `json:"teamSlug,omitempty"`
+}
+
+// TeamRole defines model for TeamRole.
+type TeamRole struct {
+	Role     nullable.Nullable[string] `json:"role"`
+	TeamSlug string                    `json:"teamSlug"`
+}
+
+// TeamRoleListItem defines model for TeamRoleListItem.
+type TeamRoleListItem struct {
+	Desc             string   `json:"desc"`
+	Id               string   `json:"id"`
+	IsAllowed        bool     `json:"isAllowed"`
+	IsMinimumRoleFor string   `json:"isMinimumRoleFor"`
+	IsRetired        bool     `json:"isRetired"`
+	Name             string   `json:"name"`
+	Scopes           []string `json:"scopes"`
+}
+
+// TrustedRelay defines model for TrustedRelay.
+type TrustedRelay struct {
+	Description nullable.Nullable[string] `json:"description,omitempty"`
+	Id          *string                   `json:"id,omitempty"`
+	Name        *string                   `json:"name,omitempty"`
+	PublicKey   *string                   `json:"publicKey,omitempty"`
+}
+
+// TrustedRelayUpdate defines model for TrustedRelayUpdate.
+type TrustedRelayUpdate struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	PublicKey   *string `json:"publicKey,omitempty"`
+}
+
+// UpdateExternalUser defines model for UpdateExternalUser.
+type UpdateExternalUser struct {
+	ExternalId    *string `json:"external_id,omitempty"`
+	ExternalName  string  `json:"external_name"`
+	Id            int     `json:"id"`
+	IntegrationId int     `json:"integration_id"`
+	Provider      string  `json:"provider"`
+	UserId        int     `json:"user_id"`
+}
+
+// UpdateOrganization defines model for UpdateOrganization.
+type UpdateOrganization struct {
+	AlertsMemberWrite          *bool                     `json:"alertsMemberWrite,omitempty"`
+	AllowJoinRequests          *bool                     `json:"allowJoinRequests,omitempty"`
+	AllowMemberProjectCreation *bool                     `json:"allowMemberProjectCreation,omitempty"`
+	AllowSharedIssues          *bool                     `json:"allowSharedIssues,omitempty"`
+	AttachmentsRole            *string                   `json:"attachmentsRole,omitempty"`
+	Avatar                     *string                   `json:"avatar,omitempty"`
+	AvatarType                 *string                   `json:"avatarType,omitempty"`
+	CodecovAccess              *bool                     `json:"codecovAccess,omitempty"`
+	DataScrubber               *bool                     `json:"dataScrubber,omitempty"`
+	DataScrubberDefaults       *bool                     `json:"dataScrubberDefaults,omitempty"`
+	DebugFilesRole             *string                   `json:"debugFilesRole,omitempty"`
+	DefaultRole                *string                   `json:"defaultRole,omitempty"`
+	EnhancedPrivacy            *bool                     `json:"enhancedPrivacy,omitempty"`
+	EventsMemberAdmin          *bool                     `json:"eventsMemberAdmin,omitempty"`
+	GithubNudgeInvite          *bool                     `json:"githubNudgeInvite,omitempty"`
+	GithubOpenPRBot            *bool                     `json:"githubOpenPRBot,omitempty"`
+	GithubPRBot                *bool                     `json:"githubPRBot,omitempty"`
+	GitlabPRBot                *bool                     `json:"gitlabPRBot,omitempty"`
+	HideAiFeatures             *bool                     `json:"hideAiFeatures,omitempty"`
+	IsEarlyAdopter             *bool                     `json:"isEarlyAdopter,omitempty"`
+	Name                       *string                   `json:"name,omitempty"`
+	OpenMembership             *bool                     `json:"openMembership,omitempty"`
+	RelayPiiConfig             nullable.Nullable[string] `json:"relayPiiConfig,omitempty"`
+	Require2FA                 *bool                     `json:"require2FA,omitempty"`
+	SafeFields                 *[]string                 `json:"safeFields,omitempty"`
+	ScrapeJavaScript           *bool                     `json:"scrapeJavaScript,omitempty"`
+	ScrubIPAddresses           *bool                     `json:"scrubIPAddresses,omitempty"`
+	SensitiveFields            *[]string                 `json:"sensitiveFields,omitempty"`
+	Slug                       *string                   `json:"slug,omitempty"`
+	StoreCrashReports          *int                      `json:"storeCrashReports,omitempty"`
+	TrustedRelays              *[]TrustedRelayUpdate     `json:"trustedRelays,omitempty"`
+}
+
+// UpdateOrganizationWorkflowRequest defines model for UpdateOrganizationWorkflowRequest.
+type UpdateOrganizationWorkflowRequest struct {
+	ActionFilters []OrganizationWorkflowActionFilter `json:"actionFilters"`
+	Config        OrganizationWorkflowConfig         `json:"config"`
+	DetectorIds   []string                           `json:"detectorIds"`
+	Enabled       bool                               `json:"enabled"`
+	Environment   nullable.Nullable[string]          `json:"environment,omitempty"`
+	Id            string                             `json:"id"`
+	Name          string                             `json:"name"`
+	Triggers      OrganizationWorkflowTrigger        `json:"triggers"`
+}
+
+// Cursor defines model for cursor.
+type Cursor = string
+
+// DashboardId defines model for dashboard_id.
+type DashboardId = string
+
+// DetectorId defines model for detector_id.
+type DetectorId = string
+
+// IntegrationId defines model for integration_id.
+type IntegrationId = string
+
+// MemberId defines model for member_id.
+type MemberId = string
+
+// OrganizationIdOrSlug defines model for organization_id_or_slug.
+type OrganizationIdOrSlug = string
+
+// ProjectIdOrSlug defines model for project_id_or_slug.
+type ProjectIdOrSlug = string
+
+// TeamIdOrSlug defines model for team_id_or_slug.
+type TeamIdOrSlug = string
+
+// GetOrganizationAlertRuleDetectorParams defines parameters for GetOrganizationAlertRuleDetector.
+type GetOrganizationAlertRuleDetectorParams struct {
+	AlertRuleId *string `form:"alert_rule_id,omitempty" json:"alert_rule_id,omitempty"`
+}
+
+// GetOrganizationAlertRuleWorkflowParams defines parameters for GetOrganizationAlertRuleWorkflow.
+type GetOrganizationAlertRuleWorkflowParams struct {
+	RuleId      *string `form:"rule_id,omitempty" json:"rule_id,omitempty"`
+	AlertRuleId *string `form:"alert_rule_id,omitempty" json:"alert_rule_id,omitempty"`
+}
+
+// ListOrganizationDashboardsParams defines parameters for ListOrganizationDashboards.
+type ListOrganizationDashboardsParams struct {
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListOrganizationMonitorsParams defines parameters for ListOrganizationMonitors.
+type ListOrganizationMonitorsParams struct {
+	Cursor  *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Project *string `form:"project,omitempty" json:"project,omitempty"`
+	Query   *string `form:"query,omitempty" json:"query,omitempty"`
+}
+
+// ListOrganizationIntegrationsParams defines parameters for ListOrganizationIntegrations.
+type ListOrganizationIntegrationsParams struct {
+	Cursor      *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+	ProviderKey *string `form:"provider_key,omitempty" json:"provider_key,omitempty"`
+}
+
+// UpdateOrganizationIntegrationJSONBody defines parameters for UpdateOrganizationIntegration.
+type UpdateOrganizationIntegrationJSONBody struct {
+	union json.RawMessage
+}
+
+// ListOrganizationMembersParams defines parameters for ListOrganizationMembers.
+type ListOrganizationMembersParams struct {
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// CreateOrganizationMemberJSONBody defines parameters for CreateOrganizationMember.
+type CreateOrganizationMemberJSONBody struct {
+	Email   string    `json:"email"`
+	OrgRole string    `json:"orgRole"`
+	Teams   *[]string `json:"teams,omitempty"`
+}
+
+// UpdateOrganizationMemberJSONBody defines parameters for UpdateOrganizationMember.
+type UpdateOrganizationMemberJSONBody struct {
+	OrgRole   *string     `json:"orgRole,omitempty"`
+	TeamRoles *[]TeamRole `json:"teamRoles,omitempty"`
+}
+
+// UpdateOrganizationMemberTeamRoleJSONBody defines parameters for UpdateOrganizationMemberTeamRole.
+type UpdateOrganizationMemberTeamRoleJSONBody struct {
+	TeamRole string `json:"teamRole"`
+}
+
+// ListOrganizationProjectsParams defines parameters for ListOrganizationProjects.
+type ListOrganizationProjectsParams struct {
+	Cursor  *Cursor   `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Options *[]string `form:"options,omitempty" json:"options,omitempty"`
+}
+
+// ListOrganizationRepositoriesParams defines parameters for ListOrganizationRepositories.
+type ListOrganizationRepositoriesParams struct {
+	Cursor        *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+	IntegrationId *string `form:"integration_id,omitempty" json:"integration_id,omitempty"`
+	Status        *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// CreateOrganizationRepositoryJSONBody defines parameters for CreateOrganizationRepository.
+type CreateOrganizationRepositoryJSONBody struct {
+	Identifier   string `json:"identifier"`
+	Installation string `json:"installation"`
+	Provider     string `json:"provider"`
+}
+
+// ListSentryAppInstallationsParams defines parameters for ListSentryAppInstallations.
+type ListSentryAppInstallationsParams struct {
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// DisableSpikeProtectionJSONBody defines parameters for DisableSpikeProtection.
+type DisableSpikeProtectionJSONBody struct {
+	Projects []string `json:"projects"`
+}
+
+// EnableSpikeProtectionJSONBody defines parameters for EnableSpikeProtection.
+type EnableSpikeProtectionJSONBody struct {
+	Projects []string `json:"projects"`
+}
+
+// ListOrganizationTeamsParams defines parameters for ListOrganizationTeams.
+type ListOrganizationTeamsParams struct {
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// CreateOrganizationTeamJSONBody defines parameters for CreateOrganizationTeam.
+type CreateOrganizationTeamJSONBody struct {
+	Name string `json:"name"`
+}
+
+// ListOrganizationWorkflowsParams defines parameters for ListOrganizationWorkflows.
+type ListOrganizationWorkflowsParams struct {
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// UpdateOrganizationProjectJSONBody defines parameters for UpdateOrganizationProject.
+type UpdateOrganizationProjectJSONBody struct {
+	AllowedDomains       *[]string               `json:"allowedDomains,omitempty"`
+	DigestsMaxDelay      *int64                  `json:"digestsMaxDelay,omitempty"`
+	DigestsMinDelay      *int64                  `json:"digestsMinDelay,omitempty"`
+	FingerprintingRules  *string                 `json:"fingerprintingRules,omitempty"`
+	GroupingEnhancements *string                 `json:"groupingEnhancements,omitempty"`
+	HighlightTags        *[]string               `json:"highlightTags,omitempty"`
+	Name                 *string                 `json:"name,omitempty"`
+	Options              *map[string]interface{} `json:"options,omitempty"`
+	Platform             *string                 `json:"platform,omitempty"`
+	ResolveAge           *int64                  `json:"resolveAge,omitempty"`
+	ScrapeJavaScript     *bool                   `json:"scrapeJavaScript,omitempty"`
+	SecurityToken        *string                 `json:"securityToken,omitempty"`
+	SecurityTokenHeader  *string                 `json:"securityTokenHeader,omitempty"`
+	Slug                 *string                 `json:"slug,omitempty"`
+	SubjectTemplate      *string                 `json:"subjectTemplate,omitempty"`
+	VerifySSL            *bool                   `json:"verifySSL,omitempty"`
+}
+
+// UpdateProjectFilterJSONBody defines parameters for UpdateProjectFilter.
+type UpdateProjectFilterJSONBody struct {
+	Active     *bool     `json:"active,omitempty"`
+	Subfilters *[]string `json:"subfilters,omitempty"`
+}
+
+// ListProjectClientKeysParams defines parameters for ListProjectClientKeys.
+type ListProjectClientKeysParams struct {
+	Cursor *Cursor                            `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Status *ListProjectClientKeysParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListProjectClientKeysParamsStatus defines parameters for ListProjectClientKeys.
+type ListProjectClientKeysParamsStatus string
+
+// CreateProjectClientKeyJSONBody defines parameters for CreateProjectClientKey.
+type CreateProjectClientKeyJSONBody struct {
+	BrowserSdkVersion       *string `json:"browserSdkVersion,omitempty"`
+	DynamicSdkLoaderOptions *struct {
+		HasDebug       *bool `json:"hasDebug,omitempty"`
+		HasPerformance *bool `json:"hasPerformance,omitempty"`
+		HasReplay      *bool `json:"hasReplay,omitempty"`
+	} `json:"dynamicSdkLoaderOptions,omitempty"`
+	Name      string `json:"name"`
+	RateLimit *struct {
+		Count  int64 `json:"count"`
+		Window int64 `json:"window"`
+	} `json:"rateLimit,omitempty"`
+}
+
+// UpdateProjectClientKeyJSONBody defines parameters for UpdateProjectClientKey.
+type UpdateProjectClientKeyJSONBody struct {
+	BrowserSdkVersion       *string `json:"browserSdkVersion,omitempty"`
+	DynamicSdkLoaderOptions *struct {
+		HasDebug       *bool `json:"hasDebug,omitempty"`
+		HasPerformance *bool `json:"hasPerformance,omitempty"`
+		HasReplay      *bool `json:"hasReplay,omitempty"`
+	} `json:"dynamicSdkLoaderOptions,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	RateLimit *struct {
+		Count  int64 `json:"count"`
+		Window int64 `json:"window"`
+	} `json:"rateLimit,omitempty"`
+}
+
+// UpdateProjectOwnershipJSONBody defines parameters for UpdateProjectOwnership.
+type UpdateProjectOwnershipJSONBody struct {
+	AutoAssignment     string `json:"autoAssignment"`
+	CodeownersAutoSync bool   `json:"codeownersAutoSync"`
+	Fallthrough        bool   `json:"fallthrough"`
+	Raw                string `json:"raw"`
+}
+
+// CreateProjectRuleJSONBody defines parameters for CreateProjectRule.
+type CreateProjectRuleJSONBody struct {
+	ActionMatch string                 `json:"actionMatch"`
+	Actions     []ProjectRuleAction    `json:"actions"`
+	Conditions  []ProjectRuleCondition `json:"conditions"`
+	Environment *string                `json:"environment,omitempty"`
+	FilterMatch string                 `json:"filterMatch"`
+	Filters     []ProjectRuleFilter    `json:"filters"`
+	Frequency   int64                  `json:"frequency"`
+	Name        string                 `json:"name"`
+	Owner       *string                `json:"owner,omitempty"`
+	Projects    []string               `json:"projects"`
+}
+
+// UpdateProjectRuleJSONBody defines parameters for UpdateProjectRule.
+type UpdateProjectRuleJSONBody struct {
+	ActionMatch string                 `json:"actionMatch"`
+	Actions     []ProjectRuleAction    `json:"actions"`
+	Conditions  []ProjectRuleCondition `json:"conditions"`
+	Environment *string                `json:"environment,omitempty"`
+	FilterMatch string                 `json:"filterMatch"`
+	Filters     []ProjectRuleFilter    `json:"filters"`
+	Frequency   int64                  `json:"frequency"`
+	Name        string                 `json:"name"`
+	Owner       *string                `json:"owner,omitempty"`
+	Projects    []string               `json:"projects"`
+}
+
+// DeleteProjectSymbolSourceParams defines parameters for DeleteProjectSymbolSource.
+type DeleteProjectSymbolSourceParams struct {
+	Id string `form:"id" json:"id"`
+}
+
+// ListProjectSymbolSourcesParams defines parameters for ListProjectSymbolSources.
+type ListProjectSymbolSourcesParams struct {
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+}
+
+// UpdateProjectSymbolSourceParams defines parameters for UpdateProjectSymbolSource.
+type UpdateProjectSymbolSourceParams struct {
+	Id string `form:"id" json:"id"`
+}
+
+// ListOrganizationTeamMembersParams defines parameters for ListOrganizationTeamMembers.
+type ListOrganizationTeamMembersParams struct {
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListTeamProjectsParams defines parameters for ListTeamProjects.
+type ListTeamProjectsParams struct {
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// CreateOrganizationTeamProjectJSONBody defines parameters for CreateOrganizationTeamProject.
+type CreateOrganizationTeamProjectJSONBody struct {
+	DefaultRules *bool   `json:"default_rules,omitempty"`
+	Name         string  `json:"name"`
+	Platform     string  `json:"platform"`
+	Slug         *string `json:"slug,omitempty"`
+}
+
+// CreateOrganizationJSONRequestBody defines body for CreateOrganization for application/json ContentType.
+type CreateOrganizationJSONRequestBody = CreateOrganization
+
+// UpdateOrganizationJSONRequestBody defines body for UpdateOrganization for application/json ContentType.
+type UpdateOrganizationJSONRequestBody = UpdateOrganization
+
+// CreateOrganizationDashboardJSONRequestBody defines body for CreateOrganizationDashboard for application/json ContentType.
+type CreateOrganizationDashboardJSONRequestBody = OrganizationDashboardRequest
+
+// UpdateOrganizationDashboardJSONRequestBody defines body for UpdateOrganizationDashboard for application/json ContentType.
+type UpdateOrganizationDashboardJSONRequestBody = OrganizationDashboardRequest
+
+// UpdateProjectMonitorJSONRequestBody defines body for UpdateProjectMonitor for application/json ContentType.
+type UpdateProjectMonitorJSONRequestBody = ProjectMonitorRequest
+
+// CreateOrganizationExternalUserJSONRequestBody defines body for CreateOrganizationExternalUser for application/json ContentType.
+type CreateOrganizationExternalUserJSONRequestBody = CreateExternalUser
+
+// UpdateOrganizationExternalUserJSONRequestBody defines body for UpdateOrganizationExternalUser for application/json ContentType.
+type UpdateOrganizationExternalUserJSONRequestBody = UpdateExternalUser
+
+// UpdateOrganizationIntegrationJSONRequestBody defines body for UpdateOrganizationIntegration for application/json ContentType.
+type UpdateOrganizationIntegrationJSONRequestBody UpdateOrganizationIntegrationJSONBody
+
+// CreateOrganizationMemberJSONRequestBody defines body for CreateOrganizationMember for application/json ContentType.
+type CreateOrganizationMemberJSONRequestBody CreateOrganizationMemberJSONBody
+
+// UpdateOrganizationMemberJSONRequestBody defines body for UpdateOrganizationMember for application/json ContentType.
+type UpdateOrganizationMemberJSONRequestBody UpdateOrganizationMemberJSONBody
+
+// UpdateOrganizationMemberTeamRoleJSONRequestBody defines body for UpdateOrganizationMemberTeamRole for application/json ContentType.
+type UpdateOrganizationMemberTeamRoleJSONRequestBody UpdateOrganizationMemberTeamRoleJSONBody
+
+// CreateOrganizationNotificationActionJSONRequestBody defines body for CreateOrganizationNotificationAction for application/json ContentType.
+type CreateOrganizationNotificationActionJSONRequestBody = NotificationActionRequest
+
+// UpdateOrganizationNotificationActionJSONRequestBody defines body for UpdateOrganizationNotificationAction for application/json ContentType.
+type UpdateOrganizationNotificationActionJSONRequestBody = NotificationActionRequest
+
+// CreateProjectMonitorJSONRequestBody defines body for CreateProjectMonitor for application/json ContentType.
+type CreateProjectMonitorJSONRequestBody = ProjectMonitorRequest
+
+// CreateOrganizationRepositoryJSONRequestBody defines body for CreateOrganizationRepository for application/json ContentType.
+type CreateOrganizationRepositoryJSONRequestBody CreateOrganizationRepositoryJSONBody
+
+// DisableSpikeProtectionJSONRequestBody defines body for DisableSpikeProtection for application/json ContentType.
+type DisableSpikeProtectionJSONRequestBody DisableSpikeProtectionJSONBody
+
+// EnableSpikeProtectionJSONRequestBody defines body for EnableSpikeProtection for application/json ContentType.
+type EnableSpikeProtectionJSONRequestBody EnableSpikeProtectionJSONBody
+
+// CreateOrganizationTeamJSONRequestBody defines body for CreateOrganizationTeam for application/json ContentType.
+type CreateOrganizationTeamJSONRequestBody CreateOrganizationTeamJSONBody
+
+// CreateOrganizationWorkflowJSONRequestBody defines body for CreateOrganizationWorkflow for application/json ContentType.
+type CreateOrganizationWorkflowJSONRequestBody = OrganizationWorkflowRequest
+
+// UpdateOrganizationWorkflowJSONRequestBody defines body for UpdateOrganizationWorkflow for application/json ContentType.
+type UpdateOrganizationWorkflowJSONRequestBody = UpdateOrganizationWorkflowRequest
+
+// UpdateOrganizationProjectJSONRequestBody defines body for UpdateOrganizationProject for application/json ContentType.
+type UpdateOrganizationProjectJSONRequestBody UpdateOrganizationProjectJSONBody
+
+// UpdateProjectFilterJSONRequestBody defines body for UpdateProjectFilter for application/json ContentType.
+type UpdateProjectFilterJSONRequestBody UpdateProjectFilterJSONBody
+
+// CreateProjectClientKeyJSONRequestBody defines body for CreateProjectClientKey for application/json ContentType.
+type CreateProjectClientKeyJSONRequestBody CreateProjectClientKeyJSONBody
+
+// UpdateProjectClientKeyJSONRequestBody defines body for UpdateProjectClientKey for application/json ContentType.
+type UpdateProjectClientKeyJSONRequestBody UpdateProjectClientKeyJSONBody
+
+// UpdateProjectOwnershipJSONRequestBody defines body for UpdateProjectOwnership for application/json ContentType.
+type UpdateProjectOwnershipJSONRequestBody UpdateProjectOwnershipJSONBody
+
+// CreateProjectRuleJSONRequestBody defines body for CreateProjectRule for application/json ContentType.
+type CreateProjectRuleJSONRequestBody CreateProjectRuleJSONBody
+
+// UpdateProjectRuleJSONRequestBody defines body for UpdateProjectRule for application/json ContentType.
+type UpdateProjectRuleJSONRequestBody UpdateProjectRuleJSONBody
+
+// CreateProjectSymbolSourceJSONRequestBody defines body for CreateProjectSymbolSource for application/json ContentType.
+type CreateProjectSymbolSourceJSONRequestBody = ProjectSymbolSourceRequest
+
+// UpdateProjectSymbolSourceJSONRequestBody defines body for UpdateProjectSymbolSource for application/json ContentType.
+type UpdateProjectSymbolSourceJSONRequestBody = ProjectSymbolSourceRequest
+
+// CreateOrganizationTeamProjectJSONRequestBody defines body for CreateOrganizationTeamProject for application/json ContentType.
+type CreateOrganizationTeamProjectJSONRequestBody CreateOrganizationTeamProjectJSONBody
+
+// AsNotificationActionTargetIdentifier0 returns the union data inside the NotificationAction_TargetIdentifier as a NotificationActionTargetIdentifier0
+func (t NotificationAction_TargetIdentifier) AsNotificationActionTargetIdentifier0() (NotificationActionTargetIdentifier0, error) {
+	var body NotificationActionTargetIdentifier0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNotificationActionTargetIdentifier0 overwrites any union data inside the NotificationAction_TargetIdentifier as the provided NotificationActionTargetIdentifier0
+func (t *NotificationAction_TargetIdentifier) FromNotificationActionTargetIdentifier0(v NotificationActionTargetIdentifier0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNotificationActionTargetIdentifier0 performs a merge with any union data inside the NotificationAction_TargetIdentifier, using the provided NotificationActionTargetIdentifier0
+func (t *NotificationAction_TargetIdentifier) MergeNotificationActionTargetIdentifier0(v NotificationActionTargetIdentifier0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsNotificationActionTargetIdentifier1 returns the union data inside the NotificationAction_TargetIdentifier as a NotificationActionTargetIdentifier1
+func (t NotificationAction_TargetIdentifier) AsNotificationActionTargetIdentifier1() (NotificationActionTargetIdentifier1, error) {
+	var body NotificationActionTargetIdentifier1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNotificationActionTargetIdentifier1 overwrites any union data inside the NotificationAction_TargetIdentifier as the provided NotificationActionTargetIdentifier1
+func (t *NotificationAction_TargetIdentifier) FromNotificationActionTargetIdentifier1(v NotificationActionTargetIdentifier1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNotificationActionTargetIdentifier1 performs a merge with any union data inside the NotificationAction_TargetIdentifier, using the provided NotificationActionTargetIdentifier1
+func (t *NotificationAction_TargetIdentifier) MergeNotificationActionTargetIdentifier1(v NotificationActionTargetIdentifier1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t NotificationAction_TargetIdentifier) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *NotificationAction_TargetIdentifier) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsOrganizationIntegrationOpsgenie returns the union data inside the OrganizationIntegration as a OrganizationIntegrationOpsgenie
+func (t OrganizationIntegration) AsOrganizationIntegrationOpsgenie() (OrganizationIntegrationOpsgenie, error) {
+	var body OrganizationIntegrationOpsgenie
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationIntegrationOpsgenie overwrites any union data inside the OrganizationIntegration as the provided OrganizationIntegrationOpsgenie
+func (t *OrganizationIntegration) FromOrganizationIntegrationOpsgenie(v OrganizationIntegrationOpsgenie) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationIntegrationOpsgenie performs a merge with any union data inside the OrganizationIntegration, using the provided OrganizationIntegrationOpsgenie
+func (t *OrganizationIntegration) MergeOrganizationIntegrationOpsgenie(v OrganizationIntegrationOpsgenie) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationIntegrationPagerDuty returns the union data inside the OrganizationIntegration as a OrganizationIntegrationPagerDuty
+func (t OrganizationIntegration) AsOrganizationIntegrationPagerDuty() (OrganizationIntegrationPagerDuty, error) {
+	var body OrganizationIntegrationPagerDuty
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationIntegrationPagerDuty overwrites any union data inside the OrganizationIntegration as the provided OrganizationIntegrationPagerDuty
+func (t *OrganizationIntegration) FromOrganizationIntegrationPagerDuty(v OrganizationIntegrationPagerDuty) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationIntegrationPagerDuty performs a merge with any union data inside the OrganizationIntegration, using the provided OrganizationIntegrationPagerDuty
+func (t *OrganizationIntegration) MergeOrganizationIntegrationPagerDuty(v OrganizationIntegrationPagerDuty) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t OrganizationIntegration) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	object["accountType"], err = json.Marshal(t.AccountType)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'accountType': %w", err)
+	}
+
+	object["configData"], err = json.Marshal(t.ConfigData)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'configData': %w", err)
+	}
+
+	object["configOrganization"], err = json.Marshal(t.ConfigOrganization)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'configOrganization': %w", err)
+	}
+
+	object["domainName"], err = json.Marshal(t.DomainName)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'domainName': %w", err)
+	}
+
+	object["gracePeriodEnd"], err = json.Marshal(t.GracePeriodEnd)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'gracePeriodEnd': %w", err)
+	}
+
+	object["icon"], err = json.Marshal(t.Icon)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'icon': %w", err)
+	}
+
+	object["id"], err = json.Marshal(t.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'id': %w", err)
+	}
+
+	object["name"], err = json.Marshal(t.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	object["organizationId"], err = json.Marshal(t.OrganizationId)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'organizationId': %w", err)
+	}
+
+	object["organizationIntegrationStatus"], err = json.Marshal(t.OrganizationIntegrationStatus)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'organizationIntegrationStatus': %w", err)
+	}
+
+	object["provider"], err = json.Marshal(t.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'provider': %w", err)
+	}
+
+	if t.Scopes != nil {
+		object["scopes"], err = json.Marshal(t.Scopes)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'scopes': %w", err)
+		}
+	}
+
+	object["status"], err = json.Marshal(t.Status)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'status': %w", err)
+	}
+
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *OrganizationIntegration) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["accountType"]; found {
+		err = json.Unmarshal(raw, &t.AccountType)
+		if err != nil {
+			return fmt.Errorf("error reading 'accountType': %w", err)
+		}
+	}
+
+	if raw, found := object["configData"]; found {
+		err = json.Unmarshal(raw, &t.ConfigData)
+		if err != nil {
+			return fmt.Errorf("error reading 'configData': %w", err)
+		}
+	}
+
+	if raw, found := object["configOrganization"]; found {
+		err = json.Unmarshal(raw, &t.ConfigOrganization)
+		if err != nil {
+			return fmt.Errorf("error reading 'configOrganization': %w", err)
+		}
+	}
+
+	if raw, found := object["domainName"]; found {
+		err = json.Unmarshal(raw, &t.DomainName)
+		if err != nil {
+			return fmt.Errorf("error reading 'domainName': %w", err)
+		}
+	}
+
+	if raw, found := object["gracePeriodEnd"]; found {
+		err = json.Unmarshal(raw, &t.GracePeriodEnd)
+		if err != nil {
+			return fmt.Errorf("error reading 'gracePeriodEnd': %w", err)
+		}
+	}
+
+	if raw, found := object["icon"]; found {
+		err = json.Unmarshal(raw, &t.Icon)
+		if err != nil {
+			return fmt.Errorf("error reading 'icon': %w", err)
+		}
+	}
+
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &t.Id)
+		if err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &t.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+	}
+
+	if raw, found := object["organizationId"]; found {
+		err = json.Unmarshal(raw, &t.OrganizationId)
+		if err != nil {
+			return fmt.Errorf("error reading 'organizationId': %w", err)
+		}
+	}
+
+	if raw, found := object["organizationIntegrationStatus"]; found {
+		err = json.Unmarshal(raw, &t.OrganizationIntegrationStatus)
+		if err != nil {
+			return fmt.Errorf("error reading 'organizationIntegrationStatus': %w", err)
+		}
+	}
+
+	if raw, found := object["provider"]; found {
+		err = json.Unmarshal(raw, &t.Provider)
+		if err != nil {
+			return fmt.Errorf("error reading 'provider': %w", err)
+		}
+	}
+
+	if raw, found := object["scopes"]; found {
+		err = json.Unmarshal(raw, &t.Scopes)
+		if err != nil {
+			return fmt.Errorf("error reading 'scopes': %w", err)
+		}
+	}
+
+	if raw, found := object["status"]; found {
+		err = json.Unmarshal(raw, &t.Status)
+		if err != nil {
+			return fmt.Errorf("error reading 'status': %w", err)
+		}
+	}
+
+	return err
+}
+
+// AsOrganizationRepositoryExternalSlug0 returns the union data inside the OrganizationRepository_ExternalSlug as a OrganizationRepositoryExternalSlug0
+func (t OrganizationRepository_ExternalSlug) AsOrganizationRepositoryExternalSlug0() (OrganizationRepositoryExternalSlug0, error) {
+	var body OrganizationRepositoryExternalSlug0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationRepositoryExternalSlug0 overwrites any union data inside the OrganizationRepository_ExternalSlug as the provided OrganizationRepositoryExternalSlug0
+func (t *OrganizationRepository_ExternalSlug) FromOrganizationRepositoryExternalSlug0(v OrganizationRepositoryExternalSlug0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationRepositoryExternalSlug0 performs a merge with any union data inside the OrganizationRepository_ExternalSlug, using the provided OrganizationRepositoryExternalSlug0
+func (t *OrganizationRepository_ExternalSlug) MergeOrganizationRepositoryExternalSlug0(v OrganizationRepositoryExternalSlug0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationRepositoryExternalSlug1 returns the union data inside the OrganizationRepository_ExternalSlug as a OrganizationRepositoryExternalSlug1
+func (t OrganizationRepository_ExternalSlug) AsOrganizationRepositoryExternalSlug1() (OrganizationRepositoryExternalSlug1, error) {
+	var body OrganizationRepositoryExternalSlug1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationRepositoryExternalSlug1 overwrites any union data inside the OrganizationRepository_ExternalSlug as the provided OrganizationRepositoryExternalSlug1
+func (t *OrganizationRepository_ExternalSlug) FromOrganizationRepositoryExternalSlug1(v OrganizationRepositoryExternalSlug1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationRepositoryExternalSlug1 performs a merge with any union data inside the OrganizationRepository_ExternalSlug, using the provided OrganizationRepositoryExternalSlug1
+func (t *OrganizationRepository_ExternalSlug) MergeOrganizationRepositoryExternalSlug1(v OrganizationRepositoryExternalSlug1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t OrganizationRepository_ExternalSlug) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *OrganizationRepository_ExternalSlug) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsOrganizationWorkflowActionFilters0 returns the union data inside the OrganizationWorkflow_ActionFilters as a OrganizationWorkflowActionFilters0
+func (t OrganizationWorkflow_ActionFilters) AsOrganizationWorkflowActionFilters0() (OrganizationWorkflowActionFilters0, error) {
+	var body OrganizationWorkflowActionFilters0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilters0 overwrites any union data inside the OrganizationWorkflow_ActionFilters as the provided OrganizationWorkflowActionFilters0
+func (t *OrganizationWorkflow_ActionFilters) FromOrganizationWorkflowActionFilters0(v OrganizationWorkflowActionFilters0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilters0 performs a merge with any union data inside the OrganizationWorkflow_ActionFilters, using the provided OrganizationWorkflowActionFilters0
+func (t *OrganizationWorkflow_ActionFilters) MergeOrganizationWorkflowActionFilters0(v OrganizationWorkflowActionFilters0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilters1 returns the union data inside the OrganizationWorkflow_ActionFilters as a OrganizationWorkflowActionFilters1
+func (t OrganizationWorkflow_ActionFilters) AsOrganizationWorkflowActionFilters1() (OrganizationWorkflowActionFilters1, error) {
+	var body OrganizationWorkflowActionFilters1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilters1 overwrites any union data inside the OrganizationWorkflow_ActionFilters as the provided OrganizationWorkflowActionFilters1
+func (t *OrganizationWorkflow_ActionFilters) FromOrganizationWorkflowActionFilters1(v OrganizationWorkflowActionFilters1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilters1 performs a merge with any union data inside the OrganizationWorkflow_ActionFilters, using the provided OrganizationWorkflowActionFilters1
+func (t *OrganizationWorkflow_ActionFilters) MergeOrganizationWorkflowActionFilters1(v OrganizationWorkflowActionFilters1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t OrganizationWorkflow_ActionFilters) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *OrganizationWorkflow_ActionFilters) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsOrganizationWorkflowTrigger returns the union data inside the OrganizationWorkflow_Triggers as a OrganizationWorkflowTrigger
+func (t OrganizationWorkflow_Triggers) AsOrganizationWorkflowTrigger() (OrganizationWorkflowTrigger, error) {
+	var body OrganizationWorkflowTrigger
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowTrigger overwrites any union data inside the OrganizationWorkflow_Triggers as the provided OrganizationWorkflowTrigger
+func (t *OrganizationWorkflow_Triggers) FromOrganizationWorkflowTrigger(v OrganizationWorkflowTrigger) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowTrigger performs a merge with any union data inside the OrganizationWorkflow_Triggers, using the provided OrganizationWorkflowTrigger
+func (t *OrganizationWorkflow_Triggers) MergeOrganizationWorkflowTrigger(v OrganizationWorkflowTrigger) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowTriggers1 returns the union data inside the OrganizationWorkflow_Triggers as a OrganizationWorkflowTriggers1
+func (t OrganizationWorkflow_Triggers) AsOrganizationWorkflowTriggers1() (OrganizationWorkflowTriggers1, error) {
+	var body OrganizationWorkflowTriggers1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowTriggers1 overwrites any union data inside the OrganizationWorkflow_Triggers as the provided OrganizationWorkflowTriggers1
+func (t *OrganizationWorkflow_Triggers) FromOrganizationWorkflowTriggers1(v OrganizationWorkflowTriggers1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowTriggers1 performs a merge with any union data inside the OrganizationWorkflow_Triggers, using the provided OrganizationWorkflowTriggers1
+func (t *OrganizationWorkflow_Triggers) MergeOrganizationWorkflowTriggers1(v OrganizationWorkflowTriggers1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t OrganizationWorkflow_Triggers) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *OrganizationWorkflow_Triggers) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterActionEmail returns the union data inside the OrganizationWorkflowActionFilterAction as a OrganizationWorkflowActionFilterActionEmail
+func (t OrganizationWorkflowActionFilterAction) AsOrganizationWorkflowActionFilterActionEmail() (OrganizationWorkflowActionFilterActionEmail, error) {
+	var body OrganizationWorkflowActionFilterActionEmail
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterActionEmail overwrites any union data inside the OrganizationWorkflowActionFilterAction as the provided OrganizationWorkflowActionFilterActionEmail
+func (t *OrganizationWorkflowActionFilterAction) FromOrganizationWorkflowActionFilterActionEmail(v OrganizationWorkflowActionFilterActionEmail) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"email"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterActionEmail performs a merge with any union data inside the OrganizationWorkflowActionFilterAction, using the provided OrganizationWorkflowActionFilterActionEmail
+func (t *OrganizationWorkflowActionFilterAction) MergeOrganizationWorkflowActionFilterActionEmail(v OrganizationWorkflowActionFilterActionEmail) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"email"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterActionPlugin returns the union data inside the OrganizationWorkflowActionFilterAction as a OrganizationWorkflowActionFilterActionPlugin
+func (t OrganizationWorkflowActionFilterAction) AsOrganizationWorkflowActionFilterActionPlugin() (OrganizationWorkflowActionFilterActionPlugin, error) {
+	var body OrganizationWorkflowActionFilterActionPlugin
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterActionPlugin overwrites any union data inside the OrganizationWorkflowActionFilterAction as the provided OrganizationWorkflowActionFilterActionPlugin
+func (t *OrganizationWorkflowActionFilterAction) FromOrganizationWorkflowActionFilterActionPlugin(v OrganizationWorkflowActionFilterActionPlugin) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"plugin"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterActionPlugin performs a merge with any union data inside the OrganizationWorkflowActionFilterAction, using the provided OrganizationWorkflowActionFilterActionPlugin
+func (t *OrganizationWorkflowActionFilterAction) MergeOrganizationWorkflowActionFilterActionPlugin(v OrganizationWorkflowActionFilterActionPlugin) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"plugin"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterActionSlack returns the union data inside the OrganizationWorkflowActionFilterAction as a OrganizationWorkflowActionFilterActionSlack
+func (t OrganizationWorkflowActionFilterAction) AsOrganizationWorkflowActionFilterActionSlack() (OrganizationWorkflowActionFilterActionSlack, error) {
+	var body OrganizationWorkflowActionFilterActionSlack
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterActionSlack overwrites any union data inside the OrganizationWorkflowActionFilterAction as the provided OrganizationWorkflowActionFilterActionSlack
+func (t *OrganizationWorkflowActionFilterAction) FromOrganizationWorkflowActionFilterActionSlack(v OrganizationWorkflowActionFilterActionSlack) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"slack"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterActionSlack performs a merge with any union data inside the OrganizationWorkflowActionFilterAction, using the provided OrganizationWorkflowActionFilterActionSlack
+func (t *OrganizationWorkflowActionFilterAction) MergeOrganizationWorkflowActionFilterActionSlack(v OrganizationWorkflowActionFilterActionSlack) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"slack"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterActionPagerDuty returns the union data inside the OrganizationWorkflowActionFilterAction as a OrganizationWorkflowActionFilterActionPagerDuty
+func (t OrganizationWorkflowActionFilterAction) AsOrganizationWorkflowActionFilterActionPagerDuty() (OrganizationWorkflowActionFilterActionPagerDuty, error) {
+	var body OrganizationWorkflowActionFilterActionPagerDuty
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterActionPagerDuty overwrites any union data inside the OrganizationWorkflowActionFilterAction as the provided OrganizationWorkflowActionFilterActionPagerDuty
+func (t *OrganizationWorkflowActionFilterAction) FromOrganizationWorkflowActionFilterActionPagerDuty(v OrganizationWorkflowActionFilterActionPagerDuty) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"pagerduty"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterActionPagerDuty performs a merge with any union data inside the OrganizationWorkflowActionFilterAction, using the provided OrganizationWorkflowActionFilterActionPagerDuty
+func (t *OrganizationWorkflowActionFilterAction) MergeOrganizationWorkflowActionFilterActionPagerDuty(v OrganizationWorkflowActionFilterActionPagerDuty) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"pagerduty"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterActionDiscord returns the union data inside the OrganizationWorkflowActionFilterAction as a OrganizationWorkflowActionFilterActionDiscord
+func (t OrganizationWorkflowActionFilterAction) AsOrganizationWorkflowActionFilterActionDiscord() (OrganizationWorkflowActionFilterActionDiscord, error) {
+	var body OrganizationWorkflowActionFilterActionDiscord
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterActionDiscord overwrites any union data inside the OrganizationWorkflowActionFilterAction as the provided OrganizationWorkflowActionFilterActionDiscord
+func (t *OrganizationWorkflowActionFilterAction) FromOrganizationWorkflowActionFilterActionDiscord(v OrganizationWorkflowActionFilterActionDiscord) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"discord"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterActionDiscord performs a merge with any union data inside the OrganizationWorkflowActionFilterAction, using the provided OrganizationWorkflowActionFilterActionDiscord
+func (t *OrganizationWorkflowActionFilterAction) MergeOrganizationWorkflowActionFilterActionDiscord(v OrganizationWorkflowActionFilterActionDiscord) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"discord"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterActionMsTeams returns the union data inside the OrganizationWorkflowActionFilterAction as a OrganizationWorkflowActionFilterActionMsTeams
+func (t OrganizationWorkflowActionFilterAction) AsOrganizationWorkflowActionFilterActionMsTeams() (OrganizationWorkflowActionFilterActionMsTeams, error) {
+	var body OrganizationWorkflowActionFilterActionMsTeams
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterActionMsTeams overwrites any union data inside the OrganizationWorkflowActionFilterAction as the provided OrganizationWorkflowActionFilterActionMsTeams
+func (t *OrganizationWorkflowActionFilterAction) FromOrganizationWorkflowActionFilterActionMsTeams(v OrganizationWorkflowActionFilterActionMsTeams) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"msteams"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterActionMsTeams performs a merge with any union data inside the OrganizationWorkflowActionFilterAction, using the provided OrganizationWorkflowActionFilterActionMsTeams
+func (t *OrganizationWorkflowActionFilterAction) MergeOrganizationWorkflowActionFilterActionMsTeams(v OrganizationWorkflowActionFilterActionMsTeams) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"msteams"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterActionOpsgenie returns the union data inside the OrganizationWorkflowActionFilterAction as a OrganizationWorkflowActionFilterActionOpsgenie
+func (t OrganizationWorkflowActionFilterAction) AsOrganizationWorkflowActionFilterActionOpsgenie() (OrganizationWorkflowActionFilterActionOpsgenie, error) {
+	var body OrganizationWorkflowActionFilterActionOpsgenie
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterActionOpsgenie overwrites any union data inside the OrganizationWorkflowActionFilterAction as the provided OrganizationWorkflowActionFilterActionOpsgenie
+func (t *OrganizationWorkflowActionFilterAction) FromOrganizationWorkflowActionFilterActionOpsgenie(v OrganizationWorkflowActionFilterActionOpsgenie) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"opsgenie"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterActionOpsgenie performs a merge with any union data inside the OrganizationWorkflowActionFilterAction, using the provided OrganizationWorkflowActionFilterActionOpsgenie
+func (t *OrganizationWorkflowActionFilterAction) MergeOrganizationWorkflowActionFilterActionOpsgenie(v OrganizationWorkflowActionFilterActionOpsgenie) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"opsgenie"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterActionVsts returns the union data inside the OrganizationWorkflowActionFilterAction as a OrganizationWorkflowActionFilterActionVsts
+func (t OrganizationWorkflowActionFilterAction) AsOrganizationWorkflowActionFilterActionVsts() (OrganizationWorkflowActionFilterActionVsts, error) {
+	var body OrganizationWorkflowActionFilterActionVsts
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterActionVsts overwrites any union data inside the OrganizationWorkflowActionFilterAction as the provided OrganizationWorkflowActionFilterActionVsts
+func (t *OrganizationWorkflowActionFilterAction) FromOrganizationWorkflowActionFilterActionVsts(v OrganizationWorkflowActionFilterActionVsts) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"vsts"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterActionVsts performs a merge with any union data inside the OrganizationWorkflowActionFilterAction, using the provided OrganizationWorkflowActionFilterActionVsts
+func (t *OrganizationWorkflowActionFilterAction) MergeOrganizationWorkflowActionFilterActionVsts(v OrganizationWorkflowActionFilterActionVsts) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"vsts"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterActionJira returns the union data inside the OrganizationWorkflowActionFilterAction as a OrganizationWorkflowActionFilterActionJira
+func (t OrganizationWorkflowActionFilterAction) AsOrganizationWorkflowActionFilterActionJira() (OrganizationWorkflowActionFilterActionJira, error) {
+	var body OrganizationWorkflowActionFilterActionJira
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterActionJira overwrites any union data inside the OrganizationWorkflowActionFilterAction as the provided OrganizationWorkflowActionFilterActionJira
+func (t *OrganizationWorkflowActionFilterAction) FromOrganizationWorkflowActionFilterActionJira(v OrganizationWorkflowActionFilterActionJira) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"jira"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterActionJira performs a merge with any union data inside the OrganizationWorkflowActionFilterAction, using the provided OrganizationWorkflowActionFilterActionJira
+func (t *OrganizationWorkflowActionFilterAction) MergeOrganizationWorkflowActionFilterActionJira(v OrganizationWorkflowActionFilterActionJira) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"jira"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterActionJiraServer returns the union data inside the OrganizationWorkflowActionFilterAction as a OrganizationWorkflowActionFilterActionJiraServer
+func (t OrganizationWorkflowActionFilterAction) AsOrganizationWorkflowActionFilterActionJiraServer() (OrganizationWorkflowActionFilterActionJiraServer, error) {
+	var body OrganizationWorkflowActionFilterActionJiraServer
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterActionJiraServer overwrites any union data inside the OrganizationWorkflowActionFilterAction as the provided OrganizationWorkflowActionFilterActionJiraServer
+func (t *OrganizationWorkflowActionFilterAction) FromOrganizationWorkflowActionFilterActionJiraServer(v OrganizationWorkflowActionFilterActionJiraServer) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"jira_server"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterActionJiraServer performs a merge with any union data inside the OrganizationWorkflowActionFilterAction, using the provided OrganizationWorkflowActionFilterActionJiraServer
+func (t *OrganizationWorkflowActionFilterAction) MergeOrganizationWorkflowActionFilterActionJiraServer(v OrganizationWorkflowActionFilterActionJiraServer) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"jira_server"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterActionGitHub returns the union data inside the OrganizationWorkflowActionFilterAction as a OrganizationWorkflowActionFilterActionGitHub
+func (t OrganizationWorkflowActionFilterAction) AsOrganizationWorkflowActionFilterActionGitHub() (OrganizationWorkflowActionFilterActionGitHub, error) {
+	var body OrganizationWorkflowActionFilterActionGitHub
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterActionGitHub overwrites any union data inside the OrganizationWorkflowActionFilterAction as the provided OrganizationWorkflowActionFilterActionGitHub
+func (t *OrganizationWorkflowActionFilterAction) FromOrganizationWorkflowActionFilterActionGitHub(v OrganizationWorkflowActionFilterActionGitHub) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"github"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterActionGitHub performs a merge with any union data inside the OrganizationWorkflowActionFilterAction, using the provided OrganizationWorkflowActionFilterActionGitHub
+func (t *OrganizationWorkflowActionFilterAction) MergeOrganizationWorkflowActionFilterActionGitHub(v OrganizationWorkflowActionFilterActionGitHub) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"github"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterActionSentryApp returns the union data inside the OrganizationWorkflowActionFilterAction as a OrganizationWorkflowActionFilterActionSentryApp
+func (t OrganizationWorkflowActionFilterAction) AsOrganizationWorkflowActionFilterActionSentryApp() (OrganizationWorkflowActionFilterActionSentryApp, error) {
+	var body OrganizationWorkflowActionFilterActionSentryApp
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterActionSentryApp overwrites any union data inside the OrganizationWorkflowActionFilterAction as the provided OrganizationWorkflowActionFilterActionSentryApp
+func (t *OrganizationWorkflowActionFilterAction) FromOrganizationWorkflowActionFilterActionSentryApp(v OrganizationWorkflowActionFilterActionSentryApp) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"sentry_app"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterActionSentryApp performs a merge with any union data inside the OrganizationWorkflowActionFilterAction, using the provided OrganizationWorkflowActionFilterActionSentryApp
+func (t *OrganizationWorkflowActionFilterAction) MergeOrganizationWorkflowActionFilterActionSentryApp(v OrganizationWorkflowActionFilterActionSentryApp) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"sentry_app"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterActionWebhook returns the union data inside the OrganizationWorkflowActionFilterAction as a OrganizationWorkflowActionFilterActionWebhook
+func (t OrganizationWorkflowActionFilterAction) AsOrganizationWorkflowActionFilterActionWebhook() (OrganizationWorkflowActionFilterActionWebhook, error) {
+	var body OrganizationWorkflowActionFilterActionWebhook
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterActionWebhook overwrites any union data inside the OrganizationWorkflowActionFilterAction as the provided OrganizationWorkflowActionFilterActionWebhook
+func (t *OrganizationWorkflowActionFilterAction) FromOrganizationWorkflowActionFilterActionWebhook(v OrganizationWorkflowActionFilterActionWebhook) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"webhook"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterActionWebhook performs a merge with any union data inside the OrganizationWorkflowActionFilterAction, using the provided OrganizationWorkflowActionFilterActionWebhook
+func (t *OrganizationWorkflowActionFilterAction) MergeOrganizationWorkflowActionFilterActionWebhook(v OrganizationWorkflowActionFilterActionWebhook) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"webhook"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t OrganizationWorkflowActionFilterAction) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"type"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t OrganizationWorkflowActionFilterAction) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "discord":
+		return t.AsOrganizationWorkflowActionFilterActionDiscord()
+	case "email":
+		return t.AsOrganizationWorkflowActionFilterActionEmail()
+	case "github":
+		return t.AsOrganizationWorkflowActionFilterActionGitHub()
+	case "jira":
+		return t.AsOrganizationWorkflowActionFilterActionJira()
+	case "jira_server":
+		return t.AsOrganizationWorkflowActionFilterActionJiraServer()
+	case "msteams":
+		return t.AsOrganizationWorkflowActionFilterActionMsTeams()
+	case "opsgenie":
+		return t.AsOrganizationWorkflowActionFilterActionOpsgenie()
+	case "pagerduty":
+		return t.AsOrganizationWorkflowActionFilterActionPagerDuty()
+	case "plugin":
+		return t.AsOrganizationWorkflowActionFilterActionPlugin()
+	case "sentry_app":
+		return t.AsOrganizationWorkflowActionFilterActionSentryApp()
+	case "slack":
+		return t.AsOrganizationWorkflowActionFilterActionSlack()
+	case "vsts":
+		return t.AsOrganizationWorkflowActionFilterActionVsts()
+	case "webhook":
+		return t.AsOrganizationWorkflowActionFilterActionWebhook()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t OrganizationWorkflowActionFilterAction) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *OrganizationWorkflowActionFilterAction) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionAgeComparison returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionAgeComparison
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionAgeComparison() (OrganizationWorkflowActionFilterConditionAgeComparison, error) {
+	var body OrganizationWorkflowActionFilterConditionAgeComparison
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionAgeComparison overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionAgeComparison
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionAgeComparison(v OrganizationWorkflowActionFilterConditionAgeComparison) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"age_comparison"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionAgeComparison performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionAgeComparison
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionAgeComparison(v OrganizationWorkflowActionFilterConditionAgeComparison) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"age_comparison"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionAssignedTo returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionAssignedTo
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionAssignedTo() (OrganizationWorkflowActionFilterConditionAssignedTo, error) {
+	var body OrganizationWorkflowActionFilterConditionAssignedTo
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionAssignedTo overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionAssignedTo
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionAssignedTo(v OrganizationWorkflowActionFilterConditionAssignedTo) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"assigned_to"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionAssignedTo performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionAssignedTo
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionAssignedTo(v OrganizationWorkflowActionFilterConditionAssignedTo) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"assigned_to"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionIssueCategory returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionIssueCategory
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionIssueCategory() (OrganizationWorkflowActionFilterConditionIssueCategory, error) {
+	var body OrganizationWorkflowActionFilterConditionIssueCategory
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionIssueCategory overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionIssueCategory
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionIssueCategory(v OrganizationWorkflowActionFilterConditionIssueCategory) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"issue_category"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionIssueCategory performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionIssueCategory
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionIssueCategory(v OrganizationWorkflowActionFilterConditionIssueCategory) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"issue_category"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionIssueOccurrences returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionIssueOccurrences
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionIssueOccurrences() (OrganizationWorkflowActionFilterConditionIssueOccurrences, error) {
+	var body OrganizationWorkflowActionFilterConditionIssueOccurrences
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionIssueOccurrences overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionIssueOccurrences
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionIssueOccurrences(v OrganizationWorkflowActionFilterConditionIssueOccurrences) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"issue_occurrences"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionIssueOccurrences performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionIssueOccurrences
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionIssueOccurrences(v OrganizationWorkflowActionFilterConditionIssueOccurrences) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"issue_occurrences"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionIssuePriorityDeescalating returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionIssuePriorityDeescalating
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionIssuePriorityDeescalating() (OrganizationWorkflowActionFilterConditionIssuePriorityDeescalating, error) {
+	var body OrganizationWorkflowActionFilterConditionIssuePriorityDeescalating
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionIssuePriorityDeescalating overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionIssuePriorityDeescalating
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionIssuePriorityDeescalating(v OrganizationWorkflowActionFilterConditionIssuePriorityDeescalating) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"issue_priority_deescalating"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionIssuePriorityDeescalating performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionIssuePriorityDeescalating
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionIssuePriorityDeescalating(v OrganizationWorkflowActionFilterConditionIssuePriorityDeescalating) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"issue_priority_deescalating"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual() (OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual, error) {
+	var body OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual(v OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"issue_priority_greater_or_equal"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual(v OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"issue_priority_greater_or_equal"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount() (OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount, error) {
+	var body OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount(v OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"event_unique_user_frequency_count"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount(v OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"event_unique_user_frequency_count"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionEventFrequencyCount returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionEventFrequencyCount
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionEventFrequencyCount() (OrganizationWorkflowActionFilterConditionEventFrequencyCount, error) {
+	var body OrganizationWorkflowActionFilterConditionEventFrequencyCount
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionEventFrequencyCount overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionEventFrequencyCount
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionEventFrequencyCount(v OrganizationWorkflowActionFilterConditionEventFrequencyCount) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"event_frequency_count"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionEventFrequencyCount performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionEventFrequencyCount
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionEventFrequencyCount(v OrganizationWorkflowActionFilterConditionEventFrequencyCount) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"event_frequency_count"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionEventFrequencyPercent returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionEventFrequencyPercent
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionEventFrequencyPercent() (OrganizationWorkflowActionFilterConditionEventFrequencyPercent, error) {
+	var body OrganizationWorkflowActionFilterConditionEventFrequencyPercent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionEventFrequencyPercent overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionEventFrequencyPercent
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionEventFrequencyPercent(v OrganizationWorkflowActionFilterConditionEventFrequencyPercent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"event_frequency_percent"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionEventFrequencyPercent performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionEventFrequencyPercent
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionEventFrequencyPercent(v OrganizationWorkflowActionFilterConditionEventFrequencyPercent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"event_frequency_percent"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionPercentSessionsCount returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionPercentSessionsCount
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionPercentSessionsCount() (OrganizationWorkflowActionFilterConditionPercentSessionsCount, error) {
+	var body OrganizationWorkflowActionFilterConditionPercentSessionsCount
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionPercentSessionsCount overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionPercentSessionsCount
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionPercentSessionsCount(v OrganizationWorkflowActionFilterConditionPercentSessionsCount) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"percent_sessions_count"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionPercentSessionsCount performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionPercentSessionsCount
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionPercentSessionsCount(v OrganizationWorkflowActionFilterConditionPercentSessionsCount) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"percent_sessions_count"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionPercentSessionsPercent returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionPercentSessionsPercent
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionPercentSessionsPercent() (OrganizationWorkflowActionFilterConditionPercentSessionsPercent, error) {
+	var body OrganizationWorkflowActionFilterConditionPercentSessionsPercent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionPercentSessionsPercent overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionPercentSessionsPercent
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionPercentSessionsPercent(v OrganizationWorkflowActionFilterConditionPercentSessionsPercent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"percent_sessions_percent"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionPercentSessionsPercent performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionPercentSessionsPercent
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionPercentSessionsPercent(v OrganizationWorkflowActionFilterConditionPercentSessionsPercent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"percent_sessions_percent"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionEventAttribute returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionEventAttribute
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionEventAttribute() (OrganizationWorkflowActionFilterConditionEventAttribute, error) {
+	var body OrganizationWorkflowActionFilterConditionEventAttribute
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionEventAttribute overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionEventAttribute
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionEventAttribute(v OrganizationWorkflowActionFilterConditionEventAttribute) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"event_attribute"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionEventAttribute performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionEventAttribute
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionEventAttribute(v OrganizationWorkflowActionFilterConditionEventAttribute) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"event_attribute"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionTaggedEvent returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionTaggedEvent
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionTaggedEvent() (OrganizationWorkflowActionFilterConditionTaggedEvent, error) {
+	var body OrganizationWorkflowActionFilterConditionTaggedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionTaggedEvent overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionTaggedEvent
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionTaggedEvent(v OrganizationWorkflowActionFilterConditionTaggedEvent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"tagged_event"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionTaggedEvent performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionTaggedEvent
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionTaggedEvent(v OrganizationWorkflowActionFilterConditionTaggedEvent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"tagged_event"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionLatestRelease returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionLatestRelease
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionLatestRelease() (OrganizationWorkflowActionFilterConditionLatestRelease, error) {
+	var body OrganizationWorkflowActionFilterConditionLatestRelease
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionLatestRelease overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionLatestRelease
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionLatestRelease(v OrganizationWorkflowActionFilterConditionLatestRelease) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"latest_release"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionLatestRelease performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionLatestRelease
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionLatestRelease(v OrganizationWorkflowActionFilterConditionLatestRelease) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"latest_release"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionLatestAdoptedRelease returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionLatestAdoptedRelease
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionLatestAdoptedRelease() (OrganizationWorkflowActionFilterConditionLatestAdoptedRelease, error) {
+	var body OrganizationWorkflowActionFilterConditionLatestAdoptedRelease
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionLatestAdoptedRelease overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionLatestAdoptedRelease
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionLatestAdoptedRelease(v OrganizationWorkflowActionFilterConditionLatestAdoptedRelease) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"latest_adopted_release"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionLatestAdoptedRelease performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionLatestAdoptedRelease
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionLatestAdoptedRelease(v OrganizationWorkflowActionFilterConditionLatestAdoptedRelease) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"latest_adopted_release"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionLevel returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionLevel
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionLevel() (OrganizationWorkflowActionFilterConditionLevel, error) {
+	var body OrganizationWorkflowActionFilterConditionLevel
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionLevel overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionLevel
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionLevel(v OrganizationWorkflowActionFilterConditionLevel) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"level"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionLevel performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionLevel
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionLevel(v OrganizationWorkflowActionFilterConditionLevel) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"level"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionIssueType returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionIssueType
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionIssueType() (OrganizationWorkflowActionFilterConditionIssueType, error) {
+	var body OrganizationWorkflowActionFilterConditionIssueType
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionIssueType overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionIssueType
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionIssueType(v OrganizationWorkflowActionFilterConditionIssueType) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"issue_type"}`))
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionIssueType performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionIssueType
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionIssueType(v OrganizationWorkflowActionFilterConditionIssueType) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"issue_type"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t OrganizationWorkflowActionFilterCondition) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"type"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t OrganizationWorkflowActionFilterCondition) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "age_comparison":
+		return t.AsOrganizationWorkflowActionFilterConditionAgeComparison()
+	case "assigned_to":
+		return t.AsOrganizationWorkflowActionFilterConditionAssignedTo()
+	case "event_attribute":
+		return t.AsOrganizationWorkflowActionFilterConditionEventAttribute()
+	case "event_frequency_count":
+		return t.AsOrganizationWorkflowActionFilterConditionEventFrequencyCount()
+	case "event_frequency_percent":
+		return t.AsOrganizationWorkflowActionFilterConditionEventFrequencyPercent()
+	case "event_unique_user_frequency_count":
+		return t.AsOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount()
+	case "issue_category":
+		return t.AsOrganizationWorkflowActionFilterConditionIssueCategory()
+	case "issue_occurrences":
+		return t.AsOrganizationWorkflowActionFilterConditionIssueOccurrences()
+	case "issue_priority_deescalating":
+		return t.AsOrganizationWorkflowActionFilterConditionIssuePriorityDeescalating()
+	case "issue_priority_greater_or_equal":
+		return t.AsOrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual()
+	case "issue_type":
+		return t.AsOrganizationWorkflowActionFilterConditionIssueType()
+	case "latest_adopted_release":
+		return t.AsOrganizationWorkflowActionFilterConditionLatestAdoptedRelease()
+	case "latest_release":
+		return t.AsOrganizationWorkflowActionFilterConditionLatestRelease()
+	case "level":
+		return t.AsOrganizationWorkflowActionFilterConditionLevel()
+	case "percent_sessions_count":
+		return t.AsOrganizationWorkflowActionFilterConditionPercentSessionsCount()
+	case "percent_sessions_percent":
+		return t.AsOrganizationWorkflowActionFilterConditionPercentSessionsPercent()
+	case "tagged_event":
+		return t.AsOrganizationWorkflowActionFilterConditionTaggedEvent()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t OrganizationWorkflowActionFilterCondition) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *OrganizationWorkflowActionFilterCondition) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0 returns the union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier as a OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0
+func (t OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) AsOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0() (OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0, error) {
+	var body OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0 overwrites any union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier as the provided OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0
+func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) FromOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0(v OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0 performs a merge with any union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier, using the provided OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0
+func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) MergeOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0(v OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1 returns the union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier as a OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1
+func (t OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) AsOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1() (OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1, error) {
+	var body OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1 overwrites any union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier as the provided OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1
+func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) FromOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1(v OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1 performs a merge with any union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier, using the provided OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1
+func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) MergeOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1(v OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsOrganizationWorkflowTriggerConditionComparison0 returns the union data inside the OrganizationWorkflowTriggerCondition_Comparison as a OrganizationWorkflowTriggerConditionComparison0
+func (t OrganizationWorkflowTriggerCondition_Comparison) AsOrganizationWorkflowTriggerConditionComparison0() (OrganizationWorkflowTriggerConditionComparison0, error) {
+	var body OrganizationWorkflowTriggerConditionComparison0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowTriggerConditionComparison0 overwrites any union data inside the OrganizationWorkflowTriggerCondition_Comparison as the provided OrganizationWorkflowTriggerConditionComparison0
+func (t *OrganizationWorkflowTriggerCondition_Comparison) FromOrganizationWorkflowTriggerConditionComparison0(v OrganizationWorkflowTriggerConditionComparison0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowTriggerConditionComparison0 performs a merge with any union data inside the OrganizationWorkflowTriggerCondition_Comparison, using the provided OrganizationWorkflowTriggerConditionComparison0
+func (t *OrganizationWorkflowTriggerCondition_Comparison) MergeOrganizationWorkflowTriggerConditionComparison0(v OrganizationWorkflowTriggerConditionComparison0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowTriggerConditionComparison1 returns the union data inside the OrganizationWorkflowTriggerCondition_Comparison as a OrganizationWorkflowTriggerConditionComparison1
+func (t OrganizationWorkflowTriggerCondition_Comparison) AsOrganizationWorkflowTriggerConditionComparison1() (OrganizationWorkflowTriggerConditionComparison1, error) {
+	var body OrganizationWorkflowTriggerConditionComparison1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowTriggerConditionComparison1 overwrites any union data inside the OrganizationWorkflowTriggerCondition_Comparison as the provided OrganizationWorkflowTriggerConditionComparison1
+func (t *OrganizationWorkflowTriggerCondition_Comparison) FromOrganizationWorkflowTriggerConditionComparison1(v OrganizationWorkflowTriggerConditionComparison1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowTriggerConditionComparison1 performs a merge with any union data inside the OrganizationWorkflowTriggerCondition_Comparison, using the provided OrganizationWorkflowTriggerConditionComparison1
+func (t *OrganizationWorkflowTriggerCondition_Comparison) MergeOrganizationWorkflowTriggerConditionComparison1(v OrganizationWorkflowTriggerConditionComparison1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t OrganizationWorkflowTriggerCondition_Comparison) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *OrganizationWorkflowTriggerCondition_Comparison) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectFilterActive0 returns the union data inside the ProjectFilter_Active as a ProjectFilterActive0
+func (t ProjectFilter_Active) AsProjectFilterActive0() (ProjectFilterActive0, error) {
+	var body ProjectFilterActive0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectFilterActive0 overwrites any union data inside the ProjectFilter_Active as the provided ProjectFilterActive0
+func (t *ProjectFilter_Active) FromProjectFilterActive0(v ProjectFilterActive0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectFilterActive0 performs a merge with any union data inside the ProjectFilter_Active, using the provided ProjectFilterActive0
+func (t *ProjectFilter_Active) MergeProjectFilterActive0(v ProjectFilterActive0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectFilterActive1 returns the union data inside the ProjectFilter_Active as a ProjectFilterActive1
+func (t ProjectFilter_Active) AsProjectFilterActive1() (ProjectFilterActive1, error) {
+	var body ProjectFilterActive1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectFilterActive1 overwrites any union data inside the ProjectFilter_Active as the provided ProjectFilterActive1
+func (t *ProjectFilter_Active) FromProjectFilterActive1(v ProjectFilterActive1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectFilterActive1 performs a merge with any union data inside the ProjectFilter_Active, using the provided ProjectFilterActive1
+func (t *ProjectFilter_Active) MergeProjectFilterActive1(v ProjectFilterActive1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectFilter_Active) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectFilter_Active) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectMonitorRequestMetricIssue returns the union data inside the ProjectMonitorRequest as a ProjectMonitorRequestMetricIssue
+func (t ProjectMonitorRequest) AsProjectMonitorRequestMetricIssue() (ProjectMonitorRequestMetricIssue, error) {
+	var body ProjectMonitorRequestMetricIssue
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorRequestMetricIssue overwrites any union data inside the ProjectMonitorRequest as the provided ProjectMonitorRequestMetricIssue
+func (t *ProjectMonitorRequest) FromProjectMonitorRequestMetricIssue(v ProjectMonitorRequestMetricIssue) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"metric_issue"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorRequestMetricIssue performs a merge with any union data inside the ProjectMonitorRequest, using the provided ProjectMonitorRequestMetricIssue
+func (t *ProjectMonitorRequest) MergeProjectMonitorRequestMetricIssue(v ProjectMonitorRequestMetricIssue) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"metric_issue"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorRequestMonitorCheckInFailure returns the union data inside the ProjectMonitorRequest as a ProjectMonitorRequestMonitorCheckInFailure
+func (t ProjectMonitorRequest) AsProjectMonitorRequestMonitorCheckInFailure() (ProjectMonitorRequestMonitorCheckInFailure, error) {
+	var body ProjectMonitorRequestMonitorCheckInFailure
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorRequestMonitorCheckInFailure overwrites any union data inside the ProjectMonitorRequest as the provided ProjectMonitorRequestMonitorCheckInFailure
+func (t *ProjectMonitorRequest) FromProjectMonitorRequestMonitorCheckInFailure(v ProjectMonitorRequestMonitorCheckInFailure) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"monitor_check_in_failure"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorRequestMonitorCheckInFailure performs a merge with any union data inside the ProjectMonitorRequest, using the provided ProjectMonitorRequestMonitorCheckInFailure
+func (t *ProjectMonitorRequest) MergeProjectMonitorRequestMonitorCheckInFailure(v ProjectMonitorRequestMonitorCheckInFailure) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"monitor_check_in_failure"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorRequestUptimeDomainFailure returns the union data inside the ProjectMonitorRequest as a ProjectMonitorRequestUptimeDomainFailure
+func (t ProjectMonitorRequest) AsProjectMonitorRequestUptimeDomainFailure() (ProjectMonitorRequestUptimeDomainFailure, error) {
+	var body ProjectMonitorRequestUptimeDomainFailure
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorRequestUptimeDomainFailure overwrites any union data inside the ProjectMonitorRequest as the provided ProjectMonitorRequestUptimeDomainFailure
+func (t *ProjectMonitorRequest) FromProjectMonitorRequestUptimeDomainFailure(v ProjectMonitorRequestUptimeDomainFailure) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"uptime_domain_failure"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorRequestUptimeDomainFailure performs a merge with any union data inside the ProjectMonitorRequest, using the provided ProjectMonitorRequestUptimeDomainFailure
+func (t *ProjectMonitorRequest) MergeProjectMonitorRequestUptimeDomainFailure(v ProjectMonitorRequestUptimeDomainFailure) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"uptime_domain_failure"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorRequestGeneric returns the union data inside the ProjectMonitorRequest as a ProjectMonitorRequestGeneric
+func (t ProjectMonitorRequest) AsProjectMonitorRequestGeneric() (ProjectMonitorRequestGeneric, error) {
+	var body ProjectMonitorRequestGeneric
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorRequestGeneric overwrites any union data inside the ProjectMonitorRequest as the provided ProjectMonitorRequestGeneric
+func (t *ProjectMonitorRequest) FromProjectMonitorRequestGeneric(v ProjectMonitorRequestGeneric) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"ProjectMonitorRequest_Generic"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorRequestGeneric performs a merge with any union data inside the ProjectMonitorRequest, using the provided ProjectMonitorRequestGeneric
+func (t *ProjectMonitorRequest) MergeProjectMonitorRequestGeneric(v ProjectMonitorRequestGeneric) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"ProjectMonitorRequest_Generic"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectMonitorRequest) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"type"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t ProjectMonitorRequest) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "ProjectMonitorRequest_Generic":
+		return t.AsProjectMonitorRequestGeneric()
+	case "metric_issue":
+		return t.AsProjectMonitorRequestMetricIssue()
+	case "monitor_check_in_failure":
+		return t.AsProjectMonitorRequestMonitorCheckInFailure()
+	case "uptime_domain_failure":
+		return t.AsProjectMonitorRequestUptimeDomainFailure()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t ProjectMonitorRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectMonitorRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectMonitorConditionGroupConditionComparison0 returns the union data inside the ProjectMonitorConditionGroupCondition_Comparison as a ProjectMonitorConditionGroupConditionComparison0
+func (t ProjectMonitorConditionGroupCondition_Comparison) AsProjectMonitorConditionGroupConditionComparison0() (ProjectMonitorConditionGroupConditionComparison0, error) {
+	var body ProjectMonitorConditionGroupConditionComparison0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorConditionGroupConditionComparison0 overwrites any union data inside the ProjectMonitorConditionGroupCondition_Comparison as the provided ProjectMonitorConditionGroupConditionComparison0
+func (t *ProjectMonitorConditionGroupCondition_Comparison) FromProjectMonitorConditionGroupConditionComparison0(v ProjectMonitorConditionGroupConditionComparison0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorConditionGroupConditionComparison0 performs a merge with any union data inside the ProjectMonitorConditionGroupCondition_Comparison, using the provided ProjectMonitorConditionGroupConditionComparison0
+func (t *ProjectMonitorConditionGroupCondition_Comparison) MergeProjectMonitorConditionGroupConditionComparison0(v ProjectMonitorConditionGroupConditionComparison0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorConditionGroupConditionComparison1 returns the union data inside the ProjectMonitorConditionGroupCondition_Comparison as a ProjectMonitorConditionGroupConditionComparison1
+func (t ProjectMonitorConditionGroupCondition_Comparison) AsProjectMonitorConditionGroupConditionComparison1() (ProjectMonitorConditionGroupConditionComparison1, error) {
+	var body ProjectMonitorConditionGroupConditionComparison1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorConditionGroupConditionComparison1 overwrites any union data inside the ProjectMonitorConditionGroupCondition_Comparison as the provided ProjectMonitorConditionGroupConditionComparison1
+func (t *ProjectMonitorConditionGroupCondition_Comparison) FromProjectMonitorConditionGroupConditionComparison1(v ProjectMonitorConditionGroupConditionComparison1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorConditionGroupConditionComparison1 performs a merge with any union data inside the ProjectMonitorConditionGroupCondition_Comparison, using the provided ProjectMonitorConditionGroupConditionComparison1
+func (t *ProjectMonitorConditionGroupCondition_Comparison) MergeProjectMonitorConditionGroupConditionComparison1(v ProjectMonitorConditionGroupConditionComparison1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorConditionGroupConditionComparison2 returns the union data inside the ProjectMonitorConditionGroupCondition_Comparison as a ProjectMonitorConditionGroupConditionComparison2
+func (t ProjectMonitorConditionGroupCondition_Comparison) AsProjectMonitorConditionGroupConditionComparison2() (ProjectMonitorConditionGroupConditionComparison2, error) {
+	var body ProjectMonitorConditionGroupConditionComparison2
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorConditionGroupConditionComparison2 overwrites any union data inside the ProjectMonitorConditionGroupCondition_Comparison as the provided ProjectMonitorConditionGroupConditionComparison2
+func (t *ProjectMonitorConditionGroupCondition_Comparison) FromProjectMonitorConditionGroupConditionComparison2(v ProjectMonitorConditionGroupConditionComparison2) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorConditionGroupConditionComparison2 performs a merge with any union data inside the ProjectMonitorConditionGroupCondition_Comparison, using the provided ProjectMonitorConditionGroupConditionComparison2
+func (t *ProjectMonitorConditionGroupCondition_Comparison) MergeProjectMonitorConditionGroupConditionComparison2(v ProjectMonitorConditionGroupConditionComparison2) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectMonitorConditionGroupCondition_Comparison) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectMonitorConditionGroupCondition_Comparison) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectMonitorConfigMetricIssue returns the union data inside the ProjectMonitorConfig as a ProjectMonitorConfigMetricIssue
+func (t ProjectMonitorConfig) AsProjectMonitorConfigMetricIssue() (ProjectMonitorConfigMetricIssue, error) {
+	var body ProjectMonitorConfigMetricIssue
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorConfigMetricIssue overwrites any union data inside the ProjectMonitorConfig as the provided ProjectMonitorConfigMetricIssue
+func (t *ProjectMonitorConfig) FromProjectMonitorConfigMetricIssue(v ProjectMonitorConfigMetricIssue) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorConfigMetricIssue performs a merge with any union data inside the ProjectMonitorConfig, using the provided ProjectMonitorConfigMetricIssue
+func (t *ProjectMonitorConfig) MergeProjectMonitorConfigMetricIssue(v ProjectMonitorConfigMetricIssue) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorConfigUptimeDomainFailure returns the union data inside the ProjectMonitorConfig as a ProjectMonitorConfigUptimeDomainFailure
+func (t ProjectMonitorConfig) AsProjectMonitorConfigUptimeDomainFailure() (ProjectMonitorConfigUptimeDomainFailure, error) {
+	var body ProjectMonitorConfigUptimeDomainFailure
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorConfigUptimeDomainFailure overwrites any union data inside the ProjectMonitorConfig as the provided ProjectMonitorConfigUptimeDomainFailure
+func (t *ProjectMonitorConfig) FromProjectMonitorConfigUptimeDomainFailure(v ProjectMonitorConfigUptimeDomainFailure) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorConfigUptimeDomainFailure performs a merge with any union data inside the ProjectMonitorConfig, using the provided ProjectMonitorConfigUptimeDomainFailure
+func (t *ProjectMonitorConfig) MergeProjectMonitorConfigUptimeDomainFailure(v ProjectMonitorConfigUptimeDomainFailure) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectMonitorConfig) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectMonitorConfig) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectMonitorDataSourceConfigCronCrontab returns the union data inside the ProjectMonitorDataSourceConfigCron as a ProjectMonitorDataSourceConfigCronCrontab
+func (t ProjectMonitorDataSourceConfigCron) AsProjectMonitorDataSourceConfigCronCrontab() (ProjectMonitorDataSourceConfigCronCrontab, error) {
+	var body ProjectMonitorDataSourceConfigCronCrontab
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorDataSourceConfigCronCrontab overwrites any union data inside the ProjectMonitorDataSourceConfigCron as the provided ProjectMonitorDataSourceConfigCronCrontab
+func (t *ProjectMonitorDataSourceConfigCron) FromProjectMonitorDataSourceConfigCronCrontab(v ProjectMonitorDataSourceConfigCronCrontab) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"schedule_type":"crontab"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorDataSourceConfigCronCrontab performs a merge with any union data inside the ProjectMonitorDataSourceConfigCron, using the provided ProjectMonitorDataSourceConfigCronCrontab
+func (t *ProjectMonitorDataSourceConfigCron) MergeProjectMonitorDataSourceConfigCronCrontab(v ProjectMonitorDataSourceConfigCronCrontab) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"schedule_type":"crontab"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorDataSourceConfigCronInterval returns the union data inside the ProjectMonitorDataSourceConfigCron as a ProjectMonitorDataSourceConfigCronInterval
+func (t ProjectMonitorDataSourceConfigCron) AsProjectMonitorDataSourceConfigCronInterval() (ProjectMonitorDataSourceConfigCronInterval, error) {
+	var body ProjectMonitorDataSourceConfigCronInterval
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorDataSourceConfigCronInterval overwrites any union data inside the ProjectMonitorDataSourceConfigCron as the provided ProjectMonitorDataSourceConfigCronInterval
+func (t *ProjectMonitorDataSourceConfigCron) FromProjectMonitorDataSourceConfigCronInterval(v ProjectMonitorDataSourceConfigCronInterval) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"schedule_type":"interval"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorDataSourceConfigCronInterval performs a merge with any union data inside the ProjectMonitorDataSourceConfigCron, using the provided ProjectMonitorDataSourceConfigCronInterval
+func (t *ProjectMonitorDataSourceConfigCron) MergeProjectMonitorDataSourceConfigCronInterval(v ProjectMonitorDataSourceConfigCronInterval) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"schedule_type":"interval"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectMonitorDataSourceConfigCron) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"schedule_type"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t ProjectMonitorDataSourceConfigCron) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "crontab":
+		return t.AsProjectMonitorDataSourceConfigCronCrontab()
+	case "interval":
+		return t.AsProjectMonitorDataSourceConfigCronInterval()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t ProjectMonitorDataSourceConfigCron) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectMonitorDataSourceConfigCron) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectMonitorDataSourceConfigCronIntervalValue returns the union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item as a ProjectMonitorDataSourceConfigCronIntervalValue
+func (t ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) AsProjectMonitorDataSourceConfigCronIntervalValue() (ProjectMonitorDataSourceConfigCronIntervalValue, error) {
+	var body ProjectMonitorDataSourceConfigCronIntervalValue
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorDataSourceConfigCronIntervalValue overwrites any union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item as the provided ProjectMonitorDataSourceConfigCronIntervalValue
+func (t *ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) FromProjectMonitorDataSourceConfigCronIntervalValue(v ProjectMonitorDataSourceConfigCronIntervalValue) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorDataSourceConfigCronIntervalValue performs a merge with any union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item, using the provided ProjectMonitorDataSourceConfigCronIntervalValue
+func (t *ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) MergeProjectMonitorDataSourceConfigCronIntervalValue(v ProjectMonitorDataSourceConfigCronIntervalValue) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorDataSourceConfigCronIntervalUnit returns the union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item as a ProjectMonitorDataSourceConfigCronIntervalUnit
+func (t ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) AsProjectMonitorDataSourceConfigCronIntervalUnit() (ProjectMonitorDataSourceConfigCronIntervalUnit, error) {
+	var body ProjectMonitorDataSourceConfigCronIntervalUnit
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorDataSourceConfigCronIntervalUnit overwrites any union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item as the provided ProjectMonitorDataSourceConfigCronIntervalUnit
+func (t *ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) FromProjectMonitorDataSourceConfigCronIntervalUnit(v ProjectMonitorDataSourceConfigCronIntervalUnit) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorDataSourceConfigCronIntervalUnit performs a merge with any union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item, using the provided ProjectMonitorDataSourceConfigCronIntervalUnit
+func (t *ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) MergeProjectMonitorDataSourceConfigCronIntervalUnit(v ProjectMonitorDataSourceConfigCronIntervalUnit) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectMonitorDataSourceWrapperCronMonitor returns the union data inside the ProjectMonitorDataSourceWrapper as a ProjectMonitorDataSourceWrapperCronMonitor
+func (t ProjectMonitorDataSourceWrapper) AsProjectMonitorDataSourceWrapperCronMonitor() (ProjectMonitorDataSourceWrapperCronMonitor, error) {
+	var body ProjectMonitorDataSourceWrapperCronMonitor
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorDataSourceWrapperCronMonitor overwrites any union data inside the ProjectMonitorDataSourceWrapper as the provided ProjectMonitorDataSourceWrapperCronMonitor
+func (t *ProjectMonitorDataSourceWrapper) FromProjectMonitorDataSourceWrapperCronMonitor(v ProjectMonitorDataSourceWrapperCronMonitor) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"cron_monitor"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorDataSourceWrapperCronMonitor performs a merge with any union data inside the ProjectMonitorDataSourceWrapper, using the provided ProjectMonitorDataSourceWrapperCronMonitor
+func (t *ProjectMonitorDataSourceWrapper) MergeProjectMonitorDataSourceWrapperCronMonitor(v ProjectMonitorDataSourceWrapperCronMonitor) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"cron_monitor"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorDataSourceWrapperSnubaQuerySubscription returns the union data inside the ProjectMonitorDataSourceWrapper as a ProjectMonitorDataSourceWrapperSnubaQuerySubscription
+func (t ProjectMonitorDataSourceWrapper) AsProjectMonitorDataSourceWrapperSnubaQuerySubscription() (ProjectMonitorDataSourceWrapperSnubaQuerySubscription, error) {
+	var body ProjectMonitorDataSourceWrapperSnubaQuerySubscription
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorDataSourceWrapperSnubaQuerySubscription overwrites any union data inside the ProjectMonitorDataSourceWrapper as the provided ProjectMonitorDataSourceWrapperSnubaQuerySubscription
+func (t *ProjectMonitorDataSourceWrapper) FromProjectMonitorDataSourceWrapperSnubaQuerySubscription(v ProjectMonitorDataSourceWrapperSnubaQuerySubscription) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"snuba_query_subscription"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorDataSourceWrapperSnubaQuerySubscription performs a merge with any union data inside the ProjectMonitorDataSourceWrapper, using the provided ProjectMonitorDataSourceWrapperSnubaQuerySubscription
+func (t *ProjectMonitorDataSourceWrapper) MergeProjectMonitorDataSourceWrapperSnubaQuerySubscription(v ProjectMonitorDataSourceWrapperSnubaQuerySubscription) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"snuba_query_subscription"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorDataSourceWrapperUptimeSubscription returns the union data inside the ProjectMonitorDataSourceWrapper as a ProjectMonitorDataSourceWrapperUptimeSubscription
+func (t ProjectMonitorDataSourceWrapper) AsProjectMonitorDataSourceWrapperUptimeSubscription() (ProjectMonitorDataSourceWrapperUptimeSubscription, error) {
+	var body ProjectMonitorDataSourceWrapperUptimeSubscription
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorDataSourceWrapperUptimeSubscription overwrites any union data inside the ProjectMonitorDataSourceWrapper as the provided ProjectMonitorDataSourceWrapperUptimeSubscription
+func (t *ProjectMonitorDataSourceWrapper) FromProjectMonitorDataSourceWrapperUptimeSubscription(v ProjectMonitorDataSourceWrapperUptimeSubscription) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"uptime_subscription"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorDataSourceWrapperUptimeSubscription performs a merge with any union data inside the ProjectMonitorDataSourceWrapper, using the provided ProjectMonitorDataSourceWrapperUptimeSubscription
+func (t *ProjectMonitorDataSourceWrapper) MergeProjectMonitorDataSourceWrapperUptimeSubscription(v ProjectMonitorDataSourceWrapperUptimeSubscription) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"uptime_subscription"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectMonitorDataSourceWrapper) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"type"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t ProjectMonitorDataSourceWrapper) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "cron_monitor":
+		return t.AsProjectMonitorDataSourceWrapperCronMonitor()
+	case "snuba_query_subscription":
+		return t.AsProjectMonitorDataSourceWrapperSnubaQuerySubscription()
+	case "uptime_subscription":
+		return t.AsProjectMonitorDataSourceWrapperUptimeSubscription()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t ProjectMonitorDataSourceWrapper) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectMonitorDataSourceWrapper) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectMonitorOwnerUser returns the union data inside the ProjectMonitorOwner as a ProjectMonitorOwnerUser
+func (t ProjectMonitorOwner) AsProjectMonitorOwnerUser() (ProjectMonitorOwnerUser, error) {
+	var body ProjectMonitorOwnerUser
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorOwnerUser overwrites any union data inside the ProjectMonitorOwner as the provided ProjectMonitorOwnerUser
+func (t *ProjectMonitorOwner) FromProjectMonitorOwnerUser(v ProjectMonitorOwnerUser) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"user"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorOwnerUser performs a merge with any union data inside the ProjectMonitorOwner, using the provided ProjectMonitorOwnerUser
+func (t *ProjectMonitorOwner) MergeProjectMonitorOwnerUser(v ProjectMonitorOwnerUser) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"user"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorOwnerTeam returns the union data inside the ProjectMonitorOwner as a ProjectMonitorOwnerTeam
+func (t ProjectMonitorOwner) AsProjectMonitorOwnerTeam() (ProjectMonitorOwnerTeam, error) {
+	var body ProjectMonitorOwnerTeam
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorOwnerTeam overwrites any union data inside the ProjectMonitorOwner as the provided ProjectMonitorOwnerTeam
+func (t *ProjectMonitorOwner) FromProjectMonitorOwnerTeam(v ProjectMonitorOwnerTeam) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"team"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorOwnerTeam performs a merge with any union data inside the ProjectMonitorOwner, using the provided ProjectMonitorOwnerTeam
+func (t *ProjectMonitorOwner) MergeProjectMonitorOwnerTeam(v ProjectMonitorOwnerTeam) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"type":"team"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectMonitorOwner) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"type"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t ProjectMonitorOwner) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "team":
+		return t.AsProjectMonitorOwnerTeam()
+	case "user":
+		return t.AsProjectMonitorOwnerUser()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t ProjectMonitorOwner) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectMonitorOwner) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectRuleActionNotifyEmail returns the union data inside the ProjectRuleAction as a ProjectRuleActionNotifyEmail
+func (t ProjectRuleAction) AsProjectRuleActionNotifyEmail() (ProjectRuleActionNotifyEmail, error) {
+	var body ProjectRuleActionNotifyEmail
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionNotifyEmail overwrites any union data inside the ProjectRuleAction as the provided ProjectRuleActionNotifyEmail
+func (t *ProjectRuleAction) FromProjectRuleActionNotifyEmail(v ProjectRuleActionNotifyEmail) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.mail.actions.NotifyEmailAction"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionNotifyEmail performs a merge with any union data inside the ProjectRuleAction, using the provided ProjectRuleActionNotifyEmail
+func (t *ProjectRuleAction) MergeProjectRuleActionNotifyEmail(v ProjectRuleActionNotifyEmail) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.mail.actions.NotifyEmailAction"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleActionNotifyEvent returns the union data inside the ProjectRuleAction as a ProjectRuleActionNotifyEvent
+func (t ProjectRuleAction) AsProjectRuleActionNotifyEvent() (ProjectRuleActionNotifyEvent, error) {
+	var body ProjectRuleActionNotifyEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionNotifyEvent overwrites any union data inside the ProjectRuleAction as the provided ProjectRuleActionNotifyEvent
+func (t *ProjectRuleAction) FromProjectRuleActionNotifyEvent(v ProjectRuleActionNotifyEvent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.actions.notify_event.NotifyEventAction"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionNotifyEvent performs a merge with any union data inside the ProjectRuleAction, using the provided ProjectRuleActionNotifyEvent
+func (t *ProjectRuleAction) MergeProjectRuleActionNotifyEvent(v ProjectRuleActionNotifyEvent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.actions.notify_event.NotifyEventAction"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleActionNotifyEventService returns the union data inside the ProjectRuleAction as a ProjectRuleActionNotifyEventService
+func (t ProjectRuleAction) AsProjectRuleActionNotifyEventService() (ProjectRuleActionNotifyEventService, error) {
+	var body ProjectRuleActionNotifyEventService
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionNotifyEventService overwrites any union data inside the ProjectRuleAction as the provided ProjectRuleActionNotifyEventService
+func (t *ProjectRuleAction) FromProjectRuleActionNotifyEventService(v ProjectRuleActionNotifyEventService) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.actions.notify_event_service.NotifyEventServiceAction"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionNotifyEventService performs a merge with any union data inside the ProjectRuleAction, using the provided ProjectRuleActionNotifyEventService
+func (t *ProjectRuleAction) MergeProjectRuleActionNotifyEventService(v ProjectRuleActionNotifyEventService) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.actions.notify_event_service.NotifyEventServiceAction"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleActionNotifyEventSentryApp returns the union data inside the ProjectRuleAction as a ProjectRuleActionNotifyEventSentryApp
+func (t ProjectRuleAction) AsProjectRuleActionNotifyEventSentryApp() (ProjectRuleActionNotifyEventSentryApp, error) {
+	var body ProjectRuleActionNotifyEventSentryApp
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionNotifyEventSentryApp overwrites any union data inside the ProjectRuleAction as the provided ProjectRuleActionNotifyEventSentryApp
+func (t *ProjectRuleAction) FromProjectRuleActionNotifyEventSentryApp(v ProjectRuleActionNotifyEventSentryApp) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionNotifyEventSentryApp performs a merge with any union data inside the ProjectRuleAction, using the provided ProjectRuleActionNotifyEventSentryApp
+func (t *ProjectRuleAction) MergeProjectRuleActionNotifyEventSentryApp(v ProjectRuleActionNotifyEventSentryApp) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleActionOpsgenieNotifyTeam returns the union data inside the ProjectRuleAction as a ProjectRuleActionOpsgenieNotifyTeam
+func (t ProjectRuleAction) AsProjectRuleActionOpsgenieNotifyTeam() (ProjectRuleActionOpsgenieNotifyTeam, error) {
+	var body ProjectRuleActionOpsgenieNotifyTeam
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionOpsgenieNotifyTeam overwrites any union data inside the ProjectRuleAction as the provided ProjectRuleActionOpsgenieNotifyTeam
+func (t *ProjectRuleAction) FromProjectRuleActionOpsgenieNotifyTeam(v ProjectRuleActionOpsgenieNotifyTeam) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.opsgenie.notify_action.OpsgenieNotifyTeamAction"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionOpsgenieNotifyTeam performs a merge with any union data inside the ProjectRuleAction, using the provided ProjectRuleActionOpsgenieNotifyTeam
+func (t *ProjectRuleAction) MergeProjectRuleActionOpsgenieNotifyTeam(v ProjectRuleActionOpsgenieNotifyTeam) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.opsgenie.notify_action.OpsgenieNotifyTeamAction"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleActionPagerDutyNotifyService returns the union data inside the ProjectRuleAction as a ProjectRuleActionPagerDutyNotifyService
+func (t ProjectRuleAction) AsProjectRuleActionPagerDutyNotifyService() (ProjectRuleActionPagerDutyNotifyService, error) {
+	var body ProjectRuleActionPagerDutyNotifyService
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionPagerDutyNotifyService overwrites any union data inside the ProjectRuleAction as the provided ProjectRuleActionPagerDutyNotifyService
+func (t *ProjectRuleAction) FromProjectRuleActionPagerDutyNotifyService(v ProjectRuleActionPagerDutyNotifyService) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.pagerduty.notify_action.PagerDutyNotifyServiceAction"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionPagerDutyNotifyService performs a merge with any union data inside the ProjectRuleAction, using the provided ProjectRuleActionPagerDutyNotifyService
+func (t *ProjectRuleAction) MergeProjectRuleActionPagerDutyNotifyService(v ProjectRuleActionPagerDutyNotifyService) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.pagerduty.notify_action.PagerDutyNotifyServiceAction"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleActionSlackNotifyService returns the union data inside the ProjectRuleAction as a ProjectRuleActionSlackNotifyService
+func (t ProjectRuleAction) AsProjectRuleActionSlackNotifyService() (ProjectRuleActionSlackNotifyService, error) {
+	var body ProjectRuleActionSlackNotifyService
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionSlackNotifyService overwrites any union data inside the ProjectRuleAction as the provided ProjectRuleActionSlackNotifyService
+func (t *ProjectRuleAction) FromProjectRuleActionSlackNotifyService(v ProjectRuleActionSlackNotifyService) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.slack.notify_action.SlackNotifyServiceAction"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionSlackNotifyService performs a merge with any union data inside the ProjectRuleAction, using the provided ProjectRuleActionSlackNotifyService
+func (t *ProjectRuleAction) MergeProjectRuleActionSlackNotifyService(v ProjectRuleActionSlackNotifyService) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.slack.notify_action.SlackNotifyServiceAction"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleActionMsTeamsNotifyService returns the union data inside the ProjectRuleAction as a ProjectRuleActionMsTeamsNotifyService
+func (t ProjectRuleAction) AsProjectRuleActionMsTeamsNotifyService() (ProjectRuleActionMsTeamsNotifyService, error) {
+	var body ProjectRuleActionMsTeamsNotifyService
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionMsTeamsNotifyService overwrites any union data inside the ProjectRuleAction as the provided ProjectRuleActionMsTeamsNotifyService
+func (t *ProjectRuleAction) FromProjectRuleActionMsTeamsNotifyService(v ProjectRuleActionMsTeamsNotifyService) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.msteams.notify_action.MsTeamsNotifyServiceAction"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionMsTeamsNotifyService performs a merge with any union data inside the ProjectRuleAction, using the provided ProjectRuleActionMsTeamsNotifyService
+func (t *ProjectRuleAction) MergeProjectRuleActionMsTeamsNotifyService(v ProjectRuleActionMsTeamsNotifyService) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.msteams.notify_action.MsTeamsNotifyServiceAction"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleActionDiscordNotifyService returns the union data inside the ProjectRuleAction as a ProjectRuleActionDiscordNotifyService
+func (t ProjectRuleAction) AsProjectRuleActionDiscordNotifyService() (ProjectRuleActionDiscordNotifyService, error) {
+	var body ProjectRuleActionDiscordNotifyService
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionDiscordNotifyService overwrites any union data inside the ProjectRuleAction as the provided ProjectRuleActionDiscordNotifyService
+func (t *ProjectRuleAction) FromProjectRuleActionDiscordNotifyService(v ProjectRuleActionDiscordNotifyService) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.discord.notify_action.DiscordNotifyServiceAction"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionDiscordNotifyService performs a merge with any union data inside the ProjectRuleAction, using the provided ProjectRuleActionDiscordNotifyService
+func (t *ProjectRuleAction) MergeProjectRuleActionDiscordNotifyService(v ProjectRuleActionDiscordNotifyService) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.discord.notify_action.DiscordNotifyServiceAction"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleActionJiraCreateTicket returns the union data inside the ProjectRuleAction as a ProjectRuleActionJiraCreateTicket
+func (t ProjectRuleAction) AsProjectRuleActionJiraCreateTicket() (ProjectRuleActionJiraCreateTicket, error) {
+	var body ProjectRuleActionJiraCreateTicket
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionJiraCreateTicket overwrites any union data inside the ProjectRuleAction as the provided ProjectRuleActionJiraCreateTicket
+func (t *ProjectRuleAction) FromProjectRuleActionJiraCreateTicket(v ProjectRuleActionJiraCreateTicket) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.jira.notify_action.JiraCreateTicketAction"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionJiraCreateTicket performs a merge with any union data inside the ProjectRuleAction, using the provided ProjectRuleActionJiraCreateTicket
+func (t *ProjectRuleAction) MergeProjectRuleActionJiraCreateTicket(v ProjectRuleActionJiraCreateTicket) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.jira.notify_action.JiraCreateTicketAction"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleActionJiraServerCreateTicket returns the union data inside the ProjectRuleAction as a ProjectRuleActionJiraServerCreateTicket
+func (t ProjectRuleAction) AsProjectRuleActionJiraServerCreateTicket() (ProjectRuleActionJiraServerCreateTicket, error) {
+	var body ProjectRuleActionJiraServerCreateTicket
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionJiraServerCreateTicket overwrites any union data inside the ProjectRuleAction as the provided ProjectRuleActionJiraServerCreateTicket
+func (t *ProjectRuleAction) FromProjectRuleActionJiraServerCreateTicket(v ProjectRuleActionJiraServerCreateTicket) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.jira_server.notify_action.JiraServerCreateTicketAction"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionJiraServerCreateTicket performs a merge with any union data inside the ProjectRuleAction, using the provided ProjectRuleActionJiraServerCreateTicket
+func (t *ProjectRuleAction) MergeProjectRuleActionJiraServerCreateTicket(v ProjectRuleActionJiraServerCreateTicket) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.jira_server.notify_action.JiraServerCreateTicketAction"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleActionGitHubCreateTicket returns the union data inside the ProjectRuleAction as a ProjectRuleActionGitHubCreateTicket
+func (t ProjectRuleAction) AsProjectRuleActionGitHubCreateTicket() (ProjectRuleActionGitHubCreateTicket, error) {
+	var body ProjectRuleActionGitHubCreateTicket
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionGitHubCreateTicket overwrites any union data inside the ProjectRuleAction as the provided ProjectRuleActionGitHubCreateTicket
+func (t *ProjectRuleAction) FromProjectRuleActionGitHubCreateTicket(v ProjectRuleActionGitHubCreateTicket) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.github.notify_action.GitHubCreateTicketAction"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionGitHubCreateTicket performs a merge with any union data inside the ProjectRuleAction, using the provided ProjectRuleActionGitHubCreateTicket
+func (t *ProjectRuleAction) MergeProjectRuleActionGitHubCreateTicket(v ProjectRuleActionGitHubCreateTicket) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.github.notify_action.GitHubCreateTicketAction"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleActionGitHubEnterpriseCreateTicket returns the union data inside the ProjectRuleAction as a ProjectRuleActionGitHubEnterpriseCreateTicket
+func (t ProjectRuleAction) AsProjectRuleActionGitHubEnterpriseCreateTicket() (ProjectRuleActionGitHubEnterpriseCreateTicket, error) {
+	var body ProjectRuleActionGitHubEnterpriseCreateTicket
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionGitHubEnterpriseCreateTicket overwrites any union data inside the ProjectRuleAction as the provided ProjectRuleActionGitHubEnterpriseCreateTicket
+func (t *ProjectRuleAction) FromProjectRuleActionGitHubEnterpriseCreateTicket(v ProjectRuleActionGitHubEnterpriseCreateTicket) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.github_enterprise.notify_action.GitHubEnterpriseCreateTicketAction"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionGitHubEnterpriseCreateTicket performs a merge with any union data inside the ProjectRuleAction, using the provided ProjectRuleActionGitHubEnterpriseCreateTicket
+func (t *ProjectRuleAction) MergeProjectRuleActionGitHubEnterpriseCreateTicket(v ProjectRuleActionGitHubEnterpriseCreateTicket) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.github_enterprise.notify_action.GitHubEnterpriseCreateTicketAction"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleActionAzureDevopsCreateTicket returns the union data inside the ProjectRuleAction as a ProjectRuleActionAzureDevopsCreateTicket
+func (t ProjectRuleAction) AsProjectRuleActionAzureDevopsCreateTicket() (ProjectRuleActionAzureDevopsCreateTicket, error) {
+	var body ProjectRuleActionAzureDevopsCreateTicket
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionAzureDevopsCreateTicket overwrites any union data inside the ProjectRuleAction as the provided ProjectRuleActionAzureDevopsCreateTicket
+func (t *ProjectRuleAction) FromProjectRuleActionAzureDevopsCreateTicket(v ProjectRuleActionAzureDevopsCreateTicket) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.vsts.notify_action.AzureDevopsCreateTicketAction"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionAzureDevopsCreateTicket performs a merge with any union data inside the ProjectRuleAction, using the provided ProjectRuleActionAzureDevopsCreateTicket
+func (t *ProjectRuleAction) MergeProjectRuleActionAzureDevopsCreateTicket(v ProjectRuleActionAzureDevopsCreateTicket) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.integrations.vsts.notify_action.AzureDevopsCreateTicketAction"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectRuleAction) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"id"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t ProjectRuleAction) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "sentry.integrations.discord.notify_action.DiscordNotifyServiceAction":
+		return t.AsProjectRuleActionDiscordNotifyService()
+	case "sentry.integrations.github.notify_action.GitHubCreateTicketAction":
+		return t.AsProjectRuleActionGitHubCreateTicket()
+	case "sentry.integrations.github_enterprise.notify_action.GitHubEnterpriseCreateTicketAction":
+		return t.AsProjectRuleActionGitHubEnterpriseCreateTicket()
+	case "sentry.integrations.jira.notify_action.JiraCreateTicketAction":
+		return t.AsProjectRuleActionJiraCreateTicket()
+	case "sentry.integrations.jira_server.notify_action.JiraServerCreateTicketAction":
+		return t.AsProjectRuleActionJiraServerCreateTicket()
+	case "sentry.integrations.msteams.notify_action.MsTeamsNotifyServiceAction":
+		return t.AsProjectRuleActionMsTeamsNotifyService()
+	case "sentry.integrations.opsgenie.notify_action.OpsgenieNotifyTeamAction":
+		return t.AsProjectRuleActionOpsgenieNotifyTeam()
+	case "sentry.integrations.pagerduty.notify_action.PagerDutyNotifyServiceAction":
+		return t.AsProjectRuleActionPagerDutyNotifyService()
+	case "sentry.integrations.slack.notify_action.SlackNotifyServiceAction":
+		return t.AsProjectRuleActionSlackNotifyService()
+	case "sentry.integrations.vsts.notify_action.AzureDevopsCreateTicketAction":
+		return t.AsProjectRuleActionAzureDevopsCreateTicket()
+	case "sentry.mail.actions.NotifyEmailAction":
+		return t.AsProjectRuleActionNotifyEmail()
+	case "sentry.rules.actions.notify_event.NotifyEventAction":
+		return t.AsProjectRuleActionNotifyEvent()
+	case "sentry.rules.actions.notify_event_sentry_app.NotifyEventSentryAppAction":
+		return t.AsProjectRuleActionNotifyEventSentryApp()
+	case "sentry.rules.actions.notify_event_service.NotifyEventServiceAction":
+		return t.AsProjectRuleActionNotifyEventService()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t ProjectRuleAction) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectRuleAction) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectRuleActionNotifyEmailTargetIdentifier0 returns the union data inside the ProjectRuleActionNotifyEmail_TargetIdentifier as a ProjectRuleActionNotifyEmailTargetIdentifier0
+func (t ProjectRuleActionNotifyEmail_TargetIdentifier) AsProjectRuleActionNotifyEmailTargetIdentifier0() (ProjectRuleActionNotifyEmailTargetIdentifier0, error) {
+	var body ProjectRuleActionNotifyEmailTargetIdentifier0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionNotifyEmailTargetIdentifier0 overwrites any union data inside the ProjectRuleActionNotifyEmail_TargetIdentifier as the provided ProjectRuleActionNotifyEmailTargetIdentifier0
+func (t *ProjectRuleActionNotifyEmail_TargetIdentifier) FromProjectRuleActionNotifyEmailTargetIdentifier0(v ProjectRuleActionNotifyEmailTargetIdentifier0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionNotifyEmailTargetIdentifier0 performs a merge with any union data inside the ProjectRuleActionNotifyEmail_TargetIdentifier, using the provided ProjectRuleActionNotifyEmailTargetIdentifier0
+func (t *ProjectRuleActionNotifyEmail_TargetIdentifier) MergeProjectRuleActionNotifyEmailTargetIdentifier0(v ProjectRuleActionNotifyEmailTargetIdentifier0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleActionNotifyEmailTargetIdentifier1 returns the union data inside the ProjectRuleActionNotifyEmail_TargetIdentifier as a ProjectRuleActionNotifyEmailTargetIdentifier1
+func (t ProjectRuleActionNotifyEmail_TargetIdentifier) AsProjectRuleActionNotifyEmailTargetIdentifier1() (ProjectRuleActionNotifyEmailTargetIdentifier1, error) {
+	var body ProjectRuleActionNotifyEmailTargetIdentifier1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleActionNotifyEmailTargetIdentifier1 overwrites any union data inside the ProjectRuleActionNotifyEmail_TargetIdentifier as the provided ProjectRuleActionNotifyEmailTargetIdentifier1
+func (t *ProjectRuleActionNotifyEmail_TargetIdentifier) FromProjectRuleActionNotifyEmailTargetIdentifier1(v ProjectRuleActionNotifyEmailTargetIdentifier1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleActionNotifyEmailTargetIdentifier1 performs a merge with any union data inside the ProjectRuleActionNotifyEmail_TargetIdentifier, using the provided ProjectRuleActionNotifyEmailTargetIdentifier1
+func (t *ProjectRuleActionNotifyEmail_TargetIdentifier) MergeProjectRuleActionNotifyEmailTargetIdentifier1(v ProjectRuleActionNotifyEmailTargetIdentifier1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectRuleActionNotifyEmail_TargetIdentifier) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectRuleActionNotifyEmail_TargetIdentifier) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectRuleConditionFirstSeenEvent returns the union data inside the ProjectRuleCondition as a ProjectRuleConditionFirstSeenEvent
+func (t ProjectRuleCondition) AsProjectRuleConditionFirstSeenEvent() (ProjectRuleConditionFirstSeenEvent, error) {
+	var body ProjectRuleConditionFirstSeenEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleConditionFirstSeenEvent overwrites any union data inside the ProjectRuleCondition as the provided ProjectRuleConditionFirstSeenEvent
+func (t *ProjectRuleCondition) FromProjectRuleConditionFirstSeenEvent(v ProjectRuleConditionFirstSeenEvent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleConditionFirstSeenEvent performs a merge with any union data inside the ProjectRuleCondition, using the provided ProjectRuleConditionFirstSeenEvent
+func (t *ProjectRuleCondition) MergeProjectRuleConditionFirstSeenEvent(v ProjectRuleConditionFirstSeenEvent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleConditionRegressionEvent returns the union data inside the ProjectRuleCondition as a ProjectRuleConditionRegressionEvent
+func (t ProjectRuleCondition) AsProjectRuleConditionRegressionEvent() (ProjectRuleConditionRegressionEvent, error) {
+	var body ProjectRuleConditionRegressionEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleConditionRegressionEvent overwrites any union data inside the ProjectRuleCondition as the provided ProjectRuleConditionRegressionEvent
+func (t *ProjectRuleCondition) FromProjectRuleConditionRegressionEvent(v ProjectRuleConditionRegressionEvent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.regression_event.RegressionEventCondition"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleConditionRegressionEvent performs a merge with any union data inside the ProjectRuleCondition, using the provided ProjectRuleConditionRegressionEvent
+func (t *ProjectRuleCondition) MergeProjectRuleConditionRegressionEvent(v ProjectRuleConditionRegressionEvent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.regression_event.RegressionEventCondition"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleConditionReappearedEvent returns the union data inside the ProjectRuleCondition as a ProjectRuleConditionReappearedEvent
+func (t ProjectRuleCondition) AsProjectRuleConditionReappearedEvent() (ProjectRuleConditionReappearedEvent, error) {
+	var body ProjectRuleConditionReappearedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleConditionReappearedEvent overwrites any union data inside the ProjectRuleCondition as the provided ProjectRuleConditionReappearedEvent
+func (t *ProjectRuleCondition) FromProjectRuleConditionReappearedEvent(v ProjectRuleConditionReappearedEvent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.reappeared_event.ReappearedEventCondition"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleConditionReappearedEvent performs a merge with any union data inside the ProjectRuleCondition, using the provided ProjectRuleConditionReappearedEvent
+func (t *ProjectRuleCondition) MergeProjectRuleConditionReappearedEvent(v ProjectRuleConditionReappearedEvent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.reappeared_event.ReappearedEventCondition"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleConditionNewHighPriorityIssue returns the union data inside the ProjectRuleCondition as a ProjectRuleConditionNewHighPriorityIssue
+func (t ProjectRuleCondition) AsProjectRuleConditionNewHighPriorityIssue() (ProjectRuleConditionNewHighPriorityIssue, error) {
+	var body ProjectRuleConditionNewHighPriorityIssue
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleConditionNewHighPriorityIssue overwrites any union data inside the ProjectRuleCondition as the provided ProjectRuleConditionNewHighPriorityIssue
+func (t *ProjectRuleCondition) FromProjectRuleConditionNewHighPriorityIssue(v ProjectRuleConditionNewHighPriorityIssue) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.high_priority_issue.NewHighPriorityIssueCondition"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleConditionNewHighPriorityIssue performs a merge with any union data inside the ProjectRuleCondition, using the provided ProjectRuleConditionNewHighPriorityIssue
+func (t *ProjectRuleCondition) MergeProjectRuleConditionNewHighPriorityIssue(v ProjectRuleConditionNewHighPriorityIssue) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.high_priority_issue.NewHighPriorityIssueCondition"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleConditionExistingHighPriorityIssue returns the union data inside the ProjectRuleCondition as a ProjectRuleConditionExistingHighPriorityIssue
+func (t ProjectRuleCondition) AsProjectRuleConditionExistingHighPriorityIssue() (ProjectRuleConditionExistingHighPriorityIssue, error) {
+	var body ProjectRuleConditionExistingHighPriorityIssue
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleConditionExistingHighPriorityIssue overwrites any union data inside the ProjectRuleCondition as the provided ProjectRuleConditionExistingHighPriorityIssue
+func (t *ProjectRuleCondition) FromProjectRuleConditionExistingHighPriorityIssue(v ProjectRuleConditionExistingHighPriorityIssue) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.high_priority_issue.ExistingHighPriorityIssueCondition"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleConditionExistingHighPriorityIssue performs a merge with any union data inside the ProjectRuleCondition, using the provided ProjectRuleConditionExistingHighPriorityIssue
+func (t *ProjectRuleCondition) MergeProjectRuleConditionExistingHighPriorityIssue(v ProjectRuleConditionExistingHighPriorityIssue) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.high_priority_issue.ExistingHighPriorityIssueCondition"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleConditionEventFrequency returns the union data inside the ProjectRuleCondition as a ProjectRuleConditionEventFrequency
+func (t ProjectRuleCondition) AsProjectRuleConditionEventFrequency() (ProjectRuleConditionEventFrequency, error) {
+	var body ProjectRuleConditionEventFrequency
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleConditionEventFrequency overwrites any union data inside the ProjectRuleCondition as the provided ProjectRuleConditionEventFrequency
+func (t *ProjectRuleCondition) FromProjectRuleConditionEventFrequency(v ProjectRuleConditionEventFrequency) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.event_frequency.EventFrequencyCondition"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleConditionEventFrequency performs a merge with any union data inside the ProjectRuleCondition, using the provided ProjectRuleConditionEventFrequency
+func (t *ProjectRuleCondition) MergeProjectRuleConditionEventFrequency(v ProjectRuleConditionEventFrequency) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.event_frequency.EventFrequencyCondition"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleConditionEventUniqueUserFrequency returns the union data inside the ProjectRuleCondition as a ProjectRuleConditionEventUniqueUserFrequency
+func (t ProjectRuleCondition) AsProjectRuleConditionEventUniqueUserFrequency() (ProjectRuleConditionEventUniqueUserFrequency, error) {
+	var body ProjectRuleConditionEventUniqueUserFrequency
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleConditionEventUniqueUserFrequency overwrites any union data inside the ProjectRuleCondition as the provided ProjectRuleConditionEventUniqueUserFrequency
+func (t *ProjectRuleCondition) FromProjectRuleConditionEventUniqueUserFrequency(v ProjectRuleConditionEventUniqueUserFrequency) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.event_frequency.EventUniqueUserFrequencyCondition"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleConditionEventUniqueUserFrequency performs a merge with any union data inside the ProjectRuleCondition, using the provided ProjectRuleConditionEventUniqueUserFrequency
+func (t *ProjectRuleCondition) MergeProjectRuleConditionEventUniqueUserFrequency(v ProjectRuleConditionEventUniqueUserFrequency) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.event_frequency.EventUniqueUserFrequencyCondition"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleConditionEventFrequencyPercent returns the union data inside the ProjectRuleCondition as a ProjectRuleConditionEventFrequencyPercent
+func (t ProjectRuleCondition) AsProjectRuleConditionEventFrequencyPercent() (ProjectRuleConditionEventFrequencyPercent, error) {
+	var body ProjectRuleConditionEventFrequencyPercent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleConditionEventFrequencyPercent overwrites any union data inside the ProjectRuleCondition as the provided ProjectRuleConditionEventFrequencyPercent
+func (t *ProjectRuleCondition) FromProjectRuleConditionEventFrequencyPercent(v ProjectRuleConditionEventFrequencyPercent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.event_frequency.EventFrequencyPercentCondition"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleConditionEventFrequencyPercent performs a merge with any union data inside the ProjectRuleCondition, using the provided ProjectRuleConditionEventFrequencyPercent
+func (t *ProjectRuleCondition) MergeProjectRuleConditionEventFrequencyPercent(v ProjectRuleConditionEventFrequencyPercent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.conditions.event_frequency.EventFrequencyPercentCondition"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectRuleCondition) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"id"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t ProjectRuleCondition) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "sentry.rules.conditions.event_frequency.EventFrequencyCondition":
+		return t.AsProjectRuleConditionEventFrequency()
+	case "sentry.rules.conditions.event_frequency.EventFrequencyPercentCondition":
+		return t.AsProjectRuleConditionEventFrequencyPercent()
+	case "sentry.rules.conditions.event_frequency.EventUniqueUserFrequencyCondition":
+		return t.AsProjectRuleConditionEventUniqueUserFrequency()
+	case "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition":
+		return t.AsProjectRuleConditionFirstSeenEvent()
+	case "sentry.rules.conditions.high_priority_issue.ExistingHighPriorityIssueCondition":
+		return t.AsProjectRuleConditionExistingHighPriorityIssue()
+	case "sentry.rules.conditions.high_priority_issue.NewHighPriorityIssueCondition":
+		return t.AsProjectRuleConditionNewHighPriorityIssue()
+	case "sentry.rules.conditions.reappeared_event.ReappearedEventCondition":
+		return t.AsProjectRuleConditionReappearedEvent()
+	case "sentry.rules.conditions.regression_event.RegressionEventCondition":
+		return t.AsProjectRuleConditionRegressionEvent()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t ProjectRuleCondition) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectRuleCondition) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectRuleFilterAgeComparison returns the union data inside the ProjectRuleFilter as a ProjectRuleFilterAgeComparison
+func (t ProjectRuleFilter) AsProjectRuleFilterAgeComparison() (ProjectRuleFilterAgeComparison, error) {
+	var body ProjectRuleFilterAgeComparison
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleFilterAgeComparison overwrites any union data inside the ProjectRuleFilter as the provided ProjectRuleFilterAgeComparison
+func (t *ProjectRuleFilter) FromProjectRuleFilterAgeComparison(v ProjectRuleFilterAgeComparison) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.age_comparison.AgeComparisonFilter"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleFilterAgeComparison performs a merge with any union data inside the ProjectRuleFilter, using the provided ProjectRuleFilterAgeComparison
+func (t *ProjectRuleFilter) MergeProjectRuleFilterAgeComparison(v ProjectRuleFilterAgeComparison) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.age_comparison.AgeComparisonFilter"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleFilterIssueOccurrences returns the union data inside the ProjectRuleFilter as a ProjectRuleFilterIssueOccurrences
+func (t ProjectRuleFilter) AsProjectRuleFilterIssueOccurrences() (ProjectRuleFilterIssueOccurrences, error) {
+	var body ProjectRuleFilterIssueOccurrences
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleFilterIssueOccurrences overwrites any union data inside the ProjectRuleFilter as the provided ProjectRuleFilterIssueOccurrences
+func (t *ProjectRuleFilter) FromProjectRuleFilterIssueOccurrences(v ProjectRuleFilterIssueOccurrences) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleFilterIssueOccurrences performs a merge with any union data inside the ProjectRuleFilter, using the provided ProjectRuleFilterIssueOccurrences
+func (t *ProjectRuleFilter) MergeProjectRuleFilterIssueOccurrences(v ProjectRuleFilterIssueOccurrences) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleFilterAssignedTo returns the union data inside the ProjectRuleFilter as a ProjectRuleFilterAssignedTo
+func (t ProjectRuleFilter) AsProjectRuleFilterAssignedTo() (ProjectRuleFilterAssignedTo, error) {
+	var body ProjectRuleFilterAssignedTo
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleFilterAssignedTo overwrites any union data inside the ProjectRuleFilter as the provided ProjectRuleFilterAssignedTo
+func (t *ProjectRuleFilter) FromProjectRuleFilterAssignedTo(v ProjectRuleFilterAssignedTo) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.assigned_to.AssignedToFilter"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleFilterAssignedTo performs a merge with any union data inside the ProjectRuleFilter, using the provided ProjectRuleFilterAssignedTo
+func (t *ProjectRuleFilter) MergeProjectRuleFilterAssignedTo(v ProjectRuleFilterAssignedTo) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.assigned_to.AssignedToFilter"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleFilterLatestAdoptedRelease returns the union data inside the ProjectRuleFilter as a ProjectRuleFilterLatestAdoptedRelease
+func (t ProjectRuleFilter) AsProjectRuleFilterLatestAdoptedRelease() (ProjectRuleFilterLatestAdoptedRelease, error) {
+	var body ProjectRuleFilterLatestAdoptedRelease
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleFilterLatestAdoptedRelease overwrites any union data inside the ProjectRuleFilter as the provided ProjectRuleFilterLatestAdoptedRelease
+func (t *ProjectRuleFilter) FromProjectRuleFilterLatestAdoptedRelease(v ProjectRuleFilterLatestAdoptedRelease) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.latest_adopted_release_filter.LatestAdoptedReleaseFilter"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleFilterLatestAdoptedRelease performs a merge with any union data inside the ProjectRuleFilter, using the provided ProjectRuleFilterLatestAdoptedRelease
+func (t *ProjectRuleFilter) MergeProjectRuleFilterLatestAdoptedRelease(v ProjectRuleFilterLatestAdoptedRelease) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.latest_adopted_release_filter.LatestAdoptedReleaseFilter"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleFilterLatestRelease returns the union data inside the ProjectRuleFilter as a ProjectRuleFilterLatestRelease
+func (t ProjectRuleFilter) AsProjectRuleFilterLatestRelease() (ProjectRuleFilterLatestRelease, error) {
+	var body ProjectRuleFilterLatestRelease
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleFilterLatestRelease overwrites any union data inside the ProjectRuleFilter as the provided ProjectRuleFilterLatestRelease
+func (t *ProjectRuleFilter) FromProjectRuleFilterLatestRelease(v ProjectRuleFilterLatestRelease) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.latest_release.LatestReleaseFilter"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleFilterLatestRelease performs a merge with any union data inside the ProjectRuleFilter, using the provided ProjectRuleFilterLatestRelease
+func (t *ProjectRuleFilter) MergeProjectRuleFilterLatestRelease(v ProjectRuleFilterLatestRelease) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.latest_release.LatestReleaseFilter"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleFilterIssueCategory returns the union data inside the ProjectRuleFilter as a ProjectRuleFilterIssueCategory
+func (t ProjectRuleFilter) AsProjectRuleFilterIssueCategory() (ProjectRuleFilterIssueCategory, error) {
+	var body ProjectRuleFilterIssueCategory
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleFilterIssueCategory overwrites any union data inside the ProjectRuleFilter as the provided ProjectRuleFilterIssueCategory
+func (t *ProjectRuleFilter) FromProjectRuleFilterIssueCategory(v ProjectRuleFilterIssueCategory) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.issue_category.IssueCategoryFilter"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleFilterIssueCategory performs a merge with any union data inside the ProjectRuleFilter, using the provided ProjectRuleFilterIssueCategory
+func (t *ProjectRuleFilter) MergeProjectRuleFilterIssueCategory(v ProjectRuleFilterIssueCategory) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.issue_category.IssueCategoryFilter"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleFilterEventAttribute returns the union data inside the ProjectRuleFilter as a ProjectRuleFilterEventAttribute
+func (t ProjectRuleFilter) AsProjectRuleFilterEventAttribute() (ProjectRuleFilterEventAttribute, error) {
+	var body ProjectRuleFilterEventAttribute
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleFilterEventAttribute overwrites any union data inside the ProjectRuleFilter as the provided ProjectRuleFilterEventAttribute
+func (t *ProjectRuleFilter) FromProjectRuleFilterEventAttribute(v ProjectRuleFilterEventAttribute) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.event_attribute.EventAttributeFilter"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleFilterEventAttribute performs a merge with any union data inside the ProjectRuleFilter, using the provided ProjectRuleFilterEventAttribute
+func (t *ProjectRuleFilter) MergeProjectRuleFilterEventAttribute(v ProjectRuleFilterEventAttribute) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.event_attribute.EventAttributeFilter"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleFilterTaggedEvent returns the union data inside the ProjectRuleFilter as a ProjectRuleFilterTaggedEvent
+func (t ProjectRuleFilter) AsProjectRuleFilterTaggedEvent() (ProjectRuleFilterTaggedEvent, error) {
+	var body ProjectRuleFilterTaggedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleFilterTaggedEvent overwrites any union data inside the ProjectRuleFilter as the provided ProjectRuleFilterTaggedEvent
+func (t *ProjectRuleFilter) FromProjectRuleFilterTaggedEvent(v ProjectRuleFilterTaggedEvent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.tagged_event.TaggedEventFilter"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleFilterTaggedEvent performs a merge with any union data inside the ProjectRuleFilter, using the provided ProjectRuleFilterTaggedEvent
+func (t *ProjectRuleFilter) MergeProjectRuleFilterTaggedEvent(v ProjectRuleFilterTaggedEvent) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.tagged_event.TaggedEventFilter"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleFilterLevel returns the union data inside the ProjectRuleFilter as a ProjectRuleFilterLevel
+func (t ProjectRuleFilter) AsProjectRuleFilterLevel() (ProjectRuleFilterLevel, error) {
+	var body ProjectRuleFilterLevel
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleFilterLevel overwrites any union data inside the ProjectRuleFilter as the provided ProjectRuleFilterLevel
+func (t *ProjectRuleFilter) FromProjectRuleFilterLevel(v ProjectRuleFilterLevel) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.level.LevelFilter"}`))
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleFilterLevel performs a merge with any union data inside the ProjectRuleFilter, using the provided ProjectRuleFilterLevel
+func (t *ProjectRuleFilter) MergeProjectRuleFilterLevel(v ProjectRuleFilterLevel) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err = runtime.JSONMerge(b, []byte(`{"id":"sentry.rules.filters.level.LevelFilter"}`))
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectRuleFilter) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"id"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t ProjectRuleFilter) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "sentry.rules.filters.age_comparison.AgeComparisonFilter":
+		return t.AsProjectRuleFilterAgeComparison()
+	case "sentry.rules.filters.assigned_to.AssignedToFilter":
+		return t.AsProjectRuleFilterAssignedTo()
+	case "sentry.rules.filters.event_attribute.EventAttributeFilter":
+		return t.AsProjectRuleFilterEventAttribute()
+	case "sentry.rules.filters.issue_category.IssueCategoryFilter":
+		return t.AsProjectRuleFilterIssueCategory()
+	case "sentry.rules.filters.issue_occurrences.IssueOccurrencesFilter":
+		return t.AsProjectRuleFilterIssueOccurrences()
+	case "sentry.rules.filters.latest_adopted_release_filter.LatestAdoptedReleaseFilter":
+		return t.AsProjectRuleFilterLatestAdoptedRelease()
+	case "sentry.rules.filters.latest_release.LatestReleaseFilter":
+		return t.AsProjectRuleFilterLatestRelease()
+	case "sentry.rules.filters.level.LevelFilter":
+		return t.AsProjectRuleFilterLevel()
+	case "sentry.rules.filters.tagged_event.TaggedEventFilter":
+		return t.AsProjectRuleFilterTaggedEvent()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t ProjectRuleFilter) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectRuleFilter) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectRuleFilterAssignedToTargetIdentifier0 returns the union data inside the ProjectRuleFilterAssignedTo_TargetIdentifier as a ProjectRuleFilterAssignedToTargetIdentifier0
+func (t ProjectRuleFilterAssignedTo_TargetIdentifier) AsProjectRuleFilterAssignedToTargetIdentifier0() (ProjectRuleFilterAssignedToTargetIdentifier0, error) {
+	var body ProjectRuleFilterAssignedToTargetIdentifier0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleFilterAssignedToTargetIdentifier0 overwrites any union data inside the ProjectRuleFilterAssignedTo_TargetIdentifier as the provided ProjectRuleFilterAssignedToTargetIdentifier0
+func (t *ProjectRuleFilterAssignedTo_TargetIdentifier) FromProjectRuleFilterAssignedToTargetIdentifier0(v ProjectRuleFilterAssignedToTargetIdentifier0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleFilterAssignedToTargetIdentifier0 performs a merge with any union data inside the ProjectRuleFilterAssignedTo_TargetIdentifier, using the provided ProjectRuleFilterAssignedToTargetIdentifier0
+func (t *ProjectRuleFilterAssignedTo_TargetIdentifier) MergeProjectRuleFilterAssignedToTargetIdentifier0(v ProjectRuleFilterAssignedToTargetIdentifier0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleFilterAssignedToTargetIdentifier1 returns the union data inside the ProjectRuleFilterAssignedTo_TargetIdentifier as a ProjectRuleFilterAssignedToTargetIdentifier1
+func (t ProjectRuleFilterAssignedTo_TargetIdentifier) AsProjectRuleFilterAssignedToTargetIdentifier1() (ProjectRuleFilterAssignedToTargetIdentifier1, error) {
+	var body ProjectRuleFilterAssignedToTargetIdentifier1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleFilterAssignedToTargetIdentifier1 overwrites any union data inside the ProjectRuleFilterAssignedTo_TargetIdentifier as the provided ProjectRuleFilterAssignedToTargetIdentifier1
+func (t *ProjectRuleFilterAssignedTo_TargetIdentifier) FromProjectRuleFilterAssignedToTargetIdentifier1(v ProjectRuleFilterAssignedToTargetIdentifier1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleFilterAssignedToTargetIdentifier1 performs a merge with any union data inside the ProjectRuleFilterAssignedTo_TargetIdentifier, using the provided ProjectRuleFilterAssignedToTargetIdentifier1
+func (t *ProjectRuleFilterAssignedTo_TargetIdentifier) MergeProjectRuleFilterAssignedToTargetIdentifier1(v ProjectRuleFilterAssignedToTargetIdentifier1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectRuleFilterAssignedTo_TargetIdentifier) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectRuleFilterAssignedTo_TargetIdentifier) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectRuleFilterEventAttributeValue0 returns the union data inside the ProjectRuleFilterEventAttribute_Value as a ProjectRuleFilterEventAttributeValue0
+func (t ProjectRuleFilterEventAttribute_Value) AsProjectRuleFilterEventAttributeValue0() (ProjectRuleFilterEventAttributeValue0, error) {
+	var body ProjectRuleFilterEventAttributeValue0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleFilterEventAttributeValue0 overwrites any union data inside the ProjectRuleFilterEventAttribute_Value as the provided ProjectRuleFilterEventAttributeValue0
+func (t *ProjectRuleFilterEventAttribute_Value) FromProjectRuleFilterEventAttributeValue0(v ProjectRuleFilterEventAttributeValue0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleFilterEventAttributeValue0 performs a merge with any union data inside the ProjectRuleFilterEventAttribute_Value, using the provided ProjectRuleFilterEventAttributeValue0
+func (t *ProjectRuleFilterEventAttribute_Value) MergeProjectRuleFilterEventAttributeValue0(v ProjectRuleFilterEventAttributeValue0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectRuleFilterEventAttributeValue1 returns the union data inside the ProjectRuleFilterEventAttribute_Value as a ProjectRuleFilterEventAttributeValue1
+func (t ProjectRuleFilterEventAttribute_Value) AsProjectRuleFilterEventAttributeValue1() (ProjectRuleFilterEventAttributeValue1, error) {
+	var body ProjectRuleFilterEventAttributeValue1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectRuleFilterEventAttributeValue1 overwrites any union data inside the ProjectRuleFilterEventAttribute_Value as the provided ProjectRuleFilterEventAttributeValue1
+func (t *ProjectRuleFilterEventAttribute_Value) FromProjectRuleFilterEventAttributeValue1(v ProjectRuleFilterEventAttributeValue1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectRuleFilterEventAttributeValue1 performs a merge with any union data inside the ProjectRuleFilterEventAttribute_Value, using the provided ProjectRuleFilterEventAttributeValue1
+func (t *ProjectRuleFilterEventAttribute_Value) MergeProjectRuleFilterEventAttributeValue1(v ProjectRuleFilterEventAttributeValue1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectRuleFilterEventAttribute_Value) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectRuleFilterEventAttribute_Value) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsOrganizationIntegrationOpsgenieConfigData returns the union data inside the UpdateOrganizationIntegrationJSONBody as a OrganizationIntegrationOpsgenieConfigData
+func (t UpdateOrganizationIntegrationJSONBody) AsOrganizationIntegrationOpsgenieConfigData() (OrganizationIntegrationOpsgenieConfigData, error) {
+	var body OrganizationIntegrationOpsgenieConfigData
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationIntegrationOpsgenieConfigData overwrites any union data inside the UpdateOrganizationIntegrationJSONBody as the provided OrganizationIntegrationOpsgenieConfigData
+func (t *UpdateOrganizationIntegrationJSONBody) FromOrganizationIntegrationOpsgenieConfigData(v OrganizationIntegrationOpsgenieConfigData) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationIntegrationOpsgenieConfigData performs a merge with any union data inside the UpdateOrganizationIntegrationJSONBody, using the provided OrganizationIntegrationOpsgenieConfigData
+func (t *UpdateOrganizationIntegrationJSONBody) MergeOrganizationIntegrationOpsgenieConfigData(v OrganizationIntegrationOpsgenieConfigData) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationIntegrationPagerDutyConfigData returns the union data inside the UpdateOrganizationIntegrationJSONBody as a OrganizationIntegrationPagerDutyConfigData
+func (t UpdateOrganizationIntegrationJSONBody) AsOrganizationIntegrationPagerDutyConfigData() (OrganizationIntegrationPagerDutyConfigData, error) {
+	var body OrganizationIntegrationPagerDutyConfigData
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationIntegrationPagerDutyConfigData overwrites any union data inside the UpdateOrganizationIntegrationJSONBody as the provided OrganizationIntegrationPagerDutyConfigData
+func (t *UpdateOrganizationIntegrationJSONBody) FromOrganizationIntegrationPagerDutyConfigData(v OrganizationIntegrationPagerDutyConfigData) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationIntegrationPagerDutyConfigData performs a merge with any union data inside the UpdateOrganizationIntegrationJSONBody, using the provided OrganizationIntegrationPagerDutyConfigData
+func (t *UpdateOrganizationIntegrationJSONBody) MergeOrganizationIntegrationPagerDutyConfigData(v OrganizationIntegrationPagerDutyConfigData) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t UpdateOrganizationIntegrationJSONBody) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *UpdateOrganizationIntegrationJSONBody) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// HealthCheck Health Check
+	// (GET /0/internal/health/)
+	HealthCheck(w http.ResponseWriter, r *http.Request)
+	// CreateOrganization Create a New Organization
+	// (POST /0/organizations/)
+	CreateOrganization(w http.ResponseWriter, r *http.Request)
+	// DeleteOrganization Delete an Organization
+	// (DELETE /0/organizations/{organization_id_or_slug}/)
+	DeleteOrganization(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug)
+	// GetOrganization Retrieve an Organization
+	// (GET /0/organizations/{organization_id_or_slug}/)
+	GetOrganization(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug)
+	// UpdateOrganization Update an Organization
+	// (PUT /0/organizations/{organization_id_or_slug}/)
+	UpdateOrganization(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug)
+	// GetOrganizationAlertRuleDetector Retrieve the Monitor Created for a Metric Alert Rule
+	// (GET /0/organizations/{organization_id_or_slug}/alert-rule-detector/)
+	GetOrganizationAlertRuleDetector(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params GetOrganizationAlertRuleDetectorParams)
+	// GetOrganizationAlertRuleWorkflow Retrieve the Alert Created for an Issue or Metric Alert Rule
+	// (GET /0/organizations/{organization_id_or_slug}/alert-rule-workflow/)
+	GetOrganizationAlertRuleWorkflow(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params GetOrganizationAlertRuleWorkflowParams)
+	// ListOrganizationDashboards List an Organization's Custom Dashboards
+	// (GET /0/organizations/{organization_id_or_slug}/dashboards/)
+	ListOrganizationDashboards(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationDashboardsParams)
+	// CreateOrganizationDashboard Create a New Dashboard for an Organization
+	// (POST /0/organizations/{organization_id_or_slug}/dashboards/)
+	CreateOrganizationDashboard(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug)
+	// DeleteOrganizationDashboard Delete an Organization's Custom Dashboard
+	// (DELETE /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/)
+	DeleteOrganizationDashboard(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId)
+	// GetOrganizationDashboard Retrieve an Organization's Custom Dashboard
+	// (GET /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/)
+	GetOrganizationDashboard(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId)
+	// UpdateOrganizationDashboard Edit an Organization's Custom Dashboard
+	// (PUT /0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/)
+	UpdateOrganizationDashboard(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId)
+	// ListOrganizationMonitors List Monitors for an Organization
+	// (GET /0/organizations/{organization_id_or_slug}/detectors/)
+	ListOrganizationMonitors(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationMonitorsParams)
+	// DeleteProjectMonitor Delete a Monitor
+	// (DELETE /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/)
+	DeleteProjectMonitor(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId)
+	// GetProjectMonitor Retrieve a Monitor
+	// (GET /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/)
+	GetProjectMonitor(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId)
+	// UpdateProjectMonitor Update a Monitor
+	// (PUT /0/organizations/{organization_id_or_slug}/detectors/{detector_id}/)
+	UpdateProjectMonitor(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId)
+	// CreateOrganizationExternalUser Create an External User
+	// (POST /0/organizations/{organization_id_or_slug}/external-users/)
+	CreateOrganizationExternalUser(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug)
+	// DeleteOrganizationExternalUser Delete an External User
+	// (DELETE /0/organizations/{organization_id_or_slug}/external-users/{external_user_id}/)
+	DeleteOrganizationExternalUser(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string)
+	// UpdateOrganizationExternalUser Update an External User
+	// (PUT /0/organizations/{organization_id_or_slug}/external-users/{external_user_id}/)
+	UpdateOrganizationExternalUser(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string)
+	// ListOrganizationIntegrations List Organization Integrations
+	// (GET /0/organizations/{organization_id_or_slug}/integrations/)
+	ListOrganizationIntegrations(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationIntegrationsParams)
+	// GetOrganizationIntegration Retrieve an Organization Integration
+	// (GET /0/organizations/{organization_id_or_slug}/integrations/{integration_id}/)
+	GetOrganizationIntegration(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId)
+	// UpdateOrganizationIntegration Update an Organization Integration
+	// (POST /0/organizations/{organization_id_or_slug}/integrations/{integration_id}/)
+	UpdateOrganizationIntegration(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId)
+	// ListOrganizationMembers List Organization Members
+	// (GET /0/organizations/{organization_id_or_slug}/members/)
+	ListOrganizationMembers(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationMembersParams)
+	// CreateOrganizationMember Create an Organization Member
+	// (POST /0/organizations/{organization_id_or_slug}/members/)
+	CreateOrganizationMember(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug)
+	// DeleteOrganizationMember Delete an Organization Member
+	// (DELETE /0/organizations/{organization_id_or_slug}/members/{member_id}/)
+	DeleteOrganizationMember(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId)
+	// GetOrganizationMember Retrieve an Organization Member
+	// (GET /0/organizations/{organization_id_or_slug}/members/{member_id}/)
+	GetOrganizationMember(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId)
+	// UpdateOrganizationMember Update an Organization Member
+	// (PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/)
+	UpdateOrganizationMember(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId)
+	// RemoveOrganizationMemberFromTeam Delete an Organization Member from a Team
+	// (DELETE /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/)
+	RemoveOrganizationMemberFromTeam(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug)
+	// AddOrganizationMemberToTeam Add an Organization Member to a Team
+	// (POST /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/)
+	AddOrganizationMemberToTeam(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug)
+	// UpdateOrganizationMemberTeamRole Update an Organization Member's Team Role
+	// (PUT /0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/)
+	UpdateOrganizationMemberTeamRole(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug)
+	// CreateOrganizationNotificationAction Create a Spike Protection Notification Action
+	// (POST /0/organizations/{organization_id_or_slug}/notifications/actions/)
+	CreateOrganizationNotificationAction(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug)
+	// DeleteOrganizationNotificationAction Delete a Spike Protection Notification Action
+	// (DELETE /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/)
+	DeleteOrganizationNotificationAction(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, actionId string)
+	// GetOrganizationNotificationAction Retrieve a Spike Protection Notification Action
+	// (GET /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/)
+	GetOrganizationNotificationAction(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, actionId string)
+	// UpdateOrganizationNotificationAction Update a Spike Protection Notification Action
+	// (PUT /0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/)
+	UpdateOrganizationNotificationAction(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, actionId string)
+	// ListOrganizationProjects List Organization Projects
+	// (GET /0/organizations/{organization_id_or_slug}/projects/)
+	ListOrganizationProjects(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationProjectsParams)
+	// CreateProjectMonitor Create a Monitor for a Project
+	// (POST /0/organizations/{organization_id_or_slug}/projects/{project_id_or_slug}/detectors/)
+	CreateProjectMonitor(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug)
+	// ListOrganizationRepositories List an Organization's Repositories
+	// (GET /0/organizations/{organization_id_or_slug}/repos/)
+	ListOrganizationRepositories(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationRepositoriesParams)
+	// CreateOrganizationRepository Add a Repository to an Organization
+	// (POST /0/organizations/{organization_id_or_slug}/repos/)
+	CreateOrganizationRepository(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug)
+	// DeleteOrganizationRepository Delete a Repository
+	// (DELETE /0/organizations/{organization_id_or_slug}/repos/{repo_id}/)
+	DeleteOrganizationRepository(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, repoId string)
+	// ListSentryAppInstallations List Sentry App Installations
+	// (GET /0/organizations/{organization_id_or_slug}/sentry-app-installations/)
+	ListSentryAppInstallations(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListSentryAppInstallationsParams)
+	// DisableSpikeProtection Disable Spike Protection
+	// (DELETE /0/organizations/{organization_id_or_slug}/spike-protections/)
+	DisableSpikeProtection(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug)
+	// EnableSpikeProtection Enable Spike Protection
+	// (POST /0/organizations/{organization_id_or_slug}/spike-protections/)
+	EnableSpikeProtection(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug)
+	// ListOrganizationTeams List Teams
+	// (GET /0/organizations/{organization_id_or_slug}/teams/)
+	ListOrganizationTeams(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationTeamsParams)
+	// CreateOrganizationTeam Create a New Team
+	// (POST /0/organizations/{organization_id_or_slug}/teams/)
+	CreateOrganizationTeam(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug)
+	// ListOrganizationWorkflows List Organization Alerts
+	// (GET /0/organizations/{organization_id_or_slug}/workflows/)
+	ListOrganizationWorkflows(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationWorkflowsParams)
+	// CreateOrganizationWorkflow Create an Alert for an Organization
+	// (POST /0/organizations/{organization_id_or_slug}/workflows/)
+	CreateOrganizationWorkflow(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug)
+	// DeleteOrganizationWorkflow Delete an Alert for an Organization
+	// (DELETE /0/organizations/{organization_id_or_slug}/workflows/{workflow_id}/)
+	DeleteOrganizationWorkflow(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, workflowId string)
+	// GetOrganizationWorkflow Retrieve an Alert for an Organization
+	// (GET /0/organizations/{organization_id_or_slug}/workflows/{workflow_id}/)
+	GetOrganizationWorkflow(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, workflowId string)
+	// UpdateOrganizationWorkflow Update an Alert for an Organization
+	// (PUT /0/organizations/{organization_id_or_slug}/workflows/{workflow_id}/)
+	UpdateOrganizationWorkflow(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, workflowId string)
+	// DeleteOrganizationProject Delete a Project
+	// (DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/)
+	DeleteOrganizationProject(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug)
+	// GetOrganizationProject Retrieve a Project
+	// (GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/)
+	GetOrganizationProject(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug)
+	// UpdateOrganizationProject Update a Project
+	// (PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/)
+	UpdateOrganizationProject(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug)
+	// ListProjectFilters List a Project's Inbound Data Filters
+	// (GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/)
+	ListProjectFilters(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug)
+	// UpdateProjectFilter Update an Inbound Data Filter
+	// (PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/{filter_id}/)
+	UpdateProjectFilter(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, filterId string)
+	// ListProjectClientKeys List Client Keys
+	// (GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/)
+	ListProjectClientKeys(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params ListProjectClientKeysParams)
+	// CreateProjectClientKey Create a Client Key
+	// (POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/)
+	CreateProjectClientKey(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug)
+	// DeleteProjectClientKey Delete a Client Key
+	// (DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{key_id}/)
+	DeleteProjectClientKey(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string)
+	// GetProjectClientKey Retrieve a Client Key
+	// (GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{key_id}/)
+	GetProjectClientKey(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string)
+	// UpdateProjectClientKey Update a Client Key
+	// (PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{key_id}/)
+	UpdateProjectClientKey(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string)
+	// GetProjectOwnership Retrieve ownership configuration for a project
+	// (GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/)
+	GetProjectOwnership(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug)
+	// UpdateProjectOwnership Update ownership configuration for a project
+	// (PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/)
+	UpdateProjectOwnership(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug)
+	// CreateProjectRule Create a Rule
+	// (POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/)
+	CreateProjectRule(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug)
+	// DeleteProjectRule Delete a Rule
+	// (DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/)
+	DeleteProjectRule(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string)
+	// GetProjectRule Retrieve a Rule
+	// (GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/)
+	GetProjectRule(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string)
+	// UpdateProjectRule Update a Rule
+	// (PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/)
+	UpdateProjectRule(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string)
+	// DeleteProjectSymbolSource Delete a Symbol Source from a Project
+	// (DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/)
+	DeleteProjectSymbolSource(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params DeleteProjectSymbolSourceParams)
+	// ListProjectSymbolSources Retrieve a Project's Symbol Sources
+	// (GET /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/)
+	ListProjectSymbolSources(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params ListProjectSymbolSourcesParams)
+	// CreateProjectSymbolSource Add a Symbol Source to a Project
+	// (POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/)
+	CreateProjectSymbolSource(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug)
+	// UpdateProjectSymbolSource Update a Project's Symbol Source
+	// (PUT /0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/)
+	UpdateProjectSymbolSource(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params UpdateProjectSymbolSourceParams)
+	// RemoveTeamFromProject Remove a Team from a Project
+	// (DELETE /0/projects/{organization_id_or_slug}/{project_id_or_slug}/teams/{team_id_or_slug}/)
+	RemoveTeamFromProject(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug)
+	// AddTeamToProject Add a Team to a Project
+	// (POST /0/projects/{organization_id_or_slug}/{project_id_or_slug}/teams/{team_id_or_slug}/)
+	AddTeamToProject(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug)
+	// DeleteOrganizationTeam Delete a Team
+	// (DELETE /0/teams/{organization_id_or_slug}/{team_id_or_slug}/)
+	DeleteOrganizationTeam(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug)
+	// GetOrganizationTeam Retrieve a Team
+	// (GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/)
+	GetOrganizationTeam(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug)
+	// ListOrganizationTeamMembers List a Team's Members
+	// (GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/members/)
+	ListOrganizationTeamMembers(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params ListOrganizationTeamMembersParams)
+	// ListTeamProjects List a Team's Projects
+	// (GET /0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/)
+	ListTeamProjects(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params ListTeamProjectsParams)
+	// CreateOrganizationTeamProject Create a Project
+	// (POST /0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/)
+	CreateOrganizationTeamProject(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// HealthCheck operation middleware
+func (siw *ServerInterfaceWrapper) HealthCheck(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HealthCheck(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganization(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOrganization operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganization(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOrganization(w, r, organizationIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganization operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganization(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganization(w, r, organizationIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganization(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganization(w, r, organizationIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationAlertRuleDetector operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationAlertRuleDetector(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationAlertRuleDetectorParams
+
+	// ------------- Optional query parameter "alert_rule_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "alert_rule_id", r.URL.Query(), &params.AlertRuleId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "alert_rule_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "alert_rule_id", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationAlertRuleDetector(w, r, organizationIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationAlertRuleWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationAlertRuleWorkflow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationAlertRuleWorkflowParams
+
+	// ------------- Optional query parameter "rule_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "rule_id", r.URL.Query(), &params.RuleId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "rule_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rule_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "alert_rule_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "alert_rule_id", r.URL.Query(), &params.AlertRuleId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "alert_rule_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "alert_rule_id", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationAlertRuleWorkflow(w, r, organizationIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationDashboards operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationDashboards(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrganizationDashboardsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationDashboards(w, r, organizationIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganizationDashboard operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganizationDashboard(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganizationDashboard(w, r, organizationIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOrganizationDashboard operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganizationDashboard(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "dashboard_id" -------------
+	var dashboardId DashboardId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "dashboard_id", r.PathValue("dashboard_id"), &dashboardId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dashboard_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOrganizationDashboard(w, r, organizationIdOrSlug, dashboardId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationDashboard operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationDashboard(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "dashboard_id" -------------
+	var dashboardId DashboardId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "dashboard_id", r.PathValue("dashboard_id"), &dashboardId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dashboard_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationDashboard(w, r, organizationIdOrSlug, dashboardId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganizationDashboard operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationDashboard(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "dashboard_id" -------------
+	var dashboardId DashboardId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "dashboard_id", r.PathValue("dashboard_id"), &dashboardId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dashboard_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationDashboard(w, r, organizationIdOrSlug, dashboardId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationMonitors operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationMonitors(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrganizationMonitorsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "project" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "project", r.URL.Query(), &params.Project, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "query", r.URL.Query(), &params.Query, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "query"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationMonitors(w, r, organizationIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProjectMonitor operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProjectMonitor(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "detector_id" -------------
+	var detectorId DetectorId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "detector_id", r.PathValue("detector_id"), &detectorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "detector_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProjectMonitor(w, r, organizationIdOrSlug, detectorId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectMonitor operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectMonitor(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "detector_id" -------------
+	var detectorId DetectorId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "detector_id", r.PathValue("detector_id"), &detectorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "detector_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectMonitor(w, r, organizationIdOrSlug, detectorId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProjectMonitor operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectMonitor(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "detector_id" -------------
+	var detectorId DetectorId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "detector_id", r.PathValue("detector_id"), &detectorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "detector_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProjectMonitor(w, r, organizationIdOrSlug, detectorId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganizationExternalUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganizationExternalUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganizationExternalUser(w, r, organizationIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOrganizationExternalUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganizationExternalUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "external_user_id" -------------
+	var externalUserId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "external_user_id", r.PathValue("external_user_id"), &externalUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "external_user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOrganizationExternalUser(w, r, organizationIdOrSlug, externalUserId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganizationExternalUser operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationExternalUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "external_user_id" -------------
+	var externalUserId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "external_user_id", r.PathValue("external_user_id"), &externalUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "external_user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationExternalUser(w, r, organizationIdOrSlug, externalUserId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationIntegrations operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationIntegrations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrganizationIntegrationsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "provider_key" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "provider_key", r.URL.Query(), &params.ProviderKey, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "provider_key"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider_key", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationIntegrations(w, r, organizationIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationIntegration operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationIntegration(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "integration_id" -------------
+	var integrationId IntegrationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "integration_id", r.PathValue("integration_id"), &integrationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "integration_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationIntegration(w, r, organizationIdOrSlug, integrationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganizationIntegration operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationIntegration(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "integration_id" -------------
+	var integrationId IntegrationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "integration_id", r.PathValue("integration_id"), &integrationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "integration_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationIntegration(w, r, organizationIdOrSlug, integrationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrganizationMembersParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationMembers(w, r, organizationIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganizationMember operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganizationMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganizationMember(w, r, organizationIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOrganizationMember operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganizationMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "member_id" -------------
+	var memberId MemberId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "member_id", r.PathValue("member_id"), &memberId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "member_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOrganizationMember(w, r, organizationIdOrSlug, memberId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationMember operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "member_id" -------------
+	var memberId MemberId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "member_id", r.PathValue("member_id"), &memberId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "member_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationMember(w, r, organizationIdOrSlug, memberId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganizationMember operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "member_id" -------------
+	var memberId MemberId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "member_id", r.PathValue("member_id"), &memberId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "member_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationMember(w, r, organizationIdOrSlug, memberId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveOrganizationMemberFromTeam operation middleware
+func (siw *ServerInterfaceWrapper) RemoveOrganizationMemberFromTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "member_id" -------------
+	var memberId MemberId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "member_id", r.PathValue("member_id"), &memberId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "member_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "team_id_or_slug" -------------
+	var teamIdOrSlug TeamIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_id_or_slug", r.PathValue("team_id_or_slug"), &teamIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveOrganizationMemberFromTeam(w, r, organizationIdOrSlug, memberId, teamIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddOrganizationMemberToTeam operation middleware
+func (siw *ServerInterfaceWrapper) AddOrganizationMemberToTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "member_id" -------------
+	var memberId MemberId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "member_id", r.PathValue("member_id"), &memberId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "member_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "team_id_or_slug" -------------
+	var teamIdOrSlug TeamIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_id_or_slug", r.PathValue("team_id_or_slug"), &teamIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddOrganizationMemberToTeam(w, r, organizationIdOrSlug, memberId, teamIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganizationMemberTeamRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationMemberTeamRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "member_id" -------------
+	var memberId MemberId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "member_id", r.PathValue("member_id"), &memberId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "member_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "team_id_or_slug" -------------
+	var teamIdOrSlug TeamIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_id_or_slug", r.PathValue("team_id_or_slug"), &teamIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationMemberTeamRole(w, r, organizationIdOrSlug, memberId, teamIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganizationNotificationAction operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganizationNotificationAction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganizationNotificationAction(w, r, organizationIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOrganizationNotificationAction operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganizationNotificationAction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "action_id" -------------
+	var actionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "action_id", r.PathValue("action_id"), &actionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOrganizationNotificationAction(w, r, organizationIdOrSlug, actionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationNotificationAction operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationNotificationAction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "action_id" -------------
+	var actionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "action_id", r.PathValue("action_id"), &actionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationNotificationAction(w, r, organizationIdOrSlug, actionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganizationNotificationAction operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationNotificationAction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "action_id" -------------
+	var actionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "action_id", r.PathValue("action_id"), &actionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationNotificationAction(w, r, organizationIdOrSlug, actionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationProjects operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationProjects(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrganizationProjectsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "options" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "options", r.URL.Query(), &params.Options, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "options"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "options", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationProjects(w, r, organizationIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProjectMonitor operation middleware
+func (siw *ServerInterfaceWrapper) CreateProjectMonitor(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProjectMonitor(w, r, organizationIdOrSlug, projectIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationRepositories operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationRepositories(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrganizationRepositoriesParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "integration_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "integration_id", r.URL.Query(), &params.IntegrationId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "integration_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "integration_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", r.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationRepositories(w, r, organizationIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganizationRepository operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganizationRepository(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganizationRepository(w, r, organizationIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOrganizationRepository operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganizationRepository(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repo_id" -------------
+	var repoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo_id", r.PathValue("repo_id"), &repoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repo_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOrganizationRepository(w, r, organizationIdOrSlug, repoId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSentryAppInstallations operation middleware
+func (siw *ServerInterfaceWrapper) ListSentryAppInstallations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSentryAppInstallationsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSentryAppInstallations(w, r, organizationIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DisableSpikeProtection operation middleware
+func (siw *ServerInterfaceWrapper) DisableSpikeProtection(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DisableSpikeProtection(w, r, organizationIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EnableSpikeProtection operation middleware
+func (siw *ServerInterfaceWrapper) EnableSpikeProtection(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EnableSpikeProtection(w, r, organizationIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationTeams operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationTeams(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrganizationTeamsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationTeams(w, r, organizationIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganizationTeam operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganizationTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganizationTeam(w, r, organizationIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationWorkflows operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationWorkflows(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrganizationWorkflowsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationWorkflows(w, r, organizationIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganizationWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganizationWorkflow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganizationWorkflow(w, r, organizationIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOrganizationWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganizationWorkflow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "workflow_id" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflow_id", r.PathValue("workflow_id"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflow_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOrganizationWorkflow(w, r, organizationIdOrSlug, workflowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationWorkflow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "workflow_id" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflow_id", r.PathValue("workflow_id"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflow_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationWorkflow(w, r, organizationIdOrSlug, workflowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganizationWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationWorkflow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "workflow_id" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workflow_id", r.PathValue("workflow_id"), &workflowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflow_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationWorkflow(w, r, organizationIdOrSlug, workflowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOrganizationProject operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganizationProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOrganizationProject(w, r, organizationIdOrSlug, projectIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationProject operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationProject(w, r, organizationIdOrSlug, projectIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganizationProject operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationProject(w, r, organizationIdOrSlug, projectIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProjectFilters operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectFilters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjectFilters(w, r, organizationIdOrSlug, projectIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProjectFilter operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectFilter(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "filter_id" -------------
+	var filterId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "filter_id", r.PathValue("filter_id"), &filterId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProjectFilter(w, r, organizationIdOrSlug, projectIdOrSlug, filterId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProjectClientKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectClientKeys(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectClientKeysParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", r.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjectClientKeys(w, r, organizationIdOrSlug, projectIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProjectClientKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateProjectClientKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProjectClientKey(w, r, organizationIdOrSlug, projectIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProjectClientKey operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProjectClientKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "key_id" -------------
+	var keyId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key_id", r.PathValue("key_id"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProjectClientKey(w, r, organizationIdOrSlug, projectIdOrSlug, keyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectClientKey operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectClientKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "key_id" -------------
+	var keyId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key_id", r.PathValue("key_id"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectClientKey(w, r, organizationIdOrSlug, projectIdOrSlug, keyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProjectClientKey operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectClientKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "key_id" -------------
+	var keyId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key_id", r.PathValue("key_id"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProjectClientKey(w, r, organizationIdOrSlug, projectIdOrSlug, keyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectOwnership operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectOwnership(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectOwnership(w, r, organizationIdOrSlug, projectIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProjectOwnership operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectOwnership(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProjectOwnership(w, r, organizationIdOrSlug, projectIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProjectRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateProjectRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProjectRule(w, r, organizationIdOrSlug, projectIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProjectRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProjectRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "rule_id" -------------
+	var ruleId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rule_id", r.PathValue("rule_id"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rule_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProjectRule(w, r, organizationIdOrSlug, projectIdOrSlug, ruleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectRule operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "rule_id" -------------
+	var ruleId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rule_id", r.PathValue("rule_id"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rule_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectRule(w, r, organizationIdOrSlug, projectIdOrSlug, ruleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProjectRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "rule_id" -------------
+	var ruleId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rule_id", r.PathValue("rule_id"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rule_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProjectRule(w, r, organizationIdOrSlug, projectIdOrSlug, ruleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProjectSymbolSource operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProjectSymbolSource(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteProjectSymbolSourceParams
+
+	// ------------- Required query parameter "id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "id", r.URL.Query(), &params.Id, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProjectSymbolSource(w, r, organizationIdOrSlug, projectIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProjectSymbolSources operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectSymbolSources(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectSymbolSourcesParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "id", r.URL.Query(), &params.Id, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjectSymbolSources(w, r, organizationIdOrSlug, projectIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProjectSymbolSource operation middleware
+func (siw *ServerInterfaceWrapper) CreateProjectSymbolSource(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProjectSymbolSource(w, r, organizationIdOrSlug, projectIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProjectSymbolSource operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectSymbolSource(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateProjectSymbolSourceParams
+
+	// ------------- Required query parameter "id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "id", r.URL.Query(), &params.Id, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProjectSymbolSource(w, r, organizationIdOrSlug, projectIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveTeamFromProject operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTeamFromProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "team_id_or_slug" -------------
+	var teamIdOrSlug TeamIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_id_or_slug", r.PathValue("team_id_or_slug"), &teamIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveTeamFromProject(w, r, organizationIdOrSlug, projectIdOrSlug, teamIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddTeamToProject operation middleware
+func (siw *ServerInterfaceWrapper) AddTeamToProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "project_id_or_slug" -------------
+	var projectIdOrSlug ProjectIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id_or_slug", r.PathValue("project_id_or_slug"), &projectIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "team_id_or_slug" -------------
+	var teamIdOrSlug TeamIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_id_or_slug", r.PathValue("team_id_or_slug"), &teamIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTeamToProject(w, r, organizationIdOrSlug, projectIdOrSlug, teamIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOrganizationTeam operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganizationTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "team_id_or_slug" -------------
+	var teamIdOrSlug TeamIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_id_or_slug", r.PathValue("team_id_or_slug"), &teamIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOrganizationTeam(w, r, organizationIdOrSlug, teamIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationTeam operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "team_id_or_slug" -------------
+	var teamIdOrSlug TeamIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_id_or_slug", r.PathValue("team_id_or_slug"), &teamIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationTeam(w, r, organizationIdOrSlug, teamIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationTeamMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationTeamMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "team_id_or_slug" -------------
+	var teamIdOrSlug TeamIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_id_or_slug", r.PathValue("team_id_or_slug"), &teamIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrganizationTeamMembersParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationTeamMembers(w, r, organizationIdOrSlug, teamIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTeamProjects operation middleware
+func (siw *ServerInterfaceWrapper) ListTeamProjects(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "team_id_or_slug" -------------
+	var teamIdOrSlug TeamIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_id_or_slug", r.PathValue("team_id_or_slug"), &teamIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_id_or_slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTeamProjectsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTeamProjects(w, r, organizationIdOrSlug, teamIdOrSlug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganizationTeamProject operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganizationTeamProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organization_id_or_slug" -------------
+	var organizationIdOrSlug OrganizationIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organization_id_or_slug", r.PathValue("organization_id_or_slug"), &organizationIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id_or_slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "team_id_or_slug" -------------
+	var teamIdOrSlug TeamIdOrSlug
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_id_or_slug", r.PathValue("team_id_or_slug"), &teamIdOrSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_id_or_slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganizationTeamProject(w, r, organizationIdOrSlug, teamIdOrSlug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of [http.ServeMux].
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	http.Handler
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/internal/health/{$}", wrapper.HealthCheck)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/organizations/{$}", wrapper.CreateOrganization)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/{$}", wrapper.DeleteOrganization)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/{$}", wrapper.GetOrganization)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/{$}", wrapper.UpdateOrganization)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/dashboards/{$}", wrapper.ListOrganizationDashboards)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/dashboards/{$}", wrapper.CreateOrganizationDashboard)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/{$}", wrapper.DeleteOrganizationDashboard)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/{$}", wrapper.GetOrganizationDashboard)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/dashboards/{dashboard_id}/{$}", wrapper.UpdateOrganizationDashboard)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/external-users/{$}", wrapper.CreateOrganizationExternalUser)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/external-users/{external_user_id}/{$}", wrapper.DeleteOrganizationExternalUser)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/external-users/{external_user_id}/{$}", wrapper.UpdateOrganizationExternalUser)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/teams/{$}", wrapper.ListOrganizationTeams)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/teams/{$}", wrapper.CreateOrganizationTeam)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/integrations/{$}", wrapper.ListOrganizationIntegrations)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/integrations/{integration_id}/{$}", wrapper.GetOrganizationIntegration)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/integrations/{integration_id}/{$}", wrapper.UpdateOrganizationIntegration)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/repos/{$}", wrapper.ListOrganizationRepositories)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/repos/{$}", wrapper.CreateOrganizationRepository)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/repos/{repo_id}/{$}", wrapper.DeleteOrganizationRepository)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/members/{$}", wrapper.ListOrganizationMembers)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/members/{$}", wrapper.CreateOrganizationMember)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/members/{member_id}/{$}", wrapper.DeleteOrganizationMember)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/members/{member_id}/{$}", wrapper.GetOrganizationMember)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/members/{member_id}/{$}", wrapper.UpdateOrganizationMember)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/{$}", wrapper.RemoveOrganizationMemberFromTeam)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/{$}", wrapper.AddOrganizationMemberToTeam)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/members/{member_id}/teams/{team_id_or_slug}/{$}", wrapper.UpdateOrganizationMemberTeamRole)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/projects/{$}", wrapper.ListOrganizationProjects)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/sentry-app-installations/{$}", wrapper.ListSentryAppInstallations)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/notifications/actions/{$}", wrapper.CreateOrganizationNotificationAction)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/{$}", wrapper.DeleteOrganizationNotificationAction)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/{$}", wrapper.GetOrganizationNotificationAction)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/notifications/actions/{action_id}/{$}", wrapper.UpdateOrganizationNotificationAction)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/spike-protections/{$}", wrapper.DisableSpikeProtection)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/spike-protections/{$}", wrapper.EnableSpikeProtection)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/workflows/{$}", wrapper.ListOrganizationWorkflows)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/workflows/{$}", wrapper.CreateOrganizationWorkflow)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/workflows/{workflow_id}/{$}", wrapper.DeleteOrganizationWorkflow)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/workflows/{workflow_id}/{$}", wrapper.GetOrganizationWorkflow)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/workflows/{workflow_id}/{$}", wrapper.UpdateOrganizationWorkflow)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/alert-rule-workflow/{$}", wrapper.GetOrganizationAlertRuleWorkflow)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/alert-rule-detector/{$}", wrapper.GetOrganizationAlertRuleDetector)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/detectors/{$}", wrapper.ListOrganizationMonitors)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/projects/{project_id_or_slug}/detectors/{$}", wrapper.CreateProjectMonitor)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/detectors/{detector_id}/{$}", wrapper.DeleteProjectMonitor)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/detectors/{detector_id}/{$}", wrapper.GetProjectMonitor)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/0/organizations/{organization_id_or_slug}/detectors/{detector_id}/{$}", wrapper.UpdateProjectMonitor)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/teams/{organization_id_or_slug}/{team_id_or_slug}/{$}", wrapper.DeleteOrganizationTeam)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/teams/{organization_id_or_slug}/{team_id_or_slug}/{$}", wrapper.GetOrganizationTeam)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/teams/{organization_id_or_slug}/{team_id_or_slug}/members/{$}", wrapper.ListOrganizationTeamMembers)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/{$}", wrapper.ListTeamProjects)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/teams/{organization_id_or_slug}/{team_id_or_slug}/projects/{$}", wrapper.CreateOrganizationTeamProject)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/{$}", wrapper.DeleteOrganizationProject)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/{$}", wrapper.GetOrganizationProject)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/{$}", wrapper.UpdateOrganizationProject)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/teams/{team_id_or_slug}/{$}", wrapper.RemoveTeamFromProject)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/teams/{team_id_or_slug}/{$}", wrapper.AddTeamToProject)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{$}", wrapper.ListProjectClientKeys)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{$}", wrapper.CreateProjectClientKey)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{key_id}/{$}", wrapper.DeleteProjectClientKey)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{key_id}/{$}", wrapper.GetProjectClientKey)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/keys/{key_id}/{$}", wrapper.UpdateProjectClientKey)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{$}", wrapper.CreateProjectRule)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/{$}", wrapper.DeleteProjectRule)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/{$}", wrapper.GetProjectRule)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/rules/{rule_id}/{$}", wrapper.UpdateProjectRule)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/{$}", wrapper.GetProjectOwnership)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/ownership/{$}", wrapper.UpdateProjectOwnership)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/{$}", wrapper.ListProjectFilters)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/filters/{filter_id}/{$}", wrapper.UpdateProjectFilter)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/{$}", wrapper.DeleteProjectSymbolSource)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/{$}", wrapper.ListProjectSymbolSources)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/{$}", wrapper.CreateProjectSymbolSource)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/0/projects/{organization_id_or_slug}/{project_id_or_slug}/symbol-sources/{$}", wrapper.UpdateProjectSymbolSource)
+
+	return m
+}
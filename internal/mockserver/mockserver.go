@@ -0,0 +1,122 @@
+// Package mockserver implements an in-memory fake of the Sentry API for
+// running acceptance tests without a live Sentry organization.
+//
+// Routing and parameter parsing are generated from the OpenAPI document in
+// internal/apiclient/api.yaml. Organizations, teams, projects, client keys,
+// monitors (detectors) and alerts (workflows) are stateful; the remaining
+// operations respond with 501 Not Implemented.
+package mockserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+)
+
+// DefaultOrganization is the slug of the organization created by NewServer
+// when Config.Organization is empty.
+const DefaultOrganization = "terraform-provider-sentry"
+
+// Config configures a mock Sentry server.
+type Config struct {
+	// Organization is the slug of the organization that exists from the
+	// start.
+	Organization string
+	// Token is the bearer token that requests must present. Any token is
+	// accepted when empty.
+	Token string
+	// PageSize is the maximum number of results of a list response. Defaults
+	// to 100.
+	PageSize int
+	// RateLimit is the number of requests allowed per RateLimitWindow before
+	// responding with 429 Too Many Requests. Requests are not limited when
+	// zero, but rate-limit headers are still sent.
+	RateLimit int
+	// RateLimitWindow defaults to one second.
+	RateLimitWindow time.Duration
+}
+
+// Server is a mock Sentry server. It serves the API under /api/.
+type Server struct {
+	config  Config
+	handler http.Handler
+
+	mu            sync.Mutex
+	nextID        int
+	organizations collection
+	orgState      map[string]*organizationState
+
+	rateMu          sync.Mutex
+	rateWindowStart time.Time
+	rateCount       int
+}
+
+var _ ServerInterface = (*Server)(nil)
+
+// NewServer returns a mock Sentry server containing the configured
+// organization.
+func NewServer(config Config) *Server {
+	if config.Organization == "" {
+		config.Organization = DefaultOrganization
+	}
+	if config.PageSize <= 0 {
+		config.PageSize = 100
+	}
+	if config.RateLimitWindow <= 0 {
+		config.RateLimitWindow = time.Second
+	}
+
+	s := &Server{
+		config:   config,
+		orgState: make(map[string]*organizationState),
+	}
+	s.createOrganization(config.Organization, config.Organization)
+
+	s.handler = HandlerWithOptions(s, StdHTTPServerOptions{
+		BaseURL: "/api",
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			writeDetail(w, http.StatusBadRequest, err.Error())
+		},
+	})
+
+	return s
+}
+
+// NewTestServer starts a mock Sentry server. The API base URL is the URL of
+// the returned server followed by /api/.
+func NewTestServer(config Config) (*httptest.Server, *Server) {
+	s := NewServer(config)
+	return httptest.NewServer(s), s
+}
+
+// Organization returns the slug of the organization that exists from the
+// start.
+func (s *Server) Organization() string {
+	return s.config.Organization
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		writeDetail(w, http.StatusUnauthorized, "Invalid token")
+		return
+	}
+	if !s.allow(w) {
+		writeDetail(w, http.StatusTooManyRequests, "You are attempting to use this endpoint too frequently.")
+		return
+	}
+
+	s.handler.ServeHTTP(w, r)
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	if s.config.Token == "" {
+		return true
+	}
+	return r.Header.Get("Authorization") == "Bearer "+s.config.Token
+}
+
+// HealthCheck implements ServerInterface.
+func (s *Server) HealthCheck(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, object{"healthy": true})
+}
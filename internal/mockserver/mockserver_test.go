@@ -0,0 +1,273 @@
+package mockserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jianyuan/go-sentry/v2/sentry"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+)
+
+func newTestClient(t *testing.T, config Config) (*apiclient.ClientWithResponses, *Server) {
+	t.Helper()
+
+	ts, s := NewTestServer(config)
+	t.Cleanup(ts.Close)
+
+	client, err := apiclient.NewClientWithResponses(ts.URL+"/api", apiclient.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer token")
+		return nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, s
+}
+
+func TestServer_projects(t *testing.T) {
+	ctx := context.Background()
+	client, s := newTestClient(t, Config{})
+	org := s.Organization()
+
+	teamResp, err := client.CreateOrganizationTeamWithResponse(ctx, org, apiclient.CreateOrganizationTeamJSONRequestBody{Name: "My Team"})
+	if err != nil {
+		t.Fatal(err)
+	} else if teamResp.JSON201 == nil {
+		t.Fatalf("create team: status %d: %s", teamResp.StatusCode(), teamResp.Body)
+	}
+	if got := teamResp.JSON201.Slug; got != "my-team" {
+		t.Errorf("team slug = %q, want %q", got, "my-team")
+	}
+
+	projectResp, err := client.CreateOrganizationTeamProjectWithResponse(ctx, org, "my-team", apiclient.CreateOrganizationTeamProjectJSONRequestBody{Name: "My Project", Platform: "go"})
+	if err != nil {
+		t.Fatal(err)
+	} else if projectResp.JSON201 == nil {
+		t.Fatalf("create project: status %d: %s", projectResp.StatusCode(), projectResp.Body)
+	}
+	project := *projectResp.JSON201
+	if project.Slug != "my-project" || project.Organization.Slug != org || len(project.Teams) != 1 || project.Teams[0].Slug != "my-team" {
+		t.Errorf("unexpected project %+v", project)
+	}
+
+	conflictResp, err := client.CreateOrganizationTeamProjectWithResponse(ctx, org, "my-team", apiclient.CreateOrganizationTeamProjectJSONRequestBody{Name: "My Project", Platform: "go"})
+	if err != nil {
+		t.Fatal(err)
+	} else if conflictResp.StatusCode() != http.StatusConflict {
+		t.Errorf("duplicate project: status %d, want %d", conflictResp.StatusCode(), http.StatusConflict)
+	}
+
+	teamProjectsResp, err := client.ListTeamProjectsWithResponse(ctx, org, "my-team", nil)
+	if err != nil {
+		t.Fatal(err)
+	} else if teamProjectsResp.JSON200 == nil || len(*teamProjectsResp.JSON200) != 1 {
+		t.Fatalf("list team projects: status %d: %s", teamProjectsResp.StatusCode(), teamProjectsResp.Body)
+	}
+	if got := (*teamProjectsResp.JSON200)[0].Slug; got != project.Slug {
+		t.Errorf("team project slug = %q, want %q", got, project.Slug)
+	}
+
+	keysResp, err := client.ListProjectClientKeysWithResponse(ctx, org, project.Slug, nil)
+	if err != nil {
+		t.Fatal(err)
+	} else if keysResp.JSON200 == nil || len(*keysResp.JSON200) != 1 {
+		t.Fatalf("list keys: status %d: %s", keysResp.StatusCode(), keysResp.Body)
+	}
+	if key := (*keysResp.JSON200)[0]; key.Name != "Default" || !strings.Contains(key.Dsn["public"], key.Public) {
+		t.Errorf("unexpected default key %+v", key)
+	}
+
+	monitorsResp, err := client.ListOrganizationMonitorsWithResponse(ctx, org, &apiclient.ListOrganizationMonitorsParams{
+		Project: &project.Id,
+		Query:   new("type:error"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	} else if monitorsResp.JSON200 == nil || len(*monitorsResp.JSON200) != 1 {
+		t.Fatalf("list monitors: status %d: %s", monitorsResp.StatusCode(), monitorsResp.Body)
+	}
+
+	updateResp, err := client.UpdateOrganizationProjectWithResponse(ctx, org, project.Slug, apiclient.UpdateOrganizationProjectJSONRequestBody{Name: new("Renamed")})
+	if err != nil {
+		t.Fatal(err)
+	} else if updateResp.JSON200 == nil || updateResp.JSON200.Name != "Renamed" {
+		t.Fatalf("update project: status %d: %s", updateResp.StatusCode(), updateResp.Body)
+	}
+
+	deleteResp, err := client.DeleteOrganizationProjectWithResponse(ctx, org, project.Id)
+	if err != nil {
+		t.Fatal(err)
+	} else if deleteResp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("delete project: status %d: %s", deleteResp.StatusCode(), deleteResp.Body)
+	}
+
+	getResp, err := client.GetOrganizationProjectWithResponse(ctx, org, project.Id)
+	if err != nil {
+		t.Fatal(err)
+	} else if getResp.StatusCode() != http.StatusNotFound {
+		t.Errorf("get deleted project: status %d, want %d", getResp.StatusCode(), http.StatusNotFound)
+	}
+}
+
+func TestServer_pagination(t *testing.T) {
+	ctx := context.Background()
+	client, s := newTestClient(t, Config{PageSize: 2})
+
+	for i := range 5 {
+		resp, err := client.CreateOrganizationTeamWithResponse(ctx, s.Organization(), apiclient.CreateOrganizationTeamJSONRequestBody{Name: fmt.Sprintf("team-%d", i)})
+		if err != nil {
+			t.Fatal(err)
+		} else if resp.StatusCode() != http.StatusCreated {
+			t.Fatalf("create team: status %d: %s", resp.StatusCode(), resp.Body)
+		}
+	}
+
+	var slugs []string
+	params := &apiclient.ListOrganizationTeamsParams{}
+	for pages := 1; ; pages++ {
+		resp, err := client.ListOrganizationTeamsWithResponse(ctx, s.Organization(), params)
+		if err != nil {
+			t.Fatal(err)
+		} else if resp.JSON200 == nil {
+			t.Fatalf("list teams: status %d: %s", resp.StatusCode(), resp.Body)
+		}
+		for _, team := range *resp.JSON200 {
+			slugs = append(slugs, team.Slug)
+		}
+
+		params.Cursor = sentryclient.ParseNextPaginationCursor(resp.HTTPResponse)
+		if params.Cursor == nil {
+			if pages != 3 {
+				t.Errorf("pages = %d, want 3", pages)
+			}
+			break
+		}
+	}
+
+	if got, want := strings.Join(slugs, ","), "team-0,team-1,team-2,team-3,team-4"; got != want {
+		t.Errorf("teams = %s, want %s", got, want)
+	}
+}
+
+func TestServer_rateLimit(t *testing.T) {
+	ctx := context.Background()
+	client, s := newTestClient(t, Config{RateLimit: 2, RateLimitWindow: time.Minute})
+
+	for i, want := range []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+		resp, err := client.GetOrganizationWithResponse(ctx, s.Organization())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode() != want {
+			t.Errorf("request %d: status %d, want %d", i, resp.StatusCode(), want)
+		}
+
+		rate := sentry.ParseRate(resp.HTTPResponse)
+		if rate.Limit != 2 || rate.Remaining != max(1-i, 0) || rate.Reset.Before(time.Now()) {
+			t.Errorf("request %d: unexpected rate %+v", i, rate)
+		}
+	}
+}
+
+func TestServer_authentication(t *testing.T) {
+	ctx := context.Background()
+
+	client, s := newTestClient(t, Config{Token: "token"})
+	resp, err := client.GetOrganizationWithResponse(ctx, s.Organization())
+	if err != nil {
+		t.Fatal(err)
+	} else if resp.StatusCode() != http.StatusOK {
+		t.Errorf("status %d, want %d", resp.StatusCode(), http.StatusOK)
+	}
+
+	client, s = newTestClient(t, Config{Token: "other"})
+	resp, err = client.GetOrganizationWithResponse(ctx, s.Organization())
+	if err != nil {
+		t.Fatal(err)
+	} else if resp.StatusCode() != http.StatusUnauthorized {
+		t.Errorf("status %d, want %d", resp.StatusCode(), http.StatusUnauthorized)
+	}
+}
+
+func TestServer_monitorsAndAlerts(t *testing.T) {
+	ctx := context.Background()
+	client, s := newTestClient(t, Config{})
+	org := s.Organization()
+
+	if _, err := client.CreateOrganizationTeamWithResponse(ctx, org, apiclient.CreateOrganizationTeamJSONRequestBody{Name: "team"}); err != nil {
+		t.Fatal(err)
+	}
+	projectResp, err := client.CreateOrganizationTeamProjectWithResponse(ctx, org, "team", apiclient.CreateOrganizationTeamProjectJSONRequestBody{Name: "project", Platform: "go"})
+	if err != nil {
+		t.Fatal(err)
+	} else if projectResp.JSON201 == nil {
+		t.Fatalf("create project: status %d: %s", projectResp.StatusCode(), projectResp.Body)
+	}
+	projectID := projectResp.JSON201.Id
+
+	monitorResp, err := client.CreateProjectMonitorWithBodyWithResponse(ctx, org, projectID, "application/json", strings.NewReader(`{
+		"type": "metric_issue",
+		"name": "Latency",
+		"projectId": "`+projectID+`",
+		"description": null,
+		"owner": "team:`+projectResp.JSON201.Teams[0].Id+`",
+		"dataSources": [{"aggregate": "count()", "dataset": "events", "environment": null, "eventTypes": ["error"], "query": "", "timeWindow": 60, "extrapolationMode": null}],
+		"conditionGroup": {"logicType": "any", "conditions": [{"type": "gt", "comparison": 10, "conditionResult": 75}]}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	} else if monitorResp.JSON201 == nil {
+		t.Fatalf("create monitor: status %d: %s", monitorResp.StatusCode(), monitorResp.Body)
+	}
+	monitor := *monitorResp.JSON201
+	if !monitor.Enabled || monitor.ProjectId != projectID || len(monitor.DataSources) != 1 || len(monitor.ConditionGroup.Conditions) != 1 {
+		t.Errorf("unexpected monitor %+v", monitor)
+	}
+	if dataSource, err := monitor.DataSources[0].AsProjectMonitorDataSourceWrapperSnubaQuerySubscription(); err != nil {
+		t.Error(err)
+	} else if dataSource.QueryObj.SnubaQuery.Aggregate != "count()" {
+		t.Errorf("unexpected data source %+v", dataSource)
+	}
+	if owner, err := monitor.Owner.Get(); err != nil {
+		t.Error(err)
+	} else if typ, err := owner.Discriminator(); err != nil || typ != "team" {
+		t.Errorf("owner type = %q, want %q", typ, "team")
+	}
+
+	alertResp, err := client.CreateOrganizationWorkflowWithBodyWithResponse(ctx, org, "application/json", strings.NewReader(`{
+		"name": "Alert",
+		"enabled": true,
+		"config": {"frequency": 30},
+		"detectorIds": ["`+monitor.Id+`"],
+		"triggers": {"logicType": "any-short", "conditions": [{"type": "first_seen_event", "comparison": true, "conditionResult": true}]},
+		"actionFilters": [{"logicType": "any", "conditions": [], "actions": [{"type": "email", "integrationId": null, "data": {}, "config": {"targetType": "issue_owners", "targetIdentifier": null, "targetDisplay": null}, "status": "active"}]}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	} else if alertResp.JSON201 == nil {
+		t.Fatalf("create alert: status %d: %s", alertResp.StatusCode(), alertResp.Body)
+	}
+	if triggers, err := alertResp.JSON201.Triggers.AsOrganizationWorkflowTrigger(); err != nil {
+		t.Error(err)
+	} else if len(triggers.Conditions) != 1 || triggers.Conditions[0].Id == nil {
+		t.Errorf("unexpected triggers %+v", triggers)
+	}
+
+	if resp, err := client.DeleteProjectMonitorWithResponse(ctx, org, monitor.Id); err != nil {
+		t.Fatal(err)
+	} else if resp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("delete monitor: status %d: %s", resp.StatusCode(), resp.Body)
+	}
+
+	getResp, err := client.GetOrganizationWorkflowWithResponse(ctx, org, alertResp.JSON201.Id)
+	if err != nil {
+		t.Fatal(err)
+	} else if getResp.JSON200 == nil || len(getResp.JSON200.DetectorIds) != 0 {
+		t.Errorf("get alert: status %d: %s", getResp.StatusCode(), getResp.Body)
+	}
+}
@@ -0,0 +1,156 @@
+package mockserver
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// object is a JSON object as stored by the server. Objects are stored as
+// decoded JSON rather than typed models, so that request fields that the
+// server does not know about are returned unchanged.
+type object = map[string]any
+
+// collection is an ordered list of objects, looked up by ID or slug.
+type collection struct {
+	items []object
+}
+
+func (c *collection) add(o object) {
+	c.items = append(c.items, o)
+}
+
+func (c *collection) get(idOrSlug string) object {
+	for _, o := range c.items {
+		if o["id"] == idOrSlug || (o["slug"] != nil && o["slug"] == idOrSlug) {
+			return o
+		}
+	}
+	return nil
+}
+
+func (c *collection) remove(id any) {
+	c.items = slices.DeleteFunc(c.items, func(o object) bool {
+		return o["id"] == id
+	})
+}
+
+func (c *collection) filter(f func(object) bool) []object {
+	var items []object
+	for _, o := range c.items {
+		if f(o) {
+			items = append(items, o)
+		}
+	}
+	return items
+}
+
+func (c *collection) hasSlug(slug string) bool {
+	return slices.ContainsFunc(c.items, func(o object) bool {
+		return o["slug"] == slug
+	})
+}
+
+// newID returns a new numeric ID, unique within the server. The caller must
+// hold s.mu.
+func (s *Server) newID() string {
+	s.nextID++
+	return strconv.Itoa(s.nextID)
+}
+
+// newNumericID is like newID, for IDs that Sentry returns as numbers.
+func (s *Server) newNumericID() int {
+	s.nextID++
+	return s.nextID
+}
+
+// removeValue returns the values other than v.
+func removeValue(values []any, v any) []any {
+	return slices.DeleteFunc(values, func(value any) bool {
+		return value == v
+	})
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+var slugInvalidChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// slugify derives a slug from a name the way Sentry does.
+func slugify(name string) string {
+	return strings.Trim(slugInvalidChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// decodeObject decodes the JSON object in the request body. An empty body
+// decodes to an empty object.
+func decodeObject(r *http.Request) (object, error) {
+	o := object{}
+	if r.Body == nil {
+		return o, nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return o, nil
+	}
+	if err := json.Unmarshal(body, &o); err != nil {
+		return nil, fmt.Errorf("invalid request body: %w", err)
+	}
+	return o, nil
+}
+
+// merge copies the given fields of src into dst.
+func merge(dst, src object, fields ...string) {
+	for _, field := range fields {
+		if v, ok := src[field]; ok {
+			dst[field] = v
+		}
+	}
+}
+
+// clone returns a deep copy of a decoded JSON value, so that responses never
+// share state with the server.
+func clone[T any](v T) T {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	var out T
+	if err := json.Unmarshal(b, &out); err != nil {
+		panic(err)
+	}
+	return out
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeDetail(w http.ResponseWriter, status int, detail string) {
+	writeJSON(w, status, object{"detail": detail})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeDetail(w, http.StatusNotFound, "The requested resource does not exist")
+}
@@ -0,0 +1,198 @@
+package mockserver
+
+import (
+	"maps"
+	"net/http"
+)
+
+// organizationState holds the objects that belong to an organization.
+type organizationState struct {
+	teams     collection
+	projects  collection
+	detectors collection
+	workflows collection
+
+	// projectTeams maps project IDs to the IDs of the teams that have access
+	// to the project.
+	projectTeams map[string][]string
+	// keys maps project IDs to the client keys of the project.
+	keys map[string]*collection
+}
+
+// createOrganization adds an organization. The caller must hold s.mu, except
+// in NewServer.
+func (s *Server) createOrganization(name, slug string) object {
+	org := object{
+		"id":                         s.newID(),
+		"slug":                       slug,
+		"name":                       name,
+		"dateCreated":                now(),
+		"status":                     object{"id": "active", "name": "active"},
+		"isEarlyAdopter":             false,
+		"require2FA":                 false,
+		"hideAiFeatures":             false,
+		"features":                   []any{},
+		"avatar":                     object{"avatarType": "letter_avatar", "avatarUuid": nil},
+		"defaultRole":                "member",
+		"openMembership":             true,
+		"allowSharedIssues":          true,
+		"enhancedPrivacy":            false,
+		"dataScrubber":               false,
+		"dataScrubberDefaults":       false,
+		"sensitiveFields":            []any{},
+		"safeFields":                 []any{},
+		"scrubIPAddresses":           false,
+		"scrapeJavaScript":           true,
+		"storeCrashReports":          0,
+		"allowJoinRequests":          true,
+		"allowMemberProjectCreation": true,
+		"alertsMemberWrite":          true,
+		"eventsMemberAdmin":          true,
+		"attachmentsRole":            "member",
+		"debugFilesRole":             "admin",
+		"relayPiiConfig":             nil,
+		"trustedRelays":              []any{},
+		"orgRoleList": []any{
+			orgRole("member", "Member"),
+			orgRole("admin", "Admin"),
+			orgRole("manager", "Manager"),
+			orgRole("owner", "Owner"),
+		},
+		"teamRoleList": []any{
+			teamRole("contributor", "Contributor"),
+			teamRole("admin", "Team Admin"),
+		},
+	}
+
+	s.organizations.add(org)
+	s.orgState[org["id"].(string)] = &organizationState{
+		projectTeams: make(map[string][]string),
+		keys:         make(map[string]*collection),
+	}
+	return org
+}
+
+func orgRole(id, name string) object {
+	return object{
+		"id":                 id,
+		"name":               name,
+		"desc":               name,
+		"scopes":             []any{},
+		"allowed":            true,
+		"isAllowed":          true,
+		"isRetired":          false,
+		"isTeamRolesAllowed": true,
+		"minimumTeamRole":    "contributor",
+	}
+}
+
+func teamRole(id, name string) object {
+	return object{
+		"id":               id,
+		"name":             name,
+		"desc":             name,
+		"scopes":           []any{},
+		"allowed":          true,
+		"isAllowed":        true,
+		"isRetired":        false,
+		"isMinimumRoleFor": nil,
+	}
+}
+
+// organization looks up an organization and its objects. The caller must hold
+// s.mu.
+func (s *Server) organization(idOrSlug string) (object, *organizationState) {
+	org := s.organizations.get(idOrSlug)
+	if org == nil {
+		return nil, nil
+	}
+	return org, s.orgState[org["id"].(string)]
+}
+
+// CreateOrganization implements ServerInterface.
+func (s *Server) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeObject(r)
+	if err != nil {
+		writeDetail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	name, _ := body["name"].(string)
+	if name == "" {
+		writeJSON(w, http.StatusBadRequest, object{"name": []string{"This field is required."}})
+		return
+	}
+	slug, _ := body["slug"].(string)
+	if slug == "" {
+		slug = slugify(name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.organizations.hasSlug(slug) {
+		writeDetail(w, http.StatusConflict, "An organization with this slug already exists.")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, clone(s.createOrganization(name, slug)))
+}
+
+// GetOrganization implements ServerInterface.
+func (s *Server) GetOrganization(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, _ := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, clone(org))
+}
+
+// UpdateOrganization implements ServerInterface.
+func (s *Server) UpdateOrganization(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug) {
+	body, err := decodeObject(r)
+	if err != nil {
+		writeDetail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, _ := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	if slug, ok := body["slug"].(string); ok && slug != org["slug"] && s.organizations.hasSlug(slug) {
+		writeDetail(w, http.StatusConflict, "An organization with this slug already exists.")
+		return
+	}
+
+	delete(body, "id")
+	maps.Copy(org, body)
+
+	writeJSON(w, http.StatusOK, clone(org))
+}
+
+// DeleteOrganization implements ServerInterface.
+func (s *Server) DeleteOrganization(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, _ := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	s.organizations.remove(org["id"])
+	delete(s.orgState, org["id"].(string))
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// writePage writes the page of items selected by the cursor, with a Link
+// header pointing to the previous and next pages. Cursors have Sentry's
+// "value:offset:is_prev" format.
+func (s *Server) writePage(w http.ResponseWriter, r *http.Request, cursor *Cursor, items []object) {
+	offset := 0
+	if cursor != nil && *cursor != "" {
+		parts := strings.Split(*cursor, ":")
+		if len(parts) != 3 {
+			writeDetail(w, http.StatusBadRequest, "Invalid cursor parameter.")
+			return
+		}
+		var err error
+		offset, err = strconv.Atoi(parts[1])
+		if err != nil || offset < 0 {
+			writeDetail(w, http.StatusBadRequest, "Invalid cursor parameter.")
+			return
+		}
+	}
+
+	pageSize := s.config.PageSize
+	start := min(offset, len(items))
+	end := min(start+pageSize, len(items))
+
+	previous := max(start-pageSize, 0)
+	w.Header().Set("Link", strings.Join([]string{
+		pageLink(r, "previous", fmt.Sprintf("0:%d:1", previous), start > 0),
+		pageLink(r, "next", fmt.Sprintf("0:%d:0", end), end < len(items)),
+	}, ", "))
+
+	page := make([]object, 0, end-start)
+	for _, item := range items[start:end] {
+		page = append(page, clone(item))
+	}
+	writeJSON(w, http.StatusOK, page)
+}
+
+func pageLink(r *http.Request, rel, cursor string, results bool) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	query := r.URL.Query()
+	query.Set("cursor", cursor)
+
+	return fmt.Sprintf(`<%s://%s%s?%s>; rel="%s"; results="%t"; cursor="%s"`, scheme, r.Host, r.URL.Path, query.Encode(), rel, results, cursor)
+}
@@ -0,0 +1,373 @@
+package mockserver
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+)
+
+// renderProject returns the response body of a project. The caller must hold
+// s.mu.
+func renderProject(org object, state *organizationState, project object) object {
+	teams := []any{}
+	for _, teamID := range state.projectTeams[project["id"].(string)] {
+		if team := state.teams.get(teamID); team != nil {
+			teams = append(teams, team)
+		}
+	}
+
+	resp := clone(project)
+	resp["organization"] = clone(org)
+	resp["teams"] = clone(teams)
+	if len(teams) > 0 {
+		resp["team"] = clone(teams[0])
+	} else {
+		resp["team"] = nil
+	}
+	return resp
+}
+
+// ListOrganizationProjects implements ServerInterface.
+func (s *Server) ListOrganizationProjects(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationProjectsParams) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	projects := make([]object, 0, len(state.projects.items))
+	for _, project := range state.projects.items {
+		projects = append(projects, renderProject(org, state, project))
+	}
+
+	s.writePage(w, r, params.Cursor, projects)
+}
+
+// ListTeamProjects implements ServerInterface.
+func (s *Server) ListTeamProjects(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params ListTeamProjectsParams) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	team := state.teams.get(teamIdOrSlug)
+	if team == nil {
+		writeNotFound(w)
+		return
+	}
+
+	projects := []object{}
+	for _, project := range state.projects.items {
+		if slices.Contains(state.projectTeams[project["id"].(string)], team["id"].(string)) {
+			projects = append(projects, renderProject(org, state, project))
+		}
+	}
+
+	s.writePage(w, r, params.Cursor, projects)
+}
+
+// CreateOrganizationTeamProject implements ServerInterface. Like Sentry, it
+// also creates the default client key and the default monitors of the
+// project.
+func (s *Server) CreateOrganizationTeamProject(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug) {
+	body, err := decodeObject(r)
+	if err != nil {
+		writeDetail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	name, _ := body["name"].(string)
+	if name == "" {
+		writeJSON(w, http.StatusBadRequest, object{"name": []string{"This field is required."}})
+		return
+	}
+	slug, _ := body["slug"].(string)
+	if slug == "" {
+		slug = slugify(name)
+	}
+	platform, _ := body["platform"].(string)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	team := state.teams.get(teamIdOrSlug)
+	if team == nil {
+		writeNotFound(w)
+		return
+	}
+
+	if state.projects.hasSlug(slug) {
+		writeDetail(w, http.StatusConflict, "A project with this slug already exists.")
+		return
+	}
+
+	project := object{
+		"id":                   s.newID(),
+		"slug":                 slug,
+		"name":                 name,
+		"platform":             platform,
+		"dateCreated":          now(),
+		"isBookmarked":         false,
+		"isMember":             true,
+		"isPublic":             false,
+		"hasAccess":            true,
+		"status":               "active",
+		"color":                "#3f70bf",
+		"features":             []any{},
+		"firstEvent":           nil,
+		"allowedDomains":       []any{"*"},
+		"digestsMinDelay":      300,
+		"digestsMaxDelay":      1800,
+		"resolveAge":           0,
+		"scrapeJavaScript":     true,
+		"securityToken":        randomHex(32),
+		"securityTokenHeader":  nil,
+		"verifySSL":            false,
+		"subjectTemplate":      "$shortID - $title",
+		"fingerprintingRules":  "",
+		"groupingEnhancements": "",
+		"highlightTags":        []any{},
+		"options": object{
+			"filters:blacklisted_ips":          "",
+			"filters:releases":                 "",
+			"filters:error_messages":           "",
+			"quotas:spike-protection-disabled": false,
+		},
+	}
+	projectID := project["id"].(string)
+
+	state.projects.add(project)
+	state.projectTeams[projectID] = []string{team["id"].(string)}
+	state.keys[projectID] = &collection{}
+	s.createProjectKey(r, state, project, object{"name": "Default"})
+	for _, monitor := range []struct{ name, typ string }{
+		{"Error Monitor", "error"},
+		{"Issue Stream", "issue_stream"},
+	} {
+		state.detectors.add(object{
+			"id":             s.newID(),
+			"projectId":      projectID,
+			"name":           monitor.name,
+			"type":           monitor.typ,
+			"enabled":        true,
+			"description":    nil,
+			"owner":          nil,
+			"config":         object{},
+			"dataSources":    []any{},
+			"conditionGroup": nil,
+			"dateCreated":    now(),
+			"dateUpdated":    now(),
+		})
+	}
+
+	writeJSON(w, http.StatusCreated, renderProject(org, state, project))
+}
+
+// project looks up an organization and one of its projects. The caller must
+// hold s.mu.
+func (s *Server) project(organizationIdOrSlug, projectIdOrSlug string) (object, *organizationState, object) {
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		return nil, nil, nil
+	}
+	project := state.projects.get(projectIdOrSlug)
+	if project == nil {
+		return nil, nil, nil
+	}
+	return org, state, project
+}
+
+// GetOrganizationProject implements ServerInterface.
+func (s *Server) GetOrganizationProject(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state, project := s.project(organizationIdOrSlug, projectIdOrSlug)
+	if project == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, renderProject(org, state, project))
+}
+
+// UpdateOrganizationProject implements ServerInterface.
+func (s *Server) UpdateOrganizationProject(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) {
+	body, err := decodeObject(r)
+	if err != nil {
+		writeDetail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state, project := s.project(organizationIdOrSlug, projectIdOrSlug)
+	if project == nil {
+		writeNotFound(w)
+		return
+	}
+
+	if slug, ok := body["slug"].(string); ok && slug != project["slug"] && state.projects.hasSlug(slug) {
+		writeDetail(w, http.StatusConflict, "A project with this slug already exists.")
+		return
+	}
+
+	// Options are merged rather than replaced.
+	if options, ok := body["options"].(object); ok {
+		current, _ := project["options"].(object)
+		if current == nil {
+			current = object{}
+		}
+		maps.Copy(current, options)
+		body["options"] = current
+	}
+
+	for _, field := range []string{"id", "organization", "team", "teams"} {
+		delete(body, field)
+	}
+	maps.Copy(project, body)
+
+	writeJSON(w, http.StatusOK, renderProject(org, state, project))
+}
+
+// DeleteOrganizationProject implements ServerInterface.
+func (s *Server) DeleteOrganizationProject(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, state, project := s.project(organizationIdOrSlug, projectIdOrSlug)
+	if project == nil {
+		writeNotFound(w)
+		return
+	}
+
+	projectID := project["id"].(string)
+	state.projects.remove(projectID)
+	delete(state.projectTeams, projectID)
+	delete(state.keys, projectID)
+	state.detectors.items = slices.DeleteFunc(state.detectors.items, func(o object) bool {
+		return o["projectId"] == projectID
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddTeamToProject implements ServerInterface.
+func (s *Server) AddTeamToProject(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state, project := s.project(organizationIdOrSlug, projectIdOrSlug)
+	if project == nil {
+		writeNotFound(w)
+		return
+	}
+
+	team := state.teams.get(teamIdOrSlug)
+	if team == nil {
+		writeNotFound(w)
+		return
+	}
+
+	projectID, teamID := project["id"].(string), team["id"].(string)
+	if !slices.Contains(state.projectTeams[projectID], teamID) {
+		state.projectTeams[projectID] = append(state.projectTeams[projectID], teamID)
+	}
+
+	writeJSON(w, http.StatusCreated, renderProject(org, state, project))
+}
+
+// RemoveTeamFromProject implements ServerInterface.
+func (s *Server) RemoveTeamFromProject(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state, project := s.project(organizationIdOrSlug, projectIdOrSlug)
+	if project == nil {
+		writeNotFound(w)
+		return
+	}
+
+	team := state.teams.get(teamIdOrSlug)
+	if team == nil {
+		writeNotFound(w)
+		return
+	}
+
+	projectID := project["id"].(string)
+	state.projectTeams[projectID] = slices.DeleteFunc(state.projectTeams[projectID], func(id string) bool {
+		return id == team["id"]
+	})
+
+	writeJSON(w, http.StatusOK, renderProject(org, state, project))
+}
+
+// setSpikeProtection implements EnableSpikeProtection and
+// DisableSpikeProtection, which toggle an option of the given projects.
+func (s *Server) setSpikeProtection(w http.ResponseWriter, r *http.Request, organizationIdOrSlug string, enabled bool, status int) {
+	body, err := decodeObject(r)
+	if err != nil {
+		writeDetail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	projects, _ := body["projects"].([]any)
+	if len(projects) == 0 {
+		writeJSON(w, http.StatusBadRequest, object{"projects": []string{"This field is required."}})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	var targets []object
+	if projects[0] == "$all" {
+		targets = state.projects.items
+	} else {
+		for _, idOrSlug := range projects {
+			idOrSlug, _ := idOrSlug.(string)
+			project := state.projects.get(idOrSlug)
+			if project == nil {
+				writeNotFound(w)
+				return
+			}
+			targets = append(targets, project)
+		}
+	}
+
+	for _, project := range targets {
+		project["options"].(object)["quotas:spike-protection-disabled"] = !enabled
+	}
+
+	w.WriteHeader(status)
+}
+
+// EnableSpikeProtection implements ServerInterface.
+func (s *Server) EnableSpikeProtection(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug) {
+	s.setSpikeProtection(w, r, organizationIdOrSlug, true, http.StatusCreated)
+}
+
+// DisableSpikeProtection implements ServerInterface.
+func (s *Server) DisableSpikeProtection(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug) {
+	s.setSpikeProtection(w, r, organizationIdOrSlug, false, http.StatusOK)
+}
@@ -0,0 +1,44 @@
+package mockserver
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// unlimitedRateLimit is the limit reported when requests are not limited, so
+// that clients still exercise their header parsing.
+const unlimitedRateLimit = 1 << 20
+
+// allow counts the request against the current rate-limit window and sets the
+// rate-limit headers that Sentry sends. It reports whether the request is
+// within the limit.
+func (s *Server) allow(w http.ResponseWriter) bool {
+	s.rateMu.Lock()
+	defer s.rateMu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.rateWindowStart) >= s.config.RateLimitWindow {
+		s.rateWindowStart = now
+		s.rateCount = 0
+	}
+	s.rateCount++
+
+	limit := s.config.RateLimit
+	if limit <= 0 {
+		limit = unlimitedRateLimit
+	}
+	remaining := max(limit-s.rateCount, 0)
+	// The reset header has a resolution of one second, round it up so that
+	// clients never retry before the window ends.
+	reset := s.rateWindowStart.Add(s.config.RateLimitWindow).Add(time.Second - 1).Truncate(time.Second)
+
+	h := w.Header()
+	h.Set("X-Sentry-Rate-Limit-Limit", strconv.Itoa(limit))
+	h.Set("X-Sentry-Rate-Limit-Remaining", strconv.Itoa(remaining))
+	h.Set("X-Sentry-Rate-Limit-Reset", strconv.FormatInt(reset.Unix(), 10))
+	h.Set("X-Sentry-Rate-Limit-ConcurrentLimit", "25")
+	h.Set("X-Sentry-Rate-Limit-ConcurrentRemaining", "24")
+
+	return s.config.RateLimit <= 0 || s.rateCount <= limit
+}
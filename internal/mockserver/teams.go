@@ -0,0 +1,121 @@
+package mockserver
+
+import (
+	"net/http"
+	"slices"
+)
+
+// ListOrganizationTeams implements ServerInterface.
+func (s *Server) ListOrganizationTeams(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationTeamsParams) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	s.writePage(w, r, params.Cursor, state.teams.items)
+}
+
+// CreateOrganizationTeam implements ServerInterface.
+func (s *Server) CreateOrganizationTeam(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug) {
+	body, err := decodeObject(r)
+	if err != nil {
+		writeDetail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	name, _ := body["name"].(string)
+	slug, _ := body["slug"].(string)
+	if slug == "" {
+		slug = slugify(name)
+	}
+	if name == "" {
+		name = slug
+	}
+	if slug == "" {
+		writeJSON(w, http.StatusBadRequest, object{"slug": []string{"Name or slug is required"}})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	if state.teams.hasSlug(slug) {
+		writeDetail(w, http.StatusConflict, "A team with this slug already exists.")
+		return
+	}
+
+	team := object{
+		"id":          s.newID(),
+		"slug":        slug,
+		"name":        name,
+		"dateCreated": now(),
+		"isMember":    true,
+		"teamRole":    "admin",
+		"flags":       object{"idp:provisioned": false},
+		"access":      []any{},
+		"hasAccess":   true,
+		"isPending":   false,
+		"memberCount": 1,
+		"avatar":      object{"avatarType": "letter_avatar", "avatarUuid": nil},
+	}
+	state.teams.add(team)
+
+	writeJSON(w, http.StatusCreated, clone(team))
+}
+
+// GetOrganizationTeam implements ServerInterface.
+func (s *Server) GetOrganizationTeam(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	team := state.teams.get(teamIdOrSlug)
+	if team == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, clone(team))
+}
+
+// DeleteOrganizationTeam implements ServerInterface.
+func (s *Server) DeleteOrganizationTeam(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	team := state.teams.get(teamIdOrSlug)
+	if team == nil {
+		writeNotFound(w)
+		return
+	}
+
+	state.teams.remove(team["id"])
+	for projectID, teamIDs := range state.projectTeams {
+		state.projectTeams[projectID] = slices.DeleteFunc(teamIDs, func(id string) bool {
+			return id == team["id"]
+		})
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,222 @@
+package mockserver
+
+import (
+	"net/http"
+)
+
+// The operations below are not backed by state. They respond with 501 Not
+// Implemented, so that tests relying on them fail with a clear error.
+
+func notImplemented(w http.ResponseWriter, r *http.Request) {
+	writeDetail(w, http.StatusNotImplemented, "The mock Sentry server does not implement "+r.Method+" "+r.URL.Path)
+}
+
+// GetOrganizationAlertRuleDetector implements ServerInterface.
+func (s *Server) GetOrganizationAlertRuleDetector(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params GetOrganizationAlertRuleDetectorParams) {
+	notImplemented(w, r)
+}
+
+// GetOrganizationAlertRuleWorkflow implements ServerInterface.
+func (s *Server) GetOrganizationAlertRuleWorkflow(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params GetOrganizationAlertRuleWorkflowParams) {
+	notImplemented(w, r)
+}
+
+// ListOrganizationDashboards implements ServerInterface.
+func (s *Server) ListOrganizationDashboards(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationDashboardsParams) {
+	notImplemented(w, r)
+}
+
+// CreateOrganizationDashboard implements ServerInterface.
+func (s *Server) CreateOrganizationDashboard(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug) {
+	notImplemented(w, r)
+}
+
+// DeleteOrganizationDashboard implements ServerInterface.
+func (s *Server) DeleteOrganizationDashboard(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId) {
+	notImplemented(w, r)
+}
+
+// GetOrganizationDashboard implements ServerInterface.
+func (s *Server) GetOrganizationDashboard(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId) {
+	notImplemented(w, r)
+}
+
+// UpdateOrganizationDashboard implements ServerInterface.
+func (s *Server) UpdateOrganizationDashboard(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId) {
+	notImplemented(w, r)
+}
+
+// CreateOrganizationExternalUser implements ServerInterface.
+func (s *Server) CreateOrganizationExternalUser(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug) {
+	notImplemented(w, r)
+}
+
+// DeleteOrganizationExternalUser implements ServerInterface.
+func (s *Server) DeleteOrganizationExternalUser(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string) {
+	notImplemented(w, r)
+}
+
+// UpdateOrganizationExternalUser implements ServerInterface.
+func (s *Server) UpdateOrganizationExternalUser(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, externalUserId string) {
+	notImplemented(w, r)
+}
+
+// ListOrganizationIntegrations implements ServerInterface.
+func (s *Server) ListOrganizationIntegrations(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationIntegrationsParams) {
+	notImplemented(w, r)
+}
+
+// GetOrganizationIntegration implements ServerInterface.
+func (s *Server) GetOrganizationIntegration(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId) {
+	notImplemented(w, r)
+}
+
+// UpdateOrganizationIntegration implements ServerInterface.
+func (s *Server) UpdateOrganizationIntegration(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId) {
+	notImplemented(w, r)
+}
+
+// ListOrganizationRepositories implements ServerInterface.
+func (s *Server) ListOrganizationRepositories(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationRepositoriesParams) {
+	notImplemented(w, r)
+}
+
+// CreateOrganizationRepository implements ServerInterface.
+func (s *Server) CreateOrganizationRepository(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug) {
+	notImplemented(w, r)
+}
+
+// DeleteOrganizationRepository implements ServerInterface.
+func (s *Server) DeleteOrganizationRepository(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, repoId string) {
+	notImplemented(w, r)
+}
+
+// ListOrganizationMembers implements ServerInterface.
+func (s *Server) ListOrganizationMembers(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationMembersParams) {
+	notImplemented(w, r)
+}
+
+// CreateOrganizationMember implements ServerInterface.
+func (s *Server) CreateOrganizationMember(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug) {
+	notImplemented(w, r)
+}
+
+// DeleteOrganizationMember implements ServerInterface.
+func (s *Server) DeleteOrganizationMember(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId) {
+	notImplemented(w, r)
+}
+
+// GetOrganizationMember implements ServerInterface.
+func (s *Server) GetOrganizationMember(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId) {
+	notImplemented(w, r)
+}
+
+// UpdateOrganizationMember implements ServerInterface.
+func (s *Server) UpdateOrganizationMember(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId) {
+	notImplemented(w, r)
+}
+
+// AddOrganizationMemberToTeam implements ServerInterface.
+func (s *Server) AddOrganizationMemberToTeam(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug) {
+	notImplemented(w, r)
+}
+
+// RemoveOrganizationMemberFromTeam implements ServerInterface.
+func (s *Server) RemoveOrganizationMemberFromTeam(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug) {
+	notImplemented(w, r)
+}
+
+// UpdateOrganizationMemberTeamRole implements ServerInterface.
+func (s *Server) UpdateOrganizationMemberTeamRole(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, teamIdOrSlug TeamIdOrSlug) {
+	notImplemented(w, r)
+}
+
+// ListSentryAppInstallations implements ServerInterface.
+func (s *Server) ListSentryAppInstallations(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListSentryAppInstallationsParams) {
+	notImplemented(w, r)
+}
+
+// CreateOrganizationNotificationAction implements ServerInterface.
+func (s *Server) CreateOrganizationNotificationAction(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug) {
+	notImplemented(w, r)
+}
+
+// DeleteOrganizationNotificationAction implements ServerInterface.
+func (s *Server) DeleteOrganizationNotificationAction(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, actionId string) {
+	notImplemented(w, r)
+}
+
+// GetOrganizationNotificationAction implements ServerInterface.
+func (s *Server) GetOrganizationNotificationAction(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, actionId string) {
+	notImplemented(w, r)
+}
+
+// UpdateOrganizationNotificationAction implements ServerInterface.
+func (s *Server) UpdateOrganizationNotificationAction(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, actionId string) {
+	notImplemented(w, r)
+}
+
+// ListOrganizationTeamMembers implements ServerInterface.
+func (s *Server) ListOrganizationTeamMembers(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params ListOrganizationTeamMembersParams) {
+	notImplemented(w, r)
+}
+
+// GetProjectOwnership implements ServerInterface.
+func (s *Server) GetProjectOwnership(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) {
+	notImplemented(w, r)
+}
+
+// UpdateProjectOwnership implements ServerInterface.
+func (s *Server) UpdateProjectOwnership(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) {
+	notImplemented(w, r)
+}
+
+// ListProjectFilters implements ServerInterface.
+func (s *Server) ListProjectFilters(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) {
+	notImplemented(w, r)
+}
+
+// UpdateProjectFilter implements ServerInterface.
+func (s *Server) UpdateProjectFilter(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, filterId string) {
+	notImplemented(w, r)
+}
+
+// DeleteProjectSymbolSource implements ServerInterface.
+func (s *Server) DeleteProjectSymbolSource(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params DeleteProjectSymbolSourceParams) {
+	notImplemented(w, r)
+}
+
+// ListProjectSymbolSources implements ServerInterface.
+func (s *Server) ListProjectSymbolSources(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params ListProjectSymbolSourcesParams) {
+	notImplemented(w, r)
+}
+
+// CreateProjectSymbolSource implements ServerInterface.
+func (s *Server) CreateProjectSymbolSource(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) {
+	notImplemented(w, r)
+}
+
+// UpdateProjectSymbolSource implements ServerInterface.
+func (s *Server) UpdateProjectSymbolSource(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params UpdateProjectSymbolSourceParams) {
+	notImplemented(w, r)
+}
+
+// CreateProjectRule implements ServerInterface.
+func (s *Server) CreateProjectRule(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) {
+	notImplemented(w, r)
+}
+
+// DeleteProjectRule implements ServerInterface.
+func (s *Server) DeleteProjectRule(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string) {
+	notImplemented(w, r)
+}
+
+// GetProjectRule implements ServerInterface.
+func (s *Server) GetProjectRule(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string) {
+	notImplemented(w, r)
+}
+
+// UpdateProjectRule implements ServerInterface.
+func (s *Server) UpdateProjectRule(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string) {
+	notImplemented(w, r)
+}
@@ -0,0 +1,176 @@
+package mockserver
+
+import (
+	"net/http"
+)
+
+// updateWorkflow applies the writable fields of a request body to an alert,
+// assigning IDs to new condition groups, conditions and actions. The caller
+// must hold s.mu.
+func (s *Server) updateWorkflow(workflow, body object) {
+	merge(workflow, body, "name", "enabled", "environment", "config", "detectorIds")
+
+	if triggers, ok := body["triggers"].(object); ok {
+		s.assignConditionGroupIDs(triggers)
+		workflow["triggers"] = triggers
+	}
+
+	if actionFilters, ok := body["actionFilters"].([]any); ok {
+		for _, actionFilter := range actionFilters {
+			if actionFilter, ok := actionFilter.(object); ok {
+				s.assignConditionGroupIDs(actionFilter)
+				if actions, ok := actionFilter["actions"].([]any); ok {
+					s.assignIDs(actions)
+				}
+			}
+		}
+		workflow["actionFilters"] = actionFilters
+	}
+
+	workflow["dateUpdated"] = now()
+}
+
+func (s *Server) assignConditionGroupIDs(group object) {
+	if group["id"] == nil {
+		group["id"] = s.newID()
+	}
+	if conditions, ok := group["conditions"].([]any); ok {
+		for _, condition := range conditions {
+			if condition, ok := condition.(object); ok && condition["id"] == nil {
+				condition["id"] = s.newNumericID()
+			}
+		}
+	}
+}
+
+func (s *Server) assignIDs(items []any) {
+	for _, item := range items {
+		if item, ok := item.(object); ok && item["id"] == nil {
+			item["id"] = s.newID()
+		}
+	}
+}
+
+// ListOrganizationWorkflows implements ServerInterface.
+func (s *Server) ListOrganizationWorkflows(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, params ListOrganizationWorkflowsParams) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	s.writePage(w, r, params.Cursor, state.workflows.items)
+}
+
+// CreateOrganizationWorkflow implements ServerInterface.
+func (s *Server) CreateOrganizationWorkflow(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug) {
+	body, err := decodeObject(r)
+	if err != nil {
+		writeDetail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if name, _ := body["name"].(string); name == "" {
+		writeJSON(w, http.StatusBadRequest, object{"name": []string{"This field is required."}})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	workflow := object{
+		"id":            s.newID(),
+		"enabled":       true,
+		"environment":   nil,
+		"config":        object{},
+		"detectorIds":   []any{},
+		"triggers":      nil,
+		"actionFilters": []any{},
+		"createdBy":     nil,
+		"lastTriggered": nil,
+		"dateCreated":   now(),
+	}
+	s.updateWorkflow(workflow, body)
+	state.workflows.add(workflow)
+
+	writeJSON(w, http.StatusCreated, clone(workflow))
+}
+
+// GetOrganizationWorkflow implements ServerInterface.
+func (s *Server) GetOrganizationWorkflow(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, workflowId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	workflow := state.workflows.get(workflowId)
+	if workflow == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, clone(workflow))
+}
+
+// UpdateOrganizationWorkflow implements ServerInterface.
+func (s *Server) UpdateOrganizationWorkflow(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, workflowId string) {
+	body, err := decodeObject(r)
+	if err != nil {
+		writeDetail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	workflow := state.workflows.get(workflowId)
+	if workflow == nil {
+		writeNotFound(w)
+		return
+	}
+
+	s.updateWorkflow(workflow, body)
+
+	writeJSON(w, http.StatusOK, clone(workflow))
+}
+
+// DeleteOrganizationWorkflow implements ServerInterface.
+func (s *Server) DeleteOrganizationWorkflow(w http.ResponseWriter, r *http.Request, organizationIdOrSlug OrganizationIdOrSlug, workflowId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, state := s.organization(organizationIdOrSlug)
+	if org == nil {
+		writeNotFound(w)
+		return
+	}
+
+	workflow := state.workflows.get(workflowId)
+	if workflow == nil {
+		writeNotFound(w)
+		return
+	}
+
+	state.workflows.remove(workflow["id"])
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -12,6 +12,7 @@ import (
 type baseDataSource struct {
 	client    *sentry.Client
 	apiClient *apiclient.ClientWithResponses
+	defaults  *providerdata.Defaults
 }
 
 func (d *baseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -24,4 +25,5 @@ func (d *baseDataSource) Configure(ctx context.Context, req datasource.Configure
 
 	d.client = providerData.Client
 	d.apiClient = providerData.ApiClient
+	d.defaults = &providerData.Defaults
 }
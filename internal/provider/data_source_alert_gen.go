@@ -32,8 +32,9 @@ func (d *AlertDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 		MarkdownDescription: "Retrieve an Alert for a Monitor in an Organization.",
 		Attributes: map[string]schema.Attribute{
 			"organization": schema.StringAttribute{
-				MarkdownDescription: "The organization slug or internal ID of the alert.",
-				Required:            true,
+				MarkdownDescription: "The organization slug or internal ID of the alert. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 			},
 			"id": schema.StringAttribute{
@@ -83,6 +84,7 @@ func (d *AlertDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 func (d *AlertDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data AlertDataSourceModel
 
+	resp.Diagnostics.Append(applyProviderDefaultsToConfig(ctx, d.defaults, &req.Config)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -158,6 +158,7 @@ func (d *AllClientKeysDataSource) Schema(ctx context.Context, req datasource.Sch
 func (d *AllClientKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data AllClientKeysDataSourceModel
 
+	resp.Diagnostics.Append(applyProviderDefaultsToConfig(ctx, d.defaults, &req.Config)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -87,6 +87,7 @@ func (d *AllOrganizationMembersDataSource) Schema(ctx context.Context, req datas
 func (d *AllOrganizationMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data AllOrganizationMembersDataSourceModel
 
+	resp.Diagnostics.Append(applyProviderDefaultsToConfig(ctx, d.defaults, &req.Config)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
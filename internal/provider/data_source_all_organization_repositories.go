@@ -189,6 +189,7 @@ func (d *AllOrganizationRepositoriesDataSource) Schema(ctx context.Context, req
 func (d *AllOrganizationRepositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data AllOrganizationRepositoriesDataSourceModel
 
+	resp.Diagnostics.Append(applyProviderDefaultsToConfig(ctx, d.defaults, &req.Config)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -34,8 +34,9 @@ func (d *AllProjectsDataSource) Schema(ctx context.Context, req datasource.Schem
 		MarkdownDescription: "List of projects in an organization.",
 		Attributes: map[string]schema.Attribute{
 			"organization": schema.StringAttribute{
-				MarkdownDescription: "The organization slug or internal ID to list projects for.",
-				Required:            true,
+				MarkdownDescription: "The organization slug or internal ID to list projects for. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 			},
 			"project_slugs": schema.SetAttribute{
@@ -119,6 +120,7 @@ func (d *AllProjectsDataSource) Schema(ctx context.Context, req datasource.Schem
 func (d *AllProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data AllProjectsDataSourceModel
 
+	resp.Diagnostics.Append(applyProviderDefaultsToConfig(ctx, d.defaults, &req.Config)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -207,30 +207,48 @@ func (d *ClientKeyDataSource) ConfigValidators(ctx context.Context) []datasource
 func (d *ClientKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data ClientKeyDataSourceModel
 
+	resp.Diagnostics.Append(applyProviderDefaultsToConfig(ctx, d.defaults, &req.Config)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	foundKey, diags := readClientKey(ctx, d.apiClient, data.Organization.ValueString(), data.Project.ValueString(), data.Id, data.Name, data.First)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *foundKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readClientKey looks up a client key by ID, by name, or as the only or first key of the project.
+func readClientKey(ctx context.Context, apiClient *apiclient.ClientWithResponses, organization string, project string, id types.String, name types.String, first types.Bool) (*apiclient.ProjectKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	var foundKey *apiclient.ProjectKey
 
-	if data.Id.IsNull() {
+	if id.IsNull() {
 		var allKeys []apiclient.ProjectKey
 		params := &apiclient.ListProjectClientKeysParams{}
 		for {
-			httpResp, err := d.apiClient.ListProjectClientKeysWithResponse(
+			httpResp, err := apiClient.ListProjectClientKeysWithResponse(
 				ctx,
-				data.Organization.ValueString(),
-				data.Project.ValueString(),
+				organization,
+				project,
 				params,
 			)
 			if err != nil {
-				resp.Diagnostics.Append(diagutils.NewClientError("read", err))
-				return
+				diags.Append(diagutils.NewClientError("read", err))
+				return nil, diags
 			}
 			if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
-				resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
-				return
+				diags.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+				return nil, diags
 			}
 
 			allKeys = append(allKeys, *httpResp.JSON200...)
@@ -241,10 +259,10 @@ func (d *ClientKeyDataSource) Read(ctx context.Context, req datasource.ReadReque
 			}
 		}
 
-		if data.Name.IsNull() {
+		if name.IsNull() {
 			if len(allKeys) == 1 {
 				foundKey = new(allKeys[0])
-			} else if !data.First.IsNull() && data.First.ValueBool() {
+			} else if !first.IsNull() && first.ValueBool() {
 				// Find the first key
 
 				// Sort keys by date created
@@ -254,13 +272,13 @@ func (d *ClientKeyDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 				foundKey = new(allKeys[0])
 			} else {
-				resp.Diagnostics.AddError("Client error", "Multiple keys found, please specify the key by `name`, `id`, or set the `first` flag to `true`.")
-				return
+				diags.AddError("Client error", "Multiple keys found, please specify the key by `name`, `id`, or set the `first` flag to `true`.")
+				return nil, diags
 			}
 		} else {
 			// Find the key by name
 			for _, key := range allKeys {
-				if key.Name == data.Name.ValueString() {
+				if key.Name == name.ValueString() {
 					foundKey = new(key)
 					break
 				}
@@ -269,33 +287,28 @@ func (d *ClientKeyDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	} else {
 		// Get the key by ID
-		httpResp, err := d.apiClient.GetProjectClientKeyWithResponse(
+		httpResp, err := apiClient.GetProjectClientKeyWithResponse(
 			ctx,
-			data.Organization.ValueString(),
-			data.Project.ValueString(),
-			data.Id.ValueString(),
+			organization,
+			project,
+			id.ValueString(),
 		)
 		if err != nil {
-			resp.Diagnostics.Append(diagutils.NewClientError("read", err))
-			return
+			diags.Append(diagutils.NewClientError("read", err))
+			return nil, diags
 		}
 		if httpResp.StatusCode() != http.StatusOK {
-			resp.Diagnostics.Append(diagutils.NewClientError("read", err))
-			return
+			diags.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+			return nil, diags
 		}
 
 		foundKey = httpResp.JSON200
 	}
 
 	if foundKey == nil {
-		resp.Diagnostics.Append(diagutils.NewNotFoundError("client key"))
-		return
+		diags.Append(diagutils.NewNotFoundError("client key"))
+		return nil, diags
 	}
 
-	resp.Diagnostics.Append(data.Fill(ctx, *foundKey)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return foundKey, diags
 }
@@ -32,8 +32,9 @@ func (d *CronMonitorDataSource) Schema(ctx context.Context, req datasource.Schem
 		MarkdownDescription: "Retrieve a Cron Monitor.",
 		Attributes: map[string]schema.Attribute{
 			"organization": schema.StringAttribute{
-				MarkdownDescription: "The organization slug or internal ID of the monitor.",
-				Required:            true,
+				MarkdownDescription: "The organization slug or internal ID of the monitor. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 			},
 			"id": schema.StringAttribute{
@@ -132,6 +133,7 @@ func (d *CronMonitorDataSource) Schema(ctx context.Context, req datasource.Schem
 func (d *CronMonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data CronMonitorDataSourceModel
 
+	resp.Diagnostics.Append(applyProviderDefaultsToConfig(ctx, d.defaults, &req.Config)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -33,8 +33,9 @@ func (d *MetricMonitorDataSource) Schema(ctx context.Context, req datasource.Sch
 		MarkdownDescription: "Retrieve a Metric Monitor for a Project.",
 		Attributes: map[string]schema.Attribute{
 			"organization": schema.StringAttribute{
-				MarkdownDescription: "The organization slug or internal ID of the monitor.",
-				Required:            true,
+				MarkdownDescription: "The organization slug or internal ID of the monitor. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 			},
 			"id": schema.StringAttribute{
@@ -189,6 +190,7 @@ func (d *MetricMonitorDataSource) Schema(ctx context.Context, req datasource.Sch
 func (d *MetricMonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data MetricMonitorDataSourceModel
 
+	resp.Diagnostics.Append(applyProviderDefaultsToConfig(ctx, d.defaults, &req.Config)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+)
+
+func (d *MonitorsDataSource) read(ctx context.Context, data *MonitorsDataSourceModel) (diags diag.Diagnostics) {
+	var params apiclient.ListOrganizationMonitorsParams
+
+	if data.Project.IsKnown() {
+		// The monitors endpoint only accepts project IDs
+		httpResp, err := d.apiClient.GetOrganizationProjectWithResponse(ctx, data.Organization.Get(), data.Project.Get())
+		if err != nil {
+			diags.Append(diagutils.NewClientError("read project", err))
+			return
+		} else if httpResp.StatusCode() == http.StatusNotFound {
+			diags.Append(diagutils.NewNotFoundError("project"))
+			return
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			diags.Append(diagutils.NewClientStatusError("read project", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+
+		params.Project = new(httpResp.JSON200.Id)
+	}
+
+	var queryParts []string
+	if data.Type.IsKnown() {
+		queryParts = append(queryParts, fmt.Sprintf("type:%s", data.Type.Get()))
+	}
+	if v := data.Query.ValueString(); v != "" {
+		queryParts = append(queryParts, v)
+	}
+	if len(queryParts) > 0 {
+		params.Query = new(strings.Join(queryParts, " "))
+	}
+
+	monitors := tfutils.MergeDiagnostics(listOrganizationMonitors(ctx, d.apiClient, data.Organization.Get(), params))(&diags)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(data.Fill(ctx, monitors)...)
+	return
+}
+
+func (m *MonitorsDataSourceModel) Fill(ctx context.Context, data []apiclient.ProjectMonitor) (diags diag.Diagnostics) {
+	monitors := make([]*MonitorsDataSourceModelMonitorsItem, 0, len(data))
+	for _, monitor := range data {
+		item := &MonitorsDataSourceModelMonitorsItem{}
+		item.Id.Set(monitor.Id)
+		item.ProjectId.Set(monitor.ProjectId)
+		item.Type.Set(monitor.Type)
+		item.Name.Set(monitor.Name)
+		if v, err := monitor.Description.Get(); err == nil {
+			item.Description.Set(v)
+		} else {
+			item.Description.SetNull()
+		}
+		item.Enabled.Set(monitor.Enabled)
+
+		config, err := monitor.Config.MarshalJSON()
+		if err != nil {
+			diags.AddError("Invalid config", err.Error())
+			return
+		}
+		if string(config) == "null" {
+			item.ConfigJson = jsontypes.NewNormalizedNull()
+		} else {
+			item.ConfigJson = jsontypes.NewNormalizedValue(string(config))
+		}
+
+		monitors = append(monitors, item)
+	}
+
+	diags.Append(m.Monitors.Set(ctx, monitors)...)
+	return
+}
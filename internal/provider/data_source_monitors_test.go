@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccMonitorsDataSource_basic(t *testing.T) {
+	rn := "data.sentry_monitors.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorsDataSourceConfig(`
+					type = "error"
+				`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("monitors"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"id":         knownvalue.NotNull(),
+							"project_id": knownvalue.StringExact(acctest.TestProject.Id),
+							"type":       knownvalue.StringExact("error"),
+							"name":       knownvalue.StringExact("Error Monitor"),
+							"enabled":    knownvalue.Bool(true),
+						}),
+					})),
+				},
+			},
+			{
+				Config: testAccMonitorsDataSourceConfig(`
+					query = "Issue Stream"
+				`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("monitors"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"type": knownvalue.StringExact("issue_stream"),
+						}),
+					})),
+				},
+			},
+			{
+				Config: testAccMonitorsDataSourceConfig(`
+					type  = "error"
+					query = "tf-no-such-monitor"
+				`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("monitors"), knownvalue.ListSizeExact(0)),
+				},
+			},
+		},
+	})
+}
+
+func testAccMonitorsDataSourceConfig(extras string) string {
+	return fmt.Sprintf(`
+data "sentry_monitors" "test" {
+	organization = "%s"
+	project      = "%s"
+
+	%s
+}
+`, acctest.TestOrganization, acctest.TestProject.Slug, extras)
+}
@@ -33,8 +33,9 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 		MarkdownDescription: "Retrieves a project.",
 		Attributes: map[string]schema.Attribute{
 			"organization": schema.StringAttribute{
-				MarkdownDescription: "The organization slug.",
-				Required:            true,
+				MarkdownDescription: "The organization slug. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 			},
 			"slug": schema.StringAttribute{
@@ -119,6 +120,7 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data ProjectDataSourceModel
 
+	resp.Diagnostics.Append(applyProviderDefaultsToConfig(ctx, d.defaults, &req.Config)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -30,13 +30,15 @@ func (d *ProjectIssueStreamMonitorDataSource) Schema(ctx context.Context, req da
 		MarkdownDescription: "Retrieve a Project Issue Stream Monitor by project ID or slug. This is helpful for managing [default monitors](https://docs.sentry.io/product/new-monitors-and-alerts/monitors/#default-monitors) that were created by Sentry outside of Terraform. You can then map these IDs into `sentry_alert.monitor_ids` to define [alert rules](../resources/alert.md) for those monitors.\n\n**Note:** When multiple monitors are found, the `first` attribute can be set to `true` to return the first monitor found. If `first` is not set to `true` and multiple monitors are found, the data source will return an error.",
 		Attributes: map[string]schema.Attribute{
 			"organization": schema.StringAttribute{
-				MarkdownDescription: "The organization slug or internal ID of the monitor.",
-				Required:            true,
+				MarkdownDescription: "The organization slug or internal ID of the monitor. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 			},
 			"project": schema.StringAttribute{
-				MarkdownDescription: "The project slug or internal ID of the monitor.",
-				Required:            true,
+				MarkdownDescription: "The project slug or internal ID of the monitor. Defaults to the `default_project` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 			},
 			"first": schema.BoolAttribute{
@@ -88,6 +90,7 @@ func (d *ProjectIssueStreamMonitorDataSource) Schema(ctx context.Context, req da
 func (d *ProjectIssueStreamMonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data ProjectIssueStreamMonitorDataSourceModel
 
+	resp.Diagnostics.Append(applyProviderDefaultsToConfig(ctx, d.defaults, &req.Config)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -30,8 +30,9 @@ func (d *TeamDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 		MarkdownDescription: "Retrieves a Team",
 		Attributes: map[string]schema.Attribute{
 			"organization": schema.StringAttribute{
-				MarkdownDescription: "The organization slug or internal ID of the organization.",
-				Required:            true,
+				MarkdownDescription: "The organization slug or internal ID of the organization. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 			},
 			"slug": schema.StringAttribute{
@@ -80,6 +81,7 @@ func (d *TeamDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data TeamDataSourceModel
 
+	resp.Diagnostics.Append(applyProviderDefaultsToConfig(ctx, d.defaults, &req.Config)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
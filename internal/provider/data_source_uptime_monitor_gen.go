@@ -32,8 +32,9 @@ func (d *UptimeMonitorDataSource) Schema(ctx context.Context, req datasource.Sch
 		MarkdownDescription: "Retrieve an Uptime Monitor for a Project.",
 		Attributes: map[string]schema.Attribute{
 			"organization": schema.StringAttribute{
-				MarkdownDescription: "The organization slug or internal ID of the monitor.",
-				Required:            true,
+				MarkdownDescription: "The organization slug or internal ID of the monitor. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 			},
 			"id": schema.StringAttribute{
@@ -135,6 +136,7 @@ func (d *UptimeMonitorDataSource) Schema(ctx context.Context, req datasource.Sch
 func (d *UptimeMonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data UptimeMonitorDataSourceModel
 
+	resp.Diagnostics.Append(applyProviderDefaultsToConfig(ctx, d.defaults, &req.Config)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/samber/lo"
+)
+
+type ClientKeyEphemeralResourceModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	First        types.Bool   `tfsdk:"first"`
+	ProjectId    types.String `tfsdk:"project_id"`
+	Public       types.String `tfsdk:"public"`
+	Secret       types.String `tfsdk:"secret"`
+	Dsn          types.Map    `tfsdk:"dsn"`
+}
+
+func (m *ClientKeyEphemeralResourceModel) Fill(ctx context.Context, key apiclient.ProjectKey) (diags diag.Diagnostics) {
+	m.Id = types.StringValue(key.Id)
+	m.ProjectId = types.StringValue(key.ProjectId.String())
+	m.Name = types.StringValue(key.Name)
+	m.Public = types.StringValue(key.Public)
+	m.Secret = types.StringValue(key.Secret)
+	m.Dsn = types.MapValueMust(types.StringType, lo.MapValues(key.Dsn, func(v string, _ string) attr.Value {
+		return types.StringValue(v)
+	}))
+	return
+}
+
+var _ ephemeral.EphemeralResource = &ClientKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ClientKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigValidators = &ClientKeyEphemeralResource{}
+
+func NewClientKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &ClientKeyEphemeralResource{}
+}
+
+type ClientKeyEphemeralResource struct {
+	baseEphemeralResource
+}
+
+func (r *ClientKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key"
+}
+
+func (r *ClientKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve a Project's Client Key without persisting its secrets to state. Use this to pass DSNs to secret managers or other write-only attributes.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization the resource belongs to. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The project the resource belongs to. Defaults to the `default_project` provider attribute.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the client key.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the client key.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"first": schema.BoolAttribute{
+				MarkdownDescription: "Return the first key of the returned keys.",
+				Optional:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project that the key belongs to.",
+				Computed:            true,
+			},
+			"public": schema.StringAttribute{
+				MarkdownDescription: "The public key.",
+				Computed:            true,
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "The secret key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"dsn": schema.MapAttribute{
+				MarkdownDescription: "This is a map of DSN values. The keys include `public`, `secret`, `csp`, `security`, `minidump`, `nel`, `unreal`, `cdn`, and `crons`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *ClientKeyEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("first"),
+		),
+	}
+}
+
+func (r *ClientKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ClientKeyEphemeralResourceModel
+
+	resp.Diagnostics.Append(applyProviderDefaultsToConfig(ctx, r.defaults, &req.Config)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	foundKey, diags := readClientKey(ctx, r.apiClient, data.Organization.ValueString(), data.Project.ValueString(), data.Id, data.Name, data.First)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *foundKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
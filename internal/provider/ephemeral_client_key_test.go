@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccClientKeyEphemeralResource(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	keyName := acctest.RandomWithPrefix("tf-key")
+	rn := "echo.test"
+
+	providerFactories := map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+	for name, factory := range testAccProtoV6ProviderFactories {
+		providerFactories[name] = factory
+	}
+
+	checks := []statecheck.StateCheck{
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("data").AtMapKey("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("data").AtMapKey("project"), knownvalue.StringExact(projectName)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("data").AtMapKey("id"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("data").AtMapKey("project_id"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("data").AtMapKey("public"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("data").AtMapKey("secret"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("data").AtMapKey("dsn"), knownvalue.MapPartial(map[string]knownvalue.Check{
+			"public": knownvalue.NotNull(),
+			"secret": knownvalue.NotNull(),
+			"csp":    knownvalue.NotNull(),
+		})),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientKeyEphemeralResourceConfig(teamName, projectName, keyName, `
+					id = sentry_key.test.id
+				`),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact(keyName)),
+				),
+			},
+			{
+				Config: testAccClientKeyEphemeralResourceConfig(teamName, projectName, keyName, `
+					name = sentry_key.test.name
+				`),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact(keyName)),
+				),
+			},
+			{
+				Config: testAccClientKeyEphemeralResourceConfig(teamName, projectName, keyName, `
+					first = true
+				`),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact("Default")),
+				),
+			},
+		},
+	})
+}
+
+func testAccClientKeyEphemeralResourceConfig(teamName, projectName, keyName, extras string) string {
+	return testAccClientKeyResourceConfig(testAccClientKeyResourceConfigData{
+		TeamName:    teamName,
+		ProjectName: projectName,
+		KeyName:     keyName,
+	}) + fmt.Sprintf(`
+ephemeral "sentry_key" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	%[1]s
+}
+
+provider "echo" {
+	data = ephemeral.sentry_key.test
+}
+
+resource "echo" "test" {}
+`, extras)
+}
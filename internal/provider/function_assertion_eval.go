@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/uptimeassertion"
+)
+
+var _ function.Function = &AssertionEvalFunction{}
+
+func NewAssertionEvalFunction() function.Function {
+	return &AssertionEvalFunction{}
+}
+
+type AssertionEvalFunction struct {
+}
+
+func (f AssertionEvalFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "assertion_eval"
+}
+
+func (f AssertionEvalFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Evaluates an uptime assertion against a sample HTTP response and returns whether the response passes. Useful for testing assertions before they are used by an uptime monitor. Header names are compared case-insensitively, and a JSONPath operation passes when any selected value matches.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "assertion",
+				MarkdownDescription: "The assertion, as created by `assertion`, or a single operation created by one of the `op_` functions.",
+				CustomType:          jsontypes.NormalizedType{},
+			},
+			function.Int64Parameter{
+				Name:                "status_code",
+				MarkdownDescription: "The HTTP status code of the response.",
+			},
+			function.MapParameter{
+				Name:                "headers",
+				MarkdownDescription: "The HTTP headers of the response.",
+				ElementType:         types.StringType,
+			},
+			function.StringParameter{
+				Name:                "body",
+				MarkdownDescription: "The body of the response.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f AssertionEvalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var assertion string
+	var statusCode int64
+	var headers map[string]string
+	var body string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &assertion, &statusCode, &headers, &body))
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := uptimeassertion.Parse([]byte(assertion))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	httpHeaders := make(http.Header, len(headers))
+	for key, value := range headers {
+		httpHeaders.Add(key, value)
+	}
+
+	result := parsed.Evaluate(uptimeassertion.Response{
+		StatusCode: int(statusCode),
+		Headers:    httpHeaders,
+		Body:       []byte(body),
+	})
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAssertionEvalFunction_known(t *testing.T) {
+	config := func(statusCode string, contentType string, body string) string {
+		return `
+			locals {
+				assertion = provider::sentry::assertion(
+					provider::sentry::op_and(
+						provider::sentry::op_status_code_check("equals", 200),
+						provider::sentry::op_header_check(
+							"equals",
+							provider::sentry::op_header_operand_literal("content-type"),
+							"equals",
+							provider::sentry::op_header_operand_glob("application/json*"),
+						),
+						provider::sentry::op_jsonpath(
+							provider::sentry::op_jsonpath_operand_literal("ok"),
+							"equals",
+							"$.status",
+						),
+					),
+				)
+			}
+
+			output "test" {
+				value = provider::sentry::assertion_eval(local.assertion, ` + statusCode + `, { "Content-Type" = "` + contentType + `" }, jsonencode(` + body + `))
+			}
+		`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("200", "application/json; charset=utf-8", `{ status = "ok" }`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: config("500", "application/json", `{ status = "ok" }`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: config("200", "text/html", `{ status = "ok" }`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: config("200", "application/json", `{ status = "degraded" }`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::assertion_eval(provider::sentry::op_status_code_check("less_than", 400), 204, {}, "")
+					}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::assertion_eval(
+							provider::sentry::op_jsonpath(provider::sentry::op_jsonpath_operand_literal("ok"), "equals", "status"),
+							200,
+							{},
+							"{}",
+						)
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Invalid value for "assertion" parameter: invalid JSONPath "status" at position 0: expected the expression to start with $.`),
+			},
+		},
+	})
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/crontab"
+)
+
+var _ function.Function = &CronNextFunction{}
+
+func NewCronNextFunction() function.Function {
+	return &CronNextFunction{}
+}
+
+type CronNextFunction struct {
+}
+
+func (f CronNextFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_next"
+}
+
+func (f CronNextFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Returns the next times a crontab schedule runs, as computed for the `next_runs` attribute of `sentry_cron_monitor`. Each time is in RFC 3339 format and in the given time zone.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "crontab",
+				MarkdownDescription: "The crontab schedule, e.g. `0 0 * * *` or `@daily`.",
+			},
+			function.StringParameter{
+				Name:                "timezone",
+				MarkdownDescription: "The time zone of the schedule, e.g. `UTC` or `America/New_York`.",
+			},
+			function.StringParameter{
+				Name:                "from",
+				MarkdownDescription: "The RFC 3339 timestamp to start from, e.g. `plantimestamp()`. Only times after it are returned.",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: "The number of times to return, between 1 and 100.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f CronNextFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schedule, timezone, from string
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &schedule, &timezone, &from, &count))
+	if resp.Error != nil {
+		return
+	}
+
+	s, err := crontab.Parse(schedule)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("unknown time zone %q", timezone))
+		return
+	}
+
+	fromTime, err := time.Parse(time.RFC3339, from)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, "must be an RFC 3339 timestamp")
+		return
+	}
+
+	if count < 1 || count > 100 {
+		resp.Error = function.NewArgumentFuncError(3, "must be between 1 and 100")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, cronNextRuns(s, loc, fromTime, int(count))))
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestCronNextFunction_known(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::cron_next("0 9 * * MON-FRI", "UTC", "2024-01-05T12:00:00Z", 3)
+					}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("2024-01-08T09:00:00Z"),
+						knownvalue.StringExact("2024-01-09T09:00:00Z"),
+						knownvalue.StringExact("2024-01-10T09:00:00Z"),
+					})),
+				},
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::cron_next("@daily", "America/New_York", "2024-03-09T12:00:00Z", 2)
+					}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("2024-03-10T00:00:00-05:00"),
+						knownvalue.StringExact("2024-03-11T00:00:00-04:00"),
+					})),
+				},
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::cron_next("0 0 30 2 *", "UTC", "2024-01-01T00:00:00Z", 1)
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Invalid value for "crontab" parameter: the schedule "0 0 30 2 *" never runs.`),
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::cron_next("@daily", "Mars/Olympus_Mons", "2024-01-01T00:00:00Z", 1)
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Invalid value for "timezone" parameter: unknown time zone "Mars/Olympus_Mons".`),
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::cron_next("@daily", "UTC", "tomorrow", 1)
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Invalid value for "from" parameter: must be an RFC 3339 timestamp.`),
+			},
+		},
+	})
+}
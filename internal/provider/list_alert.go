@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+type AlertListResourceModel struct {
+	Organization types.String `tfsdk:"organization"`
+}
+
+var _ list.ListResource = &AlertListResource{}
+var _ list.ListResourceWithConfigure = &AlertListResource{}
+
+func NewAlertListResource() list.ListResource {
+	return &AlertListResource{}
+}
+
+type AlertListResource struct {
+	baseListResource
+}
+
+func (r *AlertListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert"
+}
+
+func (r *AlertListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "List the Alerts of an organization.",
+		Attributes: map[string]listschema.Attribute{
+			"organization": listschema.StringAttribute{
+				MarkdownDescription: "The organization slug or internal ID to list alerts for.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *AlertListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config AlertListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var workflows []apiclient.OrganizationWorkflow
+	params := &apiclient.ListOrganizationWorkflowsParams{}
+	for {
+		httpResp, err := r.apiClient.ListOrganizationWorkflowsWithResponse(ctx, config.Organization.ValueString(), params)
+		if err != nil {
+			diags.Append(diagutils.NewClientError("read", err))
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			diags.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		workflows = append(workflows, *httpResp.JSON200...)
+
+		params.Cursor = sentryclient.ParseNextPaginationCursor(httpResp.HTTPResponse)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	stream.Results = newListResults(ctx, req, workflows, func(workflow apiclient.OrganizationWorkflow) (string, any, any, diag.Diagnostics) {
+		var data AlertResourceModel
+		data.Organization = supertypes.NewStringValue(config.Organization.ValueString())
+		diags := data.Fill(ctx, workflow)
+		return workflow.Name, data.Identity(), &data, diags
+	})
+}
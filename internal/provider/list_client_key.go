@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+)
+
+type ClientKeyListResourceModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+}
+
+var _ list.ListResource = &ClientKeyListResource{}
+var _ list.ListResourceWithConfigure = &ClientKeyListResource{}
+
+func NewClientKeyListResource() list.ListResource {
+	return &ClientKeyListResource{}
+}
+
+type ClientKeyListResource struct {
+	baseListResource
+}
+
+func (r *ClientKeyListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key"
+}
+
+func (r *ClientKeyListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "List the client keys of a project.",
+		Attributes: map[string]listschema.Attribute{
+			"organization": listschema.StringAttribute{
+				MarkdownDescription: "The organization the project belongs to.",
+				Required:            true,
+			},
+			"project": listschema.StringAttribute{
+				MarkdownDescription: "The project to list client keys for.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ClientKeyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ClientKeyListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var keys []apiclient.ProjectKey
+	params := &apiclient.ListProjectClientKeysParams{}
+	for {
+		httpResp, err := r.apiClient.ListProjectClientKeysWithResponse(ctx, config.Organization.ValueString(), config.Project.ValueString(), params)
+		if err != nil {
+			diags.Append(diagutils.NewClientError("read", err))
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			diags.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		keys = append(keys, *httpResp.JSON200...)
+
+		params.Cursor = sentryclient.ParseNextPaginationCursor(httpResp.HTTPResponse)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	stream.Results = newListResults(ctx, req, keys, func(key apiclient.ProjectKey) (string, any, any, diag.Diagnostics) {
+		var data ClientKeyResourceModel
+		data.Organization = config.Organization
+		data.Project = config.Project
+		diags := data.Fill(ctx, key)
+		return key.Name, data.Identity(), &data, diags
+	})
+}
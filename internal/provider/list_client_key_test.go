@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccClientKeyListResource(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	keyName := acctest.RandomWithPrefix("tf-key")
+	ln := "sentry_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientKeyResourceConfig(testAccClientKeyResourceConfigData{
+					TeamName:    teamName,
+					ProjectName: projectName,
+					KeyName:     keyName,
+				}),
+			},
+			{
+				Query: true,
+				Config: fmt.Sprintf(`
+					provider "sentry" {}
+
+					list "sentry_key" "test" {
+						provider = sentry
+
+						config {
+							organization = "%[1]s"
+							project      = "%[2]s"
+						}
+					}
+				`, acctest.TestOrganization, projectName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast(ln, 1),
+					querycheck.ExpectIdentity(ln, map[string]knownvalue.Check{
+						"organization": knownvalue.StringExact(acctest.TestOrganization),
+						"project":      knownvalue.StringExact(projectName),
+						"id":           knownvalue.NotNull(),
+					}),
+					querycheck.ExpectResourceDisplayName(ln, queryfilter.ByDisplayName(knownvalue.StringExact(keyName)), knownvalue.StringExact(keyName)),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+var _ list.ListResource = &CronMonitorListResource{}
+var _ list.ListResourceWithConfigure = &CronMonitorListResource{}
+
+func NewCronMonitorListResource() list.ListResource {
+	return &CronMonitorListResource{}
+}
+
+type CronMonitorListResource struct {
+	baseListResource
+}
+
+func (r *CronMonitorListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cron_monitor"
+}
+
+func (r *CronMonitorListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = monitorListResourceSchema("List the Cron Monitors of an organization.")
+}
+
+func (r *CronMonitorListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config MonitorListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	monitors, projectIdToSlugMap, diags := r.listMonitors(ctx, config, "monitor_check_in_failure")
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = newListResults(ctx, req, monitors, func(monitor apiclient.ProjectMonitor) (string, any, any, diag.Diagnostics) {
+		var data CronMonitorResourceModel
+		data.Organization = supertypes.NewStringValue(config.Organization.ValueString())
+		data.Project = supertypes.NewStringValue(monitorProjectSlug(projectIdToSlugMap, monitor))
+		diags := data.Fill(ctx, monitor)
+		return monitor.Name, data.Identity(), &data, diags
+	})
+}
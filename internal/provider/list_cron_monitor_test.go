@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccCronMonitorListResource(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-project")
+	monitorName := acctest.RandomWithPrefix("tf-cron-monitor")
+	ln := "sentry_cron_monitor.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCronMonitorResourceConfig(projectName, monitorName, `
+					schedule = {
+						crontab = "0 0 * * *"
+					}
+				`),
+			},
+			{
+				Query:  true,
+				Config: testAccMonitorListResourceConfig("sentry_cron_monitor", projectName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength(ln, 1),
+					querycheck.ExpectIdentity(ln, map[string]knownvalue.Check{
+						"organization": knownvalue.StringExact(acctest.TestOrganization),
+						"id":           knownvalue.NotNull(),
+					}),
+					querycheck.ExpectResourceKnownValues(ln, queryfilter.ByDisplayName(knownvalue.StringExact(monitorName)), []querycheck.KnownValueCheck{
+						{
+							Path:       tfjsonpath.New("project"),
+							KnownValue: knownvalue.StringExact(projectName),
+						},
+					}),
+					querycheck.ExpectResourceDisplayName(ln, queryfilter.ByDisplayName(knownvalue.StringExact(monitorName)), knownvalue.StringExact(monitorName)),
+				},
+			},
+		},
+	})
+}
+
+func testAccMonitorListResourceConfig(typeName, projectName string) string {
+	return fmt.Sprintf(`
+		provider "sentry" {}
+
+		list "%[1]s" "test" {
+			provider         = sentry
+			include_resource = true
+
+			config {
+				organization = "%[2]s"
+				project      = "%[3]s"
+			}
+		}
+	`, typeName, acctest.TestOrganization, projectName)
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccMetricMonitorListResource(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-project")
+	monitorName := acctest.RandomWithPrefix("tf-metric-monitor")
+	ln := "sentry_metric_monitor.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricMonitorResourceConfig(projectName, monitorName, `
+					aggregate = "count()"
+					dataset = "events"
+					event_types = ["default", "error"]
+					query = "is:unresolved"
+					query_type = "error"
+					time_window_seconds = 3600
+
+					condition_group = {
+						conditions = [
+							{
+								type = "gt"
+								comparison = 100
+								condition_result = 75
+							},
+						]
+					}
+				`),
+			},
+			{
+				Query:  true,
+				Config: testAccMonitorListResourceConfig("sentry_metric_monitor", projectName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength(ln, 1),
+					querycheck.ExpectIdentity(ln, map[string]knownvalue.Check{
+						"organization": knownvalue.StringExact(acctest.TestOrganization),
+						"id":           knownvalue.NotNull(),
+					}),
+					querycheck.ExpectResourceKnownValues(ln, queryfilter.ByDisplayName(knownvalue.StringExact(monitorName)), []querycheck.KnownValueCheck{
+						{
+							Path:       tfjsonpath.New("project"),
+							KnownValue: knownvalue.StringExact(projectName),
+						},
+					}),
+				},
+			},
+		},
+	})
+}
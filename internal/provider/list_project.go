@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+)
+
+type ProjectListResourceModel struct {
+	Organization types.String `tfsdk:"organization"`
+}
+
+var _ list.ListResource = &ProjectListResource{}
+var _ list.ListResourceWithConfigure = &ProjectListResource{}
+
+func NewProjectListResource() list.ListResource {
+	return &ProjectListResource{}
+}
+
+type ProjectListResource struct {
+	baseListResource
+}
+
+func (r *ProjectListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *ProjectListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "List the projects of an organization.",
+		Attributes: map[string]listschema.Attribute{
+			"organization": listschema.StringAttribute{
+				MarkdownDescription: "The organization slug or internal ID to list projects for.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ProjectListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var projects []apiclient.Project
+	params := &apiclient.ListOrganizationProjectsParams{}
+	for {
+		httpResp, err := r.apiClient.ListOrganizationProjectsWithResponse(ctx, config.Organization.ValueString(), params)
+		if err != nil {
+			diags.Append(diagutils.NewClientError("read", err))
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			diags.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		projects = append(projects, *httpResp.JSON200...)
+
+		params.Cursor = sentryclient.ParseNextPaginationCursor(httpResp.HTTPResponse)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	stream.Results = newListResults(ctx, req, projects, func(project apiclient.Project) (string, any, any, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		data := ProjectResourceModel{
+			Organization: config.Organization,
+			Id:           types.StringValue(project.Slug),
+		}
+		if !req.IncludeResource {
+			return project.Name, data.Identity(), &data, diags
+		}
+
+		// The list endpoint omits the project options, so fetch the full project
+		httpResp, err := r.apiClient.GetOrganizationProjectWithResponse(ctx, config.Organization.ValueString(), project.Slug)
+		if err != nil {
+			diags.Append(diagutils.NewClientError("read", err))
+			return project.Name, nil, nil, diags
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			diags.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+			return project.Name, nil, nil, diags
+		}
+
+		diags.Append(data.Fill(ctx, *httpResp.JSON200)...)
+		return project.Name, data.Identity(), &data, diags
+	})
+}
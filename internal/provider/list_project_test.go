@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccProjectListResource(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+	projectName := acctest.RandomWithPrefix("tf-project")
+	ln := "sentry_project.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfig(testAccProjectResourceConfigData{
+					TeamName:    teamName,
+					ProjectName: projectName,
+					Platform:    "go",
+				}),
+			},
+			{
+				Query: true,
+				Config: fmt.Sprintf(`
+					provider "sentry" {}
+
+					list "sentry_project" "test" {
+						provider         = sentry
+						include_resource = true
+
+						config {
+							organization = "%[1]s"
+						}
+					}
+				`, acctest.TestOrganization),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast(ln, 1),
+					querycheck.ExpectIdentity(ln, map[string]knownvalue.Check{
+						"organization": knownvalue.StringExact(acctest.TestOrganization),
+						"id":           knownvalue.StringExact(projectName),
+					}),
+					querycheck.ExpectResourceKnownValues(ln, queryfilter.ByDisplayName(knownvalue.StringExact(projectName)), []querycheck.KnownValueCheck{
+						{
+							Path:       tfjsonpath.New("platform"),
+							KnownValue: knownvalue.StringExact("go"),
+						},
+					}),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/providerdata"
+)
+
+type baseListResource struct {
+	client    *sentry.Client
+	apiClient *apiclient.ClientWithResponses
+}
+
+func (r *baseListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData := req.ProviderData.(*providerdata.ProviderData)
+
+	r.client = providerData.Client
+	r.apiClient = providerData.ApiClient
+}
+
+// listResultFunc converts a remote object into the display name, identity and
+// resource model of a list result.
+type listResultFunc[T any] func(item T) (displayName string, identity any, data any, diags diag.Diagnostics)
+
+// newListResults streams one list result per item, honouring the result limit
+// requested by Terraform.
+func newListResults[T any](ctx context.Context, req list.ListRequest, items []T, fn listResultFunc[T]) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+
+			displayName, identity, data, diags := fn(item)
+			result.DisplayName = displayName
+			result.Diagnostics.Append(diags...)
+			if !result.Diagnostics.HasError() {
+				result.Diagnostics.Append(result.Identity.Set(ctx, identity)...)
+			}
+			if !result.Diagnostics.HasError() && req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, data)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
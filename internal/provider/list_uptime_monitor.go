@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+var _ list.ListResource = &UptimeMonitorListResource{}
+var _ list.ListResourceWithConfigure = &UptimeMonitorListResource{}
+
+func NewUptimeMonitorListResource() list.ListResource {
+	return &UptimeMonitorListResource{}
+}
+
+type UptimeMonitorListResource struct {
+	baseListResource
+}
+
+func (r *UptimeMonitorListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_uptime_monitor"
+}
+
+func (r *UptimeMonitorListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = monitorListResourceSchema("List the Uptime Monitors of an organization.")
+}
+
+func (r *UptimeMonitorListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config MonitorListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	monitors, projectIdToSlugMap, diags := r.listMonitors(ctx, config, "uptime_domain_failure")
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = newListResults(ctx, req, monitors, func(monitor apiclient.ProjectMonitor) (string, any, any, diag.Diagnostics) {
+		var data UptimeMonitorResourceModel
+		data.Organization = supertypes.NewStringValue(config.Organization.ValueString())
+		data.Project = supertypes.NewStringValue(monitorProjectSlug(projectIdToSlugMap, monitor))
+		diags := data.Fill(ctx, monitor)
+		return monitor.Name, data.Identity(), &data, diags
+	})
+}
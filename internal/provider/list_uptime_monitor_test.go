@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccUptimeMonitorListResource(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-project")
+	monitorName := acctest.RandomWithPrefix("tf-uptime-monitor")
+	ln := "sentry_uptime_monitor.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUptimeMonitorResourceConfig(projectName, monitorName, `
+					url = "https://sentry.io"
+					method = "GET"
+					interval_seconds = 60
+					timeout_ms = 5000
+				`),
+			},
+			{
+				Query:  true,
+				Config: testAccMonitorListResourceConfig("sentry_uptime_monitor", projectName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength(ln, 1),
+					querycheck.ExpectIdentity(ln, map[string]knownvalue.Check{
+						"organization": knownvalue.StringExact(acctest.TestOrganization),
+						"id":           knownvalue.NotNull(),
+					}),
+					querycheck.ExpectResourceKnownValues(ln, queryfilter.ByDisplayName(knownvalue.StringExact(monitorName)), []querycheck.KnownValueCheck{
+						{
+							Path:       tfjsonpath.New("project"),
+							KnownValue: knownvalue.StringExact(projectName),
+						},
+					}),
+				},
+			},
+		},
+	})
+}
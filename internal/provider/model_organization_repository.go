@@ -1,12 +1,11 @@
 package provider
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/jianyuan/go-sentry/v2/sentry"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 )
 
 type OrganizationRepositoryModel struct {
@@ -17,21 +16,35 @@ type OrganizationRepositoryModel struct {
 	Identifier      types.String `tfsdk:"identifier"`
 }
 
-func (m *OrganizationRepositoryModel) Fill(organization string, repo sentry.OrganizationRepository) error {
-	m.Id = types.StringValue(repo.ID)
+func (m *OrganizationRepositoryModel) Fill(organization string, repo apiclient.OrganizationRepository) error {
+	m.Id = types.StringValue(repo.Id)
 	m.Organization = types.StringValue(organization)
-	m.IntegrationType = types.StringValue(strings.TrimPrefix(repo.Provider.ID, "integrations:"))
+	m.IntegrationType = types.StringValue(strings.TrimPrefix(repo.Provider.Id, "integrations:"))
 	m.IntegrationId = types.StringValue(repo.IntegrationId)
 
-	var identifierStr string
-	var identifierNum json.Number
-	if err := json.Unmarshal(repo.ExternalSlug, &identifierStr); err == nil {
-		m.Identifier = types.StringValue(identifierStr)
-	} else if err := json.Unmarshal(repo.ExternalSlug, &identifierNum); err == nil {
-		m.Identifier = types.StringValue(identifierNum.String())
+	if identifier, err := repo.ExternalSlug.AsOrganizationRepositoryExternalSlug0(); err == nil {
+		m.Identifier = types.StringValue(identifier)
+	} else if identifier, err := repo.ExternalSlug.AsOrganizationRepositoryExternalSlug1(); err == nil {
+		m.Identifier = types.StringValue(identifier.String())
 	} else {
-		return fmt.Errorf("failed to unmarshal identifier: %s", repo.ExternalSlug)
+		return fmt.Errorf("failed to unmarshal identifier: %w", err)
 	}
 
 	return nil
 }
+
+type OrganizationRepositoryResourceIdentityModel struct {
+	Organization    types.String `tfsdk:"organization"`
+	IntegrationType types.String `tfsdk:"integration_type"`
+	IntegrationId   types.String `tfsdk:"integration_id"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func (m OrganizationRepositoryModel) Identity() OrganizationRepositoryResourceIdentityModel {
+	return OrganizationRepositoryResourceIdentityModel{
+		Organization:    m.Organization,
+		IntegrationType: m.IntegrationType,
+		IntegrationId:   m.IntegrationId,
+		Id:              m.Id,
+	}
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+)
+
+// listOrganizationMonitors returns every monitor of an organization matching
+// the given parameters, following the pagination cursor.
+func listOrganizationMonitors(ctx context.Context, apiClient *apiclient.ClientWithResponses, organization string, params apiclient.ListOrganizationMonitorsParams) ([]apiclient.ProjectMonitor, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var monitors []apiclient.ProjectMonitor
+
+	for {
+		httpResp, err := apiClient.ListOrganizationMonitorsWithResponse(ctx, organization, &params)
+		if err != nil {
+			diags.Append(diagutils.NewClientError("read", err))
+			return nil, diags
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			diags.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+			return nil, diags
+		}
+
+		monitors = append(monitors, *httpResp.JSON200...)
+
+		params.Cursor = sentryclient.ParseNextPaginationCursor(httpResp.HTTPResponse)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	return monitors, diags
+}
+
+// readOrganizationProject returns a project by its slug or internal ID. The
+// monitors endpoints only accept project IDs, so it is used to resolve slugs.
+func readOrganizationProject(ctx context.Context, apiClient *apiclient.ClientWithResponses, organization string, project string) (*apiclient.Project, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	httpResp, err := apiClient.GetOrganizationProjectWithResponse(ctx, organization, project)
+	if err != nil {
+		diags.Append(diagutils.NewClientError("read project", err))
+		return nil, diags
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		diags.Append(diagutils.NewNotFoundError("project"))
+		return nil, diags
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		diags.Append(diagutils.NewClientStatusError("read project", httpResp.StatusCode(), httpResp.Body))
+		return nil, diags
+	}
+
+	return httpResp.JSON200, diags
+}
+
+// findProjectMonitor returns the first monitor of the given type in a project.
+// It is used to look up the default monitors Sentry creates for every project.
+func findProjectMonitor(ctx context.Context, apiClient *apiclient.ClientWithResponses, organization string, project string, monitorType string) (*apiclient.ProjectMonitor, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	projectData := tfutils.MergeDiagnostics(readOrganizationProject(ctx, apiClient, organization, project))(&diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	monitors := tfutils.MergeDiagnostics(listOrganizationMonitors(ctx, apiClient, organization, apiclient.ListOrganizationMonitorsParams{
+		Project: new(projectData.Id),
+		Query:   new(fmt.Sprintf("type:%s", monitorType)),
+	}))(&diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(monitors) == 0 {
+		diags.Append(diagutils.NewNotFoundError(fmt.Sprintf("%s monitor of project %q", monitorType, project)))
+		return nil, diags
+	}
+
+	return &monitors[0], diags
+}
+
+type MonitorListResourceModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	Query        types.String `tfsdk:"query"`
+}
+
+func monitorListResourceSchema(description string) listschema.Schema {
+	return listschema.Schema{
+		MarkdownDescription: description,
+		Attributes: map[string]listschema.Attribute{
+			"organization": listschema.StringAttribute{
+				MarkdownDescription: "The organization slug or internal ID to list monitors for.",
+				Required:            true,
+			},
+			"project": listschema.StringAttribute{
+				MarkdownDescription: "The project slug or internal ID to limit the results to.",
+				Optional:            true,
+			},
+			"query": listschema.StringAttribute{
+				MarkdownDescription: "An additional Sentry search query to filter the monitors by, e.g. `name:checkout`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// listMonitors lists the monitors of the given type selected by the list
+// configuration, along with the slugs of their projects keyed by project ID.
+func (r *baseListResource) listMonitors(ctx context.Context, config MonitorListResourceModel, monitorType string) ([]apiclient.ProjectMonitor, map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var params apiclient.ListOrganizationMonitorsParams
+	var projectIdToSlugMap map[string]string
+
+	if !config.Project.IsNull() {
+		project := tfutils.MergeDiagnostics(readOrganizationProject(ctx, r.apiClient, config.Organization.ValueString(), config.Project.ValueString()))(&diags)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+
+		params.Project = new(project.Id)
+		projectIdToSlugMap = map[string]string{project.Id: project.Slug}
+	} else {
+		projectIdToSlugMap = tfutils.MergeDiagnostics(readProjectIdToSlugMap(ctx, r.apiClient, config.Organization.ValueString()))(&diags)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+	}
+
+	queryParts := []string{fmt.Sprintf("type:%s", monitorType)}
+	if v := config.Query.ValueString(); v != "" {
+		queryParts = append(queryParts, v)
+	}
+	params.Query = new(strings.Join(queryParts, " "))
+
+	monitors := tfutils.MergeDiagnostics(listOrganizationMonitors(ctx, r.apiClient, config.Organization.ValueString(), params))(&diags)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	return monitors, projectIdToSlugMap, diags
+}
+
+// monitorProjectSlug returns the slug of a monitor's project, falling back to
+// the project ID if the project is not in the map.
+func monitorProjectSlug(projectIdToSlugMap map[string]string, monitor apiclient.ProjectMonitor) string {
+	if slug, ok := projectIdToSlugMap[monitor.ProjectId]; ok {
+		return slug
+	}
+	return monitor.ProjectId
+}
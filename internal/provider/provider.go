@@ -5,13 +5,24 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/jianyuan/go-sentry/v2/sentry"
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 	"github.com/jianyuan/terraform-provider-sentry/internal/providerdata"
@@ -20,6 +31,8 @@ import (
 
 var _ provider.Provider = &SentryProvider{}
 var _ provider.ProviderWithFunctions = &SentryProvider{}
+var _ provider.ProviderWithEphemeralResources = &SentryProvider{}
+var _ provider.ProviderWithListResources = &SentryProvider{}
 
 // SentryProvider defines the provider implementation.
 type SentryProvider struct {
@@ -31,8 +44,51 @@ type SentryProvider struct {
 
 // SentryProviderModel describes the provider data model.
 type SentryProviderModel struct {
-	Token   types.String `tfsdk:"token"`
-	BaseUrl types.String `tfsdk:"base_url"`
+	Token               types.String               `tfsdk:"token"`
+	TokenFile           types.String               `tfsdk:"token_file"`
+	OAuth               []SentryProviderOAuthModel `tfsdk:"oauth"`
+	Exec                []SentryProviderExecModel  `tfsdk:"exec"`
+	BaseUrl             types.String               `tfsdk:"base_url"`
+	DefaultOrganization types.String               `tfsdk:"default_organization"`
+	DefaultProject      types.String               `tfsdk:"default_project"`
+	CACertFile          types.String               `tfsdk:"ca_cert_file"`
+	CACertPem           types.String               `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify  types.Bool                 `tfsdk:"insecure_skip_verify"`
+	ProxyUrl            types.String               `tfsdk:"proxy_url"`
+	ClientCert          types.String               `tfsdk:"client_cert"`
+	ClientKey           types.String               `tfsdk:"client_key"`
+	Timeout             types.String               `tfsdk:"timeout"`
+	ExtraHeaders        types.Map                  `tfsdk:"extra_headers"`
+	RequestsPerSecond   types.Float64              `tfsdk:"requests_per_second"`
+	MaxConcurrency      types.Int64                `tfsdk:"max_concurrency"`
+	Retry               []SentryProviderRetryModel `tfsdk:"retry"`
+	SkipHealthCheck     types.Bool                 `tfsdk:"skip_health_check"`
+	DisableCache        types.Bool                 `tfsdk:"disable_cache"`
+}
+
+// SentryProviderOAuthModel describes the oauth block of the provider.
+type SentryProviderOAuthModel struct {
+	TokenUrl     types.String `tfsdk:"token_url"`
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	RefreshToken types.String `tfsdk:"refresh_token"`
+}
+
+// SentryProviderExecModel describes the exec block of the provider.
+type SentryProviderExecModel struct {
+	Command types.String `tfsdk:"command"`
+	Args    types.List   `tfsdk:"args"`
+	Env     types.Map    `tfsdk:"env"`
+}
+
+// SentryProviderRetryModel describes the retry block of the provider.
+type SentryProviderRetryModel struct {
+	MaxAttempts          types.Int64  `tfsdk:"max_attempts"`
+	MinBackoff           types.String `tfsdk:"min_backoff"`
+	MaxBackoff           types.String `tfsdk:"max_backoff"`
+	Jitter               types.Bool   `tfsdk:"jitter"`
+	RetryableStatusCodes types.List   `tfsdk:"retryable_status_codes"`
+	MaxWait              types.String `tfsdk:"max_wait"`
 }
 
 func (p *SentryProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -48,15 +104,219 @@ func (p *SentryProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the authentication token used to connect to Sentry. The file is read again whenever it changes, so that the token can be rotated. Conflicts with `token`, `oauth` and `exec`.",
+				Optional:            true,
+			},
 			"base_url": schema.StringAttribute{
-				MarkdownDescription: "The target Sentry Base API URL in the format `https://[hostname]/api/`. The default value is `https://sentry.io/api/`. The value must be provided when working with Sentry On-Premise. The value can be sourced from the `SENTRY_BASE_URL` environment variable.",
+				MarkdownDescription: "The target Sentry Base API URL in the format `https://[hostname]/api/`. The default value is `https://sentry.io/api/`. The value must be provided when working with Sentry On-Premise. The value can be sourced from the `SENTRY_BASE_URL` environment variable. On sentry.io, the requests of each organization are sent to the data storage region hosting it, e.g. `https://de.sentry.io`.",
+				Optional:            true,
+			},
+			"default_organization": schema.StringAttribute{
+				MarkdownDescription: "The organization used by resources and data sources that do not set `organization`. The value can be sourced from the `SENTRY_ORGANIZATION` environment variable.",
+				Optional:            true,
+			},
+			"default_project": schema.StringAttribute{
+				MarkdownDescription: "The project used by resources and data sources that do not set `project`. The value can be sourced from the `SENTRY_PROJECT` environment variable.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM-encoded CA certificate bundle to trust when connecting to Sentry, in addition to the system certificate pool.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA certificate bundle to trust when connecting to Sentry, in addition to the system certificate pool.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip TLS certificate verification when connecting to Sentry. Only use this for testing.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the proxy used to connect to Sentry, e.g. `http://proxy.example.com:3128`. Defaults to the proxy configured by the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client certificate presented to Sentry for mutual TLS. Must be set together with `client_key`.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded private key of the client certificate used for mutual TLS. Must be set together with `client_cert`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "The maximum duration of each attempt of a request to Sentry, as a duration string such as `30s` or `2m`. Every retry gets a fresh timeout. Defaults to no timeout.",
+				Optional:            true,
+			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers sent with every request to Sentry, e.g. headers required by an authenticating proxy. The `Authorization` and `User-Agent` headers set by the provider take precedence.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "The maximum number of requests per second sent to Sentry. Defaults to no limit.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrency": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of concurrent requests sent to Sentry. Defaults to the concurrency limit reported by Sentry.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"skip_health_check": schema.BoolAttribute{
+				MarkdownDescription: "Skip the request made to Sentry to verify the base URL and credentials when the provider is configured, e.g. when Sentry is not reachable while running `terraform validate`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"disable_cache": schema.BoolAttribute{
+				MarkdownDescription: "Disable the in-memory cache of responses to `GET` requests. The cache only lasts for a single Terraform command and is cleared by every change made by the provider. Defaults to `false`.",
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"oauth": schema.ListNestedBlock{
+				MarkdownDescription: "Authenticate with OAuth access tokens, which are refreshed when they expire or are rejected by Sentry. Conflicts with `token`, `token_file` and `exec`. At most one block may be specified.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"token_url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the OAuth token endpoint. Defaults to `/oauth/token/` on the host of `base_url`.",
+							Optional:            true,
+						},
+						"client_id": schema.StringAttribute{
+							MarkdownDescription: "The client ID of the OAuth application.",
+							Required:            true,
+						},
+						"client_secret": schema.StringAttribute{
+							MarkdownDescription: "The client secret of the OAuth application.",
+							Required:            true,
+							Sensitive:           true,
+						},
+						"refresh_token": schema.StringAttribute{
+							MarkdownDescription: "The refresh token exchanged for access tokens. The client credentials grant is used when not set.",
+							Optional:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"exec": schema.ListNestedBlock{
+				MarkdownDescription: "Authenticate with the token printed by a credential helper command. The command either prints the token, or a JSON object with the `token` and an optional RFC 3339 `expires_at` timestamp. The command is run again when the token expires or is rejected by Sentry. Conflicts with `token`, `token_file` and `oauth`. At most one block may be specified.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command": schema.StringAttribute{
+							MarkdownDescription: "The command to run.",
+							Required:            true,
+						},
+						"args": schema.ListAttribute{
+							MarkdownDescription: "The arguments of the command.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+						"env": schema.MapAttribute{
+							MarkdownDescription: "Environment variables added to the environment of the command.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"retry": schema.ListNestedBlock{
+				MarkdownDescription: "Controls how requests that failed with a connection error or a retryable status code are retried. At most one block may be specified.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							MarkdownDescription: "The maximum number of attempts of a request, including the first one. Defaults to `5`.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"min_backoff": schema.StringAttribute{
+							MarkdownDescription: "The minimum duration to wait between attempts, as a duration string such as `500ms`. Defaults to `1s`.",
+							Optional:            true,
+						},
+						"max_backoff": schema.StringAttribute{
+							MarkdownDescription: "The maximum duration to wait between attempts, as a duration string such as `1m`. Defaults to `30s`.",
+							Optional:            true,
+						},
+						"jitter": schema.BoolAttribute{
+							MarkdownDescription: "Randomize the duration to wait between attempts to spread out concurrent retries. Defaults to `false`.",
+							Optional:            true,
+						},
+						"retryable_status_codes": schema.ListAttribute{
+							MarkdownDescription: "The response status codes that are retried. Defaults to `[429, 500, 502, 503, 504]`.",
+							ElementType:         types.Int64Type,
+							Optional:            true,
+						},
+						"max_wait": schema.StringAttribute{
+							MarkdownDescription: "The maximum total duration to wait between the attempts of a request, as a duration string such as `5m`. Defaults to no limit.",
+							Optional:            true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
 	}
 }
 
+// unknownConnectionAttributes returns the connection attributes of the provider
+// configuration whose values are not known yet.
+func unknownConnectionAttributes(config tfsdk.Config) []string {
+	if !config.Raw.IsKnown() {
+		return providerdata.ConnectionAttributes
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := config.Raw.As(&attributes); err != nil {
+		return providerdata.ConnectionAttributes
+	}
+
+	var unknown []string
+	for _, name := range providerdata.ConnectionAttributes {
+		if v, ok := attributes[name]; ok && !v.IsFullyKnown() {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
+
 func (p *SentryProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	// The connection may depend on resources that are not created yet, e.g. a
+	// self-hosted Sentry created in the same configuration. Defer everything until
+	// the values are known, if Terraform supports it.
+	if unknownAttributes := unknownConnectionAttributes(req.Config); len(unknownAttributes) > 0 {
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &provider.Deferred{
+				Reason: provider.DeferredReasonProviderConfigUnknown,
+			}
+			return
+		}
+
+		for _, name := range unknownAttributes {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unknown provider attribute value",
+				fmt.Sprintf("The provider cannot connect to Sentry because the value of the %q attribute is not known until apply. "+
+					"Set it to a value that is known when planning, or use a version of Terraform that supports deferred actions.", name),
+			)
+		}
+		return
+	}
+
 	var data SentryProviderModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -64,12 +324,15 @@ func (p *SentryProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	// The environment variables are ignored when another authentication method is configured.
+	otherAuth := !data.TokenFile.IsNull() || len(data.OAuth) > 0 || len(data.Exec) > 0
+
 	var token string
 	if !data.Token.IsNull() {
 		token = data.Token.ValueString()
-	} else if v := os.Getenv("SENTRY_AUTH_TOKEN"); v != "" {
+	} else if v := os.Getenv("SENTRY_AUTH_TOKEN"); v != "" && !otherAuth {
 		token = v
-	} else if v := os.Getenv("SENTRY_TOKEN"); v != "" {
+	} else if v := os.Getenv("SENTRY_TOKEN"); v != "" && !otherAuth {
 		token = v
 	}
 
@@ -83,15 +346,92 @@ func (p *SentryProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	}
 
 	config := sentryclient.Config{
-		UserAgent: fmt.Sprintf("Terraform/%s (+https://www.terraform.io) terraform-provider-sentry/%s", req.TerraformVersion, p.version),
-		Token:     token,
+		UserAgent:          fmt.Sprintf("Terraform/%s (+https://www.terraform.io) terraform-provider-sentry/%s", req.TerraformVersion, p.version),
+		Token:              token,
+		CACertFile:         data.CACertFile.ValueString(),
+		CACertPEM:          data.CACertPem.ValueString(),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		ProxyURL:           data.ProxyUrl.ValueString(),
+		ClientCertPEM:      data.ClientCert.ValueString(),
+		ClientKeyPEM:       data.ClientKey.ValueString(),
+		RegionRouting:      sentryclient.IsSentryIO(baseUrl),
+	}
+
+	if !data.DisableCache.ValueBool() {
+		// The provider may be configured more than once per process, e.g. by
+		// acceptance tests, so start from an empty cache.
+		sentryclient.SharedCache.Clear()
+		config.Cache = sentryclient.SharedCache
+	}
+
+	if !data.Timeout.IsNull() {
+		timeout, err := time.ParseDuration(data.Timeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid timeout", err.Error())
+			return
+		}
+		config.Timeout = timeout
+	}
+
+	if !data.ExtraHeaders.IsNull() {
+		resp.Diagnostics.Append(data.ExtraHeaders.ElementsAs(ctx, &config.ExtraHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	config.TokenFile = data.TokenFile.ValueString()
+
+	if len(data.OAuth) > 0 {
+		oauth := sentryclient.OAuthConfig{
+			TokenURL:     data.OAuth[0].TokenUrl.ValueString(),
+			ClientID:     data.OAuth[0].ClientId.ValueString(),
+			ClientSecret: data.OAuth[0].ClientSecret.ValueString(),
+			RefreshToken: data.OAuth[0].RefreshToken.ValueString(),
+		}
+		if oauth.TokenURL == "" {
+			tokenUrl, err := sentryclient.DefaultOAuthTokenURL(baseUrl)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("base_url"), "Invalid base URL", err.Error())
+				return
+			}
+			oauth.TokenURL = tokenUrl
+		}
+		config.OAuth = &oauth
 	}
 
-	httpClient := config.HttpClient(ctx)
+	if len(data.Exec) > 0 {
+		exec := sentryclient.ExecConfig{
+			Command: data.Exec[0].Command.ValueString(),
+		}
+		resp.Diagnostics.Append(data.Exec[0].Args.ElementsAs(ctx, &exec.Args, false)...)
+		resp.Diagnostics.Append(data.Exec[0].Env.ElementsAs(ctx, &exec.Env, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		config.Exec = &exec
+	}
+
+	config.RequestsPerSecond = data.RequestsPerSecond.ValueFloat64()
+	config.MaxConcurrency = int(data.MaxConcurrency.ValueInt64())
+
+	if len(data.Retry) > 0 {
+		retry := sentryclient.DefaultRetryConfig()
+		resp.Diagnostics.Append(data.Retry[0].Fill(ctx, &retry)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		config.Retry = &retry
+	}
+
+	httpClient, err := config.HttpClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create HTTP client", err.Error())
+		return
+	}
 
 	// Old Sentry client
 	var client *sentry.Client
-	var err error
 	if baseUrl == "" {
 		client = sentry.NewClient(httpClient)
 	} else {
@@ -112,28 +452,75 @@ func (p *SentryProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	httpResp, err := apiClient.HealthCheckWithResponse(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("failed to perform health check", err.Error())
-		return
-	} else if httpResp.StatusCode() == http.StatusNotFound {
-		resp.Diagnostics.AddError("failed to perform health check", "Sentry API is not available, please check the base URL")
-		return
-	} else if httpResp.StatusCode() == http.StatusUnauthorized {
-		resp.Diagnostics.AddError("failed to perform health check", "Sentry API is not available, Please check the authentication token")
-		return
-	} else if httpResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("failed to perform health check", fmt.Errorf("unexpected status code: %d", httpResp.StatusCode()).Error())
-		return
+	if !data.SkipHealthCheck.ValueBool() {
+		httpResp, err := apiClient.HealthCheckWithResponse(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to perform health check", err.Error())
+			return
+		} else if httpResp.StatusCode() == http.StatusNotFound {
+			resp.Diagnostics.AddError("failed to perform health check", "Sentry API is not available, please check the base URL")
+			return
+		} else if httpResp.StatusCode() == http.StatusUnauthorized {
+			resp.Diagnostics.AddError("failed to perform health check", "Sentry API is not available, Please check the authentication token")
+			return
+		} else if httpResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.AddError("failed to perform health check", fmt.Errorf("unexpected status code: %d", httpResp.StatusCode()).Error())
+			return
+		}
 	}
 
 	providerData := &providerdata.ProviderData{
 		Client:    client,
 		ApiClient: apiClient,
+		Defaults:  providerdata.NewDefaults(data.DefaultOrganization.ValueString(), data.DefaultProject.ValueString()),
 	}
 
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
+	resp.ListResourceData = providerData
+}
+
+// Fill overrides the retry policy with the attributes set in the retry block.
+func (m SentryProviderRetryModel) Fill(ctx context.Context, retry *sentryclient.RetryConfig) (diags diag.Diagnostics) {
+	if !m.MaxAttempts.IsNull() {
+		retry.MaxAttempts = int(m.MaxAttempts.ValueInt64())
+	}
+
+	for _, d := range []struct {
+		name  string
+		value types.String
+		dest  *time.Duration
+	}{
+		{"min_backoff", m.MinBackoff, &retry.MinBackoff},
+		{"max_backoff", m.MaxBackoff, &retry.MaxBackoff},
+		{"max_wait", m.MaxWait, &retry.MaxWait},
+	} {
+		if d.value.IsNull() {
+			continue
+		}
+		duration, err := time.ParseDuration(d.value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("retry").AtListIndex(0).AtName(d.name), "Invalid duration", err.Error())
+			continue
+		}
+		*d.dest = duration
+	}
+
+	if !m.Jitter.IsNull() {
+		retry.Jitter = m.Jitter.ValueBool()
+	}
+
+	if !m.RetryableStatusCodes.IsNull() {
+		var codes []int64
+		diags.Append(m.RetryableStatusCodes.ElementsAs(ctx, &codes, false)...)
+		retry.RetryableStatusCodes = make([]int, len(codes))
+		for i, code := range codes {
+			retry.RetryableStatusCodes[i] = int(code)
+		}
+	}
+
+	return
 }
 
 func (p *SentryProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -147,12 +534,17 @@ func (p *SentryProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewIssueAlertResource,
 		NewNotificationActionResource,
 		NewOrganizationRepositoryResource,
+		NewProjectErrorMonitorResource,
 		NewProjectInboundDataFilterResource,
+		NewProjectIssueStreamMonitorResource,
 		NewProjectResource,
 		NewProjectSpikeProtectionResource,
 		NewProjectSymbolSourcesResource,
+		NewProjectTeamResource,
 		NewProjectOwnershipResource,
 		NewTeamMemberResource,
+		NewTeamMembersResource,
+		NewTeamProjectsResource,
 	)
 }
 
@@ -171,9 +563,30 @@ func (p *SentryProvider) DataSources(ctx context.Context) []func() datasource.Da
 	)
 }
 
+func (p *SentryProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	// Please keep the ephemeral resources sorted by name.
+	return []func() ephemeral.EphemeralResource{
+		NewClientKeyEphemeralResource,
+	}
+}
+
+func (p *SentryProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	// Please keep the list resources sorted by name.
+	return []func() list.ListResource{
+		NewAlertListResource,
+		NewClientKeyListResource,
+		NewCronMonitorListResource,
+		NewMetricMonitorListResource,
+		NewProjectListResource,
+		NewUptimeMonitorListResource,
+	}
+}
+
 func (p *SentryProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
 		NewAssertionFunction,
+		NewAssertionEvalFunction,
+		NewCronNextFunction,
 		NewOpAndFunction,
 		NewOpHeaderCheckFunction,
 		NewOpHeaderOperandGlobFunction,
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jianyuan/terraform-provider-sentry/internal/providerdata"
+)
+
+// providerDefaultAttributes returns the root attributes that fall back to a
+// default configured on the provider.
+func providerDefaultAttributes(defaults *providerdata.Defaults) []providerDefaultAttribute {
+	return []providerDefaultAttribute{
+		{"organization", defaults.Organization},
+		{"project", defaults.Project},
+	}
+}
+
+type providerDefaultAttribute struct {
+	name string
+	def  providerdata.Default
+}
+
+// rawAttribute returns the value of a root attribute of a configuration, plan
+// or state.
+func rawAttribute(raw tftypes.Value, name string) (tftypes.Value, bool) {
+	if raw.IsNull() || !raw.IsKnown() {
+		return tftypes.Value{}, false
+	}
+
+	v, _, err := tftypes.WalkAttributePath(raw, tftypes.NewAttributePath().WithAttributeName(name))
+	if err != nil {
+		return tftypes.Value{}, false
+	}
+
+	value, ok := v.(tftypes.Value)
+	return value, ok
+}
+
+// modifyPlanWithProviderDefaults sets the unconfigured organization and
+// project of a new resource to the provider defaults, with a warning naming
+// where the default came from. Existing resources keep their values, with a
+// warning when they differ from the defaults.
+func modifyPlanWithProviderDefaults(ctx context.Context, defaults *providerdata.Defaults, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when destroying, or when the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || defaults == nil {
+		return
+	}
+
+	for _, a := range providerDefaultAttributes(defaults) {
+		name, def := a.name, a.def
+		attrPath := path.Root(name)
+
+		attr, diags := req.Plan.Schema.AttributeAtPath(ctx, attrPath)
+		if diags.HasError() || !attr.IsOptional() || !attr.IsComputed() {
+			continue
+		}
+
+		if configValue, ok := rawAttribute(req.Config.Raw, name); !ok || !configValue.IsNull() {
+			continue
+		}
+
+		if stateValue, ok := rawAttribute(req.State.Raw, name); ok && !stateValue.IsNull() && stateValue.IsKnown() {
+			var current string
+			if err := stateValue.As(&current); err != nil {
+				continue
+			}
+
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attrPath, current)...)
+			if def.Value != "" && def.Value != current {
+				resp.Diagnostics.AddAttributeWarning(
+					attrPath,
+					fmt.Sprintf("Existing %s differs from the provider default", name),
+					fmt.Sprintf("The %s of this resource is %q, which differs from %q set by %s. Existing resources keep their %s, set the %q attribute explicitly to change it.", name, current, def.Value, def.Source, name, name),
+				)
+			}
+			continue
+		}
+
+		if def.Value == "" {
+			resp.Diagnostics.AddAttributeError(attrPath, fmt.Sprintf("Missing %s", name), def.Missing(name))
+			continue
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attrPath, def.Value)...)
+		resp.Diagnostics.AddAttributeWarning(
+			attrPath,
+			fmt.Sprintf("Using the default %s", name),
+			fmt.Sprintf("The %q attribute is not set, so this resource uses the %s %q set by %s.", name, name, def.Value, def.Source),
+		)
+	}
+}
+
+// applyProviderDefaultsToConfig sets the unconfigured organization and project
+// of a data source or ephemeral resource configuration to the provider
+// defaults, before the configuration is read into its model.
+func applyProviderDefaultsToConfig(ctx context.Context, defaults *providerdata.Defaults, config *tfsdk.Config) (diags diag.Diagnostics) {
+	if defaults == nil {
+		return
+	}
+
+	for _, a := range providerDefaultAttributes(defaults) {
+		name, def := a.name, a.def
+		attrPath := path.Root(name)
+
+		attr, attrDiags := config.Schema.AttributeAtPath(ctx, attrPath)
+		if attrDiags.HasError() || !attr.IsOptional() || !attr.IsComputed() {
+			continue
+		}
+
+		if configValue, ok := rawAttribute(config.Raw, name); !ok || !configValue.IsNull() {
+			continue
+		}
+
+		if def.Value == "" {
+			diags.AddAttributeError(attrPath, fmt.Sprintf("Missing %s", name), def.Missing(name))
+			continue
+		}
+
+		raw, err := tftypes.Transform(config.Raw, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+			if p.Equal(tftypes.NewAttributePath().WithAttributeName(name)) {
+				return tftypes.NewValue(tftypes.String, def.Value), nil
+			}
+			return v, nil
+		})
+		if err != nil {
+			diags.AddAttributeError(attrPath, fmt.Sprintf("Unable to apply the default %s", name), err.Error())
+			continue
+		}
+		config.Raw = raw
+	}
+
+	return
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jianyuan/terraform-provider-sentry/internal/providerdata"
+)
+
+func TestModifyPlanWithProviderDefaults(t *testing.T) {
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{Optional: true, Computed: true},
+			"project":      schema.StringAttribute{Optional: true, Computed: true},
+		},
+	}
+	objectType := s.Type().TerraformType(ctx)
+
+	unknown := tftypes.UnknownValue
+	newObject := func(organization, project any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"organization": tftypes.NewValue(tftypes.String, organization),
+			"project":      tftypes.NewValue(tftypes.String, project),
+		})
+	}
+
+	t.Setenv("SENTRY_PROJECT", "env-project")
+	defaults := providerdata.NewDefaults("provider-org", "")
+
+	testCases := []struct {
+		name             string
+		config           tftypes.Value
+		plan             tftypes.Value
+		state            tftypes.Value
+		wantOrganization string
+		wantProject      string
+		wantWarnings     []string
+	}{
+		{
+			name:             "create",
+			config:           newObject(nil, nil),
+			plan:             newObject(unknown, unknown),
+			state:            tftypes.NewValue(objectType, nil),
+			wantOrganization: "provider-org",
+			wantProject:      "env-project",
+			wantWarnings: []string{
+				`uses the organization "provider-org" set by the "default_organization" provider attribute`,
+				`uses the project "env-project" set by the SENTRY_PROJECT environment variable`,
+			},
+		},
+		{
+			name:             "configured",
+			config:           newObject("org", "project"),
+			plan:             newObject("org", "project"),
+			state:            tftypes.NewValue(objectType, nil),
+			wantOrganization: "org",
+			wantProject:      "project",
+		},
+		{
+			name:             "existing",
+			config:           newObject(nil, nil),
+			plan:             newObject(unknown, unknown),
+			state:            newObject("old-org", "env-project"),
+			wantOrganization: "old-org",
+			wantProject:      "env-project",
+			wantWarnings: []string{
+				`The organization of this resource is "old-org", which differs from "provider-org"`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: tc.config},
+				Plan:   tfsdk.Plan{Schema: s, Raw: tc.plan},
+				State:  tfsdk.State{Schema: s, Raw: tc.state},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: req.Plan,
+			}
+
+			modifyPlanWithProviderDefaults(ctx, &defaults, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+
+			var gotOrganization, gotProject string
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("organization"), &gotOrganization)...)
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("project"), &gotProject)...)
+			if gotOrganization != tc.wantOrganization || gotProject != tc.wantProject {
+				t.Errorf("plan = %q/%q, want %q/%q", gotOrganization, gotProject, tc.wantOrganization, tc.wantProject)
+			}
+
+			warnings := resp.Diagnostics.Warnings()
+			if len(warnings) != len(tc.wantWarnings) {
+				t.Fatalf("warnings = %v, want %d", warnings, len(tc.wantWarnings))
+			}
+			for i, want := range tc.wantWarnings {
+				if got := warnings[i].Detail(); !strings.Contains(got, want) {
+					t.Errorf("warning %d = %q, want it to contain %q", i, got, want)
+				}
+			}
+		})
+	}
+}
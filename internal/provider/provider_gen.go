@@ -10,7 +10,9 @@ var (
 	AutoGeneratedResources = []func() resource.Resource{
 		NewAlertResource,
 		NewCronMonitorResource,
+		NewDashboardResource,
 		NewMetricMonitorResource,
+		NewMonitorResource,
 		NewOrganizationResource,
 		NewOrganizationUserMappingResource,
 		NewUptimeMonitorResource,
@@ -20,6 +22,7 @@ var (
 		NewAllProjectsDataSource,
 		NewCronMonitorDataSource,
 		NewMetricMonitorDataSource,
+		NewMonitorsDataSource,
 		NewOrganizationDataSource,
 		NewProjectDataSource,
 		NewProjectErrorMonitorDataSource,
@@ -2,10 +2,15 @@ package provider
 
 import (
 	"context"
+	"slices"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -75,3 +80,129 @@ func TestAccProvider_validation(t *testing.T) {
 		},
 	})
 }
+
+func newTestProviderConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var schemaResp provider.SchemaResponse
+	(&SentryProvider{}).Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		} else {
+			attrs[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, attrs),
+	}
+}
+
+func TestSentryProvider_ConfigureUnknown(t *testing.T) {
+	ctx := context.Background()
+
+	config := newTestProviderConfig(t, map[string]tftypes.Value{
+		"base_url": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"token":    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+
+	t.Run("deferral allowed", func(t *testing.T) {
+		var resp provider.ConfigureResponse
+		(&SentryProvider{version: "test"}).Configure(ctx, provider.ConfigureRequest{
+			Config: config,
+			ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+				DeferralAllowed: true,
+			},
+		}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %s", resp.Diagnostics)
+		}
+		if resp.Deferred == nil || resp.Deferred.Reason != provider.DeferredReasonProviderConfigUnknown {
+			t.Errorf("expected the provider to be deferred, got %v", resp.Deferred)
+		}
+		if resp.ResourceData != nil {
+			t.Errorf("expected no resource data, got %v", resp.ResourceData)
+		}
+	})
+
+	t.Run("deferral not allowed", func(t *testing.T) {
+		var resp provider.ConfigureResponse
+		(&SentryProvider{version: "test"}).Configure(ctx, provider.ConfigureRequest{
+			Config: config,
+		}, &resp)
+
+		if resp.Deferred != nil {
+			t.Errorf("expected the provider not to be deferred, got %v", resp.Deferred)
+		}
+		if resp.ResourceData != nil {
+			t.Errorf("expected no resource data, got %v", resp.ResourceData)
+		}
+
+		var gotPaths []string
+		for _, d := range resp.Diagnostics.Errors() {
+			if d, ok := d.(diag.DiagnosticWithPath); ok {
+				gotPaths = append(gotPaths, d.Path().String())
+			}
+		}
+		if want := []string{"token", "base_url"}; !slices.Equal(gotPaths, want) {
+			t.Errorf("errors = %v, want errors for %v", resp.Diagnostics, want)
+		}
+	})
+}
+
+func TestSentryProvider_ConfigureUnknownNonConnection(t *testing.T) {
+	ctx := context.Background()
+
+	// Unknown values that the connection does not depend on do not defer the
+	// provider.
+	var resp provider.ConfigureResponse
+	(&SentryProvider{version: "test"}).Configure(ctx, provider.ConfigureRequest{
+		Config: newTestProviderConfig(t, map[string]tftypes.Value{
+			"base_url":             tftypes.NewValue(tftypes.String, "http://127.0.0.1:1/api/"),
+			"token":                tftypes.NewValue(tftypes.String, "token"),
+			"skip_health_check":    tftypes.NewValue(tftypes.Bool, true),
+			"default_organization": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+		ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+			DeferralAllowed: true,
+		},
+	}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics)
+	}
+	if resp.Deferred != nil {
+		t.Errorf("expected the provider not to be deferred, got %v", resp.Deferred)
+	}
+	if resp.ResourceData == nil {
+		t.Error("expected resource data")
+	}
+}
+
+func TestSentryProvider_ConfigureSkipHealthCheck(t *testing.T) {
+	ctx := context.Background()
+
+	var resp provider.ConfigureResponse
+	(&SentryProvider{version: "test"}).Configure(ctx, provider.ConfigureRequest{
+		Config: newTestProviderConfig(t, map[string]tftypes.Value{
+			"base_url":          tftypes.NewValue(tftypes.String, "http://127.0.0.1:1/api/"),
+			"token":             tftypes.NewValue(tftypes.String, "token"),
+			"skip_health_check": tftypes.NewValue(tftypes.Bool, true),
+		}),
+	}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", resp.Diagnostics)
+	}
+	if resp.ResourceData == nil {
+		t.Error("expected resource data")
+	}
+}
@@ -12,6 +12,7 @@ import (
 type baseResource struct {
 	client    *sentry.Client
 	apiClient *apiclient.ClientWithResponses
+	defaults  *providerdata.Defaults
 }
 
 func (r *baseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -24,4 +25,11 @@ func (r *baseResource) Configure(ctx context.Context, req resource.ConfigureRequ
 
 	r.client = providerData.Client
 	r.apiClient = providerData.ApiClient
+	r.defaults = &providerData.Defaults
+}
+
+// ModifyPlan sets the unconfigured organization and project of new resources
+// to the provider defaults.
+func (r *baseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanWithProviderDefaults(ctx, r.defaults, req, resp)
 }
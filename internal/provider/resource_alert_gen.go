@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -28,6 +29,7 @@ import (
 
 var _ resource.Resource = &AlertResource{}
 var _ resource.ResourceWithImportState = &AlertResource{}
+var _ resource.ResourceWithIdentity = &AlertResource{}
 
 func NewAlertResource() resource.Resource {
 	return &AlertResource{}
@@ -54,10 +56,12 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"organization": schema.StringAttribute{
-				MarkdownDescription: "The organization slug or internal ID to create the alert for.",
-				Required:            true,
+				MarkdownDescription: "The organization slug or internal ID to create the alert for. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -1141,6 +1145,7 @@ func (r *AlertResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *AlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -1179,6 +1184,7 @@ func (r *AlertResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *AlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -1213,6 +1219,7 @@ func (r *AlertResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *AlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -1243,6 +1250,35 @@ func (r *AlertResource) ImportState(ctx context.Context, req resource.ImportStat
 	)(ctx, req, resp)
 }
 
+func (r *AlertResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization slug or internal ID to create the alert for.",
+				RequiredForImport: true,
+				CustomType:        supertypes.StringType{},
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The internal ID of this alert.",
+				RequiredForImport: true,
+				CustomType:        supertypes.StringType{},
+			},
+		},
+	}
+}
+
+type AlertResourceIdentityModel struct {
+	Organization supertypes.StringValue `tfsdk:"organization"`
+	Id           supertypes.StringValue `tfsdk:"id"`
+}
+
+func (m AlertResourceModel) Identity() AlertResourceIdentityModel {
+	return AlertResourceIdentityModel{
+		Organization: m.Organization,
+		Id:           m.Id,
+	}
+}
+
 type AlertResourceModel struct {
 	Id                      supertypes.StringValue                                                      `tfsdk:"id"`
 	Organization            supertypes.StringValue                                                      `tfsdk:"organization"`
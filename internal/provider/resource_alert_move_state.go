@@ -0,0 +1,790 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrytypes"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+const issueAlertMoveErrorSummary = "Unable to move sentry_issue_alert to sentry_alert"
+
+var _ resource.ResourceWithMoveState = &AlertResource{}
+
+func (r *AlertResource) MoveState(ctx context.Context) []resource.StateMover {
+	var issueAlertSchema resource.SchemaResponse
+	NewIssueAlertResource().Schema(ctx, resource.SchemaRequest{}, &issueAlertSchema)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &issueAlertSchema.Schema,
+			StateMover:   r.moveIssueAlertState,
+		},
+		{
+			StateMover: r.moveMetricAlertState,
+		},
+	}
+}
+
+// moveIssueAlertState moves a sentry_issue_alert resource to sentry_alert. Sentry
+// migrates every issue alert to a workflow attached to the project's issue stream
+// monitor, so the workflow is looked up rather than created.
+func (r *AlertResource) moveIssueAlertState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != "sentry_issue_alert" || !strings.HasSuffix(req.SourceProviderAddress, "jianyuan/sentry") {
+		return
+	}
+
+	if req.SourceSchemaVersion != 2 || req.SourceState == nil {
+		resp.Diagnostics.AddError(
+			issueAlertMoveErrorSummary,
+			fmt.Sprintf("The source state uses schema version %d, but only version 2 is supported. Apply the configuration with the sentry_issue_alert resource using the current provider version before moving it.", req.SourceSchemaVersion),
+		)
+		return
+	}
+
+	var source IssueAlertModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := tfutils.MergeDiagnostics(newAlertModelFromIssueAlert(ctx, source))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.GetOrganizationAlertRuleWorkflowWithResponse(ctx, source.Organization.ValueString(), &apiclient.GetOrganizationAlertRuleWorkflowParams{
+		RuleId: source.Id.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read alert rule workflow", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			issueAlertMoveErrorSummary,
+			fmt.Sprintf("Sentry has not migrated issue alert %q to an alert yet. Try again once the alert is visible in Sentry.", source.Id.ValueString()),
+		)
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read alert rule workflow", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	monitor := tfutils.MergeDiagnostics(findProjectMonitor(ctx, r.apiClient, source.Organization.ValueString(), source.Project.ValueString(), "issue_stream"))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = supertypes.NewStringValue(httpResp.JSON200.WorkflowId)
+	data.Enabled = supertypes.NewBoolValue(true)
+	data.MonitorIds = supertypes.NewSetValueOfSlice(ctx, []string{monitor.Id})
+
+	if !source.Owner.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Issue alert owner not moved",
+			fmt.Sprintf("sentry_alert does not support an owner, so the owner %q of issue alert %q has been dropped.", source.Owner.ValueString(), source.Id.ValueString()),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, data)...)
+	if resp.TargetIdentity != nil {
+		resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, data.Identity())...)
+	}
+}
+
+// newAlertModelFromIssueAlert translates the *_v2 attributes of an issue alert
+// into a sentry_alert model. The ID, enabled state and monitors are left for the
+// caller to fill in. Anything without an equivalent is reported as an error.
+func newAlertModelFromIssueAlert(ctx context.Context, in IssueAlertModel) (*AlertResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !in.Conditions.IsNull() || !in.Filters.IsNull() || !in.Actions.IsNull() {
+		diags.AddError(
+			issueAlertMoveErrorSummary,
+			"The deprecated `conditions`, `filters` and `actions` JSON attributes cannot be moved. Switch to `conditions_v2`, `filters_v2` and `actions_v2` and apply before moving the resource.",
+		)
+		return nil, diags
+	}
+
+	inConditions := tfutils.MergeDiagnostics(in.ConditionsV2.Get(ctx))(&diags)
+	inFilters := tfutils.MergeDiagnostics(in.FiltersV2.Get(ctx))(&diags)
+	inActions := tfutils.MergeDiagnostics(in.ActionsV2.Get(ctx))(&diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Conditions
+
+	triggerConditions := []AlertResourceModelTriggerConditionsItem{}
+	var legacyTriggerConditions []string
+	var frequencyConditions []AlertResourceModelActionFiltersItemConditionsItem
+
+	for i, inCondition := range inConditions {
+		outTriggerCondition := newAlertTriggerConditionsItem(ctx)
+		outCondition := newAlertActionFilterConditionsItem(ctx)
+
+		switch {
+		case inCondition.FirstSeenEvent.IsKnown():
+			outTriggerCondition.FirstSeenEvent = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelTriggerConditionsItemFirstSeenEvent{})
+			triggerConditions = append(triggerConditions, outTriggerCondition)
+
+		case inCondition.RegressionEvent.IsKnown():
+			outTriggerCondition.RegressionEvent = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelTriggerConditionsItemRegressionEvent{})
+			triggerConditions = append(triggerConditions, outTriggerCondition)
+
+		case inCondition.ReappearedEvent.IsKnown():
+			outTriggerCondition.ReappearedEvent = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelTriggerConditionsItemReappearedEvent{})
+			triggerConditions = append(triggerConditions, outTriggerCondition)
+
+		case inCondition.NewHighPriorityIssue.IsKnown():
+			legacyTriggerConditions = append(legacyTriggerConditions, "new_high_priority_issue")
+
+		case inCondition.ExistingHighPriorityIssue.IsKnown():
+			legacyTriggerConditions = append(legacyTriggerConditions, "existing_high_priority_issue")
+
+		case inCondition.EventFrequency.IsKnown():
+			v := inCondition.EventFrequency.MustGet(ctx)
+			if !checkIssueAlertMoveIntervals(&diags, fmt.Sprintf("conditions_v2[%d].event_frequency", i), v.Interval.ValueString(), v.ComparisonInterval.ValueString()) {
+				continue
+			}
+
+			switch v.ComparisonType.ValueString() {
+			case "count":
+				outCondition.EventFrequencyCount = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemEventFrequencyCount{
+					Value:    supertypes.NewInt64PointerValueOrNull(v.Value.ValueInt64Pointer()),
+					Filters:  supertypes.NewListNestedObjectValueOfValueSlice(ctx, []AlertResourceModelActionFiltersItemConditionsItemEventFrequencyCountFiltersItem{}),
+					Interval: supertypes.NewStringPointerValueOrNull(v.Interval.ValueStringPointer()),
+				})
+			case "percent":
+				outCondition.EventFrequencyPercent = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemEventFrequencyPercent{
+					Value:              supertypes.NewInt64PointerValueOrNull(v.Value.ValueInt64Pointer()),
+					Filters:            supertypes.NewListNestedObjectValueOfValueSlice(ctx, []AlertResourceModelActionFiltersItemConditionsItemEventFrequencyPercentFiltersItem{}),
+					Interval:           supertypes.NewStringPointerValueOrNull(v.Interval.ValueStringPointer()),
+					ComparisonInterval: supertypes.NewStringPointerValueOrNull(v.ComparisonInterval.ValueStringPointer()),
+				})
+			}
+			frequencyConditions = append(frequencyConditions, outCondition)
+
+		case inCondition.EventUniqueUserFrequency.IsKnown():
+			v := inCondition.EventUniqueUserFrequency.MustGet(ctx)
+			if v.ComparisonType.ValueString() != "count" {
+				diags.AddError(
+					issueAlertMoveErrorSummary,
+					fmt.Sprintf("conditions_v2[%d].event_unique_user_frequency: sentry_alert only supports the %q comparison type for unique user frequency conditions.", i, "count"),
+				)
+				continue
+			}
+			if !checkIssueAlertMoveIntervals(&diags, fmt.Sprintf("conditions_v2[%d].event_unique_user_frequency", i), v.Interval.ValueString()) {
+				continue
+			}
+
+			outCondition.EventUniqueUserFrequencyCount = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyCount{
+				Value:    supertypes.NewInt64PointerValueOrNull(v.Value.ValueInt64Pointer()),
+				Filters:  supertypes.NewListNestedObjectValueOfValueSlice(ctx, []AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyCountFiltersItem{}),
+				Interval: supertypes.NewStringPointerValueOrNull(v.Interval.ValueStringPointer()),
+			})
+			frequencyConditions = append(frequencyConditions, outCondition)
+
+		case inCondition.EventFrequencyPercent.IsKnown():
+			v := inCondition.EventFrequencyPercent.MustGet(ctx)
+			if !checkIssueAlertMoveIntervals(&diags, fmt.Sprintf("conditions_v2[%d].event_frequency_percent", i), v.Interval.ValueString(), v.ComparisonInterval.ValueString()) {
+				continue
+			}
+
+			value := v.Value.ValueFloat64()
+			if value != math.Trunc(value) {
+				diags.AddError(
+					issueAlertMoveErrorSummary,
+					fmt.Sprintf("conditions_v2[%d].event_frequency_percent: sentry_alert only supports whole number percentages, got %v.", i, value),
+				)
+				continue
+			}
+
+			switch v.ComparisonType.ValueString() {
+			case "count":
+				outCondition.PercentSessionsCount = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemPercentSessionsCount{
+					Value:    supertypes.NewInt64Value(int64(value)),
+					Interval: supertypes.NewStringPointerValueOrNull(v.Interval.ValueStringPointer()),
+				})
+			case "percent":
+				outCondition.PercentSessionsPercent = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemPercentSessionsPercent{
+					Value:              supertypes.NewInt64Value(int64(value)),
+					Filters:            supertypes.NewListNestedObjectValueOfValueSlice(ctx, []AlertResourceModelActionFiltersItemConditionsItemPercentSessionsPercentFiltersItem{}),
+					Interval:           supertypes.NewStringPointerValueOrNull(v.Interval.ValueStringPointer()),
+					ComparisonInterval: supertypes.NewStringPointerValueOrNull(v.ComparisonInterval.ValueStringPointer()),
+				})
+			}
+			frequencyConditions = append(frequencyConditions, outCondition)
+
+		default:
+			diags.AddError(issueAlertMoveErrorSummary, fmt.Sprintf("conditions_v2[%d]: exactly one condition must be set.", i))
+		}
+	}
+
+	// Filters
+
+	var filterConditions []AlertResourceModelActionFiltersItemConditionsItem
+
+	for i, inFilter := range inFilters {
+		outCondition := newAlertActionFilterConditionsItem(ctx)
+
+		switch {
+		case inFilter.AgeComparison.IsKnown():
+			v := inFilter.AgeComparison.MustGet(ctx)
+			outCondition.AgeComparison = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemAgeComparison{
+				Time:           supertypes.NewStringPointerValueOrNull(v.Time.ValueStringPointer()),
+				Value:          supertypes.NewInt64PointerValueOrNull(v.Value.ValueInt64Pointer()),
+				ComparisonType: supertypes.NewStringPointerValueOrNull(v.ComparisonType.ValueStringPointer()),
+			})
+
+		case inFilter.IssueOccurrences.IsKnown():
+			v := inFilter.IssueOccurrences.MustGet(ctx)
+			outCondition.IssueOccurrences = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemIssueOccurrences{
+				Value: supertypes.NewInt64PointerValueOrNull(v.Value.ValueInt64Pointer()),
+			})
+
+		case inFilter.AssignedTo.IsKnown():
+			v := inFilter.AssignedTo.MustGet(ctx)
+			outCondition.AssignedTo = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemAssignedTo{
+				TargetType: supertypes.NewStringPointerValueOrNull(v.TargetType.ValueStringPointer()),
+				TargetId:   supertypes.NewStringPointerValueOrNull(v.TargetIdentifier.ValueStringPointer()),
+			})
+
+		case inFilter.LatestAdoptedRelease.IsKnown():
+			v := inFilter.LatestAdoptedRelease.MustGet(ctx)
+			outCondition.LatestAdoptedRelease = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemLatestAdoptedRelease{
+				Environment:    supertypes.NewStringPointerValueOrNull(v.Environment.ValueStringPointer()),
+				AgeComparison:  supertypes.NewStringPointerValueOrNull(v.OlderOrNewer.ValueStringPointer()),
+				ReleaseAgeType: supertypes.NewStringPointerValueOrNull(v.OldestOrNewest.ValueStringPointer()),
+			})
+
+		case inFilter.LatestRelease.IsKnown():
+			outCondition.LatestRelease = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemLatestRelease{})
+
+		case inFilter.IssueCategory.IsKnown():
+			v := inFilter.IssueCategory.MustGet(ctx)
+			categoryId, err := strconv.ParseInt(sentrydata.IssueGroupCategoryNameToId[v.Value.ValueString()], 10, 64)
+			if err != nil {
+				diags.AddError(issueAlertMoveErrorSummary, fmt.Sprintf("filters_v2[%d].issue_category: unknown issue category %q.", i, v.Value.ValueString()))
+				continue
+			}
+
+			outCondition.IssueCategory = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemIssueCategory{
+				Value:   supertypes.NewInt64Value(categoryId),
+				Include: supertypes.NewBoolValue(true),
+			})
+
+		case inFilter.EventAttribute.IsKnown():
+			v := inFilter.EventAttribute.MustGet(ctx)
+			outCondition.EventAttribute = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemEventAttribute{
+				Attribute: supertypes.NewStringPointerValueOrNull(v.Attribute.ValueStringPointer()),
+				Match:     supertypes.NewStringValue(sentrydata.MatchTypeNameToId[v.Match.ValueString()]),
+				Value:     supertypes.NewStringPointerValueOrNull(v.Value.ValueStringPointer()),
+			})
+
+		case inFilter.TaggedEvent.IsKnown():
+			v := inFilter.TaggedEvent.MustGet(ctx)
+			outCondition.TaggedEvent = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemTaggedEvent{
+				Key:   supertypes.NewStringPointerValueOrNull(v.Key.ValueStringPointer()),
+				Match: supertypes.NewStringValue(sentrydata.MatchTypeNameToId[v.Match.ValueString()]),
+				Value: supertypes.NewStringPointerValueOrNull(v.Value.ValueStringPointer()),
+			})
+
+		case inFilter.Level.IsKnown():
+			v := inFilter.Level.MustGet(ctx)
+			levelId, err := strconv.ParseInt(sentrydata.LogLevelNameToId[v.Level.ValueString()], 10, 64)
+			if err != nil {
+				diags.AddError(issueAlertMoveErrorSummary, fmt.Sprintf("filters_v2[%d].level: unknown level %q.", i, v.Level.ValueString()))
+				continue
+			}
+
+			outCondition.Level = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemLevel{
+				Match: supertypes.NewStringValue(sentrydata.MatchTypeNameToId[v.Match.ValueString()]),
+				Level: supertypes.NewInt64Value(levelId),
+			})
+
+		default:
+			diags.AddError(issueAlertMoveErrorSummary, fmt.Sprintf("filters_v2[%d]: exactly one filter must be set.", i))
+			continue
+		}
+
+		filterConditions = append(filterConditions, outCondition)
+	}
+
+	// Actions
+
+	outActions := []AlertResourceModelActionFiltersItemActionsItem{}
+
+	for i, inAction := range inActions {
+		outAction := newAlertActionFilterActionsItem(ctx)
+
+		switch {
+		case inAction.NotifyEmail.IsKnown():
+			v := inAction.NotifyEmail.MustGet(ctx)
+			targetType, ok := map[string]string{
+				"IssueOwners": "issue_owners",
+				"Team":        "team",
+				"Member":      "user",
+			}[v.TargetType.ValueString()]
+			if !ok {
+				diags.AddError(issueAlertMoveErrorSummary, fmt.Sprintf("actions_v2[%d].notify_email: unknown target type %q.", i, v.TargetType.ValueString()))
+				continue
+			}
+
+			outAction.Email = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemEmail{
+				TargetType:      supertypes.NewStringValue(targetType),
+				TargetId:        supertypes.NewStringPointerValueOrNull(v.TargetIdentifier.ValueStringPointer()),
+				FallthroughType: supertypes.NewStringPointerValueOrNull(v.FallthroughType.ValueStringPointer()),
+			})
+
+		case inAction.NotifyEvent.IsKnown():
+			outAction.Plugin = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemPlugin{})
+
+		case inAction.NotifyEventService.IsKnown():
+			v := inAction.NotifyEventService.MustGet(ctx)
+			outAction.Webhook = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemWebhook{
+				Service: supertypes.NewStringPointerValueOrNull(v.Service.ValueStringPointer()),
+			})
+
+		case inAction.NotifyEventSentryApp.IsKnown():
+			diags.AddError(
+				issueAlertMoveErrorSummary,
+				fmt.Sprintf("actions_v2[%d].notify_event_sentry_app: sentry_alert identifies Sentry apps by their ID rather than their installation UUID. Remove the action, move the resource, then add it back as a `sentry_app` action.", i),
+			)
+			continue
+
+		case inAction.OpsgenieNotifyTeam.IsKnown():
+			v := inAction.OpsgenieNotifyTeam.MustGet(ctx)
+			outAction.Opsgenie = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemOpsgenie{
+				IntegrationId: supertypes.NewStringPointerValueOrNull(v.Account.ValueStringPointer()),
+				TeamName:      supertypes.NewStringNull(),
+				TeamId:        supertypes.NewStringPointerValueOrNull(v.Team.ValueStringPointer()),
+				Priority:      supertypes.NewStringPointerValueOrNull(v.Priority.ValueStringPointer()),
+			})
+
+		case inAction.PagerDutyNotifyService.IsKnown():
+			v := inAction.PagerDutyNotifyService.MustGet(ctx)
+			outAction.Pagerduty = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemPagerduty{
+				IntegrationId: supertypes.NewStringPointerValueOrNull(v.Account.ValueStringPointer()),
+				ServiceName:   supertypes.NewStringNull(),
+				ServiceId:     supertypes.NewStringPointerValueOrNull(v.Service.ValueStringPointer()),
+				Severity:      supertypes.NewStringPointerValueOrNull(v.Severity.ValueStringPointer()),
+			})
+
+		case inAction.SlackNotifyService.IsKnown():
+			v := inAction.SlackNotifyService.MustGet(ctx)
+			tags := tfutils.MergeDiagnostics(v.Tags.ValueStringPointer(ctx))(&diags)
+			outAction.Slack = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemSlack{
+				IntegrationId: supertypes.NewStringPointerValueOrNull(v.Workspace.ValueStringPointer()),
+				ChannelName:   v.Channel,
+				ChannelId:     supertypes.NewStringPointerValueOrNull(v.ChannelId.ValueStringPointer()),
+				Tags:          supertypes.NewStringPointerValueOrNull(tags),
+				Notes:         supertypes.NewStringPointerValueOrNull(v.Notes.ValueStringPointer()),
+			})
+
+		case inAction.MsTeamsNotifyService.IsKnown():
+			v := inAction.MsTeamsNotifyService.MustGet(ctx)
+			if v.ChannelId.IsNull() {
+				diags.AddError(
+					issueAlertMoveErrorSummary,
+					fmt.Sprintf("actions_v2[%d].msteams_notify_service: the channel ID is unknown. Refresh the sentry_issue_alert resource before moving it.", i),
+				)
+				continue
+			}
+
+			outAction.Msteams = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemMsteams{
+				IntegrationId: supertypes.NewStringPointerValueOrNull(v.Team.ValueStringPointer()),
+				TeamId:        supertypes.NewStringPointerValueOrNull(v.ChannelId.ValueStringPointer()),
+				ChannelName:   supertypes.NewStringPointerValueOrNull(v.Channel.ValueStringPointer()),
+			})
+
+		case inAction.DiscordNotifyService.IsKnown():
+			v := inAction.DiscordNotifyService.MustGet(ctx)
+			tags := tfutils.MergeDiagnostics(v.Tags.ValueStringPointer(ctx))(&diags)
+			outAction.Discord = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemDiscord{
+				IntegrationId: supertypes.NewStringPointerValueOrNull(v.Server.ValueStringPointer()),
+				ChannelId:     supertypes.NewStringPointerValueOrNull(v.ChannelId.ValueStringPointer()),
+				Tags:          supertypes.NewStringPointerValueOrNull(tags),
+			})
+
+		case inAction.JiraCreateTicket.IsKnown():
+			v := inAction.JiraCreateTicket.MustGet(ctx)
+			outAction.Jira = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemJira{
+				IntegrationId: supertypes.NewStringPointerValueOrNull(v.Integration.ValueStringPointer()),
+				Project:       supertypes.NewStringPointerValueOrNull(v.Project.ValueStringPointer()),
+				IssueType:     supertypes.NewStringPointerValueOrNull(v.IssueType.ValueStringPointer()),
+			})
+
+		case inAction.JiraServerCreateTicket.IsKnown():
+			v := inAction.JiraServerCreateTicket.MustGet(ctx)
+			outAction.JiraServer = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemJiraServer{
+				IntegrationId: supertypes.NewStringPointerValueOrNull(v.Integration.ValueStringPointer()),
+				Project:       supertypes.NewStringPointerValueOrNull(v.Project.ValueStringPointer()),
+				IssueType:     supertypes.NewStringPointerValueOrNull(v.IssueType.ValueStringPointer()),
+			})
+
+		case inAction.GitHubCreateTicket.IsKnown():
+			v := inAction.GitHubCreateTicket.MustGet(ctx)
+			outAction.Github = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemGithub{
+				IntegrationId: supertypes.NewStringPointerValueOrNull(v.Integration.ValueStringPointer()),
+				Repo:          supertypes.NewStringPointerValueOrNull(v.Repo.ValueStringPointer()),
+				Assignee:      supertypes.NewStringPointerValueOrNull(v.Assignee.ValueStringPointer()),
+				Labels:        v.Labels,
+			})
+
+		case inAction.GitHubEnterpriseCreateTicket.IsKnown():
+			diags.AddError(
+				issueAlertMoveErrorSummary,
+				fmt.Sprintf("actions_v2[%d].github_enterprise_create_ticket: sentry_alert does not support GitHub Enterprise actions yet.", i),
+			)
+			continue
+
+		case inAction.AzureDevopsCreateTicket.IsKnown():
+			v := inAction.AzureDevopsCreateTicket.MustGet(ctx)
+			outAction.Vsts = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemVsts{
+				IntegrationId: supertypes.NewStringPointerValueOrNull(v.Integration.ValueStringPointer()),
+				Project:       supertypes.NewStringPointerValueOrNull(v.Project.ValueStringPointer()),
+				WorkItemType:  supertypes.NewStringPointerValueOrNull(v.WorkItemType.ValueStringPointer()),
+			})
+
+		default:
+			diags.AddError(issueAlertMoveErrorSummary, fmt.Sprintf("actions_v2[%d]: exactly one action must be set.", i))
+			continue
+		}
+
+		outActions = append(outActions, outAction)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Logic
+	//
+	// An issue alert fires when `action_match` holds for its conditions and
+	// `filter_match` holds for its filters. An alert instead fires when any trigger
+	// condition holds and then evaluates a single action filter, so both match
+	// modes have to collapse into the action filter's logic type.
+
+	actionMatch := in.ActionMatch.ValueString()
+	filterMatch := in.FilterMatch.ValueString()
+	if filterMatch == "" {
+		filterMatch = "all"
+	}
+
+	if actionMatch == "all" && len(triggerConditions)+len(legacyTriggerConditions) > 1 {
+		diags.AddError(
+			issueAlertMoveErrorSummary,
+			"`action_match` is \"all\" with more than one issue state condition. sentry_alert triggers when any of its trigger conditions are met, so this alert cannot be moved without changing its behavior.",
+		)
+	}
+	if actionMatch == "any" && len(triggerConditions)+len(legacyTriggerConditions) > 0 && len(frequencyConditions) > 0 {
+		diags.AddError(
+			issueAlertMoveErrorSummary,
+			"`action_match` is \"any\" with both issue state and frequency conditions. sentry_alert requires its trigger conditions and action filters to both be met, so this alert cannot be moved without changing its behavior.",
+		)
+	}
+
+	var logicType string
+	switch {
+	case len(frequencyConditions) == 0 && len(filterConditions) == 0:
+		logicType = "all"
+	case len(filterConditions) == 0:
+		logicType = issueAlertMatchToLogicType(actionMatch)
+	case len(frequencyConditions) == 0:
+		logicType = issueAlertMatchToLogicType(filterMatch)
+	default:
+		frequencyAll := actionMatch == "all" || len(frequencyConditions) == 1
+		filterAll := filterMatch == "all" || (filterMatch == "any" && len(filterConditions) == 1)
+		if !frequencyAll || !filterAll {
+			diags.AddError(
+				issueAlertMoveErrorSummary,
+				"The frequency conditions and filters use different match modes. sentry_alert evaluates them together in a single action filter, so this alert cannot be moved without changing its behavior.",
+			)
+		}
+		logicType = "all"
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	out := &AlertResourceModel{
+		Organization:      supertypes.NewStringPointerValueOrNull(in.Organization.ValueStringPointer()),
+		Name:              supertypes.NewStringPointerValueOrNull(in.Name.ValueStringPointer()),
+		Environment:       supertypes.NewStringPointerValueOrNull(in.Environment.ValueStringPointer()),
+		FrequencyMinutes:  supertypes.NewInt64PointerValueOrNull(in.Frequency.ValueInt64Pointer()),
+		TriggerConditions: supertypes.NewListNestedObjectValueOfValueSlice(ctx, triggerConditions),
+		ActionFilters: supertypes.NewListNestedObjectValueOfValueSlice(ctx, []AlertResourceModelActionFiltersItem{
+			{
+				LogicType:  supertypes.NewStringValue(logicType),
+				Conditions: supertypes.NewListNestedObjectValueOfValueSlice(ctx, append(frequencyConditions, filterConditions...)),
+				Actions:    supertypes.NewListNestedObjectValueOfValueSlice(ctx, outActions),
+			},
+		}),
+	}
+	if len(legacyTriggerConditions) == 0 {
+		out.LegacyTriggerConditions = supertypes.NewListValueOfNull[string](ctx)
+	} else {
+		out.LegacyTriggerConditions = supertypes.NewListValueOfSlice(ctx, legacyTriggerConditions)
+	}
+
+	return out, diags
+}
+
+// moveMetricAlertState moves a sentry_metric_alert resource to the alert Sentry
+// migrated its triggers and actions to. The alert is attached to the monitor
+// Sentry migrated the metric alert to.
+func (r *AlertResource) moveMetricAlertState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	source := tfutils.MergeDiagnostics(readMetricAlertMoveState(req))(&resp.Diagnostics)
+	if source == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := tfutils.MergeDiagnostics(newAlertModelFromMetricAlert(ctx, *source))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.GetOrganizationAlertRuleWorkflowWithResponse(ctx, source.Organization, &apiclient.GetOrganizationAlertRuleWorkflowParams{
+		AlertRuleId: &source.InternalId,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read alert rule workflow", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			metricAlertMoveErrorSummary,
+			fmt.Sprintf("Sentry has not migrated metric alert %q to an alert yet. Try again once the alert is visible in Sentry.", source.InternalId),
+		)
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read alert rule workflow", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	detectorId := tfutils.MergeDiagnostics(getMetricAlertDetectorId(ctx, r.apiClient, source.Organization, source.InternalId))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = supertypes.NewStringValue(httpResp.JSON200.WorkflowId)
+	data.MonitorIds = supertypes.NewSetValueOfSlice(ctx, []string{detectorId})
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, data)...)
+	if resp.TargetIdentity != nil {
+		resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, data.Identity())...)
+	}
+}
+
+// newAlertModelFromMetricAlert translates the triggers of a metric alert into a
+// sentry_alert model. Each trigger becomes an action filter matching issues of
+// the priority raised by the trigger's monitor condition. The ID and monitors are
+// left for the caller to fill in.
+func newAlertModelFromMetricAlert(ctx context.Context, in metricAlertState) (*AlertResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	actionFilters := []AlertResourceModelActionFiltersItem{}
+
+	for i, trigger := range in.Triggers {
+		priority, ok := metricAlertTriggerPriorities[trigger.Label]
+		if !ok {
+			diags.AddError(metricAlertMoveErrorSummary, fmt.Sprintf("trigger[%d].label: unsupported label %q. Only \"critical\" and \"warning\" triggers can be moved.", i, trigger.Label))
+			continue
+		}
+
+		greaterOrEqual := newAlertActionFilterConditionsItem(ctx)
+		greaterOrEqual.IssuePriorityGreaterOrEqual = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemIssuePriorityGreaterOrEqual{
+			Comparison: supertypes.NewInt64Value(priority),
+		})
+		deescalating := newAlertActionFilterConditionsItem(ctx)
+		deescalating.IssuePriorityDeescalating = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemIssuePriorityDeescalating{
+			Comparison: supertypes.NewInt64Value(priority),
+		})
+
+		outActions := []AlertResourceModelActionFiltersItemActionsItem{}
+		for j, inAction := range trigger.Actions {
+			outAction := newAlertActionFilterActionsItem(ctx)
+			integrationId := supertypes.NewStringNull()
+			if inAction.IntegrationId != nil && *inAction.IntegrationId != 0 {
+				integrationId = supertypes.NewStringValue(strconv.FormatInt(*inAction.IntegrationId, 10))
+			}
+
+			switch inAction.Type {
+			case "email":
+				if inAction.TargetType != "user" && inAction.TargetType != "team" {
+					diags.AddError(metricAlertMoveErrorSummary, fmt.Sprintf("trigger[%d].action[%d].target_type: unsupported email target type %q.", i, j, inAction.TargetType))
+					continue
+				}
+				outAction.Email = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemEmail{
+					TargetType:      supertypes.NewStringValue(inAction.TargetType),
+					TargetId:        metricAlertStringValue(inAction.TargetIdentifier),
+					FallthroughType: supertypes.NewStringNull(),
+				})
+
+			case "slack":
+				outAction.Slack = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemSlack{
+					IntegrationId: integrationId,
+					ChannelName:   sentrytypes.NewSlackChannelPointerValue(metricAlertStringValue(inAction.TargetIdentifier).ValueStringPointer()),
+					ChannelId:     metricAlertStringValue(inAction.InputChannelId),
+					Tags:          supertypes.NewStringNull(),
+					Notes:         supertypes.NewStringNull(),
+				})
+
+			case "pagerduty":
+				outAction.Pagerduty = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemPagerduty{
+					IntegrationId: integrationId,
+					ServiceName:   supertypes.NewStringNull(),
+					ServiceId:     metricAlertStringValue(inAction.TargetIdentifier),
+					Severity:      metricAlertStringValue(inAction.Priority),
+				})
+
+			case "msteams":
+				outAction.Msteams = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemMsteams{
+					IntegrationId: integrationId,
+					TeamId:        metricAlertStringValue(inAction.InputChannelId),
+					ChannelName:   metricAlertStringValue(inAction.TargetIdentifier),
+				})
+
+			case "opsgenie":
+				outAction.Opsgenie = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemOpsgenie{
+					IntegrationId: integrationId,
+					TeamName:      supertypes.NewStringNull(),
+					TeamId:        metricAlertStringValue(inAction.TargetIdentifier),
+					Priority:      metricAlertStringValue(inAction.Priority),
+				})
+
+			case "discord":
+				outAction.Discord = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemDiscord{
+					IntegrationId: integrationId,
+					ChannelId:     metricAlertStringValue(inAction.TargetIdentifier),
+					Tags:          supertypes.NewStringNull(),
+				})
+
+			case "sentry_app":
+				if inAction.SentryAppId == nil || *inAction.SentryAppId == 0 {
+					diags.AddError(metricAlertMoveErrorSummary, fmt.Sprintf("trigger[%d].action[%d].sentry_app_id: a Sentry app ID is required.", i, j))
+					continue
+				}
+				outAction.SentryApp = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemActionsItemSentryApp{
+					SentryAppId: supertypes.NewStringValue(strconv.FormatInt(*inAction.SentryAppId, 10)),
+					Settings:    supertypes.NewListNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemSentryAppSettingsItem](ctx),
+				})
+
+			default:
+				diags.AddError(metricAlertMoveErrorSummary, fmt.Sprintf("trigger[%d].action[%d].type: unsupported action type %q.", i, j, inAction.Type))
+				continue
+			}
+
+			outActions = append(outActions, outAction)
+		}
+
+		actionFilters = append(actionFilters, AlertResourceModelActionFiltersItem{
+			LogicType:  supertypes.NewStringValue("any-short"),
+			Conditions: supertypes.NewListNestedObjectValueOfValueSlice(ctx, []AlertResourceModelActionFiltersItemConditionsItem{greaterOrEqual, deescalating}),
+			Actions:    supertypes.NewListNestedObjectValueOfValueSlice(ctx, outActions),
+		})
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	out := &AlertResourceModel{
+		Organization:            supertypes.NewStringValue(in.Organization),
+		Enabled:                 supertypes.NewBoolValue(true),
+		Name:                    supertypes.NewStringValue(in.Name),
+		Environment:             metricAlertStringValue(in.Environment),
+		FrequencyMinutes:        supertypes.NewInt64Null(),
+		TriggerConditions:       supertypes.NewListNestedObjectValueOfValueSlice(ctx, []AlertResourceModelTriggerConditionsItem{}),
+		ActionFilters:           supertypes.NewListNestedObjectValueOfValueSlice(ctx, actionFilters),
+		LegacyTriggerConditions: supertypes.NewListValueOfNull[string](ctx),
+	}
+
+	return out, diags
+}
+
+// checkIssueAlertMoveIntervals reports an error for any interval that sentry_alert
+// does not support. Empty intervals are ignored.
+func checkIssueAlertMoveIntervals(diags *diag.Diagnostics, attribute string, intervals ...string) bool {
+	for _, interval := range intervals {
+		if interval != "" && !slices.Contains(sentrydata.EventFrequencyStandardIntervals, interval) {
+			diags.AddError(
+				issueAlertMoveErrorSummary,
+				fmt.Sprintf("%s: sentry_alert does not support the %q interval. Supported intervals are: %s.", attribute, interval, strings.Join(sentrydata.EventFrequencyStandardIntervals, ", ")),
+			)
+			return false
+		}
+	}
+	return true
+}
+
+func issueAlertMatchToLogicType(match string) string {
+	switch match {
+	case "any":
+		return "any-short"
+	default:
+		return match
+	}
+}
+
+func newAlertTriggerConditionsItem(ctx context.Context) AlertResourceModelTriggerConditionsItem {
+	return AlertResourceModelTriggerConditionsItem{
+		FirstSeenEvent:       supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelTriggerConditionsItemFirstSeenEvent](ctx),
+		IssueResolvedTrigger: supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelTriggerConditionsItemIssueResolvedTrigger](ctx),
+		ReappearedEvent:      supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelTriggerConditionsItemReappearedEvent](ctx),
+		RegressionEvent:      supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelTriggerConditionsItemRegressionEvent](ctx),
+	}
+}
+
+func newAlertActionFilterConditionsItem(ctx context.Context) AlertResourceModelActionFiltersItemConditionsItem {
+	return AlertResourceModelActionFiltersItemConditionsItem{
+		AgeComparison:                 supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemAgeComparison](ctx),
+		AssignedTo:                    supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemAssignedTo](ctx),
+		IssueCategory:                 supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssueCategory](ctx),
+		IssueOccurrences:              supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssueOccurrences](ctx),
+		IssuePriorityDeescalating:     supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityDeescalating](ctx),
+		IssuePriorityGreaterOrEqual:   supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityGreaterOrEqual](ctx),
+		EventUniqueUserFrequencyCount: supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyCount](ctx),
+		EventFrequencyCount:           supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventFrequencyCount](ctx),
+		EventFrequencyPercent:         supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventFrequencyPercent](ctx),
+		PercentSessionsCount:          supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemPercentSessionsCount](ctx),
+		PercentSessionsPercent:        supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemPercentSessionsPercent](ctx),
+		EventAttribute:                supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventAttribute](ctx),
+		TaggedEvent:                   supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemTaggedEvent](ctx),
+		LatestRelease:                 supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemLatestRelease](ctx),
+		LatestAdoptedRelease:          supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemLatestAdoptedRelease](ctx),
+		Level:                         supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemLevel](ctx),
+		IssueType:                     supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssueType](ctx),
+	}
+}
+
+func newAlertActionFilterActionsItem(ctx context.Context) AlertResourceModelActionFiltersItemActionsItem {
+	return AlertResourceModelActionFiltersItemActionsItem{
+		Email:      supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemEmail](ctx),
+		Plugin:     supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemPlugin](ctx),
+		Slack:      supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemSlack](ctx),
+		Pagerduty:  supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemPagerduty](ctx),
+		Discord:    supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemDiscord](ctx),
+		Msteams:    supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemMsteams](ctx),
+		Opsgenie:   supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemOpsgenie](ctx),
+		Vsts:       supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemVsts](ctx),
+		Jira:       supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemJira](ctx),
+		JiraServer: supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemJiraServer](ctx),
+		Github:     supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemGithub](ctx),
+		SentryApp:  supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemSentryApp](ctx),
+		Webhook:    supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemWebhook](ctx),
+	}
+}
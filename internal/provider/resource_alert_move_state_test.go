@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrytypes"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+func newTestIssueAlertCondition(ctx context.Context, fn func(*IssueAlertConditionModel)) IssueAlertConditionModel {
+	m := IssueAlertConditionModel{
+		FirstSeenEvent:            supertypes.NewSingleNestedObjectValueOfNull[IssueAlertConditionFirstSeenEventModel](ctx),
+		RegressionEvent:           supertypes.NewSingleNestedObjectValueOfNull[IssueAlertConditionRegressionEventModel](ctx),
+		ReappearedEvent:           supertypes.NewSingleNestedObjectValueOfNull[IssueAlertConditionReappearedEventModel](ctx),
+		NewHighPriorityIssue:      supertypes.NewSingleNestedObjectValueOfNull[IssueAlertConditionNewHighPriorityIssueModel](ctx),
+		ExistingHighPriorityIssue: supertypes.NewSingleNestedObjectValueOfNull[IssueAlertConditionExistingHighPriorityIssueModel](ctx),
+		EventFrequency:            supertypes.NewSingleNestedObjectValueOfNull[IssueAlertConditionEventFrequencyModel](ctx),
+		EventUniqueUserFrequency:  supertypes.NewSingleNestedObjectValueOfNull[IssueAlertConditionEventUniqueUserFrequencyModel](ctx),
+		EventFrequencyPercent:     supertypes.NewSingleNestedObjectValueOfNull[IssueAlertConditionEventFrequencyPercentModel](ctx),
+	}
+	fn(&m)
+	return m
+}
+
+func newTestIssueAlertFilter(ctx context.Context, fn func(*IssueAlertFilterModel)) IssueAlertFilterModel {
+	m := IssueAlertFilterModel{
+		AgeComparison:        supertypes.NewSingleNestedObjectValueOfNull[IssueAlertFilterAgeComparisonModel](ctx),
+		IssueOccurrences:     supertypes.NewSingleNestedObjectValueOfNull[IssueAlertFilterIssueOccurrencesModel](ctx),
+		AssignedTo:           supertypes.NewSingleNestedObjectValueOfNull[IssueAlertFilterAssignedToModel](ctx),
+		LatestAdoptedRelease: supertypes.NewSingleNestedObjectValueOfNull[IssueAlertFilterLatestAdoptedReleaseModel](ctx),
+		LatestRelease:        supertypes.NewSingleNestedObjectValueOfNull[IssueAlertFilterLatestReleaseModel](ctx),
+		IssueCategory:        supertypes.NewSingleNestedObjectValueOfNull[IssueAlertFilterIssueCategoryModel](ctx),
+		EventAttribute:       supertypes.NewSingleNestedObjectValueOfNull[IssueAlertFilterEventAttributeModel](ctx),
+		TaggedEvent:          supertypes.NewSingleNestedObjectValueOfNull[IssueAlertFilterTaggedEventModel](ctx),
+		Level:                supertypes.NewSingleNestedObjectValueOfNull[IssueAlertFilterLevelModel](ctx),
+	}
+	fn(&m)
+	return m
+}
+
+func newTestIssueAlertAction(ctx context.Context, fn func(*IssueAlertActionModel)) IssueAlertActionModel {
+	m := IssueAlertActionModel{
+		NotifyEmail:                  supertypes.NewSingleNestedObjectValueOfNull[IssueAlertActionNotifyEmailModel](ctx),
+		NotifyEvent:                  supertypes.NewSingleNestedObjectValueOfNull[IssueAlertActionNotifyEventModel](ctx),
+		NotifyEventService:           supertypes.NewSingleNestedObjectValueOfNull[IssueAlertActionNotifyEventServiceModel](ctx),
+		NotifyEventSentryApp:         supertypes.NewSingleNestedObjectValueOfNull[IssueAlertActionNotifyEventSentryAppModel](ctx),
+		OpsgenieNotifyTeam:           supertypes.NewSingleNestedObjectValueOfNull[IssueAlertActionOpsgenieNotifyTeam](ctx),
+		PagerDutyNotifyService:       supertypes.NewSingleNestedObjectValueOfNull[IssueAlertActionPagerDutyNotifyServiceModel](ctx),
+		SlackNotifyService:           supertypes.NewSingleNestedObjectValueOfNull[IssueAlertActionSlackNotifyServiceModel](ctx),
+		MsTeamsNotifyService:         supertypes.NewSingleNestedObjectValueOfNull[IssueAlertActionMsTeamsNotifyServiceModel](ctx),
+		DiscordNotifyService:         supertypes.NewSingleNestedObjectValueOfNull[IssueAlertActionDiscordNotifyServiceModel](ctx),
+		JiraCreateTicket:             supertypes.NewSingleNestedObjectValueOfNull[IssueAlertActionJiraCreateTicketModel](ctx),
+		JiraServerCreateTicket:       supertypes.NewSingleNestedObjectValueOfNull[IssueAlertActionJiraServerCreateTicketModel](ctx),
+		GitHubCreateTicket:           supertypes.NewSingleNestedObjectValueOfNull[IssueAlertActionGitHubCreateTicketModel](ctx),
+		GitHubEnterpriseCreateTicket: supertypes.NewSingleNestedObjectValueOfNull[IssueAlertActionGitHubEnterpriseCreateTicketModel](ctx),
+		AzureDevopsCreateTicket:      supertypes.NewSingleNestedObjectValueOfNull[IssueAlertActionAzureDevopsCreateTicketModel](ctx),
+	}
+	fn(&m)
+	return m
+}
+
+func newTestIssueAlertModel(ctx context.Context, conditions []IssueAlertConditionModel, filters []IssueAlertFilterModel, actions []IssueAlertActionModel) IssueAlertModel {
+	return IssueAlertModel{
+		Id:           types.StringValue("1"),
+		Organization: types.StringValue("my-org"),
+		Project:      types.StringValue("my-project"),
+		Name:         types.StringValue("My alert"),
+		Conditions:   sentrytypes.NewLossyJsonNull(),
+		Filters:      sentrytypes.NewLossyJsonNull(),
+		Actions:      sentrytypes.NewLossyJsonNull(),
+		ActionMatch:  types.StringValue("all"),
+		FilterMatch:  types.StringValue("all"),
+		Frequency:    types.Int64Value(30),
+		Environment:  types.StringNull(),
+		Owner:        types.StringNull(),
+		ConditionsV2: supertypes.NewListNestedObjectValueOfValueSlice(ctx, conditions),
+		FiltersV2:    supertypes.NewListNestedObjectValueOfValueSlice(ctx, filters),
+		ActionsV2:    supertypes.NewListNestedObjectValueOfValueSlice(ctx, actions),
+	}
+}
+
+func TestNewAlertModelFromIssueAlert(t *testing.T) {
+	ctx := context.Background()
+
+	in := newTestIssueAlertModel(ctx,
+		[]IssueAlertConditionModel{
+			newTestIssueAlertCondition(ctx, func(m *IssueAlertConditionModel) {
+				m.FirstSeenEvent = supertypes.NewSingleNestedObjectValueOf(ctx, &IssueAlertConditionFirstSeenEventModel{Name: types.StringNull()})
+			}),
+			newTestIssueAlertCondition(ctx, func(m *IssueAlertConditionModel) {
+				m.EventFrequency = supertypes.NewSingleNestedObjectValueOf(ctx, &IssueAlertConditionEventFrequencyModel{
+					Name:               types.StringNull(),
+					ComparisonType:     types.StringValue("count"),
+					ComparisonInterval: types.StringNull(),
+					Value:              types.Int64Value(100),
+					Interval:           types.StringValue("1h"),
+				})
+			}),
+		},
+		[]IssueAlertFilterModel{
+			newTestIssueAlertFilter(ctx, func(m *IssueAlertFilterModel) {
+				m.Level = supertypes.NewSingleNestedObjectValueOf(ctx, &IssueAlertFilterLevelModel{
+					Name:  types.StringNull(),
+					Match: types.StringValue("GREATER_OR_EQUAL"),
+					Level: types.StringValue("error"),
+				})
+			}),
+		},
+		[]IssueAlertActionModel{
+			newTestIssueAlertAction(ctx, func(m *IssueAlertActionModel) {
+				m.NotifyEmail = supertypes.NewSingleNestedObjectValueOf(ctx, &IssueAlertActionNotifyEmailModel{
+					Name:             types.StringNull(),
+					TargetType:       types.StringValue("IssueOwners"),
+					TargetIdentifier: types.StringNull(),
+					FallthroughType:  types.StringValue("ActiveMembers"),
+				})
+			}),
+		},
+	)
+
+	out, diags := newAlertModelFromIssueAlert(ctx, in)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	if got := out.Name.ValueString(); got != "My alert" {
+		t.Errorf("expected name %q, got %q", "My alert", got)
+	}
+	if got := out.FrequencyMinutes.ValueInt64(); got != 30 {
+		t.Errorf("expected frequency_minutes 30, got %d", got)
+	}
+	if !out.LegacyTriggerConditions.IsNull() {
+		t.Errorf("expected legacy_trigger_conditions to be null, got %s", out.LegacyTriggerConditions)
+	}
+
+	triggerConditions := out.TriggerConditions.MustGet(ctx)
+	if len(triggerConditions) != 1 || !triggerConditions[0].FirstSeenEvent.IsKnown() {
+		t.Fatalf("expected a single first_seen_event trigger condition, got %s", out.TriggerConditions)
+	}
+
+	actionFilters := out.ActionFilters.MustGet(ctx)
+	if len(actionFilters) != 1 {
+		t.Fatalf("expected a single action filter, got %d", len(actionFilters))
+	}
+	if got := actionFilters[0].LogicType.ValueString(); got != "all" {
+		t.Errorf("expected logic_type %q, got %q", "all", got)
+	}
+
+	conditions := actionFilters[0].Conditions.MustGet(ctx)
+	if len(conditions) != 2 {
+		t.Fatalf("expected 2 action filter conditions, got %d", len(conditions))
+	}
+	frequency := conditions[0].EventFrequencyCount.MustGet(ctx)
+	if frequency.Value.ValueInt64() != 100 || frequency.Interval.ValueString() != "1h" {
+		t.Errorf("unexpected event_frequency_count: %+v", frequency)
+	}
+	level := conditions[1].Level.MustGet(ctx)
+	if level.Match.ValueString() != "gte" || level.Level.ValueInt64() != 40 {
+		t.Errorf("unexpected level: %+v", level)
+	}
+
+	actions := actionFilters[0].Actions.MustGet(ctx)
+	if len(actions) != 1 {
+		t.Fatalf("expected 1 action, got %d", len(actions))
+	}
+	email := actions[0].Email.MustGet(ctx)
+	if email.TargetType.ValueString() != "issue_owners" || !email.TargetId.IsNull() || email.FallthroughType.ValueString() != "ActiveMembers" {
+		t.Errorf("unexpected email: %+v", email)
+	}
+}
+
+func TestNewAlertModelFromIssueAlert_errors(t *testing.T) {
+	ctx := context.Background()
+
+	firstSeen := newTestIssueAlertCondition(ctx, func(m *IssueAlertConditionModel) {
+		m.FirstSeenEvent = supertypes.NewSingleNestedObjectValueOf(ctx, &IssueAlertConditionFirstSeenEventModel{Name: types.StringNull()})
+	})
+	regression := newTestIssueAlertCondition(ctx, func(m *IssueAlertConditionModel) {
+		m.RegressionEvent = supertypes.NewSingleNestedObjectValueOf(ctx, &IssueAlertConditionRegressionEventModel{Name: types.StringNull()})
+	})
+
+	testCases := []struct {
+		name   string
+		in     IssueAlertModel
+		detail string
+	}{
+		{
+			name: "legacy json",
+			in: func() IssueAlertModel {
+				m := newTestIssueAlertModel(ctx, nil, nil, nil)
+				m.Conditions = sentrytypes.NewLossyJsonValue(`[]`)
+				return m
+			}(),
+			detail: "JSON attributes cannot be moved",
+		},
+		{
+			name:   "all with multiple triggers",
+			in:     newTestIssueAlertModel(ctx, []IssueAlertConditionModel{firstSeen, regression}, nil, nil),
+			detail: "more than one issue state condition",
+		},
+		{
+			name: "any with triggers and frequency",
+			in: func() IssueAlertModel {
+				m := newTestIssueAlertModel(ctx, []IssueAlertConditionModel{
+					firstSeen,
+					newTestIssueAlertCondition(ctx, func(m *IssueAlertConditionModel) {
+						m.EventFrequency = supertypes.NewSingleNestedObjectValueOf(ctx, &IssueAlertConditionEventFrequencyModel{
+							Name:               types.StringNull(),
+							ComparisonType:     types.StringValue("count"),
+							ComparisonInterval: types.StringNull(),
+							Value:              types.Int64Value(100),
+							Interval:           types.StringValue("1h"),
+						})
+					}),
+				}, nil, nil)
+				m.ActionMatch = types.StringValue("any")
+				return m
+			}(),
+			detail: "both issue state and frequency conditions",
+		},
+		{
+			name: "unsupported interval",
+			in: newTestIssueAlertModel(ctx, []IssueAlertConditionModel{
+				newTestIssueAlertCondition(ctx, func(m *IssueAlertConditionModel) {
+					m.EventFrequencyPercent = supertypes.NewSingleNestedObjectValueOf(ctx, &IssueAlertConditionEventFrequencyPercentModel{
+						Name:               types.StringNull(),
+						ComparisonType:     types.StringValue("count"),
+						ComparisonInterval: types.StringNull(),
+						Value:              types.Float64Value(10),
+						Interval:           types.StringValue("10m"),
+					})
+				}),
+			}, nil, nil),
+			detail: `does not support the "10m" interval`,
+		},
+		{
+			name: "sentry app action",
+			in: newTestIssueAlertModel(ctx, []IssueAlertConditionModel{firstSeen}, nil, []IssueAlertActionModel{
+				newTestIssueAlertAction(ctx, func(m *IssueAlertActionModel) {
+					m.NotifyEventSentryApp = supertypes.NewSingleNestedObjectValueOf(ctx, &IssueAlertActionNotifyEventSentryAppModel{
+						Name:                      types.StringNull(),
+						SentryAppInstallationUuid: types.StringValue("00000000-0000-0000-0000-000000000000"),
+						Settings:                  supertypes.NewMapValueOfNull[string](ctx),
+						SettingsLabels:            supertypes.NewMapValueOfNull[string](ctx),
+					})
+				}),
+			}),
+			detail: "installation UUID",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, diags := newAlertModelFromIssueAlert(ctx, tc.in)
+			if !diags.HasError() {
+				t.Fatal("expected error")
+			}
+			found := false
+			for _, d := range diags.Errors() {
+				if d.Summary() == issueAlertMoveErrorSummary && strings.Contains(d.Detail(), tc.detail) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Errorf("expected error containing %q, got: %s", tc.detail, diags)
+			}
+		})
+	}
+}
+
+func TestNewAlertModelFromIssueAlert_legacyTriggerConditions(t *testing.T) {
+	ctx := context.Background()
+
+	in := newTestIssueAlertModel(ctx, []IssueAlertConditionModel{
+		newTestIssueAlertCondition(ctx, func(m *IssueAlertConditionModel) {
+			m.NewHighPriorityIssue = supertypes.NewSingleNestedObjectValueOf(ctx, &IssueAlertConditionNewHighPriorityIssueModel{Name: types.StringNull()})
+		}),
+	}, nil, nil)
+
+	out, diags := newAlertModelFromIssueAlert(ctx, in)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	legacyTriggerConditions := out.LegacyTriggerConditions.MustGet(ctx)
+	if len(legacyTriggerConditions) != 1 || legacyTriggerConditions[0] != "new_high_priority_issue" {
+		t.Errorf("expected legacy_trigger_conditions [new_high_priority_issue], got %v", legacyTriggerConditions)
+	}
+	if triggerConditions := out.TriggerConditions.MustGet(ctx); len(triggerConditions) != 0 {
+		t.Errorf("expected no trigger conditions, got %d", len(triggerConditions))
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
@@ -34,8 +35,19 @@ func (m *AllProjectsSpikeProtectionResourceModel) Fill(ctx context.Context, proj
 	return
 }
 
+type AllProjectsSpikeProtectionResourceIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+}
+
+func (m AllProjectsSpikeProtectionResourceModel) Identity() AllProjectsSpikeProtectionResourceIdentityModel {
+	return AllProjectsSpikeProtectionResourceIdentityModel{
+		Organization: m.Organization,
+	}
+}
+
 var _ resource.Resource = &AllProjectsSpikeProtectionResource{}
 var _ resource.ResourceWithConfigure = &AllProjectsSpikeProtectionResource{}
+var _ resource.ResourceWithIdentity = &AllProjectsSpikeProtectionResource{}
 
 func NewAllProjectsSpikeProtectionResource() resource.Resource {
 	return &AllProjectsSpikeProtectionResource{}
@@ -168,6 +180,7 @@ func (r *AllProjectsSpikeProtectionResource) Create(ctx context.Context, req res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *AllProjectsSpikeProtectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -194,6 +207,7 @@ func (r *AllProjectsSpikeProtectionResource) Read(ctx context.Context, req resou
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *AllProjectsSpikeProtectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -252,6 +266,7 @@ func (r *AllProjectsSpikeProtectionResource) Update(ctx context.Context, req res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *AllProjectsSpikeProtectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -301,3 +316,14 @@ func (r *AllProjectsSpikeProtectionResource) Delete(ctx context.Context, req res
 		}
 	}
 }
+
+func (r *AllProjectsSpikeProtectionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -95,10 +96,25 @@ func (m *ClientKeyResourceModel) Fill(ctx context.Context, key apiclient.Project
 	return
 }
 
+type ClientKeyResourceIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func (m ClientKeyResourceModel) Identity() ClientKeyResourceIdentityModel {
+	return ClientKeyResourceIdentityModel{
+		Organization: m.Organization,
+		Project:      m.Project,
+		Id:           m.Id,
+	}
+}
+
 var _ resource.Resource = &ClientKeyResource{}
 var _ resource.ResourceWithConfigure = &ClientKeyResource{}
 var _ resource.ResourceWithConfigValidators = &ClientKeyResource{}
 var _ resource.ResourceWithImportState = &ClientKeyResource{}
+var _ resource.ResourceWithIdentity = &ClientKeyResource{}
 
 func NewClientKeyResource() resource.Resource {
 	return &ClientKeyResource{}
@@ -302,6 +318,7 @@ func (r *ClientKeyResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ClientKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -336,6 +353,7 @@ func (r *ClientKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ClientKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -405,6 +423,7 @@ func (r *ClientKeyResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.Identity())...)
 }
 
 func (r *ClientKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -435,3 +454,22 @@ func (r *ClientKeyResource) Delete(ctx context.Context, req resource.DeleteReque
 func (r *ClientKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	intresource.ImportState3PartPath("organization", "project", "id")(ctx, req, resp)
 }
+
+func (r *ClientKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"project": identityschema.StringAttribute{
+				Description:       "The project of this resource.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of this resource.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/jianyuan/terraform-provider-sentry/internal/crontab"
 	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
 	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
 	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
@@ -24,6 +26,7 @@ import (
 
 var _ resource.Resource = &CronMonitorResource{}
 var _ resource.ResourceWithImportState = &CronMonitorResource{}
+var _ resource.ResourceWithIdentity = &CronMonitorResource{}
 
 func NewCronMonitorResource() resource.Resource {
 	return &CronMonitorResource{}
@@ -50,18 +53,22 @@ func (r *CronMonitorResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"organization": schema.StringAttribute{
-				MarkdownDescription: "The organization slug or internal ID to create the monitor for.",
-				Required:            true,
+				MarkdownDescription: "The organization slug or internal ID to create the monitor for. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"project": schema.StringAttribute{
-				MarkdownDescription: "The project slug or internal ID to create the monitor for.",
-				Required:            true,
+				MarkdownDescription: "The project slug or internal ID to create the monitor for. Defaults to the `default_project` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -132,10 +139,11 @@ func (r *CronMonitorResource) Schema(ctx context.Context, req resource.SchemaReq
 				CustomType:          supertypes.NewSingleNestedObjectTypeOf[CronMonitorResourceModelSchedule](ctx),
 				Attributes: map[string]schema.Attribute{
 					"crontab": schema.StringAttribute{
-						MarkdownDescription: "Use the crontab syntax (e.g. `0 0 * * *`). Conflicts with `interval_value` and `interval_unit`.",
+						MarkdownDescription: "Use the crontab syntax (e.g. `0 0 * * *`), including ranges, steps, lists and macros such as `@daily`. Conflicts with `interval_value` and `interval_unit`.",
 						Optional:            true,
 						CustomType:          supertypes.StringType{},
 						Validators: []validator.String{
+							crontab.Validator(),
 							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("interval_value"), path.MatchRelative().AtParent().AtName("interval_unit")),
 							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("interval_value")),
 							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("interval_unit")),
@@ -174,6 +182,14 @@ func (r *CronMonitorResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 				sentrydata.Timezones,
 			),
+			"next_runs": schema.ListAttribute{
+				MarkdownDescription: "The next five times the monitor expects a check-in, in RFC 3339 format and in the monitor's `timezone`. Only set for `crontab` schedules. The times are computed when the schedule or timezone changes and are not updated as they pass, so use `provider::sentry::cron_next(schedule, timezone, plantimestamp(), 5)` for the upcoming times as of a plan.",
+				Computed:            true,
+				CustomType:          supertypes.NewListTypeOf[string](ctx),
+				PlanModifiers: []planmodifier.List{
+					cronMonitorNextRunsUseStateUnlessScheduleChangesModifier{},
+				},
+			},
 		},
 	}
 }
@@ -213,6 +229,7 @@ func (r *CronMonitorResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *CronMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -251,6 +268,7 @@ func (r *CronMonitorResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *CronMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -285,6 +303,7 @@ func (r *CronMonitorResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *CronMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -315,6 +334,35 @@ func (r *CronMonitorResource) ImportState(ctx context.Context, req resource.Impo
 	)(ctx, req, resp)
 }
 
+func (r *CronMonitorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization slug or internal ID to create the monitor for.",
+				RequiredForImport: true,
+				CustomType:        supertypes.StringType{},
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The internal ID of this monitor.",
+				RequiredForImport: true,
+				CustomType:        supertypes.StringType{},
+			},
+		},
+	}
+}
+
+type CronMonitorResourceIdentityModel struct {
+	Organization supertypes.StringValue `tfsdk:"organization"`
+	Id           supertypes.StringValue `tfsdk:"id"`
+}
+
+func (m CronMonitorResourceModel) Identity() CronMonitorResourceIdentityModel {
+	return CronMonitorResourceIdentityModel{
+		Organization: m.Organization,
+		Id:           m.Id,
+	}
+}
+
 type CronMonitorResourceModel struct {
 	Id                    supertypes.StringValue                                                 `tfsdk:"id"`
 	Organization          supertypes.StringValue                                                 `tfsdk:"organization"`
@@ -329,6 +377,7 @@ type CronMonitorResourceModel struct {
 	RecoveryThreshold     supertypes.Int64Value                                                  `tfsdk:"recovery_threshold"`
 	Schedule              supertypes.SingleNestedObjectValueOf[CronMonitorResourceModelSchedule] `tfsdk:"schedule"`
 	Timezone              supertypes.StringValue                                                 `tfsdk:"timezone"`
+	NextRuns              supertypes.ListValueOf[string]                                         `tfsdk:"next_runs"`
 }
 
 type CronMonitorResourceModelOwner struct {
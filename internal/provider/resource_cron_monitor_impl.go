@@ -3,12 +3,81 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/crontab"
 	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
 )
 
+var _ resource.ResourceWithValidateConfig = &CronMonitorResource{}
+
+// cronMonitorNextRunsCount is the number of upcoming check-ins in `next_runs`.
+const cronMonitorNextRunsCount = 5
+
+// cronMonitorIntervalUnitMinutes is the shortest length of each interval unit
+// in minutes. Months are counted as 28 days.
+var cronMonitorIntervalUnitMinutes = map[string]int64{
+	"minute": 1,
+	"hour":   60,
+	"day":    24 * 60,
+	"week":   7 * 24 * 60,
+	"month":  28 * 24 * 60,
+	"year":   365 * 24 * 60,
+}
+
+// cronNextRuns returns the next count times after from that the schedule runs
+// in the given location, formatted in RFC 3339.
+func cronNextRuns(schedule *crontab.Schedule, loc *time.Location, from time.Time, count int) []string {
+	runs := make([]string, 0, count)
+	for _, run := range schedule.NextN(from.In(loc), count) {
+		runs = append(runs, run.Format(time.RFC3339))
+	}
+	return runs
+}
+
+type cronMonitorNextRunsUseStateUnlessScheduleChangesModifier struct{}
+
+func (m cronMonitorNextRunsUseStateUnlessScheduleChangesModifier) Description(_ context.Context) string {
+	return "Use the prior next runs unless the schedule or timezone is changing."
+}
+
+func (m cronMonitorNextRunsUseStateUnlessScheduleChangesModifier) MarkdownDescription(_ context.Context) string {
+	return "Use the prior next runs unless the schedule or timezone is changing."
+}
+
+func (m cronMonitorNextRunsUseStateUnlessScheduleChangesModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	if !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var planSchedule, stateSchedule types.Object
+	var planTimezone, stateTimezone types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("schedule"), &planSchedule)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("schedule"), &stateSchedule)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timezone"), &planTimezone)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timezone"), &stateTimezone)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planSchedule.Equal(stateSchedule) || !planTimezone.Equal(stateTimezone) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
 func (r *CronMonitorResource) getCreateJSONRequestBody(ctx context.Context, data CronMonitorResourceModel) (*apiclient.CreateProjectMonitorJSONRequestBody, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -112,6 +181,59 @@ func (r *CronMonitorResource) getUpdateJSONRequestBody(ctx context.Context, data
 	return r.getCreateJSONRequestBody(ctx, data)
 }
 
+func (r *CronMonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CronMonitorResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Schedule.IsKnown() {
+		return
+	}
+
+	schedule := tfutils.MergeDiagnostics(data.Schedule.Get(ctx))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var periodMinutes int64
+	switch {
+	case schedule.Crontab.IsKnown():
+		s, err := crontab.Parse(schedule.Crontab.Get())
+		if err != nil {
+			// Reported by the attribute validator.
+			return
+		}
+		periodMinutes = int64(s.Period() / time.Minute)
+	case schedule.IntervalValue.IsKnown() && schedule.IntervalUnit.IsKnown():
+		unitMinutes, ok := cronMonitorIntervalUnitMinutes[schedule.IntervalUnit.Get()]
+		if !ok {
+			return
+		}
+		periodMinutes = schedule.IntervalValue.Get() * unitMinutes
+	default:
+		return
+	}
+
+	if data.CheckinMarginMinutes.IsKnown() && data.CheckinMarginMinutes.Get() > periodMinutes {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("checkin_margin_minutes"),
+			"Invalid check-in margin",
+			fmt.Sprintf("The check-in margin (%d minutes) must not exceed the time between scheduled check-ins (%d minutes).", data.CheckinMarginMinutes.Get(), periodMinutes),
+		)
+	}
+
+	if data.MaxRuntimeMinutes.IsKnown() && data.MaxRuntimeMinutes.Get() > periodMinutes {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_runtime_minutes"),
+			"Invalid maximum runtime",
+			fmt.Sprintf("The maximum runtime (%d minutes) must not exceed the time between scheduled check-ins (%d minutes).", data.MaxRuntimeMinutes.Get(), periodMinutes),
+		)
+	}
+}
+
 func (m *CronMonitorResourceModel) Fill(ctx context.Context, data apiclient.ProjectMonitor) (diags diag.Diagnostics) {
 	m.Id.Set(data.Id)
 	m.Name.Set(data.Name)
@@ -172,6 +294,17 @@ func (m *CronMonitorResourceModel) Fill(ctx context.Context, data apiclient.Proj
 		return
 	}
 
+	// The schedule that the known runs were computed for.
+	var prevCrontab, prevTimezone string
+	if m.NextRuns.IsKnown() && m.Schedule.IsKnown() {
+		prevSchedule := tfutils.MergeDiagnostics(m.Schedule.Get(ctx))(&diags)
+		if diags.HasError() {
+			return
+		}
+		prevCrontab = prevSchedule.Crontab.ValueString()
+		prevTimezone = m.Timezone.ValueString()
+	}
+
 	schedule := &CronMonitorResourceModelSchedule{}
 
 	switch configValue := configValue.(type) {
@@ -183,12 +316,25 @@ func (m *CronMonitorResourceModel) Fill(ctx context.Context, data apiclient.Proj
 		m.Timezone.Set(configValue.Timezone)
 		schedule.Crontab.Set(configValue.Schedule)
 
+		// Schedules that cannot be evaluated, such as ones created outside of
+		// Terraform with syntax this provider does not support, have no runs.
+		s, scheduleErr := crontab.Parse(configValue.Schedule)
+		loc, locErr := time.LoadLocation(configValue.Timezone)
+		if scheduleErr != nil || locErr != nil {
+			m.NextRuns.SetNull(ctx)
+		} else if prevCrontab != configValue.Schedule || prevTimezone != configValue.Timezone {
+			// The runs are only recomputed when the schedule changes, so that they
+			// do not change on every refresh.
+			diags.Append(m.NextRuns.Set(ctx, cronNextRuns(s, loc, time.Now(), cronMonitorNextRunsCount))...)
+		}
+
 	case apiclient.ProjectMonitorDataSourceConfigCronInterval:
 		m.CheckinMarginMinutes.Set(configValue.CheckinMargin)
 		m.FailureIssueThreshold.Set(configValue.FailureIssueThreshold)
 		m.MaxRuntimeMinutes.Set(configValue.MaxRuntime)
 		m.RecoveryThreshold.Set(configValue.RecoveryThreshold)
 		m.Timezone.Set(configValue.Timezone)
+		m.NextRuns.SetNull(ctx)
 
 		if len(configValue.Schedule) != 2 {
 			diags.AddError("Invalid schedule", fmt.Sprintf("Expected 2 items, got %d", len(configValue.Schedule)))
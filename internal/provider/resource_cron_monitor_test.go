@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -264,6 +265,95 @@ func TestAccCronMonitorResource_validation(t *testing.T) {
 					"No attribute specified when one (and only one) of [owner.user_id.<.team_id] is required",
 				),
 			},
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_cron_monitor" "test" {
+						organization = "1"
+						project      = "2"
+						name         = "cron monitor name"
+
+						checkin_margin_minutes = 1
+						failure_issue_threshold = 2
+						max_runtime_minutes = 3
+						recovery_threshold = 4
+
+						schedule = {
+							crontab = "0 0 31 2 *"
+						}
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(
+					`the schedule "0 0 31 2 *" never runs`,
+				),
+			},
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_cron_monitor" "test" {
+						organization = "1"
+						project      = "2"
+						name         = "cron monitor name"
+
+						checkin_margin_minutes = 1
+						failure_issue_threshold = 2
+						max_runtime_minutes = 3
+						recovery_threshold = 4
+
+						schedule = {
+							crontab = "0 25 * * *"
+						}
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(
+					`invalid hour field "25": value 25 out of range 0-23`,
+				),
+			},
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_cron_monitor" "test" {
+						organization = "1"
+						project      = "2"
+						name         = "cron monitor name"
+
+						checkin_margin_minutes = 10
+						failure_issue_threshold = 2
+						max_runtime_minutes = 3
+						recovery_threshold = 4
+
+						schedule = {
+							crontab = "*/5 * * * *"
+						}
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(
+					"The check-in margin (10 minutes) must not exceed the time between scheduled check-ins (5 minutes).",
+				),
+			},
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_cron_monitor" "test" {
+						organization = "1"
+						project      = "2"
+						name         = "cron monitor name"
+
+						checkin_margin_minutes = 1
+						failure_issue_threshold = 2
+						max_runtime_minutes = 90
+						recovery_threshold = 4
+
+						schedule = {
+							interval_value = 1
+							interval_unit = "hour"
+						}
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(
+					"The maximum runtime (90 minutes) must not exceed the time between scheduled check-ins (60 minutes).",
+				),
+			},
 		},
 	})
 }
@@ -283,6 +373,9 @@ func TestAccCronMonitorResource_basic(t *testing.T) {
 		})),
 	}
 
+	// The runs are kept while the schedule is unchanged.
+	nextRunsSame := statecheck.CompareValue(compare.ValuesSame())
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -313,6 +406,7 @@ func TestAccCronMonitorResource_basic(t *testing.T) {
 						"interval_value": knownvalue.Int64Exact(1),
 						"interval_unit":  knownvalue.StringExact("day"),
 					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("next_runs"), knownvalue.Null()),
 				),
 			},
 			{
@@ -340,6 +434,8 @@ func TestAccCronMonitorResource_basic(t *testing.T) {
 						"interval_value": knownvalue.Null(),
 						"interval_unit":  knownvalue.Null(),
 					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("next_runs"), knownvalue.ListSizeExact(5)),
+					nextRunsSame.AddStateValue(rn, tfjsonpath.New("next_runs")),
 				),
 			},
 			{
@@ -367,6 +463,7 @@ func TestAccCronMonitorResource_basic(t *testing.T) {
 						"interval_value": knownvalue.Null(),
 						"interval_unit":  knownvalue.Null(),
 					})),
+					nextRunsSame.AddStateValue(rn, tfjsonpath.New("next_runs")),
 				),
 			},
 			{
@@ -374,7 +471,7 @@ func TestAccCronMonitorResource_basic(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       resourceid.ImportState2PartIDFunc(rn, "organization", "id"),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"project"},
+				ImportStateVerifyIgnore: []string{"project", "next_runs"},
 			},
 			{
 				ResourceName: rn,
@@ -386,7 +483,7 @@ func TestAccCronMonitorResource_basic(t *testing.T) {
 					"id", "id",
 				),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"project"},
+				ImportStateVerifyIgnore: []string{"project", "next_runs"},
 			},
 		},
 	})
@@ -0,0 +1,676 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+var _ resource.ResourceWithValidateConfig = &DashboardResource{}
+
+// dashboardGridColumns is the number of columns on the dashboard grid.
+const dashboardGridColumns = 6
+
+var dashboardEquationRegexp = regexp.MustCompile(`^equation\[\d+\]$`)
+
+func (r *DashboardResource) getCreateJSONRequestBody(ctx context.Context, data DashboardResourceModel) (*apiclient.CreateOrganizationDashboardJSONRequestBody, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	out := apiclient.CreateOrganizationDashboardJSONRequestBody{
+		Title:   data.Title.Get(),
+		Widgets: []apiclient.OrganizationDashboardWidget{},
+	}
+
+	if data.Projects.IsKnown() {
+		inProjects := tfutils.MergeDiagnostics(data.Projects.Get(ctx))(&diags)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		outProjects := make([]int64, 0, len(inProjects))
+		for _, project := range inProjects {
+			projectId, err := strconv.ParseInt(project, 10, 64)
+			if err != nil {
+				diags.AddAttributeError(path.Root("projects"), "Invalid project ID", fmt.Sprintf("Project ID %q must be an integer", project))
+				return nil, diags
+			}
+			outProjects = append(outProjects, projectId)
+		}
+		out.Projects = &outProjects
+	}
+
+	if data.Environments.IsKnown() {
+		environments := tfutils.MergeDiagnostics(data.Environments.Get(ctx))(&diags)
+		if diags.HasError() {
+			return nil, diags
+		}
+		out.Environment = &environments
+	}
+
+	if data.Period.IsKnown() {
+		out.Period.Set(data.Period.Get())
+	} else {
+		out.Period.SetNull()
+	}
+
+	if data.Widget.IsKnown() {
+		inWidgets := tfutils.MergeDiagnostics(data.Widget.Get(ctx))(&diags)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, inWidget := range inWidgets {
+			outWidget, widgetDiags := inWidget.ToApi(ctx)
+			diags.Append(widgetDiags...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			out.Widgets = append(out.Widgets, *outWidget)
+		}
+	}
+
+	return &out, diags
+}
+
+func (r *DashboardResource) getUpdateJSONRequestBody(ctx context.Context, data DashboardResourceModel) (*apiclient.UpdateOrganizationDashboardJSONRequestBody, diag.Diagnostics) {
+	return r.getCreateJSONRequestBody(ctx, data)
+}
+
+func (m *DashboardResourceModelWidgetItem) ToApi(ctx context.Context) (*apiclient.OrganizationDashboardWidget, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	out := apiclient.OrganizationDashboardWidget{
+		Title:       m.Title.Get(),
+		DisplayType: m.DisplayType.Get(),
+		Queries:     []apiclient.OrganizationDashboardWidgetQuery{},
+	}
+
+	if m.Id.IsKnown() {
+		out.Id = m.Id.GetPtr()
+	}
+	if m.WidgetType.IsKnown() {
+		out.WidgetType = m.WidgetType.GetPtr()
+	}
+	if m.Interval.IsKnown() {
+		out.Interval = m.Interval.GetPtr()
+	}
+
+	if m.Description.IsKnown() {
+		out.Description.Set(m.Description.Get())
+	} else {
+		out.Description.SetNull()
+	}
+
+	if m.Limit.IsKnown() {
+		out.Limit.Set(m.Limit.Get())
+	} else {
+		out.Limit.SetNull()
+	}
+
+	if m.Query.IsKnown() {
+		inQueries := tfutils.MergeDiagnostics(m.Query.Get(ctx))(&diags)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, inQuery := range inQueries {
+			outQuery := apiclient.OrganizationDashboardWidgetQuery{
+				Name:         inQuery.Name.Get(),
+				Fields:       []string{},
+				Aggregates:   []string{},
+				Columns:      []string{},
+				FieldAliases: []string{},
+				Conditions:   inQuery.Conditions.Get(),
+				Orderby:      inQuery.OrderBy.Get(),
+			}
+			if inQuery.Id.IsKnown() {
+				outQuery.Id = inQuery.Id.GetPtr()
+			}
+			if inQuery.Fields.IsKnown() {
+				outQuery.Fields = tfutils.MergeDiagnostics(inQuery.Fields.Get(ctx))(&diags)
+			}
+			if inQuery.Aggregates.IsKnown() {
+				outQuery.Aggregates = tfutils.MergeDiagnostics(inQuery.Aggregates.Get(ctx))(&diags)
+			}
+			if inQuery.Columns.IsKnown() {
+				outQuery.Columns = tfutils.MergeDiagnostics(inQuery.Columns.Get(ctx))(&diags)
+			}
+			if inQuery.FieldAliases.IsKnown() {
+				outQuery.FieldAliases = tfutils.MergeDiagnostics(inQuery.FieldAliases.Get(ctx))(&diags)
+			}
+			if diags.HasError() {
+				return nil, diags
+			}
+			out.Queries = append(out.Queries, outQuery)
+		}
+	}
+
+	if m.Layout.IsKnown() {
+		inLayout := tfutils.MergeDiagnostics(m.Layout.Get(ctx))(&diags)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		out.Layout = &apiclient.OrganizationDashboardWidgetLayout{
+			X:    inLayout.X.Get(),
+			Y:    inLayout.Y.Get(),
+			W:    inLayout.W.Get(),
+			H:    inLayout.H.Get(),
+			MinH: inLayout.MinH.Get(),
+		}
+	}
+
+	return &out, diags
+}
+
+func (m *DashboardResourceModel) Fill(ctx context.Context, data apiclient.OrganizationDashboard) (diags diag.Diagnostics) {
+	m.Id.Set(data.Id)
+	m.InternalId.Set(data.Id)
+	m.Title.Set(data.Title)
+
+	projects := make([]string, 0, len(data.Projects))
+	for _, project := range data.Projects {
+		projects = append(projects, strconv.FormatInt(project, 10))
+	}
+	m.Projects = supertypes.NewSetValueOfSlice(ctx, projects)
+
+	if data.Environment == nil {
+		m.Environments = supertypes.NewSetValueOfSlice(ctx, []string{})
+	} else {
+		m.Environments = supertypes.NewSetValueOfSlice(ctx, data.Environment)
+	}
+
+	if v, err := data.Period.Get(); err == nil {
+		m.Period.Set(v)
+	} else {
+		m.Period.SetNull()
+	}
+
+	widgets := make([]DashboardResourceModelWidgetItem, 0, len(data.Widgets))
+	for _, inWidget := range data.Widgets {
+		var widget DashboardResourceModelWidgetItem
+		diags.Append(widget.Fill(ctx, inWidget)...)
+		if diags.HasError() {
+			return
+		}
+		widgets = append(widgets, widget)
+	}
+	m.Widget = supertypes.NewListNestedObjectValueOfValueSlice(ctx, widgets)
+
+	return
+}
+
+func (m *DashboardResourceModelWidgetItem) Fill(ctx context.Context, data apiclient.OrganizationDashboardWidget) (diags diag.Diagnostics) {
+	m.Id.SetPtr(data.Id)
+	m.Title.Set(data.Title)
+	m.DisplayType.Set(data.DisplayType)
+	m.WidgetType.SetPtr(data.WidgetType)
+	m.Interval.SetPtr(data.Interval)
+
+	if v, err := data.Description.Get(); err == nil && v != "" {
+		m.Description.Set(v)
+	} else {
+		m.Description.SetNull()
+	}
+
+	if v, err := data.Limit.Get(); err == nil {
+		m.Limit.Set(v)
+	} else {
+		m.Limit.SetNull()
+	}
+
+	queries := make([]DashboardResourceModelWidgetItemQueryItem, 0, len(data.Queries))
+	for _, inQuery := range data.Queries {
+		queries = append(queries, DashboardResourceModelWidgetItemQueryItem{
+			Id:           supertypes.NewStringPointerValue(inQuery.Id),
+			Name:         supertypes.NewStringValue(inQuery.Name),
+			Fields:       supertypes.NewListValueOfSlice(ctx, sliceOrEmpty(inQuery.Fields)),
+			Aggregates:   supertypes.NewSetValueOfSlice(ctx, sliceOrEmpty(inQuery.Aggregates)),
+			Columns:      supertypes.NewSetValueOfSlice(ctx, sliceOrEmpty(inQuery.Columns)),
+			FieldAliases: supertypes.NewListValueOfSlice(ctx, sliceOrEmpty(inQuery.FieldAliases)),
+			Conditions:   supertypes.NewStringValue(inQuery.Conditions),
+			OrderBy:      supertypes.NewStringValue(inQuery.Orderby),
+		})
+	}
+	m.Query = supertypes.NewListNestedObjectValueOfValueSlice(ctx, queries)
+
+	if data.Layout == nil {
+		m.Layout = supertypes.NewSingleNestedObjectValueOfNull[DashboardResourceModelWidgetItemLayout](ctx)
+	} else {
+		m.Layout = supertypes.NewSingleNestedObjectValueOf(ctx, &DashboardResourceModelWidgetItemLayout{
+			X:    supertypes.NewInt64Value(data.Layout.X),
+			Y:    supertypes.NewInt64Value(data.Layout.Y),
+			W:    supertypes.NewInt64Value(data.Layout.W),
+			H:    supertypes.NewInt64Value(data.Layout.H),
+			MinH: supertypes.NewInt64Value(data.Layout.MinH),
+		})
+	}
+
+	return
+}
+
+func sliceOrEmpty[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+func (r *DashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DashboardResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Widget.IsKnown() {
+		return
+	}
+
+	widgets := tfutils.MergeDiagnostics(data.Widget.Get(ctx))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	type placedWidget struct {
+		index      int
+		x, y, w, h int64
+	}
+	var placed []placedWidget
+
+	for i, widget := range widgets {
+		widgetPath := path.Root("widget").AtListIndex(i)
+
+		if widget.Layout.IsKnown() {
+			layout := tfutils.MergeDiagnostics(widget.Layout.Get(ctx))(&resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if layout.X.IsKnown() && layout.W.IsKnown() && layout.X.Get()+layout.W.Get() > dashboardGridColumns {
+				resp.Diagnostics.AddAttributeError(
+					widgetPath.AtName("layout"),
+					"Invalid widget layout",
+					fmt.Sprintf("The widget extends past the %d-column grid: x (%d) + w (%d) must not exceed %d.", dashboardGridColumns, layout.X.Get(), layout.W.Get(), dashboardGridColumns),
+				)
+			}
+
+			if layout.MinH.IsKnown() && layout.H.IsKnown() && layout.MinH.Get() > layout.H.Get() {
+				resp.Diagnostics.AddAttributeError(
+					widgetPath.AtName("layout").AtName("min_h"),
+					"Invalid widget layout",
+					fmt.Sprintf("min_h (%d) must not exceed h (%d).", layout.MinH.Get(), layout.H.Get()),
+				)
+			}
+
+			if layout.X.IsKnown() && layout.Y.IsKnown() && layout.W.IsKnown() && layout.H.IsKnown() {
+				current := placedWidget{
+					index: i,
+					x:     layout.X.Get(),
+					y:     layout.Y.Get(),
+					w:     layout.W.Get(),
+					h:     layout.H.Get(),
+				}
+				for _, other := range placed {
+					if current.x < other.x+other.w && other.x < current.x+current.w &&
+						current.y < other.y+other.h && other.y < current.y+current.h {
+						resp.Diagnostics.AddAttributeError(
+							widgetPath.AtName("layout"),
+							"Invalid widget layout",
+							fmt.Sprintf("The widget overlaps with widget %d.", other.index),
+						)
+					}
+				}
+				placed = append(placed, current)
+			}
+		}
+
+		if !widget.DisplayType.IsKnown() || !widget.Query.IsKnown() {
+			continue
+		}
+
+		queries := tfutils.MergeDiagnostics(widget.Query.Get(ctx))(&resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(validateDashboardWidgetQueries(ctx, widgetPath, widget, queries)...)
+	}
+}
+
+func validateDashboardWidgetQueries(ctx context.Context, widgetPath path.Path, widget *DashboardResourceModelWidgetItem, queries []*DashboardResourceModelWidgetItemQueryItem) (diags diag.Diagnostics) {
+	displayType := widget.DisplayType.Get()
+
+	switch displayType {
+	case "text":
+		if len(queries) > 0 {
+			diags.AddAttributeError(widgetPath.AtName("query"), "Invalid widget queries", "A `text` widget must not have any queries.")
+		}
+		if widget.WidgetType.IsKnown() {
+			diags.AddAttributeError(widgetPath.AtName("widget_type"), "Invalid widget type", "A `text` widget must not set `widget_type`.")
+		}
+		return
+	case "big_number", "table":
+		if len(queries) != 1 {
+			diags.AddAttributeError(widgetPath.AtName("query"), "Invalid widget queries", fmt.Sprintf("A `%s` widget must have exactly one query, got %d.", displayType, len(queries)))
+		}
+	}
+
+	hasColumns := false
+	for j, query := range queries {
+		queryPath := widgetPath.AtName("query").AtListIndex(j)
+
+		var fields, aggregates, columns []string
+		knownFields := true
+		if query.Fields.IsKnown() {
+			fields = tfutils.MergeDiagnostics(query.Fields.Get(ctx))(&diags)
+		} else if query.Fields.IsUnknown() {
+			knownFields = false
+		}
+		if query.Aggregates.IsKnown() {
+			aggregates = tfutils.MergeDiagnostics(query.Aggregates.Get(ctx))(&diags)
+		} else if query.Aggregates.IsUnknown() {
+			knownFields = false
+		}
+		if query.Columns.IsKnown() {
+			columns = tfutils.MergeDiagnostics(query.Columns.Get(ctx))(&diags)
+		} else if query.Columns.IsUnknown() {
+			knownFields = false
+		}
+		if diags.HasError() {
+			return
+		}
+
+		if len(columns) > 0 || query.Columns.IsUnknown() {
+			hasColumns = true
+		}
+
+		switch displayType {
+		case "big_number":
+			if !query.Aggregates.IsUnknown() && len(aggregates) != 1 {
+				diags.AddAttributeError(queryPath.AtName("aggregates"), "Invalid widget query", fmt.Sprintf("A `big_number` widget query must have exactly one aggregate, got %d.", len(aggregates)))
+			}
+		case "line", "area", "bar":
+			if !query.Aggregates.IsUnknown() && len(aggregates) == 0 {
+				diags.AddAttributeError(queryPath.AtName("aggregates"), "Invalid widget query", fmt.Sprintf("A `%s` widget query must have at least one aggregate.", displayType))
+			}
+		}
+
+		// Issue widgets sort by a fixed set of keys (e.g. `date`, `priority`) rather than by a field.
+		if knownFields && widget.WidgetType.Get() != "issue" && query.OrderBy.IsKnown() && query.OrderBy.Get() != "" {
+			orderBy := query.OrderBy.Get()
+			field := orderBy
+			if field[0] == '-' {
+				field = field[1:]
+			}
+			if !slices.Contains(fields, field) && !slices.Contains(aggregates, field) && !slices.Contains(columns, field) && !dashboardEquationRegexp.MatchString(field) {
+				diags.AddAttributeError(
+					queryPath.AtName("order_by"),
+					"Invalid widget query",
+					fmt.Sprintf("order_by %q must reference one of the query's fields, aggregates or columns, or an equation.", orderBy),
+				)
+			}
+		}
+	}
+
+	if widget.Limit.IsKnown() {
+		switch displayType {
+		case "line", "area", "bar":
+			if !hasColumns {
+				diags.AddAttributeError(widgetPath.AtName("limit"), "Invalid widget limit", "`limit` requires at least one query to group by `columns`.")
+			}
+		default:
+			diags.AddAttributeError(widgetPath.AtName("limit"), "Invalid widget limit", fmt.Sprintf("`limit` is not supported by `%s` widgets.", displayType))
+		}
+	}
+
+	return
+}
+
+func (r *DashboardResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	type queryModelV0 struct {
+		Id           types.String `tfsdk:"id"`
+		Fields       types.List   `tfsdk:"fields"`
+		Aggregates   types.Set    `tfsdk:"aggregates"`
+		Columns      types.Set    `tfsdk:"columns"`
+		FieldAliases types.List   `tfsdk:"field_aliases"`
+		Name         types.String `tfsdk:"name"`
+		Conditions   types.String `tfsdk:"conditions"`
+		OrderBy      types.String `tfsdk:"order_by"`
+	}
+	type layoutModelV0 struct {
+		X    types.Int64 `tfsdk:"x"`
+		Y    types.Int64 `tfsdk:"y"`
+		W    types.Int64 `tfsdk:"w"`
+		H    types.Int64 `tfsdk:"h"`
+		MinH types.Int64 `tfsdk:"min_h"`
+	}
+	type widgetModelV0 struct {
+		Id          types.String    `tfsdk:"id"`
+		Title       types.String    `tfsdk:"title"`
+		DisplayType types.String    `tfsdk:"display_type"`
+		Interval    types.String    `tfsdk:"interval"`
+		WidgetType  types.String    `tfsdk:"widget_type"`
+		Limit       types.Int64     `tfsdk:"limit"`
+		Query       []queryModelV0  `tfsdk:"query"`
+		Layout      []layoutModelV0 `tfsdk:"layout"`
+	}
+	type modelV0 struct {
+		Id           types.String    `tfsdk:"id"`
+		Organization types.String    `tfsdk:"organization"`
+		Title        types.String    `tfsdk:"title"`
+		Widget       []widgetModelV0 `tfsdk:"widget"`
+		InternalId   types.String    `tfsdk:"internal_id"`
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"organization": schema.StringAttribute{
+						Required: true,
+					},
+					"title": schema.StringAttribute{
+						Required: true,
+					},
+					"internal_id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"widget": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Computed: true,
+								},
+								"title": schema.StringAttribute{
+									Required: true,
+								},
+								"display_type": schema.StringAttribute{
+									Required: true,
+								},
+								"interval": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+								},
+								"widget_type": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+								},
+								"limit": schema.Int64Attribute{
+									Optional: true,
+									Computed: true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"query": schema.ListNestedBlock{
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											"id": schema.StringAttribute{
+												Computed: true,
+											},
+											"fields": schema.ListAttribute{
+												ElementType: types.StringType,
+												Optional:    true,
+												Computed:    true,
+											},
+											"aggregates": schema.SetAttribute{
+												ElementType: types.StringType,
+												Optional:    true,
+												Computed:    true,
+											},
+											"columns": schema.SetAttribute{
+												ElementType: types.StringType,
+												Optional:    true,
+												Computed:    true,
+											},
+											"field_aliases": schema.ListAttribute{
+												ElementType: types.StringType,
+												Optional:    true,
+												Computed:    true,
+											},
+											"name": schema.StringAttribute{
+												Optional: true,
+											},
+											"conditions": schema.StringAttribute{
+												Optional: true,
+												Computed: true,
+											},
+											"order_by": schema.StringAttribute{
+												Optional: true,
+												Computed: true,
+											},
+										},
+									},
+								},
+								"layout": schema.ListNestedBlock{
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											"x": schema.Int64Attribute{
+												Required: true,
+											},
+											"y": schema.Int64Attribute{
+												Required: true,
+											},
+											"w": schema.Int64Attribute{
+												Required: true,
+											},
+											"h": schema.Int64Attribute{
+												Required: true,
+											},
+											"min_h": schema.Int64Attribute{
+												Required: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorStateData modelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				organization, dashboardId, err := resourceid.Split2Path(priorStateData.Id.ValueString(), "organization", "dashboard-id")
+				if err != nil {
+					resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+					return
+				}
+
+				widgets := make([]DashboardResourceModelWidgetItem, 0, len(priorStateData.Widget))
+				for _, priorWidget := range priorStateData.Widget {
+					widget := DashboardResourceModelWidgetItem{
+						Id:          supertypes.NewStringPointerValueOrNull(priorWidget.Id.ValueStringPointer()),
+						Title:       supertypes.NewStringValue(priorWidget.Title.ValueString()),
+						Description: supertypes.NewStringNull(),
+						DisplayType: supertypes.NewStringValue(priorWidget.DisplayType.ValueString()),
+						WidgetType:  supertypes.NewStringPointerValueOrNull(priorWidget.WidgetType.ValueStringPointer()),
+						Interval:    supertypes.NewStringPointerValueOrNull(priorWidget.Interval.ValueStringPointer()),
+						Limit:       supertypes.NewInt64Null(),
+						Layout:      supertypes.NewSingleNestedObjectValueOfNull[DashboardResourceModelWidgetItemLayout](ctx),
+					}
+
+					// The SDKv2 schema stored an unset limit as 0.
+					if v := priorWidget.Limit.ValueInt64(); v > 0 {
+						widget.Limit.Set(v)
+					}
+
+					queries := make([]DashboardResourceModelWidgetItemQueryItem, 0, len(priorWidget.Query))
+					for _, priorQuery := range priorWidget.Query {
+						query := DashboardResourceModelWidgetItemQueryItem{
+							Id:         supertypes.NewStringPointerValueOrNull(priorQuery.Id.ValueStringPointer()),
+							Name:       supertypes.NewStringValue(priorQuery.Name.ValueString()),
+							Conditions: supertypes.NewStringValue(priorQuery.Conditions.ValueString()),
+							OrderBy:    supertypes.NewStringValue(priorQuery.OrderBy.ValueString()),
+						}
+
+						var fields, aggregates, columns, fieldAliases []string
+						resp.Diagnostics.Append(priorQuery.Fields.ElementsAs(ctx, &fields, false)...)
+						resp.Diagnostics.Append(priorQuery.Aggregates.ElementsAs(ctx, &aggregates, false)...)
+						resp.Diagnostics.Append(priorQuery.Columns.ElementsAs(ctx, &columns, false)...)
+						resp.Diagnostics.Append(priorQuery.FieldAliases.ElementsAs(ctx, &fieldAliases, false)...)
+						if resp.Diagnostics.HasError() {
+							return
+						}
+
+						query.Fields = supertypes.NewListValueOfSlice(ctx, sliceOrEmpty(fields))
+						query.Aggregates = supertypes.NewSetValueOfSlice(ctx, sliceOrEmpty(aggregates))
+						query.Columns = supertypes.NewSetValueOfSlice(ctx, sliceOrEmpty(columns))
+						query.FieldAliases = supertypes.NewListValueOfSlice(ctx, sliceOrEmpty(fieldAliases))
+						queries = append(queries, query)
+					}
+					widget.Query = supertypes.NewListNestedObjectValueOfValueSlice(ctx, queries)
+
+					if len(priorWidget.Layout) == 1 {
+						priorLayout := priorWidget.Layout[0]
+						widget.Layout = supertypes.NewSingleNestedObjectValueOf(ctx, &DashboardResourceModelWidgetItemLayout{
+							X:    supertypes.NewInt64Value(priorLayout.X.ValueInt64()),
+							Y:    supertypes.NewInt64Value(priorLayout.Y.ValueInt64()),
+							W:    supertypes.NewInt64Value(priorLayout.W.ValueInt64()),
+							H:    supertypes.NewInt64Value(priorLayout.H.ValueInt64()),
+							MinH: supertypes.NewInt64Value(priorLayout.MinH.ValueInt64()),
+						})
+					}
+
+					widgets = append(widgets, widget)
+				}
+
+				upgradedStateData := DashboardResourceModel{
+					Id:           supertypes.NewStringValue(dashboardId),
+					Organization: supertypes.NewStringValue(organization),
+					Title:        supertypes.NewStringValue(priorStateData.Title.ValueString()),
+					Projects:     supertypes.NewSetValueOfSlice(ctx, []string{}),
+					Environments: supertypes.NewSetValueOfSlice(ctx, []string{}),
+					Period:       supertypes.NewStringNull(),
+					Widget:       supertypes.NewListNestedObjectValueOfValueSlice(ctx, widgets),
+					InternalId:   supertypes.NewStringValue(dashboardId),
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgradedStateData)...)
+			},
+		},
+	}
+}
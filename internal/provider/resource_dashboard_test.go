@@ -2,51 +2,597 @@ package provider
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"net/http"
 	"strings"
+	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
-	"github.com/jianyuan/go-sentry/v2/sentry"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/providerdata"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("sentry_dashboard", &resource.Sweeper{
-		Name: "sentry_dashboard",
-		F: func(r string) error {
-			ctx := context.Background()
+	sweep.Register("sentry_dashboard", func(ctx context.Context, pd *providerdata.ProviderData) ([]sweep.Sweepable, error) {
+		var sweepables []sweep.Sweepable
 
-			listParams := &sentry.ListCursorParams{}
+		params := &apiclient.ListOrganizationDashboardsParams{}
+		for {
+			listHttpResp, err := acctest.SharedApiClient.ListOrganizationDashboardsWithResponse(ctx, acctest.TestOrganization, params)
+			if err != nil {
+				return nil, err
+			} else if listHttpResp.StatusCode() != http.StatusOK || listHttpResp.JSON200 == nil {
+				return nil, fmt.Errorf("failed to list organization dashboards: %s", listHttpResp.Status())
+			}
 
-			for {
-				dashboards, resp, err := acctest.SharedClient.Dashboards.List(ctx, acctest.TestOrganization, listParams)
-				if err != nil {
-					return err
+			for _, dashboard := range *listHttpResp.JSON200 {
+				if !strings.HasPrefix(dashboard.Title, "tf-dashboard") {
+					continue
 				}
 
-				for _, dashboard := range dashboards {
-					if !strings.HasPrefix(sentry.StringValue(dashboard.Title), "tf-dashboard") {
-						continue
-					}
+				sweepables = append(sweepables, sweep.NewSweepResource(NewDashboardResource, pd, map[string]any{
+					"organization": acctest.TestOrganization,
+					"id":           dashboard.Id,
+				}))
+			}
 
-					log.Printf("[INFO] Destroying Dashboard %q", sentry.StringValue(dashboard.Title))
+			params.Cursor = sentryclient.ParseNextPaginationCursor(listHttpResp.HTTPResponse)
+			if params.Cursor == nil {
+				break
+			}
+		}
 
-					_, err := acctest.SharedClient.Dashboards.Delete(ctx, acctest.TestOrganization, sentry.StringValue(dashboard.ID))
-					if err != nil {
-						log.Printf("[ERROR] Failed to destroy Dashboard %q: %s", sentry.StringValue(dashboard.Title), err)
-						continue
-					}
+		return sweepables, nil
+	})
+}
+
+func TestAccDashboardResource_validation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		widgets     string
+		expectError string
+	}{
+		{
+			name: "layout exceeds grid",
+			widgets: `widget {
+				title        = "Widget"
+				display_type = "table"
+
+				query {
+					fields     = ["count()"]
+					aggregates = ["count()"]
+				}
+
+				layout {
+					x = 4
+					y = 0
+					w = 3
+					h = 2
+				}
+			}`,
+			expectError: "must not exceed 6",
+		},
+		{
+			name: "min_h exceeds h",
+			widgets: `widget {
+				title        = "Widget"
+				display_type = "table"
 
-					log.Printf("[INFO] Dashboard %q has been destroyed.", sentry.StringValue(dashboard.Title))
+				query {
+					fields     = ["count()"]
+					aggregates = ["count()"]
 				}
 
-				if resp.Cursor == "" {
-					break
+				layout {
+					x     = 0
+					y     = 0
+					w     = 2
+					h     = 1
+					min_h = 2
+				}
+			}`,
+			expectError: "min_h (2) must not exceed h (1)",
+		},
+		{
+			name: "overlapping widgets",
+			widgets: `widget {
+				title        = "Widget 1"
+				display_type = "table"
+
+				query {
+					fields     = ["count()"]
+					aggregates = ["count()"]
+				}
+
+				layout {
+					x = 0
+					y = 0
+					w = 3
+					h = 2
 				}
-				listParams.Cursor = resp.Cursor
 			}
 
-			return nil
+			widget {
+				title        = "Widget 2"
+				display_type = "table"
+
+				query {
+					fields     = ["count()"]
+					aggregates = ["count()"]
+				}
+
+				layout {
+					x = 2
+					y = 1
+					w = 2
+					h = 2
+				}
+			}`,
+			expectError: "The widget overlaps with widget 0",
+		},
+		{
+			name: "big number with multiple aggregates",
+			widgets: `widget {
+				title        = "Widget"
+				display_type = "big_number"
+
+				query {
+					fields     = ["count()", "p95(transaction.duration)"]
+					aggregates = ["count()", "p95(transaction.duration)"]
+				}
+
+				layout {
+					x = 0
+					y = 0
+					w = 1
+					h = 1
+				}
+			}`,
+			expectError: "must have exactly one aggregate, got 2",
+		},
+		{
+			name: "table with multiple queries",
+			widgets: `widget {
+				title        = "Widget"
+				display_type = "table"
+
+				query {
+					aggregates = ["count()"]
+				}
+
+				query {
+					aggregates = ["count()"]
+				}
+
+				layout {
+					x = 0
+					y = 0
+					w = 2
+					h = 2
+				}
+			}`,
+			expectError: "must have exactly one query, got 2",
+		},
+		{
+			name: "line without aggregates",
+			widgets: `widget {
+				title        = "Widget"
+				display_type = "line"
+
+				query {
+					columns = ["transaction"]
+				}
+
+				layout {
+					x = 0
+					y = 0
+					w = 2
+					h = 2
+				}
+			}`,
+			expectError: "must have at least one aggregate",
+		},
+		{
+			name: "limit without columns",
+			widgets: `widget {
+				title        = "Widget"
+				display_type = "line"
+				limit        = 5
+
+				query {
+					aggregates = ["count()"]
+				}
+
+				layout {
+					x = 0
+					y = 0
+					w = 2
+					h = 2
+				}
+			}`,
+			expectError: "requires at least one query to group by",
+		},
+		{
+			name: "order by unknown field",
+			widgets: `widget {
+				title        = "Widget"
+				display_type = "table"
+
+				query {
+					fields     = ["transaction", "count()"]
+					aggregates = ["count()"]
+					columns    = ["transaction"]
+					order_by   = "-p95(transaction.duration)"
+				}
+
+				layout {
+					x = 0
+					y = 0
+					w = 2
+					h = 2
+				}
+			}`,
+			expectError: "must reference one of the query's fields",
+		},
+		{
+			name: "text with queries",
+			widgets: `widget {
+				title        = "Widget"
+				display_type = "text"
+
+				query {
+					aggregates = ["count()"]
+				}
+
+				layout {
+					x = 0
+					y = 0
+					w = 2
+					h = 2
+				}
+			}`,
+			expectError: "must not have any queries",
+		},
+		{
+			name: "invalid aggregate",
+			widgets: `widget {
+				title        = "Widget"
+				display_type = "table"
+
+				query {
+					fields     = ["count()"]
+					aggregates = ["count("]
+				}
+
+				layout {
+					x = 0
+					y = 0
+					w = 2
+					h = 2
+				}
+			}`,
+			expectError: "unclosed parenthesis (at position 6)",
+		},
+		{
+			name: "invalid conditions",
+			widgets: `widget {
+				title        = "Widget"
+				display_type = "table"
+
+				query {
+					fields     = ["count()"]
+					aggregates = ["count()"]
+					conditions = "(level:error"
+				}
+
+				layout {
+					x = 0
+					y = 0
+					w = 2
+					h = 2
+				}
+			}`,
+			expectError: "unclosed parenthesis (at position 1)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { acctest.PreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						PlanOnly: true,
+						Config: fmt.Sprintf(`
+							resource "sentry_dashboard" "test" {
+								organization = "1"
+								title        = "dashboard"
+
+								%s
+							}
+						`, tc.widgets),
+						ExpectError: acctest.ExpectLiteralError(tc.expectError),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccDashboardResource_basic(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-project")
+	dashboardTitle := acctest.RandomWithPrefix("tf-dashboard")
+	rn := "sentry_dashboard.test"
+
+	checks := []statecheck.StateCheck{
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("internal_id"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardResourceConfig(projectName, dashboardTitle, `
+					widget {
+						title        = "Custom Widget"
+						display_type = "table"
+						widget_type  = "error-events"
+
+						query {
+							name       = "Metric"
+							fields     = ["geo.country_code", "geo.region", "count()"]
+							aggregates = ["count()"]
+							columns    = ["geo.country_code", "geo.region"]
+							conditions = "has:geo.country_code"
+							order_by   = "-count()"
+						}
+
+						layout {
+							x = 0
+							y = 0
+							w = 2
+							h = 2
+						}
+					}
+				`),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("title"), knownvalue.StringExact(dashboardTitle)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("projects"), knownvalue.SetSizeExact(0)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environments"), knownvalue.SetSizeExact(0)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("period"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widget"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"id":           knownvalue.NotNull(),
+							"title":        knownvalue.StringExact("Custom Widget"),
+							"display_type": knownvalue.StringExact("table"),
+							"widget_type":  knownvalue.StringExact("error-events"),
+							"query": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.ObjectPartial(map[string]knownvalue.Check{
+									"id":         knownvalue.NotNull(),
+									"name":       knownvalue.StringExact("Metric"),
+									"conditions": knownvalue.StringExact("has:geo.country_code"),
+									"order_by":   knownvalue.StringExact("-count()"),
+								}),
+							}),
+							"layout": knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"x":     knownvalue.Int64Exact(0),
+								"y":     knownvalue.Int64Exact(0),
+								"w":     knownvalue.Int64Exact(2),
+								"h":     knownvalue.Int64Exact(2),
+								"min_h": knownvalue.Int64Exact(1),
+							}),
+						}),
+					})),
+				),
+			},
+			{
+				Config: testAccDashboardResourceConfig(projectName, dashboardTitle+"-updated", `
+					projects     = [sentry_project.test.internal_id]
+					environments = ["production"]
+					period       = "7d"
+
+					widget {
+						title        = "Errors"
+						display_type = "big_number"
+						widget_type  = "error-events"
+
+						query {
+							fields     = ["count()"]
+							aggregates = ["count()"]
+						}
+
+						layout {
+							x = 0
+							y = 0
+							w = 1
+							h = 1
+						}
+					}
+
+					widget {
+						title        = "Errors by Transaction"
+						display_type = "line"
+						widget_type  = "error-events"
+						limit        = 5
+
+						query {
+							fields     = ["transaction", "count()"]
+							aggregates = ["count()"]
+							columns    = ["transaction"]
+							order_by   = "-count()"
+						}
+
+						layout {
+							x = 1
+							y = 0
+							w = 5
+							h = 2
+						}
+					}
+
+					widget {
+						title        = "Notes"
+						display_type = "text"
+						description  = "Maintained by Terraform."
+
+						layout {
+							x = 0
+							y = 2
+							w = 6
+							h = 1
+						}
+					}
+				`),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("title"), knownvalue.StringExact(dashboardTitle+"-updated")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("projects"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environments"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("production"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("period"), knownvalue.StringExact("7d")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widget"), knownvalue.ListSizeExact(3)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widget").AtSliceIndex(1).AtMapKey("limit"), knownvalue.Int64Exact(5)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widget").AtSliceIndex(2).AtMapKey("description"), knownvalue.StringExact("Maintained by Terraform.")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widget").AtSliceIndex(2).AtMapKey("query"), knownvalue.ListSizeExact(0)),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: resourceid.ImportState2PartIDFunc(rn, "organization", "id"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName: rn,
+				ImportState:  true,
+				ImportStateIdFunc: resourceid.ImportStateURL2PartIDFunc(
+					rn,
+					"https://{organization}.sentry.io/dashboard/{id}/",
+					"organization", "organization",
+					"id", "id",
+				),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
+
+func TestAccDashboardResource_UpgradeFromVersion(t *testing.T) {
+	dashboardTitle := acctest.RandomWithPrefix("tf-dashboard")
+	rn := "sentry_dashboard.test"
+
+	checks := []statecheck.StateCheck{
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("title"), knownvalue.StringExact(dashboardTitle)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("internal_id"), knownvalue.NotNull()),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					acctest.ProviderName: {
+						Source:            "jianyuan/sentry",
+						VersionConstraint: "0.14.1",
+					},
+				},
+				Config: fmt.Sprintf(`
+					resource "sentry_dashboard" "test" {
+						organization = "%[1]s"
+						title        = "%[2]s"
+
+						widget {
+							title        = "Custom Widget"
+							display_type = "table"
+
+							query {
+								name       = "Metric"
+								fields     = ["geo.country_code", "geo.region", "count()"]
+								aggregates = ["count()"]
+								conditions = "!event.type:transaction has:geo.country_code"
+							}
+
+							layout {
+								x     = 0
+								y     = 0
+								w     = 2
+								h     = 1
+								min_h = 1
+							}
+						}
+					}
+				`, acctest.TestOrganization, dashboardTitle),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widget"), knownvalue.ListSizeExact(1)),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Config: fmt.Sprintf(`
+					resource "sentry_dashboard" "test" {
+						organization = "%[1]s"
+						title        = "%[2]s"
+
+						widget {
+							title        = "Custom Widget"
+							display_type = "table"
+
+							query {
+								name       = "Metric"
+								fields     = ["geo.country_code", "geo.region", "count()"]
+								aggregates = ["count()"]
+								conditions = "!event.type:transaction has:geo.country_code"
+							}
+
+							layout {
+								x     = 0
+								y     = 0
+								w     = 2
+								h     = 1
+								min_h = 1
+							}
+						}
+					}
+				`, acctest.TestOrganization, dashboardTitle),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widget"), knownvalue.ListSizeExact(1)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDashboardResourceConfig(projectName, title, extras string) string {
+	return fmt.Sprintf(`
+		resource "sentry_project" "test" {
+			organization = "%[1]s"
+			teams        = ["%[2]s"]
+			name         = "%[3]s"
+		}
+
+		resource "sentry_dashboard" "test" {
+			organization = "%[1]s"
+			title        = "%[4]s"
+
+			%[5]s
+		}
+	`, acctest.TestOrganization, acctest.TestTeam.Slug, projectName, title, extras)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
@@ -31,9 +32,24 @@ func (m *IntegrationOpsgenieModel) Fill(ctx context.Context, item apiclient.Orga
 	return
 }
 
+type IntegrationOpsgenieIdentityModel struct {
+	Organization  types.String `tfsdk:"organization"`
+	IntegrationId types.String `tfsdk:"integration_id"`
+	Id            types.String `tfsdk:"id"`
+}
+
+func (m IntegrationOpsgenieModel) Identity() IntegrationOpsgenieIdentityModel {
+	return IntegrationOpsgenieIdentityModel{
+		Organization:  m.Organization,
+		IntegrationId: m.IntegrationId,
+		Id:            m.Id,
+	}
+}
+
 var _ resource.Resource = &IntegrationOpsgenie{}
 var _ resource.ResourceWithConfigure = &IntegrationOpsgenie{}
 var _ resource.ResourceWithImportState = &IntegrationOpsgenie{}
+var _ resource.ResourceWithIdentity = &IntegrationOpsgenie{}
 
 func NewIntegrationOpsgenie() resource.Resource {
 	return &IntegrationOpsgenie{}
@@ -171,6 +187,7 @@ func (r *IntegrationOpsgenie) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *IntegrationOpsgenie) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -222,6 +239,7 @@ func (r *IntegrationOpsgenie) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *IntegrationOpsgenie) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -336,6 +354,7 @@ func (r *IntegrationOpsgenie) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *IntegrationOpsgenie) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -403,3 +422,22 @@ func (r *IntegrationOpsgenie) Delete(ctx context.Context, req resource.DeleteReq
 func (r *IntegrationOpsgenie) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	intresource.ImportState3PartPath("organization", "integration_id", "id")(ctx, req, resp)
 }
+
+func (r *IntegrationOpsgenie) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"integration_id": identityschema.StringAttribute{
+				Description:       "The ID of the organization integration.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of this resource.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
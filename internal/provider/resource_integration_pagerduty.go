@@ -7,9 +7,14 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
@@ -17,17 +22,21 @@ import (
 )
 
 type IntegrationPagerDutyModel struct {
-	Id             types.String `tfsdk:"id"`
-	Organization   types.String `tfsdk:"organization"`
-	IntegrationId  types.String `tfsdk:"integration_id"`
-	Service        types.String `tfsdk:"service"`
-	IntegrationKey types.String `tfsdk:"integration_key"`
+	Id                      types.String `tfsdk:"id"`
+	Organization            types.String `tfsdk:"organization"`
+	IntegrationId           types.String `tfsdk:"integration_id"`
+	Service                 types.String `tfsdk:"service"`
+	IntegrationKey          types.String `tfsdk:"integration_key"`
+	IntegrationKeyWo        types.String `tfsdk:"integration_key_wo"`
+	IntegrationKeyWoVersion types.Int64  `tfsdk:"integration_key_wo_version"`
 }
 
 func (m *IntegrationPagerDutyModel) Fill(ctx context.Context, item apiclient.OrganizationIntegrationPagerDutyServiceTableItem) (diags diag.Diagnostics) {
 	m.Id = types.StringValue(item.Id.String())
 	m.Service = types.StringValue(item.Service)
-	m.IntegrationKey = types.StringValue(item.IntegrationKey)
+	if m.IntegrationKeyWoVersion.IsNull() {
+		m.IntegrationKey = types.StringValue(item.IntegrationKey)
+	}
 	return
 }
 
@@ -41,9 +50,24 @@ type IntegrationPagerDutyConfigData struct {
 	ServiceTable []IntegrationPagerDutyConfigDataServiceTableItem `json:"service_table"`
 }
 
+type IntegrationPagerDutyIdentityModel struct {
+	Organization  types.String `tfsdk:"organization"`
+	IntegrationId types.String `tfsdk:"integration_id"`
+	Id            types.String `tfsdk:"id"`
+}
+
+func (m IntegrationPagerDutyModel) Identity() IntegrationPagerDutyIdentityModel {
+	return IntegrationPagerDutyIdentityModel{
+		Organization:  m.Organization,
+		IntegrationId: m.IntegrationId,
+		Id:            m.Id,
+	}
+}
+
 var _ resource.Resource = &IntegrationPagerDuty{}
 var _ resource.ResourceWithConfigure = &IntegrationPagerDuty{}
 var _ resource.ResourceWithImportState = &IntegrationPagerDuty{}
+var _ resource.ResourceWithIdentity = &IntegrationPagerDuty{}
 
 func NewIntegrationPagerDuty() resource.Resource {
 	return &IntegrationPagerDuty{}
@@ -73,21 +97,44 @@ func (r *IntegrationPagerDuty) Schema(ctx context.Context, req resource.SchemaRe
 				Required:            true,
 			},
 			"integration_key": schema.StringAttribute{
-				MarkdownDescription: "The integration key of the PagerDuty service.",
-				Required:            true,
+				MarkdownDescription: "The integration key of the PagerDuty service. Exactly one of `integration_key` or `integration_key_wo` must be set.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("integration_key_wo")),
+				},
+			},
+			"integration_key_wo": schema.StringAttribute{
+				MarkdownDescription: "The integration key of the PagerDuty service as a write-only attribute that is never persisted to state. Use in place of `integration_key` with Terraform 1.11 and later. Must be set together with `integration_key_wo_version`.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("integration_key_wo_version")),
+				},
+			},
+			"integration_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of `integration_key_wo`. Change this value to send an updated `integration_key_wo` to Sentry.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("integration_key_wo")),
+				},
 			},
 		},
 	}
 }
 
 func (r *IntegrationPagerDuty) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data IntegrationPagerDutyModel
+	var data, config IntegrationPagerDutyModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	integrationKey := writeOnlyStringPointer(data.IntegrationKey, config.IntegrationKeyWo)
+
 	getHttpResp, err := r.apiClient.GetOrganizationIntegrationWithResponse(ctx, data.Organization.ValueString(), data.IntegrationId.ValueString())
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
@@ -115,7 +162,7 @@ func (r *IntegrationPagerDuty) Create(ctx context.Context, req resource.CreateRe
 
 	specificIntegration.ConfigData.ServiceTable = append(specificIntegration.ConfigData.ServiceTable, apiclient.OrganizationIntegrationPagerDutyServiceTableItem{
 		Service:        data.Service.ValueString(),
-		IntegrationKey: data.IntegrationKey.ValueString(),
+		IntegrationKey: *integrationKey,
 		Id:             json.Number("0"),
 	})
 
@@ -162,7 +209,7 @@ func (r *IntegrationPagerDuty) Create(ctx context.Context, req resource.CreateRe
 
 	var found *apiclient.OrganizationIntegrationPagerDutyServiceTableItem
 	for _, item := range specificIntegration.ConfigData.ServiceTable {
-		if item.Service == data.Service.ValueString() && item.IntegrationKey == data.IntegrationKey.ValueString() {
+		if item.Service == data.Service.ValueString() && item.IntegrationKey == *integrationKey {
 			if _, ok := idsSeen[item.Id]; !ok {
 				found = &item
 				break
@@ -181,6 +228,7 @@ func (r *IntegrationPagerDuty) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *IntegrationPagerDuty) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -232,16 +280,20 @@ func (r *IntegrationPagerDuty) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *IntegrationPagerDuty) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data IntegrationPagerDutyModel
+	var data, config IntegrationPagerDutyModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	integrationKey := writeOnlyStringPointer(data.IntegrationKey, config.IntegrationKeyWo)
+
 	httpResp, err := r.apiClient.GetOrganizationIntegrationWithResponse(ctx, data.Organization.ValueString(), data.IntegrationId.ValueString())
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
@@ -278,7 +330,7 @@ func (r *IntegrationPagerDuty) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	found.Service = data.Service.ValueString()
-	found.IntegrationKey = data.IntegrationKey.ValueString()
+	found.IntegrationKey = *integrationKey
 
 	configDataJSON, err := json.Marshal(specificIntegration.ConfigData)
 	if err != nil {
@@ -346,6 +398,7 @@ func (r *IntegrationPagerDuty) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *IntegrationPagerDuty) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -413,3 +466,22 @@ func (r *IntegrationPagerDuty) Delete(ctx context.Context, req resource.DeleteRe
 func (r *IntegrationPagerDuty) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	intresource.ImportState3PartPath("organization", "integration_id", "id")(ctx, req, resp)
 }
+
+func (r *IntegrationPagerDuty) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"integration_id": identityschema.StringAttribute{
+				Description:       "The ID of the organization integration.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of this resource.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
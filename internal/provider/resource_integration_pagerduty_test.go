@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
 	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
 )
@@ -115,3 +116,61 @@ resource "sentry_integration_pagerduty" "test" {
 }
 `, acctest.TestOrganization, acctest.TestPagerDutyOrganization, serviceName, integrationKey)
 }
+
+func TestAccIntegrationPagerDutyResource_writeOnly(t *testing.T) {
+	serviceName := acctest.RandomWithPrefix("tf-pagerduty-service")
+	integrationKey := acctest.RandomWithPrefix("tf-integration-key")
+	rn := "sentry_integration_pagerduty.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+
+			if acctest.TestPagerDutyOrganization == "" {
+				t.Skip("Skipping test due to missing SENTRY_TEST_PAGERDUTY_ORGANIZATION environment variable")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationPagerDutyResourceWriteOnlyConfig(serviceName, integrationKey, 1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("service"), knownvalue.StringExact(serviceName)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("integration_key"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("integration_key_wo"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("integration_key_wo_version"), knownvalue.Int64Exact(1)),
+				},
+			},
+			{
+				Config: testAccIntegrationPagerDutyResourceWriteOnlyConfig(serviceName, integrationKey+"-rotated", 2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("integration_key"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("integration_key_wo"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("integration_key_wo_version"), knownvalue.Int64Exact(2)),
+				},
+			},
+		},
+	})
+}
+
+func testAccIntegrationPagerDutyResourceWriteOnlyConfig(serviceName, integrationKey string, version int) string {
+	return fmt.Sprintf(`
+data "sentry_organization_integration" "pagerduty" {
+	organization = "%[1]s"
+	provider_key = "pagerduty"
+	name         = "%[2]s"
+}
+
+resource "sentry_integration_pagerduty" "test" {
+	organization               = data.sentry_organization_integration.pagerduty.organization
+	integration_id             = data.sentry_organization_integration.pagerduty.id
+	service                    = "%[3]s"
+	integration_key_wo         = "%[4]s"
+	integration_key_wo_version = %[5]d
+}
+`, acctest.TestOrganization, acctest.TestPagerDutyOrganization, serviceName, integrationKey, version)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -27,11 +28,26 @@ import (
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
 
+type IssueAlertResourceIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func (m IssueAlertModel) Identity() IssueAlertResourceIdentityModel {
+	return IssueAlertResourceIdentityModel{
+		Organization: m.Organization,
+		Project:      m.Project,
+		Id:           m.Id,
+	}
+}
+
 var _ resource.Resource = &IssueAlertResource{}
 var _ resource.ResourceWithConfigValidators = &IssueAlertResource{}
 var _ resource.ResourceWithValidateConfig = &IssueAlertResource{}
 var _ resource.ResourceWithConfigure = &IssueAlertResource{}
 var _ resource.ResourceWithImportState = &IssueAlertResource{}
+var _ resource.ResourceWithIdentity = &IssueAlertResource{}
 var _ resource.ResourceWithUpgradeState = &IssueAlertResource{}
 
 func NewIssueAlertResource() resource.Resource {
@@ -44,6 +60,8 @@ type IssueAlertResource struct {
 
 func (r *IssueAlertResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_issue_alert"
+	// The project is read back from the API and follows slug renames.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *IssueAlertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -70,6 +88,7 @@ func (r *IssueAlertResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"- `filters` become `action_filters[].conditions` (e.g. `tagged_event`, `age_comparison`, `level`), and `filter_match` becomes `action_filters[].logic_type`.\n" +
 			"- `actions` become `action_filters[].actions` (e.g. `email`, `slack`), and `frequency` becomes `frequency_minutes`.\n" +
 			"- `sentry_alert` requires `monitor_ids`. For a classic alert that is not tied to a monitor, reference a project default monitor with the [`sentry_project_error_monitor`](../data-sources/project_error_monitor.md) or [`sentry_project_issue_stream_monitor`](../data-sources/project_issue_stream_monitor.md) data source — no monitor resource needs to be created.\n\n" +
+			"In Terraform v1.8.0 and later, an existing issue alert can be moved to `sentry_alert` without being recreated. Replace the resource with its `sentry_alert` equivalent and add a `moved` block from `sentry_issue_alert` to `sentry_alert`. The provider looks up the alert Sentry migrated the issue alert to and attaches the project's issue stream monitor. Issue alerts that still use the JSON `conditions`, `filters` or `actions` attributes, or that cannot be expressed as a `sentry_alert` (e.g. `action_match = \"all\"` with several issue state conditions), fail with an error describing what to change.\n\n" +
 			"A few legacy trigger types (e.g. `new_high_priority_issue`, `existing_high_priority_issue`) are currently only available through `sentry_alert`'s `legacy_trigger_conditions` passthrough.\n\n" +
 			"**NOTE:** The `conditions`, `filters`, and `actions` attributes, which are JSON strings, have been deprecated in favor of `conditions_v2`, `filters_v2`, and `actions_v2`, which are lists of objects.\n\n" +
 			"The `*_v2` attributes are available starting from v0.14.2.",
@@ -906,6 +925,7 @@ func (r *IssueAlertResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *IssueAlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -916,10 +936,10 @@ func (r *IssueAlertResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(r.read(ctx, &resp.State, &data)...)
+	resp.Diagnostics.Append(r.read(ctx, &resp.State, resp.Identity, &data)...)
 }
 
-func (r *IssueAlertResource) read(ctx context.Context, state *tfsdk.State, data *IssueAlertModel) (diags diag.Diagnostics) {
+func (r *IssueAlertResource) read(ctx context.Context, state *tfsdk.State, identity *tfsdk.ResourceIdentity, data *IssueAlertModel) (diags diag.Diagnostics) {
 	httpResp, err := r.apiClient.GetProjectRuleWithResponse(
 		ctx,
 		data.Organization.ValueString(),
@@ -943,6 +963,7 @@ func (r *IssueAlertResource) read(ctx context.Context, state *tfsdk.State, data
 	}
 
 	diags.Append(state.Set(ctx, data)...)
+	diags.Append(identity.Set(ctx, data.Identity())...)
 	return
 }
 
@@ -1071,7 +1092,7 @@ func (r *IssueAlertResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	resp.Diagnostics.Append(r.read(ctx, &resp.State, &data)...)
+	resp.Diagnostics.Append(r.read(ctx, &resp.State, resp.Identity, &data)...)
 }
 
 func (r *IssueAlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -1119,6 +1140,25 @@ func (r *IssueAlertResource) ImportState(ctx context.Context, req resource.Impor
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("actions_v2"), supertypes.NewListNestedObjectValueOfValueSlice(ctx, []IssueAlertActionModel{}))...)
 }
 
+func (r *IssueAlertResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"project": identityschema.StringAttribute{
+				Description:       "The project of this resource.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of this resource.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *IssueAlertResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	type modelV0 struct {
 		Id           types.String `tfsdk:"id"`
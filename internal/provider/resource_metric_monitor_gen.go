@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
 	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryquery"
 	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 	fint64validator "github.com/orange-cloudavenue/terraform-plugin-framework-validators/int64validator"
@@ -27,6 +29,7 @@ import (
 
 var _ resource.Resource = &MetricMonitorResource{}
 var _ resource.ResourceWithImportState = &MetricMonitorResource{}
+var _ resource.ResourceWithIdentity = &MetricMonitorResource{}
 
 func NewMetricMonitorResource() resource.Resource {
 	return &MetricMonitorResource{}
@@ -53,18 +56,22 @@ func (r *MetricMonitorResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"organization": schema.StringAttribute{
-				MarkdownDescription: "The organization slug or internal ID to create the monitor for.",
-				Required:            true,
+				MarkdownDescription: "The organization slug or internal ID to create the monitor for. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"project": schema.StringAttribute{
-				MarkdownDescription: "The project slug or internal ID to create the monitor for.",
-				Required:            true,
+				MarkdownDescription: "The project slug or internal ID to create the monitor for. Defaults to the `default_project` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -110,9 +117,12 @@ func (r *MetricMonitorResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"aggregate": schema.StringAttribute{
-				MarkdownDescription: "Aggregate query to run on the metric.",
+				MarkdownDescription: "Aggregate query to run on the metric, for example `count()` or `p95(transaction.duration)`.",
 				Required:            true,
 				CustomType:          supertypes.StringType{},
+				Validators: []validator.String{
+					sentryquery.AlertAggregateValidator(),
+				},
 			},
 			"dataset": tfutils.WithEnumStringAttribute(
 				schema.StringAttribute{
@@ -140,6 +150,9 @@ func (r *MetricMonitorResource) Schema(ctx context.Context, req resource.SchemaR
 				Optional:            true,
 				Computed:            true,
 				CustomType:          supertypes.StringType{},
+				Validators: []validator.String{
+					sentryquery.SearchQueryValidator(),
+				},
 			},
 			"query_type": tfutils.WithEnumStringAttribute(
 				schema.StringAttribute{
@@ -153,12 +166,15 @@ func (r *MetricMonitorResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 				sentrydata.SnubaQueryTypes,
 			),
-			"time_window_seconds": schema.Int64Attribute{
-				MarkdownDescription: "The time window in seconds to use for the aggregate query.",
-				Optional:            true,
-				Computed:            true,
-				CustomType:          supertypes.Int64Type{},
-			},
+			"time_window_seconds": tfutils.WithEnumInt64Attribute(
+				schema.Int64Attribute{
+					MarkdownDescription: "The time window in seconds to use for the aggregate query.",
+					Optional:            true,
+					Computed:            true,
+					CustomType:          supertypes.Int64Type{},
+				},
+				sentryquery.AlertTimeWindowSeconds,
+			),
 			"extrapolation_mode": tfutils.WithEnumStringAttribute(
 				schema.StringAttribute{
 					MarkdownDescription: "Extrapolation mode to use for the aggregate query.",
@@ -301,6 +317,7 @@ func (r *MetricMonitorResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *MetricMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -339,6 +356,7 @@ func (r *MetricMonitorResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *MetricMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -373,6 +391,7 @@ func (r *MetricMonitorResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *MetricMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -403,6 +422,35 @@ func (r *MetricMonitorResource) ImportState(ctx context.Context, req resource.Im
 	)(ctx, req, resp)
 }
 
+func (r *MetricMonitorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization slug or internal ID to create the monitor for.",
+				RequiredForImport: true,
+				CustomType:        supertypes.StringType{},
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The internal ID of this monitor.",
+				RequiredForImport: true,
+				CustomType:        supertypes.StringType{},
+			},
+		},
+	}
+}
+
+type MetricMonitorResourceIdentityModel struct {
+	Organization supertypes.StringValue `tfsdk:"organization"`
+	Id           supertypes.StringValue `tfsdk:"id"`
+}
+
+func (m MetricMonitorResourceModel) Identity() MetricMonitorResourceIdentityModel {
+	return MetricMonitorResourceIdentityModel{
+		Organization: m.Organization,
+		Id:           m.Id,
+	}
+}
+
 type MetricMonitorResourceModel struct {
 	Id                supertypes.StringValue                                                         `tfsdk:"id"`
 	Organization      supertypes.StringValue                                                         `tfsdk:"organization"`
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+const metricAlertMoveErrorSummary = "Unable to move sentry_metric_alert"
+
+// metricAlertState is the state of the SDKv2 sentry_metric_alert resource. The
+// SDKv2 stores unset optional values as their zero value, so empty strings and
+// zero numbers are treated as unset.
+type metricAlertState struct {
+	Id               string                    `json:"id"`
+	Organization     string                    `json:"organization"`
+	Project          string                    `json:"project"`
+	Name             string                    `json:"name"`
+	Environment      *string                   `json:"environment"`
+	Dataset          *string                   `json:"dataset"`
+	EventTypes       []string                  `json:"event_types"`
+	Query            *string                   `json:"query"`
+	Aggregate        string                    `json:"aggregate"`
+	TimeWindow       float64                   `json:"time_window"`
+	ThresholdType    int64                     `json:"threshold_type"`
+	ResolveThreshold *float64                  `json:"resolve_threshold"`
+	ComparisonDelta  *float64                  `json:"comparison_delta"`
+	Triggers         []metricAlertTriggerState `json:"trigger"`
+	Owner            *string                   `json:"owner"`
+	InternalId       string                    `json:"internal_id"`
+}
+
+type metricAlertTriggerState struct {
+	Label          string                          `json:"label"`
+	AlertThreshold float64                         `json:"alert_threshold"`
+	Actions        []metricAlertTriggerActionState `json:"action"`
+}
+
+type metricAlertTriggerActionState struct {
+	Type             string  `json:"type"`
+	TargetType       string  `json:"target_type"`
+	TargetIdentifier *string `json:"target_identifier"`
+	InputChannelId   *string `json:"input_channel_id"`
+	IntegrationId    *int64  `json:"integration_id"`
+	SentryAppId      *int64  `json:"sentry_app_id"`
+	Priority         *string `json:"priority"`
+}
+
+// metricAlertTriggerPriorities maps metric alert trigger labels to the priority
+// of the issue raised by the equivalent monitor condition.
+var metricAlertTriggerPriorities = map[string]int64{
+	"critical": sentrydata.DetectorPriorityLevelNameToId["high"],
+	"warning":  sentrydata.DetectorPriorityLevelNameToId["medium"],
+}
+
+// readMetricAlertMoveState decodes the raw state of a sentry_metric_alert
+// resource. It returns nil without diagnostics if the request is for another
+// resource type.
+func readMetricAlertMoveState(req resource.MoveStateRequest) (*metricAlertState, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if req.SourceTypeName != "sentry_metric_alert" || !strings.HasSuffix(req.SourceProviderAddress, "jianyuan/sentry") {
+		return nil, diags
+	}
+
+	if req.SourceSchemaVersion != 0 || req.SourceRawState == nil {
+		diags.AddError(metricAlertMoveErrorSummary, fmt.Sprintf("The source state uses schema version %d, but only version 0 is supported.", req.SourceSchemaVersion))
+		return nil, diags
+	}
+
+	var state metricAlertState
+	if err := json.Unmarshal(req.SourceRawState.JSON, &state); err != nil {
+		diags.AddError(metricAlertMoveErrorSummary, fmt.Sprintf("Unable to decode the source state: %s", err))
+		return nil, diags
+	}
+
+	if state.InternalId == "" {
+		_, _, alertId, err := resourceid.Split3Path(state.Id, "organization-slug", "project-slug", "alert-id")
+		if err != nil {
+			diags.AddError(metricAlertMoveErrorSummary, fmt.Sprintf("Unable to parse the source ID: %s", err))
+			return nil, diags
+		}
+		state.InternalId = alertId
+	}
+
+	return &state, diags
+}
+
+// getMetricAlertDetectorId returns the ID of the monitor Sentry migrated a metric
+// alert to.
+func getMetricAlertDetectorId(ctx context.Context, apiClient *apiclient.ClientWithResponses, organization string, alertRuleId string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	httpResp, err := apiClient.GetOrganizationAlertRuleDetectorWithResponse(ctx, organization, &apiclient.GetOrganizationAlertRuleDetectorParams{
+		AlertRuleId: &alertRuleId,
+	})
+	if err != nil {
+		diags.Append(diagutils.NewClientError("read alert rule detector", err))
+		return "", diags
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		diags.AddError(metricAlertMoveErrorSummary, fmt.Sprintf("Sentry has not migrated metric alert %q to a monitor yet. Try again once the monitor is visible in Sentry.", alertRuleId))
+		return "", diags
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		diags.Append(diagutils.NewClientStatusError("read alert rule detector", httpResp.StatusCode(), httpResp.Body))
+		return "", diags
+	}
+
+	return httpResp.JSON200.DetectorId, diags
+}
+
+var _ resource.ResourceWithMoveState = &MetricMonitorResource{}
+
+func (r *MetricMonitorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: r.moveMetricAlertState,
+		},
+	}
+}
+
+// moveMetricAlertState moves a sentry_metric_alert resource to the monitor Sentry
+// migrated it to.
+func (r *MetricMonitorResource) moveMetricAlertState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	source := tfutils.MergeDiagnostics(readMetricAlertMoveState(req))(&resp.Diagnostics)
+	if source == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := tfutils.MergeDiagnostics(newMetricMonitorModelFromMetricAlert(ctx, *source))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	detectorId := tfutils.MergeDiagnostics(getMetricAlertDetectorId(ctx, r.apiClient, source.Organization, source.InternalId))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = supertypes.NewStringValue(detectorId)
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, data)...)
+	if resp.TargetIdentity != nil {
+		resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, data.Identity())...)
+	}
+}
+
+// newMetricMonitorModelFromMetricAlert translates a metric alert into a
+// sentry_metric_monitor model. Each trigger becomes a condition raising an issue
+// of the matching priority, and the resolve threshold becomes the condition
+// resolving it. The ID is left for the caller to fill in.
+func newMetricMonitorModelFromMetricAlert(ctx context.Context, in metricAlertState) (*MetricMonitorResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var triggerType, resolveType string
+	switch sentrydata.AlertRuleThresholdTypeIdToName[in.ThresholdType] {
+	case "above":
+		triggerType, resolveType = "gt", "lte"
+	case "below":
+		triggerType, resolveType = "lt", "gte"
+	default:
+		diags.AddError(metricAlertMoveErrorSummary, fmt.Sprintf("threshold_type: unsupported threshold type %d.", in.ThresholdType))
+		return nil, diags
+	}
+
+	var conditions []MetricMonitorResourceModelConditionGroupConditionsItem
+	var resolveThreshold *float64
+	for i, trigger := range in.Triggers {
+		priority, ok := metricAlertTriggerPriorities[trigger.Label]
+		if !ok {
+			diags.AddError(metricAlertMoveErrorSummary, fmt.Sprintf("trigger[%d].label: unsupported label %q. Only \"critical\" and \"warning\" triggers can be moved.", i, trigger.Label))
+			continue
+		}
+
+		conditions = append(conditions, MetricMonitorResourceModelConditionGroupConditionsItem{
+			Type:                    supertypes.NewStringValue(triggerType),
+			Comparison:              types.Float64Value(trigger.AlertThreshold),
+			ComparisonSensitivity:   supertypes.NewStringNull(),
+			ComparisonThresholdType: supertypes.NewStringNull(),
+			ConditionResult:         supertypes.NewInt64Value(priority),
+		})
+
+		// Without a resolve threshold, Sentry resolves once the least severe
+		// trigger stops firing.
+		if resolveThreshold == nil || trigger.Label == "warning" {
+			resolveThreshold = &trigger.AlertThreshold
+		}
+	}
+	if in.ResolveThreshold != nil && *in.ResolveThreshold != 0 {
+		resolveThreshold = in.ResolveThreshold
+	}
+	if len(conditions) == 0 && !diags.HasError() {
+		diags.AddError(metricAlertMoveErrorSummary, "trigger: at least one trigger is required.")
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	conditions = append(conditions, MetricMonitorResourceModelConditionGroupConditionsItem{
+		Type:                    supertypes.NewStringValue(resolveType),
+		Comparison:              types.Float64Value(*resolveThreshold),
+		ComparisonSensitivity:   supertypes.NewStringNull(),
+		ComparisonThresholdType: supertypes.NewStringNull(),
+		ConditionResult:         supertypes.NewInt64Value(sentrydata.DetectorPriorityLevelNameToId["ok"]),
+	})
+
+	issueDetection := MetricMonitorResourceModelIssueDetection{
+		Type:            supertypes.NewStringValue("static"),
+		ComparisonDelta: supertypes.NewInt64Null(),
+	}
+	if in.ComparisonDelta != nil && *in.ComparisonDelta != 0 {
+		issueDetection.Type = supertypes.NewStringValue("percent")
+		issueDetection.ComparisonDelta = supertypes.NewInt64Value(int64(*in.ComparisonDelta * 60))
+	}
+
+	owner := supertypes.NewSingleNestedObjectValueOfNull[MetricMonitorResourceModelOwner](ctx)
+	if v := metricAlertStringValue(in.Owner); !v.IsNull() {
+		ownerType, ownerId, _ := strings.Cut(v.ValueString(), ":")
+		switch ownerType {
+		case "team":
+			owner = supertypes.NewSingleNestedObjectValueOf(ctx, &MetricMonitorResourceModelOwner{
+				UserId: supertypes.NewStringNull(),
+				TeamId: supertypes.NewStringValue(ownerId),
+			})
+		case "user":
+			owner = supertypes.NewSingleNestedObjectValueOf(ctx, &MetricMonitorResourceModelOwner{
+				UserId: supertypes.NewStringValue(ownerId),
+				TeamId: supertypes.NewStringNull(),
+			})
+		default:
+			diags.AddError(metricAlertMoveErrorSummary, fmt.Sprintf("owner: unsupported owner %q.", v.ValueString()))
+			return nil, diags
+		}
+	}
+
+	dataset := metricAlertStringValue(in.Dataset)
+	if dataset.IsNull() {
+		dataset = supertypes.NewStringValue("events")
+	}
+
+	eventTypes := in.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	out := &MetricMonitorResourceModel{
+		Organization:      supertypes.NewStringValue(in.Organization),
+		Project:           supertypes.NewStringValue(in.Project),
+		Enabled:           supertypes.NewBoolValue(true),
+		Name:              supertypes.NewStringValue(in.Name),
+		Description:       supertypes.NewStringNull(),
+		Owner:             owner,
+		Aggregate:         supertypes.NewStringValue(in.Aggregate),
+		Dataset:           dataset,
+		Environment:       metricAlertStringValue(in.Environment),
+		EventTypes:        supertypes.NewSetValueOfSlice(ctx, eventTypes),
+		Query:             supertypes.NewStringPointerValueOrNull(in.Query),
+		QueryType:         supertypes.NewStringNull(),
+		TimeWindowSeconds: supertypes.NewInt64Value(int64(in.TimeWindow * 60)),
+		ExtrapolationMode: supertypes.NewStringNull(),
+		IssueDetection:    supertypes.NewSingleNestedObjectValueOf(ctx, &issueDetection),
+		ConditionGroup: supertypes.NewSingleNestedObjectValueOf(ctx, &MetricMonitorResourceModelConditionGroup{
+			LogicType:  supertypes.NewStringValue("any"),
+			Conditions: supertypes.NewListNestedObjectValueOfValueSlice(ctx, conditions),
+		}),
+	}
+
+	return out, diags
+}
+
+func metricAlertStringValue(v *string) supertypes.StringValue {
+	if v == nil || *v == "" {
+		return supertypes.NewStringNull()
+	}
+	return supertypes.NewStringValue(*v)
+}
@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+const testMetricAlertRawState = `{
+	"id": "my-org/my-project/12345",
+	"organization": "my-org",
+	"project": "my-project",
+	"name": "My metric alert",
+	"environment": "",
+	"dataset": "transactions",
+	"event_types": ["transaction"],
+	"query": "http.method:GET",
+	"aggregate": "p95(transaction.duration)",
+	"time_window": 10,
+	"threshold_type": 0,
+	"resolve_threshold": 0,
+	"comparison_delta": 0,
+	"owner": "team:42",
+	"internal_id": "12345",
+	"trigger": [
+		{
+			"id": "1",
+			"label": "critical",
+			"threshold_type": 0,
+			"alert_threshold": 1000,
+			"resolve_threshold": 0,
+			"action": [
+				{
+					"id": "11",
+					"type": "email",
+					"target_type": "team",
+					"target_identifier": "42",
+					"input_channel_id": null,
+					"integration_id": null,
+					"sentry_app_id": null,
+					"priority": null
+				},
+				{
+					"id": "12",
+					"type": "slack",
+					"target_type": "specific",
+					"target_identifier": "#alerts",
+					"input_channel_id": "C0123456",
+					"integration_id": 678,
+					"sentry_app_id": null,
+					"priority": null
+				}
+			]
+		},
+		{
+			"id": "2",
+			"label": "warning",
+			"threshold_type": 0,
+			"alert_threshold": 500,
+			"resolve_threshold": 0,
+			"action": []
+		}
+	]
+}`
+
+func newTestMetricAlertMoveStateRequest(rawState string) resource.MoveStateRequest {
+	return resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/jianyuan/sentry",
+		SourceTypeName:        "sentry_metric_alert",
+		SourceSchemaVersion:   0,
+		SourceRawState:        &tfprotov6.RawState{JSON: []byte(rawState)},
+	}
+}
+
+func TestReadMetricAlertMoveState(t *testing.T) {
+	state, diags := readMetricAlertMoveState(newTestMetricAlertMoveStateRequest(testMetricAlertRawState))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+	if state.InternalId != "12345" {
+		t.Errorf("expected internal_id %q, got %q", "12345", state.InternalId)
+	}
+	if len(state.Triggers) != 2 || len(state.Triggers[0].Actions) != 2 {
+		t.Fatalf("unexpected triggers: %+v", state.Triggers)
+	}
+
+	state, diags = readMetricAlertMoveState(newTestMetricAlertMoveStateRequest(`{"id": "my-org/my-project/67890"}`))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+	if state.InternalId != "67890" {
+		t.Errorf("expected internal_id from the ID %q, got %q", "67890", state.InternalId)
+	}
+
+	req := newTestMetricAlertMoveStateRequest(testMetricAlertRawState)
+	req.SourceTypeName = "sentry_issue_alert"
+	state, diags = readMetricAlertMoveState(req)
+	if state != nil || diags.HasError() {
+		t.Errorf("expected other resource types to be skipped, got %+v: %s", state, diags)
+	}
+}
+
+func TestNewMetricMonitorModelFromMetricAlert(t *testing.T) {
+	ctx := context.Background()
+
+	in, diags := readMetricAlertMoveState(newTestMetricAlertMoveStateRequest(testMetricAlertRawState))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	out, diags := newMetricMonitorModelFromMetricAlert(ctx, *in)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	if got := out.TimeWindowSeconds.ValueInt64(); got != 600 {
+		t.Errorf("expected time_window_seconds 600, got %d", got)
+	}
+	if !out.Environment.IsNull() {
+		t.Errorf("expected environment to be null, got %s", out.Environment)
+	}
+	if got := out.Owner.MustGet(ctx).TeamId.ValueString(); got != "42" {
+		t.Errorf("expected owner team_id %q, got %q", "42", got)
+	}
+	if got := out.IssueDetection.MustGet(ctx).Type.ValueString(); got != "static" {
+		t.Errorf("expected issue_detection type %q, got %q", "static", got)
+	}
+
+	conditionGroup := out.ConditionGroup.MustGet(ctx)
+	conditions := conditionGroup.Conditions.MustGet(ctx)
+	expected := []struct {
+		Type            string
+		Comparison      float64
+		ConditionResult int64
+	}{
+		{"gt", 1000, 75},
+		{"gt", 500, 50},
+		{"lte", 500, 0},
+	}
+	if len(conditions) != len(expected) {
+		t.Fatalf("expected %d conditions, got %d", len(expected), len(conditions))
+	}
+	for i, e := range expected {
+		c := conditions[i]
+		if c.Type.ValueString() != e.Type || c.Comparison.ValueFloat64() != e.Comparison || c.ConditionResult.ValueInt64() != e.ConditionResult {
+			t.Errorf("condition %d: expected %+v, got %s %v %d", i, e, c.Type.ValueString(), c.Comparison.ValueFloat64(), c.ConditionResult.ValueInt64())
+		}
+	}
+}
+
+func TestNewMetricMonitorModelFromMetricAlert_comparison(t *testing.T) {
+	ctx := context.Background()
+
+	delta := 60.0
+	resolveThreshold := 20.0
+	in := metricAlertState{
+		Organization:     "my-org",
+		Project:          "my-project",
+		Name:             "My metric alert",
+		Aggregate:        "count()",
+		TimeWindow:       60,
+		ThresholdType:    1,
+		ResolveThreshold: &resolveThreshold,
+		ComparisonDelta:  &delta,
+		Triggers: []metricAlertTriggerState{
+			{Label: "critical", AlertThreshold: 10},
+		},
+	}
+
+	out, diags := newMetricMonitorModelFromMetricAlert(ctx, in)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	issueDetection := out.IssueDetection.MustGet(ctx)
+	if issueDetection.Type.ValueString() != "percent" || issueDetection.ComparisonDelta.ValueInt64() != 3600 {
+		t.Errorf("unexpected issue_detection: %+v", issueDetection)
+	}
+	if got := out.Dataset.ValueString(); got != "events" {
+		t.Errorf("expected dataset %q, got %q", "events", got)
+	}
+
+	conditions := out.ConditionGroup.MustGet(ctx).Conditions.MustGet(ctx)
+	if len(conditions) != 2 {
+		t.Fatalf("expected 2 conditions, got %d", len(conditions))
+	}
+	if conditions[0].Type.ValueString() != "lt" || conditions[1].Type.ValueString() != "gte" || conditions[1].Comparison.ValueFloat64() != 20 {
+		t.Errorf("unexpected conditions: %+v", conditions)
+	}
+}
+
+func TestNewAlertModelFromMetricAlert(t *testing.T) {
+	ctx := context.Background()
+
+	in, diags := readMetricAlertMoveState(newTestMetricAlertMoveStateRequest(testMetricAlertRawState))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	out, diags := newAlertModelFromMetricAlert(ctx, *in)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	if got := out.Name.ValueString(); got != "My metric alert" {
+		t.Errorf("expected name %q, got %q", "My metric alert", got)
+	}
+
+	actionFilters := out.ActionFilters.MustGet(ctx)
+	if len(actionFilters) != 2 {
+		t.Fatalf("expected 2 action filters, got %d", len(actionFilters))
+	}
+
+	conditions := actionFilters[0].Conditions.MustGet(ctx)
+	if len(conditions) != 2 {
+		t.Fatalf("expected 2 action filter conditions, got %d", len(conditions))
+	}
+	if got := conditions[0].IssuePriorityGreaterOrEqual.MustGet(ctx).Comparison.ValueInt64(); got != 75 {
+		t.Errorf("expected issue_priority_greater_or_equal 75, got %d", got)
+	}
+	if got := conditions[1].IssuePriorityDeescalating.MustGet(ctx).Comparison.ValueInt64(); got != 75 {
+		t.Errorf("expected issue_priority_deescalating 75, got %d", got)
+	}
+
+	actions := actionFilters[0].Actions.MustGet(ctx)
+	if len(actions) != 2 {
+		t.Fatalf("expected 2 actions, got %d", len(actions))
+	}
+	email := actions[0].Email.MustGet(ctx)
+	if email.TargetType.ValueString() != "team" || email.TargetId.ValueString() != "42" {
+		t.Errorf("unexpected email: %+v", email)
+	}
+	slack := actions[1].Slack.MustGet(ctx)
+	if slack.IntegrationId.ValueString() != "678" || slack.ChannelName.ValueString() != "#alerts" || slack.ChannelId.ValueString() != "C0123456" {
+		t.Errorf("unexpected slack: %+v", slack)
+	}
+
+	if got := actionFilters[1].Conditions.MustGet(ctx)[0].IssuePriorityGreaterOrEqual.MustGet(ctx).Comparison.ValueInt64(); got != 50 {
+		t.Errorf("expected warning issue_priority_greater_or_equal 50, got %d", got)
+	}
+}
+
+func TestNewModelFromMetricAlert_errors(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name   string
+		in     metricAlertState
+		detail string
+	}{
+		{
+			name: "above and below",
+			in: metricAlertState{
+				ThresholdType: 2,
+				Triggers:      []metricAlertTriggerState{{Label: "critical"}},
+			},
+			detail: "unsupported threshold type 2",
+		},
+		{
+			name: "unknown label",
+			in: metricAlertState{
+				Triggers: []metricAlertTriggerState{{Label: "info"}},
+			},
+			detail: "trigger[0].label",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, diags := newMetricMonitorModelFromMetricAlert(ctx, tc.in)
+			if !diags.HasError() {
+				t.Fatal("expected error")
+			}
+			found := false
+			for _, d := range diags.Errors() {
+				if d.Summary() == metricAlertMoveErrorSummary && strings.Contains(d.Detail(), tc.detail) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Errorf("expected error containing %q, got: %s", tc.detail, diags)
+			}
+		})
+	}
+
+	_, diags := newAlertModelFromMetricAlert(ctx, metricAlertState{
+		Triggers: []metricAlertTriggerState{
+			{
+				Label: "critical",
+				Actions: []metricAlertTriggerActionState{
+					{Type: "webhook"},
+				},
+			},
+		},
+	})
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), `unsupported action type "webhook"`) {
+		t.Errorf("expected unsupported action type error, got: %s", diags)
+	}
+}
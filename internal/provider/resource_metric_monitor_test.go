@@ -71,10 +71,80 @@ func TestAccMetricMonitorResource_validation(t *testing.T) {
 				`,
 				ExpectError: acctest.ExpectLiteralError(`The argument "aggregate" is required, but no definition was found.`),
 			},
+			{
+				PlanOnly: true,
+				Config: testAccMetricMonitorResourceConfig_validation(`
+					aggregate           = "failure_rate(transaction.duration)"
+					dataset             = "events"
+					event_types         = ["error"]
+					query               = "is:unresolved"
+					time_window_seconds = 3600
+				`),
+				ExpectError: acctest.ExpectLiteralError(`failure_rate() takes no arguments, got 1`),
+			},
+			{
+				PlanOnly: true,
+				Config: testAccMetricMonitorResourceConfig_validation(`
+					aggregate           = "p95(transaction.duration"
+					dataset             = "events"
+					event_types         = ["error"]
+					query               = "is:unresolved"
+					time_window_seconds = 3600
+				`),
+				ExpectError: acctest.ExpectLiteralError(`unclosed parenthesis (at position 4)`),
+			},
+			{
+				PlanOnly: true,
+				Config: testAccMetricMonitorResourceConfig_validation(`
+					aggregate           = "count()"
+					dataset             = "events"
+					event_types         = ["error"]
+					query               = "level: is:unresolved"
+					time_window_seconds = 3600
+				`),
+				ExpectError: acctest.ExpectLiteralError(`missing value for filter "level"`),
+			},
+			{
+				PlanOnly: true,
+				Config: testAccMetricMonitorResourceConfig_validation(`
+					aggregate           = "count()"
+					dataset             = "events"
+					event_types         = ["error"]
+					query               = "is:unresolved"
+					time_window_seconds = 3000
+				`),
+				ExpectError: acctest.ExpectLiteralError(`Attribute time_window_seconds value must be one of`),
+			},
 		},
 	})
 }
 
+func testAccMetricMonitorResourceConfig_validation(extras string) string {
+	return fmt.Sprintf(`
+		resource "sentry_metric_monitor" "test" {
+			organization = "1"
+			project      = "2"
+			name         = "metric monitor name"
+
+			%s
+
+			condition_group = {
+				conditions = [
+					{
+						type             = "gt"
+						comparison       = 100
+						condition_result = 75
+					},
+				]
+			}
+
+			issue_detection = {
+				type = "static"
+			}
+		}
+	`, extras)
+}
+
 func TestAccMetricMonitorResource_threshold(t *testing.T) {
 	projectName := acctest.RandomWithPrefix("tf-project")
 	monitorName := acctest.RandomWithPrefix("tf-metric-monitor")
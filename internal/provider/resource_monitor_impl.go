@@ -0,0 +1,390 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrytypes"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+var _ resource.ResourceWithValidateConfig = &MonitorResource{}
+
+func (r *MonitorResource) getCreateJSONRequestBody(ctx context.Context, data MonitorResourceModel) (*apiclient.CreateProjectMonitorJSONRequestBody, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	out := apiclient.ProjectMonitorRequestGeneric{
+		Type:      data.Type.Get(),
+		Name:      data.Name.Get(),
+		ProjectId: data.Project.Get(),
+	}
+
+	if data.Enabled.IsKnown() {
+		out.Enabled.Set(data.Enabled.Get())
+	} else {
+		out.Enabled.SetNull()
+	}
+
+	if data.Description.IsKnown() {
+		out.Description.Set(data.Description.Get())
+	} else {
+		out.Description.SetNull()
+	}
+
+	if data.Owner.IsKnown() {
+		owner := tfutils.MergeDiagnostics(data.Owner.Get(ctx))(&diags)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if owner.TeamId.IsKnown() {
+			out.Owner.Set(fmt.Sprintf("team:%s", owner.TeamId.Get()))
+		} else if owner.UserId.IsKnown() {
+			out.Owner.Set(fmt.Sprintf("user:%s", owner.UserId.Get()))
+		} else {
+			out.Owner.SetNull()
+		}
+	} else {
+		out.Owner.SetNull()
+	}
+
+	if data.Config.IsKnown() {
+		config := tfutils.MergeDiagnostics(data.Config.Get(ctx))(&diags)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var outConfig apiclient.ProjectMonitorConfig
+		switch {
+		case config.MetricIssue.IsKnown():
+			metricIssue := tfutils.MergeDiagnostics(config.MetricIssue.Get(ctx))(&diags)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if err := outConfig.FromProjectMonitorConfigMetricIssue(apiclient.ProjectMonitorConfigMetricIssue{
+				DetectionType:   metricIssue.DetectionType.GetPtr(),
+				ComparisonDelta: metricIssue.ComparisonDelta.GetPtr(),
+			}); err != nil {
+				diags.AddError("Failed to create monitor", err.Error())
+				return nil, diags
+			}
+			out.Config = &outConfig
+		case config.UptimeDomainFailure.IsKnown():
+			uptimeDomainFailure := tfutils.MergeDiagnostics(config.UptimeDomainFailure.Get(ctx))(&diags)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if err := outConfig.FromProjectMonitorConfigUptimeDomainFailure(apiclient.ProjectMonitorConfigUptimeDomainFailure{
+				Mode:              apiclient.ProjectMonitorConfigUptimeDomainFailureMode(sentrydata.UptimeMonitorModeNameToId[uptimeDomainFailure.Mode.Get()]),
+				Environment:       uptimeDomainFailure.Environment.Get(),
+				RecoveryThreshold: uptimeDomainFailure.RecoveryThreshold.Get(),
+				DowntimeThreshold: uptimeDomainFailure.DowntimeThreshold.Get(),
+			}); err != nil {
+				diags.AddError("Failed to create monitor", err.Error())
+				return nil, diags
+			}
+			out.Config = &outConfig
+		case !config.Json.IsNull() && !config.Json.IsUnknown():
+			if err := outConfig.UnmarshalJSON([]byte(config.Json.ValueString())); err != nil {
+				diags.AddError("Invalid config", err.Error())
+				return nil, diags
+			}
+			out.Config = &outConfig
+		}
+	}
+
+	if !data.DataSourcesJson.IsNull() && !data.DataSourcesJson.IsUnknown() {
+		var dataSources []json.RawMessage
+		if err := json.Unmarshal([]byte(data.DataSourcesJson.ValueString()), &dataSources); err != nil {
+			diags.AddError("Invalid data sources", err.Error())
+			return nil, diags
+		}
+		out.DataSources = &dataSources
+	}
+
+	if !data.ConditionGroupJson.IsNull() && !data.ConditionGroupJson.IsUnknown() {
+		out.ConditionGroup = new(json.RawMessage(data.ConditionGroupJson.ValueString()))
+	}
+
+	return newProjectMonitorRequest(out)
+}
+
+// newProjectMonitorRequest converts a generic monitor request into the request
+// body. The generic request is not part of the discriminated union, so it is
+// marshalled directly to keep the monitor type as is.
+func newProjectMonitorRequest(in apiclient.ProjectMonitorRequestGeneric) (*apiclient.ProjectMonitorRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	b, err := json.Marshal(in)
+	if err != nil {
+		diags.AddError("Error marshalling JSON", err.Error())
+		return nil, diags
+	}
+
+	var req apiclient.ProjectMonitorRequest
+	if err := req.UnmarshalJSON(b); err != nil {
+		diags.AddError("Error marshalling JSON", err.Error())
+		return nil, diags
+	}
+	return &req, nil
+}
+
+func (r *MonitorResource) getUpdateJSONRequestBody(ctx context.Context, data MonitorResourceModel) (*apiclient.UpdateProjectMonitorJSONRequestBody, diag.Diagnostics) {
+	return r.getCreateJSONRequestBody(ctx, data)
+}
+
+func (r *MonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data MonitorResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DataSourcesJson.IsNull() && !data.DataSourcesJson.IsUnknown() {
+		var dataSources []json.RawMessage
+		if err := json.Unmarshal([]byte(data.DataSourcesJson.ValueString()), &dataSources); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("data_sources_json"),
+				"Invalid data sources",
+				fmt.Sprintf("The data sources must be a JSON array: %s.", err),
+			)
+		}
+	}
+
+	if !data.Type.IsKnown() || !data.Config.IsKnown() {
+		return
+	}
+
+	config := tfutils.MergeDiagnostics(data.Config.Get(ctx))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typedConfigs := map[string]bool{
+		"metric_issue":          !config.MetricIssue.IsNull(),
+		"uptime_domain_failure": !config.UptimeDomainFailure.IsNull(),
+	}
+	for _, name := range slices.Sorted(maps.Keys(typedConfigs)) {
+		if typedConfigs[name] && name != data.Type.Get() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config").AtName(name),
+				"Invalid monitor configuration",
+				fmt.Sprintf("The `%s` configuration cannot be used with a monitor of type %q.", name, data.Type.Get()),
+			)
+		}
+	}
+
+	if _, ok := typedConfigs[data.Type.Get()]; ok && !config.Json.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("config").AtName("json"),
+			"Invalid monitor configuration",
+			fmt.Sprintf("Monitors of type %q must use the `%s` configuration instead of `json`.", data.Type.Get(), data.Type.Get()),
+		)
+	}
+}
+
+func (m *MonitorResourceModel) Fill(ctx context.Context, data apiclient.ProjectMonitor) (diags diag.Diagnostics) {
+	m.Id.Set(data.Id)
+	m.Type.Set(data.Type)
+	m.Name.Set(data.Name)
+	if v, err := data.Description.Get(); err == nil {
+		m.Description.Set(v)
+	} else {
+		m.Description.SetNull()
+	}
+	m.Enabled.Set(data.Enabled)
+
+	if data.Owner.IsSpecified() && !data.Owner.IsNull() {
+		inOwner, err := data.Owner.Get()
+		if err != nil {
+			diags.AddError("Invalid owner", err.Error())
+			return
+		}
+
+		inOwnerValue, err := inOwner.ValueByDiscriminator()
+		if err != nil {
+			diags.AddError("Invalid owner", err.Error())
+			return
+		}
+
+		outOwner := &MonitorResourceModelOwner{}
+
+		switch inOwnerValue := inOwnerValue.(type) {
+		case apiclient.ProjectMonitorOwnerUser:
+			outOwner.UserId.Set(inOwnerValue.Id)
+			diags.Append(m.Owner.Set(ctx, outOwner)...)
+		case apiclient.ProjectMonitorOwnerTeam:
+			outOwner.TeamId.Set(inOwnerValue.Id)
+			diags.Append(m.Owner.Set(ctx, outOwner)...)
+		default:
+			m.Owner.SetNull(ctx)
+		}
+	} else {
+		m.Owner.SetNull(ctx)
+	}
+
+	if diags.HasError() {
+		return
+	}
+
+	config := &MonitorResourceModelConfig{
+		MetricIssue:         supertypes.NewSingleNestedObjectValueOfNull[MonitorResourceModelConfigMetricIssue](ctx),
+		UptimeDomainFailure: supertypes.NewSingleNestedObjectValueOfNull[MonitorResourceModelConfigUptimeDomainFailure](ctx),
+		Json:                sentrytypes.NewLossyJsonNull(),
+	}
+
+	switch data.Type {
+	case "metric_issue":
+		inConfig, err := data.Config.AsProjectMonitorConfigMetricIssue()
+		if err != nil {
+			diags.AddError("Invalid config", err.Error())
+			return
+		}
+
+		var metricIssue MonitorResourceModelConfigMetricIssue
+		metricIssue.DetectionType.SetPtr(inConfig.DetectionType)
+		metricIssue.ComparisonDelta.SetPtr(inConfig.ComparisonDelta)
+		diags.Append(config.MetricIssue.Set(ctx, &metricIssue)...)
+	case "uptime_domain_failure":
+		inConfig, err := data.Config.AsProjectMonitorConfigUptimeDomainFailure()
+		if err != nil {
+			diags.AddError("Invalid config", err.Error())
+			return
+		}
+
+		var uptimeDomainFailure MonitorResourceModelConfigUptimeDomainFailure
+		if mode, ok := sentrydata.UptimeMonitorModeIdToName[int64(inConfig.Mode)]; ok {
+			uptimeDomainFailure.Mode.Set(mode)
+		} else {
+			diags.AddError("Invalid config", fmt.Sprintf("Unknown mode %d", inConfig.Mode))
+			return
+		}
+		uptimeDomainFailure.Environment.Set(inConfig.Environment)
+		uptimeDomainFailure.RecoveryThreshold.Set(inConfig.RecoveryThreshold)
+		uptimeDomainFailure.DowntimeThreshold.Set(inConfig.DowntimeThreshold)
+		diags.Append(config.UptimeDomainFailure.Set(ctx, &uptimeDomainFailure)...)
+	default:
+		b, err := data.Config.MarshalJSON()
+		if err != nil {
+			diags.AddError("Invalid config", err.Error())
+			return
+		}
+		if string(b) != "null" {
+			config.Json = sentrytypes.NewLossyJsonValue(string(b))
+		}
+	}
+
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(m.Config.Set(ctx, config)...)
+
+	if len(data.DataSources) == 0 {
+		m.DataSourcesJson = sentrytypes.NewLossyJsonNull()
+	} else {
+		v, err := monitorDataSourcesJson(data.DataSources)
+		if err != nil {
+			diags.AddError("Invalid data sources", err.Error())
+			return
+		}
+		m.DataSourcesJson = sentrytypes.NewLossyJsonValue(v)
+	}
+
+	// Monitor types without issue detection conditions have an empty
+	// condition group.
+	if data.ConditionGroup.LogicType == "" {
+		m.ConditionGroupJson = sentrytypes.NewLossyJsonNull()
+	} else {
+		b, err := json.Marshal(data.ConditionGroup)
+		if err != nil {
+			diags.AddError("Invalid condition group", err.Error())
+			return
+		}
+		m.ConditionGroupJson = sentrytypes.NewLossyJsonValue(string(b))
+	}
+
+	return
+}
+
+// afterRead sets the project of an imported monitor, which is only known from
+// the configuration otherwise.
+func (r *MonitorResource) afterRead(ctx context.Context, data *MonitorResourceModel, monitor apiclient.ProjectMonitor) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !data.Project.IsNull() && !data.Project.IsUnknown() {
+		return diags
+	}
+
+	project := tfutils.MergeDiagnostics(readOrganizationProject(ctx, r.apiClient, data.Organization.ValueString(), monitor.ProjectId))(&diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Project.Set(project.Slug)
+	return diags
+}
+
+// monitorDataSourcesJson returns the data sources of a monitor as a JSON array
+// in the shape of the `dataSources` request field. Sentry returns each data
+// source wrapped with its type, so only the query objects are kept.
+func monitorDataSourcesJson(dataSources []apiclient.ProjectMonitorDataSourceWrapper) (string, error) {
+	queryObjs := make([]any, 0, len(dataSources))
+	for _, dataSource := range dataSources {
+		value, err := dataSource.ValueByDiscriminator()
+		if err != nil {
+			// Keep the query objects of unknown data source types as is.
+			var wrapper struct {
+				QueryObj json.RawMessage `json:"queryObj"`
+			}
+			b, err := dataSource.MarshalJSON()
+			if err != nil {
+				return "", err
+			}
+			if err := json.Unmarshal(b, &wrapper); err != nil {
+				return "", err
+			}
+			queryObjs = append(queryObjs, wrapper.QueryObj)
+			continue
+		}
+
+		switch value := value.(type) {
+		case apiclient.ProjectMonitorDataSourceWrapperSnubaQuerySubscription:
+			queryObjs = append(queryObjs, value.QueryObj.SnubaQuery)
+		case apiclient.ProjectMonitorDataSourceWrapperCronMonitor:
+			queryObjs = append(queryObjs, value.QueryObj)
+		case apiclient.ProjectMonitorDataSourceWrapperUptimeSubscription:
+			queryObjs = append(queryObjs, value.QueryObj)
+		}
+	}
+
+	// Round trip through maps to sort the keys the same way as `jsonencode`.
+	b, err := json.Marshal(queryObjs)
+	if err != nil {
+		return "", err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var normalized any
+	if err := dec.Decode(&normalized); err != nil {
+		return "", err
+	}
+	b, err = json.Marshal(normalized)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/providerdata"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sweep"
+)
+
+func init() {
+	sweep.Register("sentry_monitor", func(ctx context.Context, pd *providerdata.ProviderData) ([]sweep.Sweepable, error) {
+		var sweepables []sweep.Sweepable
+
+		params := &apiclient.ListOrganizationMonitorsParams{}
+		for {
+			listHttpResp, err := acctest.SharedApiClient.ListOrganizationMonitorsWithResponse(ctx, acctest.TestOrganization, params)
+			if err != nil {
+				return nil, err
+			} else if listHttpResp.StatusCode() != http.StatusOK || listHttpResp.JSON200 == nil {
+				return nil, fmt.Errorf("failed to list organization monitors: %s", listHttpResp.Status())
+			}
+
+			for _, monitor := range *listHttpResp.JSON200 {
+				if !strings.HasPrefix(monitor.Name, "tf-monitor") {
+					continue
+				}
+
+				sweepables = append(sweepables, sweep.NewSweepResource(NewMonitorResource, pd, map[string]any{
+					"organization": acctest.TestOrganization,
+					"id":           monitor.Id,
+				}))
+			}
+
+			params.Cursor = sentryclient.ParseNextPaginationCursor(listHttpResp.HTTPResponse)
+			if params.Cursor == nil {
+				break
+			}
+		}
+
+		return sweepables, nil
+	})
+}
+
+func TestAccMonitorResource_validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PlanOnly: true,
+				Config: testAccMonitorResourceConfig_validation("error", `
+					config = {
+						metric_issue = {
+							detection_type = "static"
+						}
+					}
+				`),
+				ExpectError: acctest.ExpectLiteralError("The `metric_issue` configuration cannot be used with a monitor of type \"error\"."),
+			},
+			{
+				PlanOnly: true,
+				Config: testAccMonitorResourceConfig_validation("uptime_domain_failure", `
+					config = {
+						json = jsonencode({ mode = 1 })
+					}
+				`),
+				ExpectError: acctest.ExpectLiteralError("Monitors of type \"uptime_domain_failure\" must use the `uptime_domain_failure` configuration instead of `json`."),
+			},
+			{
+				PlanOnly: true,
+				Config: testAccMonitorResourceConfig_validation("metric_issue", `
+					config = {
+						metric_issue = {
+							detection_type = "static"
+						}
+						json = jsonencode({})
+					}
+				`),
+				ExpectError: acctest.ExpectLiteralError(`Attribute "config.metric_issue" cannot be specified when "config.json" is`),
+			},
+			{
+				PlanOnly: true,
+				Config: testAccMonitorResourceConfig_validation("metric_issue", `
+					data_sources_json = jsonencode({})
+				`),
+				ExpectError: acctest.ExpectLiteralError("The data sources must be a JSON array"),
+			},
+		},
+	})
+}
+
+func testAccMonitorResourceConfig_validation(monitorType, extras string) string {
+	return fmt.Sprintf(`
+		resource "sentry_monitor" "test" {
+			organization = "1"
+			project      = "2"
+			type         = "%s"
+			name         = "monitor name"
+
+			%s
+		}
+	`, monitorType, extras)
+}
+
+func TestAccMonitorResource_metricIssue(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-project")
+	monitorName := acctest.RandomWithPrefix("tf-monitor")
+	rn := "sentry_monitor.test"
+
+	checks := []statecheck.StateCheck{
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("project"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("type"), knownvalue.StringExact("metric_issue")),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("owner"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+			"user_id": knownvalue.Null(),
+			"team_id": knownvalue.NotNull(),
+		})),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("data_sources_json"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("condition_group_json"), knownvalue.NotNull()),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorResourceConfig(projectName, monitorName, `
+					config = {
+						metric_issue = {
+							detection_type = "static"
+						}
+					}
+				`),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(monitorName)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("config"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"metric_issue": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"detection_type":   knownvalue.StringExact("static"),
+							"comparison_delta": knownvalue.Null(),
+						}),
+						"uptime_domain_failure": knownvalue.Null(),
+						"json":                  knownvalue.Null(),
+					})),
+				),
+			},
+			{
+				Config: testAccMonitorResourceConfig(projectName, monitorName+"-updated", `
+					enabled = false
+
+					config = {
+						metric_issue = {
+							detection_type   = "percent"
+							comparison_delta = 3600
+						}
+					}
+				`),
+				ConfigStateChecks: append(
+					checks,
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(monitorName+"-updated")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("config"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"metric_issue": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"detection_type":   knownvalue.StringExact("percent"),
+							"comparison_delta": knownvalue.Int64Exact(3600),
+						}),
+						"uptime_domain_failure": knownvalue.Null(),
+						"json":                  knownvalue.Null(),
+					})),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: resourceid.ImportState2PartIDFunc(rn, "organization", "id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMonitorResourceConfig(projectName, name, extras string) string {
+	return fmt.Sprintf(`
+		resource "sentry_project" "test" {
+			organization = "%[1]s"
+			teams        = ["%[3]s"]
+			name         = "%[4]s"
+			platform     = "go"
+		}
+
+		resource "sentry_monitor" "test" {
+			organization = "%[1]s"
+			project      = sentry_project.test.slug
+			type         = "metric_issue"
+			name         = "%[5]s"
+
+			%[6]s
+
+			data_sources_json = jsonencode([
+				{
+					aggregate         = "count()"
+					dataset           = "events"
+					environment       = null
+					eventTypes        = ["error"]
+					query             = "is:unresolved"
+					queryType         = 0
+					timeWindow        = 3600
+					extrapolationMode = null
+				},
+			])
+
+			condition_group_json = jsonencode({
+				logicType = "any"
+				conditions = [
+					{
+						type            = "gt"
+						comparison      = 100
+						conditionResult = 75
+					},
+					{
+						type            = "lte"
+						comparison      = 100
+						conditionResult = 0
+					},
+				]
+			})
+
+			owner = {
+				team_id = "%[2]s"
+			}
+		}
+	`, acctest.TestOrganization, acctest.TestTeam.Id, acctest.TestTeam.Slug, projectName, name, extras)
+}
+
+func TestMonitorDataSourcesJson(t *testing.T) {
+	var dataSources []apiclient.ProjectMonitorDataSourceWrapper
+	if err := json.Unmarshal([]byte(`[
+		{
+			"type": "snuba_query_subscription",
+			"queryObj": {
+				"id": "1",
+				"snubaQuery": {
+					"id": "2",
+					"aggregate": "count()",
+					"dataset": "events",
+					"environment": null,
+					"eventTypes": ["error"],
+					"extrapolationMode": null,
+					"query": "is:unresolved",
+					"queryType": 0,
+					"timeWindow": 3600
+				}
+			}
+		},
+		{
+			"type": "unknown",
+			"queryObj": {"b": 1, "a": 12345678901234567890}
+		}
+	]`), &dataSources); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := monitorDataSourcesJson(dataSources)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `[{"aggregate":"count()","dataset":"events","environment":null,"eventTypes":["error"],"extrapolationMode":null,"query":"is:unresolved","queryType":0,"timeWindow":3600},{"a":12345678901234567890,"b":1}]`
+	if got != want {
+		t.Errorf("monitorDataSourcesJson() = %s, want %s", got, want)
+	}
+}
@@ -3,16 +3,18 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
-	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/jianyuan/go-sentry/v2/sentry"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
 	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
 	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
@@ -29,15 +31,10 @@ type NotificationActionResourceModel struct {
 	Projects         types.List   `tfsdk:"projects"`
 }
 
-func (m *NotificationActionResourceModel) Fill(action sentry.NotificationAction, projectIdToSlugMap map[string]string) error {
-	if action.ID == nil {
-		m.Id = types.StringNull()
-	} else {
-		m.Id = types.StringValue(action.ID.String())
-	}
-
-	m.TriggerType = types.StringPointerValue(action.TriggerType)
-	m.ServiceType = types.StringPointerValue(action.ServiceType)
+func (m *NotificationActionResourceModel) Fill(action apiclient.NotificationAction, projectIdToSlugMap map[string]string) error {
+	m.Id = types.StringValue(action.Id.String())
+	m.TriggerType = types.StringValue(action.TriggerType)
+	m.ServiceType = types.StringValue(action.ServiceType)
 
 	if action.IntegrationId == nil {
 		m.IntegrationId = types.StringNull()
@@ -45,13 +42,14 @@ func (m *NotificationActionResourceModel) Fill(action sentry.NotificationAction,
 		m.IntegrationId = types.StringValue(action.IntegrationId.String())
 	}
 
-	switch targetIdentifier := action.TargetIdentifier.(type) {
-	case string:
-		m.TargetIdentifier = types.StringValue(targetIdentifier)
-	case int64:
-		m.TargetIdentifier = types.StringValue(strconv.FormatInt(targetIdentifier, 10))
-	case nil:
+	if action.TargetIdentifier == nil {
 		m.TargetIdentifier = types.StringNull()
+	} else if targetIdentifier, err := action.TargetIdentifier.AsNotificationActionTargetIdentifier0(); err == nil {
+		m.TargetIdentifier = types.StringValue(targetIdentifier)
+	} else if targetIdentifier, err := action.TargetIdentifier.AsNotificationActionTargetIdentifier1(); err == nil {
+		m.TargetIdentifier = types.StringValue(targetIdentifier.String())
+	} else {
+		return fmt.Errorf("failed to unmarshal target identifier: %w", err)
 	}
 
 	m.TargetDisplay = types.StringPointerValue(action.TargetDisplay)
@@ -68,9 +66,22 @@ func (m *NotificationActionResourceModel) Fill(action sentry.NotificationAction,
 	return nil
 }
 
+type NotificationActionResourceIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func (m NotificationActionResourceModel) Identity() NotificationActionResourceIdentityModel {
+	return NotificationActionResourceIdentityModel{
+		Organization: m.Organization,
+		Id:           m.Id,
+	}
+}
+
 var _ resource.Resource = &NotificationActionResource{}
 var _ resource.ResourceWithConfigure = &NotificationActionResource{}
 var _ resource.ResourceWithImportState = &NotificationActionResource{}
+var _ resource.ResourceWithIdentity = &NotificationActionResource{}
 
 func NewNotificationActionResource() resource.Resource {
 	return &NotificationActionResource{}
@@ -80,6 +91,36 @@ type NotificationActionResource struct {
 	baseResource
 }
 
+// readProjectIdToSlugMap returns the slugs of every project of an organization
+// keyed by their internal IDs.
+func readProjectIdToSlugMap(ctx context.Context, apiClient *apiclient.ClientWithResponses, organization string) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	projectIdToSlugMap := make(map[string]string)
+	params := &apiclient.ListOrganizationProjectsParams{}
+	for {
+		httpResp, err := apiClient.ListOrganizationProjectsWithResponse(ctx, organization, params)
+		if err != nil {
+			diags.Append(diagutils.NewClientError("read projects", err))
+			return nil, diags
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			diags.Append(diagutils.NewClientStatusError("read projects", httpResp.StatusCode(), httpResp.Body))
+			return nil, diags
+		}
+
+		for _, project := range *httpResp.JSON200 {
+			projectIdToSlugMap[project.Id] = project.Slug
+		}
+
+		params.Cursor = sentryclient.ParseNextPaginationCursor(httpResp.HTTPResponse)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	return projectIdToSlugMap, diags
+}
+
 func (r *NotificationActionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_notification_action"
 }
@@ -140,12 +181,12 @@ func (r *NotificationActionResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	action, _, err := r.client.NotificationActions.Create(
+	httpResp, err := r.apiClient.CreateOrganizationNotificationActionWithResponse(
 		ctx,
 		data.Organization.ValueString(),
-		&sentry.CreateNotificationActionParams{
-			TriggerType:      data.TriggerType.ValueStringPointer(),
-			ServiceType:      data.ServiceType.ValueStringPointer(),
+		apiclient.NotificationActionRequest{
+			TriggerType:      data.TriggerType.ValueString(),
+			ServiceType:      data.ServiceType.ValueString(),
 			IntegrationId:    (*json.Number)(data.IntegrationId.ValueStringPointer()),
 			TargetIdentifier: data.TargetIdentifier.ValueStringPointer(),
 			TargetDisplay:    data.TargetDisplay.ValueStringPointer(),
@@ -155,13 +196,18 @@ func (r *NotificationActionResource) Create(ctx context.Context, req resource.Cr
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
 		return
+	} else if httpResp.StatusCode() != http.StatusCreated || httpResp.JSON201 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
+	action := httpResp.JSON201
 
 	var projectIdToSlugMap map[string]string
 	if len(action.Projects) > 0 {
-		projectIdToSlugMap, err = sentryclient.GetProjectIdToSlugMap(ctx, r.client)
-		if err != nil {
-			resp.Diagnostics.Append(diagutils.NewClientError("read projects", err))
+		var diags diag.Diagnostics
+		projectIdToSlugMap, diags = readProjectIdToSlugMap(ctx, r.apiClient, data.Organization.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
@@ -172,6 +218,7 @@ func (r *NotificationActionResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *NotificationActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -182,26 +229,30 @@ func (r *NotificationActionResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	action, apiResp, err := r.client.NotificationActions.Get(
+	httpResp, err := r.apiClient.GetOrganizationNotificationActionWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Id.ValueString(),
 	)
-	if apiResp.StatusCode == http.StatusNotFound {
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
 		resp.Diagnostics.Append(diagutils.NewNotFoundError("notification action"))
 		resp.State.RemoveResource(ctx)
 		return
-	}
-	if err != nil {
-		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
 		return
 	}
+	action := httpResp.JSON200
 
 	var projectIdToSlugMap map[string]string
 	if len(action.Projects) > 0 {
-		projectIdToSlugMap, err = sentryclient.GetProjectIdToSlugMap(ctx, r.client)
-		if err != nil {
-			resp.Diagnostics.Append(diagutils.NewClientError("read projects", err))
+		var diags diag.Diagnostics
+		projectIdToSlugMap, diags = readProjectIdToSlugMap(ctx, r.apiClient, data.Organization.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
@@ -212,6 +263,7 @@ func (r *NotificationActionResource) Read(ctx context.Context, req resource.Read
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *NotificationActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -228,34 +280,38 @@ func (r *NotificationActionResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	action, apiResp, err := r.client.NotificationActions.Update(
+	httpResp, err := r.apiClient.UpdateOrganizationNotificationActionWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Id.ValueString(),
-		&sentry.UpdateNotificationActionParams{
-			TriggerType:      data.TriggerType.ValueStringPointer(),
-			ServiceType:      data.ServiceType.ValueStringPointer(),
+		apiclient.NotificationActionRequest{
+			TriggerType:      data.TriggerType.ValueString(),
+			ServiceType:      data.ServiceType.ValueString(),
 			IntegrationId:    (*json.Number)(data.IntegrationId.ValueStringPointer()),
 			TargetIdentifier: data.TargetIdentifier.ValueStringPointer(),
 			TargetDisplay:    data.TargetDisplay.ValueStringPointer(),
 			Projects:         projects,
 		},
 	)
-	if apiResp.StatusCode == http.StatusNotFound {
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
 		resp.Diagnostics.Append(diagutils.NewNotFoundError("notification action"))
 		resp.State.RemoveResource(ctx)
 		return
-	}
-	if err != nil {
-		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+	} else if httpResp.StatusCode() != http.StatusAccepted || httpResp.JSON202 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
 		return
 	}
+	action := httpResp.JSON202
 
 	var projectIdToSlugMap map[string]string
 	if len(action.Projects) > 0 {
-		projectIdToSlugMap, err = sentryclient.GetProjectIdToSlugMap(ctx, r.client)
-		if err != nil {
-			resp.Diagnostics.Append(diagutils.NewClientError("read projects", err))
+		var diags diag.Diagnostics
+		projectIdToSlugMap, diags = readProjectIdToSlugMap(ctx, r.apiClient, data.Organization.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
@@ -266,6 +322,7 @@ func (r *NotificationActionResource) Update(ctx context.Context, req resource.Up
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *NotificationActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -276,20 +333,37 @@ func (r *NotificationActionResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	apiResp, err := r.client.NotificationActions.Delete(
+	httpResp, err := r.apiClient.DeleteOrganizationNotificationActionWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Id.ValueString(),
 	)
-	if apiResp.StatusCode == http.StatusNotFound {
-		return
-	}
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
 		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 }
 
 func (r *NotificationActionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	intresource.ImportState2PartPath("organization", "id")(ctx, req, resp)
 }
+
+func (r *NotificationActionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of this resource.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -6,18 +6,21 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
-	"github.com/jianyuan/go-sentry/v2/sentry"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
 	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
 )
 
 var _ resource.Resource = &OrganizationRepositoryResource{}
 var _ resource.ResourceWithConfigure = &OrganizationRepositoryResource{}
 var _ resource.ResourceWithImportState = &OrganizationRepositoryResource{}
+var _ resource.ResourceWithIdentity = &OrganizationRepositoryResource{}
 
 func NewOrganizationRepositoryResource() resource.Resource {
 	return &OrganizationRepositoryResource{}
@@ -74,26 +77,30 @@ func (r *OrganizationRepositoryResource) Create(ctx context.Context, req resourc
 		return
 	}
 
-	repo, _, err := r.client.OrganizationRepositories.Create(
+	httpResp, err := r.apiClient.CreateOrganizationRepositoryWithResponse(
 		ctx,
 		data.Organization.ValueString(),
-		sentry.CreateOrganizationRepositoryParams{
-			"provider":     "integrations:" + data.IntegrationType.ValueString(),
-			"installation": data.IntegrationId.ValueString(),
-			"identifier":   data.Identifier.ValueString(),
+		apiclient.CreateOrganizationRepositoryJSONRequestBody{
+			Provider:     "integrations:" + data.IntegrationType.ValueString(),
+			Installation: data.IntegrationId.ValueString(),
+			Identifier:   data.Identifier.ValueString(),
 		},
 	)
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
 		return
+	} else if httpResp.StatusCode() != http.StatusCreated || httpResp.JSON201 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 
-	if err := data.Fill(data.Organization.ValueString(), *repo); err != nil {
+	if err := data.Fill(data.Organization.ValueString(), *httpResp.JSON201); err != nil {
 		resp.Diagnostics.Append(diagutils.NewFillError(err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *OrganizationRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -104,30 +111,35 @@ func (r *OrganizationRepositoryResource) Read(ctx context.Context, req resource.
 		return
 	}
 
-	var foundRepo *sentry.OrganizationRepository
-	params := &sentry.ListOrganizationRepositoriesParams{
-		IntegrationId: data.IntegrationId.ValueString(),
+	var foundRepo *apiclient.OrganizationRepository
+	params := &apiclient.ListOrganizationRepositoriesParams{
+		IntegrationId: data.IntegrationId.ValueStringPointer(),
+		// Omitting the status only lists active repositories.
+		Status: new(""),
 	}
 
 out:
 	for {
-		repos, apiResp, err := r.client.OrganizationRepositories.List(ctx, data.Organization.ValueString(), params)
+		httpResp, err := r.apiClient.ListOrganizationRepositoriesWithResponse(ctx, data.Organization.ValueString(), params)
 		if err != nil {
 			resp.Diagnostics.Append(diagutils.NewClientError("read", err))
 			return
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+			return
 		}
 
-		for _, repo := range repos {
-			if repo.ID == data.Id.ValueString() {
-				foundRepo = repo
+		for _, repo := range *httpResp.JSON200 {
+			if repo.Id == data.Id.ValueString() {
+				foundRepo = &repo
 				break out
 			}
 		}
 
-		if apiResp.Cursor == "" {
+		params.Cursor = sentryclient.ParseNextPaginationCursor(httpResp.HTTPResponse)
+		if params.Cursor == nil {
 			break
 		}
-		params.Cursor = apiResp.Cursor
 	}
 
 	if foundRepo == nil {
@@ -141,6 +153,7 @@ out:
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *OrganizationRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -155,20 +168,45 @@ func (r *OrganizationRepositoryResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
-	_, apiResp, err := r.client.OrganizationRepositories.Delete(
+	httpResp, err := r.apiClient.DeleteOrganizationRepositoryWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Id.ValueString(),
 	)
-	if apiResp.StatusCode == http.StatusNotFound {
-		return
-	}
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
 		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusAccepted {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 }
 
 func (r *OrganizationRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	intresource.ImportState4PartPath("organization", "integration_type", "integration_id", "id")(ctx, req, resp)
 }
+
+func (r *OrganizationRepositoryResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"integration_type": identityschema.StringAttribute{
+				Description:       "The type of the organization integration.",
+				RequiredForImport: true,
+			},
+			"integration_id": identityschema.StringAttribute{
+				Description:       "The ID of the organization integration.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of this resource.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -18,6 +19,7 @@ import (
 
 var _ resource.Resource = &OrganizationUserMappingResource{}
 var _ resource.ResourceWithImportState = &OrganizationUserMappingResource{}
+var _ resource.ResourceWithIdentity = &OrganizationUserMappingResource{}
 
 func NewOrganizationUserMappingResource() resource.Resource {
 	return &OrganizationUserMappingResource{}
@@ -44,10 +46,12 @@ func (r *OrganizationUserMappingResource) Schema(ctx context.Context, req resour
 				},
 			},
 			"organization": schema.StringAttribute{
-				MarkdownDescription: "The slug of the organization the mapping belongs to.",
-				Required:            true,
+				MarkdownDescription: "The slug of the organization the mapping belongs to. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -126,6 +130,7 @@ func (r *OrganizationUserMappingResource) Create(ctx context.Context, req resour
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *OrganizationUserMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -142,6 +147,7 @@ func (r *OrganizationUserMappingResource) Read(ctx context.Context, req resource
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 	return
 
 }
@@ -178,6 +184,7 @@ func (r *OrganizationUserMappingResource) Update(ctx context.Context, req resour
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *OrganizationUserMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -204,6 +211,35 @@ func (r *OrganizationUserMappingResource) ImportState(ctx context.Context, req r
 	intresource.ImportState2PartPath("organization", "internal_id")(ctx, req, resp)
 }
 
+func (r *OrganizationUserMappingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The slug of the organization the mapping belongs to.",
+				RequiredForImport: true,
+				CustomType:        supertypes.StringType{},
+			},
+			"internal_id": identityschema.StringAttribute{
+				Description:       "The internal ID of this external user mapping (generated by Sentry).",
+				RequiredForImport: true,
+				CustomType:        supertypes.StringType{},
+			},
+		},
+	}
+}
+
+type OrganizationUserMappingResourceIdentityModel struct {
+	Organization supertypes.StringValue `tfsdk:"organization"`
+	InternalId   supertypes.StringValue `tfsdk:"internal_id"`
+}
+
+func (m OrganizationUserMappingResourceModel) Identity() OrganizationUserMappingResourceIdentityModel {
+	return OrganizationUserMappingResourceIdentityModel{
+		Organization: m.Organization,
+		InternalId:   m.InternalId,
+	}
+}
+
 type OrganizationUserMappingResourceModel struct {
 	Id               supertypes.StringValue `tfsdk:"id"`
 	Organization     supertypes.StringValue `tfsdk:"organization"`
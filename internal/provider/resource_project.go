@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -136,6 +137,7 @@ type ProjectResourceModel struct {
 	Id                   types.String                  `tfsdk:"id"`
 	Organization         types.String                  `tfsdk:"organization"`
 	Teams                supertypes.SetValueOf[string] `tfsdk:"teams"`
+	IgnoreTeamChanges    types.Bool                    `tfsdk:"ignore_team_changes"`
 	Name                 types.String                  `tfsdk:"name"`
 	Slug                 types.String                  `tfsdk:"slug"`
 	Platform             types.String                  `tfsdk:"platform"`
@@ -156,9 +158,11 @@ type ProjectResourceModel struct {
 func (m *ProjectResourceModel) Fill(ctx context.Context, project apiclient.Project) (diags diag.Diagnostics) {
 	m.Id = types.StringValue(project.Slug)
 	m.Organization = types.StringValue(project.Organization.Slug)
-	m.Teams = supertypes.NewSetValueOfSlice(ctx, lo.Map(project.Teams, func(item apiclient.Team, _ int) string {
-		return item.Slug
-	}))
+	if !m.IgnoreTeamChanges.ValueBool() {
+		m.Teams = supertypes.NewSetValueOfSlice(ctx, lo.Map(project.Teams, func(item apiclient.Team, _ int) string {
+			return item.Slug
+		}))
+	}
 	m.Name = types.StringValue(project.Name)
 	m.Slug = types.StringValue(project.Slug)
 	m.Platform = types.StringValue(project.Platform)
@@ -188,9 +192,22 @@ func (m *ProjectResourceModel) Fill(ctx context.Context, project apiclient.Proje
 	return
 }
 
+type ProjectResourceIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func (m ProjectResourceModel) Identity() ProjectResourceIdentityModel {
+	return ProjectResourceIdentityModel{
+		Organization: m.Organization,
+		Id:           m.Id,
+	}
+}
+
 var _ resource.Resource = &ProjectResource{}
 var _ resource.ResourceWithConfigure = &ProjectResource{}
 var _ resource.ResourceWithImportState = &ProjectResource{}
+var _ resource.ResourceWithIdentity = &ProjectResource{}
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -202,6 +219,8 @@ type ProjectResource struct {
 
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_project"
+	// Renaming the project slug changes its ID.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -212,13 +231,17 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"id":           ProjectResourceIdAttribute(),
 			"organization": ResourceOrganizationAttribute(),
 			"teams": schema.SetAttribute{
-				Description: "The slugs of the teams to create the project for.",
-				Required:    true,
-				CustomType:  supertypes.NewSetTypeOf[string](ctx),
+				MarkdownDescription: "The slugs of the teams to create the project for. When `ignore_team_changes` is set, only used on project creation.",
+				Required:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
 				Validators: []validator.Set{
 					setvalidator.SizeAtLeast(1),
 				},
 			},
+			"ignore_team_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether to ignore changes to the teams of the project after it is created. Set this when the teams are managed with the `sentry_project_team` or `sentry_team_projects` resources.",
+				Optional:            true,
+			},
 			"name": schema.StringAttribute{
 				Description: "The name for the project.",
 				Required:    true,
@@ -681,6 +704,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -713,6 +737,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -859,13 +884,20 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Update teams
-	if !plan.Teams.Equal(state.Teams) {
+	if !plan.IgnoreTeamChanges.ValueBool() && (!plan.Teams.Equal(state.Teams) || state.IgnoreTeamChanges.ValueBool()) {
 		planTeams := plan.Teams.DiagsGet(ctx, resp.Diagnostics)
 		stateTeams := state.Teams.DiagsGet(ctx, resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
+		// The teams in state are stale while their changes were ignored.
+		if state.IgnoreTeamChanges.ValueBool() {
+			stateTeams = lo.Map(httpRespUpdate.JSON200.Teams, func(item apiclient.Team, _ int) string {
+				return item.Slug
+			})
+		}
+
 		// Add teams
 		for _, team := range planTeams {
 			if !slices.Contains(stateTeams, team) {
@@ -928,6 +960,7 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.Identity())...)
 }
 
 func (r *ProjectResource) removeDefaultKey(ctx context.Context, organization string, project apiclient.Project) error {
@@ -1006,3 +1039,18 @@ func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportSt
 		"project", "id",
 	)(ctx, req, resp)
 }
+
+func (r *ProjectResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The slug of this project.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
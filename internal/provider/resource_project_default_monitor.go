@@ -0,0 +1,633 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrytypes"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+type ProjectDefaultMonitorResourceModel struct {
+	Id           supertypes.StringValue                                                        `tfsdk:"id"`
+	Organization supertypes.StringValue                                                        `tfsdk:"organization"`
+	Project      supertypes.StringValue                                                        `tfsdk:"project"`
+	Name         supertypes.StringValue                                                        `tfsdk:"name"`
+	Enabled      supertypes.BoolValue                                                          `tfsdk:"enabled"`
+	Owner        supertypes.SingleNestedObjectValueOf[ProjectDefaultMonitorResourceModelOwner] `tfsdk:"owner"`
+	ConfigJson   sentrytypes.LossyJson                                                         `tfsdk:"config_json"`
+	ResolveAge   supertypes.Int64Value                                                         `tfsdk:"-"`
+}
+
+// ProjectErrorMonitorResourceModel adds the settings that only error monitors
+// have.
+type ProjectErrorMonitorResourceModel struct {
+	ProjectDefaultMonitorResourceModel
+	ResolveAge supertypes.Int64Value `tfsdk:"resolve_age"`
+}
+
+type ProjectDefaultMonitorResourceModelOwner struct {
+	UserId supertypes.StringValue `tfsdk:"user_id"`
+	TeamId supertypes.StringValue `tfsdk:"team_id"`
+}
+
+func (m *ProjectDefaultMonitorResourceModel) Fill(ctx context.Context, data apiclient.ProjectMonitor) (diags diag.Diagnostics) {
+	m.Id.Set(data.Id)
+	m.Name.Set(data.Name)
+	m.Enabled.Set(data.Enabled)
+
+	if data.Owner.IsSpecified() && !data.Owner.IsNull() {
+		inOwner, err := data.Owner.Get()
+		if err != nil {
+			diags.AddError("Invalid owner", err.Error())
+			return
+		}
+
+		inOwnerValue, err := inOwner.ValueByDiscriminator()
+		if err != nil {
+			diags.AddError("Invalid owner", err.Error())
+			return
+		}
+
+		outOwner := &ProjectDefaultMonitorResourceModelOwner{}
+
+		switch inOwnerValue := inOwnerValue.(type) {
+		case apiclient.ProjectMonitorOwnerUser:
+			outOwner.UserId.Set(inOwnerValue.Id)
+			diags.Append(m.Owner.Set(ctx, outOwner)...)
+		case apiclient.ProjectMonitorOwnerTeam:
+			outOwner.TeamId.Set(inOwnerValue.Id)
+			diags.Append(m.Owner.Set(ctx, outOwner)...)
+		default:
+			m.Owner.SetNull(ctx)
+		}
+	} else {
+		m.Owner.SetNull(ctx)
+	}
+
+	config, err := data.Config.MarshalJSON()
+	if err != nil {
+		diags.AddError("Invalid config", err.Error())
+		return
+	}
+	if string(config) == "null" {
+		config = []byte("{}")
+	}
+	m.ConfigJson = sentrytypes.NewLossyJsonValue(string(config))
+
+	return
+}
+
+type ProjectDefaultMonitorResourceIdentityModel struct {
+	Organization supertypes.StringValue `tfsdk:"organization"`
+	Project      supertypes.StringValue `tfsdk:"project"`
+}
+
+func (m ProjectDefaultMonitorResourceModel) Identity() ProjectDefaultMonitorResourceIdentityModel {
+	return ProjectDefaultMonitorResourceIdentityModel{
+		Organization: m.Organization,
+		Project:      m.Project,
+	}
+}
+
+var _ resource.Resource = &ProjectDefaultMonitorResource{}
+var _ resource.ResourceWithConfigure = &ProjectDefaultMonitorResource{}
+var _ resource.ResourceWithImportState = &ProjectDefaultMonitorResource{}
+var _ resource.ResourceWithIdentity = &ProjectDefaultMonitorResource{}
+
+func NewProjectErrorMonitorResource() resource.Resource {
+	return &ProjectDefaultMonitorResource{
+		typeName:      "project_error_monitor",
+		monitorType:   "error",
+		displayName:   "Error Monitor",
+		hasResolveAge: true,
+	}
+}
+
+func NewProjectIssueStreamMonitorResource() resource.Resource {
+	return &ProjectDefaultMonitorResource{
+		typeName:    "project_issue_stream_monitor",
+		monitorType: "issue_stream",
+		displayName: "Issue Stream Monitor",
+	}
+}
+
+// ProjectDefaultMonitorResource manages one of the monitors that Sentry
+// creates for every project. Creating the resource adopts the existing
+// monitor, and deleting it resets the monitor to its defaults.
+type ProjectDefaultMonitorResource struct {
+	baseResource
+
+	typeName    string
+	monitorType string
+	displayName string
+
+	// hasResolveAge is whether the monitor resolves the issues of its project
+	// automatically, using the project's resolve age.
+	hasResolveAge bool
+}
+
+// projectDefaultMonitorDefaultsKey is the private state key of the settings
+// that the monitor had when the resource took it over.
+const projectDefaultMonitorDefaultsKey = "defaults"
+
+// projectDefaultMonitorDefaults are the settings that Sentry does not reset on
+// its own, which deleting the resource restores.
+type projectDefaultMonitorDefaults struct {
+	Config     json.RawMessage `json:"config"`
+	ResolveAge *int64          `json:"resolveAge,omitempty"`
+}
+
+// get reads the model from a plan or state, including the settings that only
+// some monitor types have.
+func (r *ProjectDefaultMonitorResource) get(ctx context.Context, getter func(context.Context, any) diag.Diagnostics, data *ProjectDefaultMonitorResourceModel) diag.Diagnostics {
+	if !r.hasResolveAge {
+		return getter(ctx, data)
+	}
+
+	var errorData ProjectErrorMonitorResourceModel
+	diags := getter(ctx, &errorData)
+	*data = errorData.ProjectDefaultMonitorResourceModel
+	data.ResolveAge = errorData.ResolveAge
+	return diags
+}
+
+// set writes the model to a state, including the settings that only some
+// monitor types have.
+func (r *ProjectDefaultMonitorResource) set(ctx context.Context, setter func(context.Context, any) diag.Diagnostics, data ProjectDefaultMonitorResourceModel) diag.Diagnostics {
+	if !r.hasResolveAge {
+		return setter(ctx, &data)
+	}
+
+	return setter(ctx, &ProjectErrorMonitorResourceModel{
+		ProjectDefaultMonitorResourceModel: data,
+		ResolveAge:                         data.ResolveAge,
+	})
+}
+
+func (r *ProjectDefaultMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+	// Renaming the project slug changes its ID.
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+func (r *ProjectDefaultMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manage the %s of a Project, one of the default monitors that Sentry creates for every project.\n\n"+
+			"**Note:** Sentry creates this monitor together with the project, so creating this resource takes over the existing monitor instead of creating a new one. Destroying this resource resets the monitor to its defaults: enabled, without an owner and with the settings it had when this resource took it over.", r.displayName),
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of this monitor.",
+				Computed:            true,
+				CustomType:          supertypes.StringType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization slug or internal ID of the monitor. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          supertypes.StringType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The project slug or internal ID of the monitor. Defaults to the `default_project` provider attribute.",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          supertypes.StringType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of this monitor.",
+				Computed:            true,
+				CustomType:          supertypes.StringType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the monitor is enabled. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          supertypes.BoolType{},
+				Default:             booldefault.StaticBool(true),
+			},
+			"owner": schema.SingleNestedAttribute{
+				MarkdownDescription: "Sentry will assign new issues to this assignee.",
+				Optional:            true,
+				CustomType:          supertypes.NewSingleNestedObjectTypeOf[ProjectDefaultMonitorResourceModelOwner](ctx),
+				Attributes: map[string]schema.Attribute{
+					"user_id": schema.StringAttribute{
+						MarkdownDescription: "The user ID to assign new issues to. Conflicts with `team_id`.",
+						Optional:            true,
+						CustomType:          supertypes.StringType{},
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("team_id")),
+						},
+					},
+					"team_id": schema.StringAttribute{
+						MarkdownDescription: "The team internal ID to assign new issues to. Conflicts with `user_id`.",
+						Optional:            true,
+						CustomType:          supertypes.StringType{},
+					},
+				},
+			},
+			"config_json": schema.StringAttribute{
+				MarkdownDescription: "The configuration of the monitor, as a JSON object. Computed from the current configuration when not set.",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          sentrytypes.LossyJsonType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+
+	if r.hasResolveAge {
+		resp.Schema.Attributes["resolve_age"] = schema.Int64Attribute{
+			MarkdownDescription: "The number of hours after which the monitor automatically resolves an issue that has not been seen, or `0` to never resolve issues automatically. This is a setting of the project, the same as the `resolve_age` attribute of `sentry_project`, so only set it in one place. Computed from the current setting when not set.",
+			Optional:            true,
+			Computed:            true,
+			CustomType:          supertypes.Int64Type{},
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+}
+
+// update writes the planned settings to the monitor. Settings that are not set
+// in the configuration are left unchanged, except for the owner which is
+// removed.
+func (r *ProjectDefaultMonitorResource) update(ctx context.Context, data *ProjectDefaultMonitorResourceModel, config ProjectDefaultMonitorResourceModel, monitor apiclient.ProjectMonitor) (diags diag.Diagnostics) {
+	out := apiclient.ProjectMonitorRequestGeneric{
+		Type:        r.monitorType,
+		Name:        monitor.Name,
+		Description: monitor.Description,
+		ProjectId:   monitor.ProjectId,
+	}
+
+	if data.Enabled.IsKnown() {
+		out.Enabled.Set(data.Enabled.Get())
+	}
+
+	if data.Owner.IsKnown() {
+		owner := tfutils.MergeDiagnostics(data.Owner.Get(ctx))(&diags)
+		if diags.HasError() {
+			return
+		}
+
+		if owner.TeamId.IsKnown() {
+			out.Owner.Set(fmt.Sprintf("team:%s", owner.TeamId.Get()))
+		} else if owner.UserId.IsKnown() {
+			out.Owner.Set(fmt.Sprintf("user:%s", owner.UserId.Get()))
+		} else {
+			out.Owner.SetNull()
+		}
+	} else {
+		out.Owner.SetNull()
+	}
+
+	// Unconfigured settings are planned from the state, so they are not sent.
+	if !config.ConfigJson.IsNull() {
+		var outConfig apiclient.ProjectMonitorConfig
+		if err := outConfig.UnmarshalJSON([]byte(data.ConfigJson.ValueString())); err != nil {
+			diags.AddError("Invalid config", err.Error())
+			return
+		}
+		out.Config = &outConfig
+	}
+
+	body := tfutils.MergeDiagnostics(newProjectMonitorRequest(out))(&diags)
+	if diags.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.UpdateProjectMonitorWithResponse(ctx, data.Organization.Get(), monitor.Id, *body)
+	if err != nil {
+		diags.Append(diagutils.NewClientError("update", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		diags.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	diags.Append(data.Fill(ctx, *httpResp.JSON200)...)
+	if diags.HasError() {
+		return
+	}
+
+	if !r.hasResolveAge {
+		return
+	}
+
+	// The resolve age is a setting of the project, which sentry_project may
+	// change in the same apply, so it is only written when it is configured.
+	if config.ResolveAge.IsNull() {
+		if !data.ResolveAge.IsKnown() {
+			diags.Append(r.readResolveAge(ctx, data, monitor.ProjectId)...)
+		}
+		return
+	}
+
+	projectHttpResp, err := r.apiClient.UpdateOrganizationProjectWithResponse(ctx, data.Organization.Get(), monitor.ProjectId, apiclient.UpdateOrganizationProjectJSONRequestBody{
+		ResolveAge: new(data.ResolveAge.Get()),
+	})
+	if err != nil {
+		diags.Append(diagutils.NewClientError("update project", err))
+		return
+	} else if projectHttpResp.StatusCode() != http.StatusOK || projectHttpResp.JSON200 == nil {
+		diags.Append(diagutils.NewClientStatusError("update project", projectHttpResp.StatusCode(), projectHttpResp.Body))
+		return
+	}
+
+	data.ResolveAge.Set(projectHttpResp.JSON200.ResolveAge)
+	return
+}
+
+// readResolveAge reads the resolve age of the monitor's project.
+func (r *ProjectDefaultMonitorResource) readResolveAge(ctx context.Context, data *ProjectDefaultMonitorResourceModel, projectId string) (diags diag.Diagnostics) {
+	project := tfutils.MergeDiagnostics(readOrganizationProject(ctx, r.apiClient, data.Organization.Get(), projectId))(&diags)
+	if diags.HasError() {
+		return
+	}
+
+	data.ResolveAge.Set(project.ResolveAge)
+	return
+}
+
+// readDefaults returns the settings of the monitor that deleting the resource
+// restores.
+func (r *ProjectDefaultMonitorResource) readDefaults(ctx context.Context, organization string, monitor apiclient.ProjectMonitor) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config, err := monitor.Config.MarshalJSON()
+	if err != nil {
+		diags.AddError("Invalid config", err.Error())
+		return nil, diags
+	}
+
+	defaults := projectDefaultMonitorDefaults{
+		Config: config,
+	}
+
+	if r.hasResolveAge {
+		project := tfutils.MergeDiagnostics(readOrganizationProject(ctx, r.apiClient, organization, monitor.ProjectId))(&diags)
+		if diags.HasError() {
+			return nil, diags
+		}
+		defaults.ResolveAge = new(project.ResolveAge)
+	}
+
+	b, err := json.Marshal(defaults)
+	if err != nil {
+		diags.AddError("Error marshalling JSON", err.Error())
+		return nil, diags
+	}
+	return b, diags
+}
+
+func (r *ProjectDefaultMonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data, config ProjectDefaultMonitorResourceModel
+
+	resp.Diagnostics.Append(r.get(ctx, req.Plan.Get, &data)...)
+	resp.Diagnostics.Append(r.get(ctx, req.Config.Get, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitor := tfutils.MergeDiagnostics(findProjectMonitor(ctx, r.apiClient, data.Organization.Get(), data.Project.Get(), r.monitorType))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaults := tfutils.MergeDiagnostics(r.readDefaults(ctx, data.Organization.Get(), *monitor))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, projectDefaultMonitorDefaultsKey, defaults)...)
+
+	resp.Diagnostics.Append(r.update(ctx, &data, config, *monitor)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.set(ctx, resp.State.Set, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
+}
+
+func (r *ProjectDefaultMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectDefaultMonitorResourceModel
+
+	resp.Diagnostics.Append(r.get(ctx, req.State.Get, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var monitor *apiclient.ProjectMonitor
+
+	// Imported resources only know their project.
+	if !data.Id.IsKnown() {
+		monitor = tfutils.MergeDiagnostics(findProjectMonitor(ctx, r.apiClient, data.Organization.Get(), data.Project.Get(), r.monitorType))(&resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		defaults := tfutils.MergeDiagnostics(r.readDefaults(ctx, data.Organization.Get(), *monitor))(&resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, projectDefaultMonitorDefaultsKey, defaults)...)
+	} else {
+		httpResp, err := r.apiClient.GetProjectMonitorWithResponse(ctx, data.Organization.Get(), data.Id.Get())
+		if err != nil {
+			resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+			return
+		} else if httpResp.StatusCode() == http.StatusNotFound {
+			resp.Diagnostics.Append(diagutils.NewNotFoundError(r.displayName))
+			resp.State.RemoveResource(ctx)
+			return
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+		monitor = httpResp.JSON200
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *monitor)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.hasResolveAge {
+		resp.Diagnostics.Append(r.readResolveAge(ctx, &data, monitor.ProjectId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.set(ctx, resp.State.Set, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
+}
+
+func (r *ProjectDefaultMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, config, state ProjectDefaultMonitorResourceModel
+
+	resp.Diagnostics.Append(r.get(ctx, req.Plan.Get, &data)...)
+	resp.Diagnostics.Append(r.get(ctx, req.Config.Get, &config)...)
+	resp.Diagnostics.Append(r.get(ctx, req.State.Get, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.GetProjectMonitorWithResponse(ctx, state.Organization.Get(), state.Id.Get())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &data, config, *httpResp.JSON200)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.set(ctx, resp.State.Set, data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
+}
+
+func (r *ProjectDefaultMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectDefaultMonitorResourceModel
+
+	resp.Diagnostics.Append(r.get(ctx, req.State.Get, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultsJson := tfutils.MergeDiagnostics(req.Private.GetKey(ctx, projectDefaultMonitorDefaultsKey))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var defaults projectDefaultMonitorDefaults
+	if len(defaultsJson) > 0 {
+		if err := json.Unmarshal(defaultsJson, &defaults); err != nil {
+			resp.Diagnostics.AddError("Invalid private state", err.Error())
+			return
+		}
+	}
+
+	getHttpResp, err := r.apiClient.GetProjectMonitorWithResponse(ctx, data.Organization.Get(), data.Id.Get())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if getHttpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if getHttpResp.StatusCode() != http.StatusOK || getHttpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", getHttpResp.StatusCode(), getHttpResp.Body))
+		return
+	}
+
+	// The monitor belongs to the project, so reset it instead of deleting it.
+	// Sentry enables its default monitors without an owner, and the other
+	// settings are restored to what they were when the resource took over the
+	// monitor. Resources that took over the monitor before these settings were
+	// recorded leave them unchanged.
+	out := apiclient.ProjectMonitorRequestGeneric{
+		Type:        r.monitorType,
+		Name:        getHttpResp.JSON200.Name,
+		Description: getHttpResp.JSON200.Description,
+		ProjectId:   getHttpResp.JSON200.ProjectId,
+	}
+	out.Enabled.Set(true)
+	out.Owner.SetNull()
+	if len(defaults.Config) > 0 && string(defaults.Config) != "null" {
+		out.Config = &apiclient.ProjectMonitorConfig{}
+		if err := out.Config.UnmarshalJSON(defaults.Config); err != nil {
+			resp.Diagnostics.AddError("Invalid config", err.Error())
+			return
+		}
+	}
+
+	body := tfutils.MergeDiagnostics(newProjectMonitorRequest(out))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.UpdateProjectMonitorWithResponse(ctx, data.Organization.Get(), data.Id.Get(), *body)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if r.hasResolveAge && defaults.ResolveAge != nil {
+		projectHttpResp, err := r.apiClient.UpdateOrganizationProjectWithResponse(ctx, data.Organization.Get(), getHttpResp.JSON200.ProjectId, apiclient.UpdateOrganizationProjectJSONRequestBody{
+			ResolveAge: defaults.ResolveAge,
+		})
+		if err != nil {
+			resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+			return
+		} else if projectHttpResp.StatusCode() == http.StatusNotFound {
+			return
+		} else if projectHttpResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", projectHttpResp.StatusCode(), projectHttpResp.Body))
+			return
+		}
+	}
+}
+
+func (r *ProjectDefaultMonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState2PartPath("organization", "project")(ctx, req, resp)
+}
+
+func (r *ProjectDefaultMonitorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"project": identityschema.StringAttribute{
+				Description:       "The project of this resource.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
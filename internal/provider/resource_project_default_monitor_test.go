@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+)
+
+func TestAccProjectErrorMonitorResource(t *testing.T) {
+	testAccProjectDefaultMonitorResource(t, "sentry_project_error_monitor", "Error Monitor", true)
+}
+
+func TestAccProjectIssueStreamMonitorResource(t *testing.T) {
+	testAccProjectDefaultMonitorResource(t, "sentry_project_issue_stream_monitor", "Issue Stream", false)
+}
+
+func testAccProjectDefaultMonitorResource(t *testing.T, resourceType string, monitorName string, hasResolveAge bool) {
+	projectName := acctest.RandomWithPrefix("tf-project")
+	rn := resourceType + ".test"
+	dn := "data." + resourceType + ".test"
+
+	checks := []statecheck.StateCheck{
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("project"), knownvalue.StringExact(projectName)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(monitorName)),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("config_json"), knownvalue.StringExact("{}")),
+	}
+
+	// Only error monitors have a resolve age.
+	resolveAgeChecks := func(resolveAge int64) []statecheck.StateCheck {
+		if !hasResolveAge {
+			return nil
+		}
+		return []statecheck.StateCheck{
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("resolve_age"), knownvalue.Int64Exact(resolveAge)),
+		}
+	}
+	resolveAgeConfig := ""
+	if hasResolveAge {
+		resolveAgeConfig = "resolve_age = 24"
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectDefaultMonitorResourceConfig(resourceType, projectName, ""),
+				ConfigStateChecks: slices.Concat(
+					checks,
+					resolveAgeChecks(0),
+					[]statecheck.StateCheck{
+						statecheck.ExpectKnownValue(rn, tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue(rn, tfjsonpath.New("owner"), knownvalue.Null()),
+					},
+				),
+			},
+			{
+				Config: testAccProjectDefaultMonitorResourceConfig(resourceType, projectName, fmt.Sprintf(`
+					enabled     = false
+					config_json = jsonencode({})
+					%[2]s
+
+					owner = {
+						team_id = "%[1]s"
+					}
+				`, acctest.TestTeam.Id, resolveAgeConfig)),
+				ConfigStateChecks: slices.Concat(
+					checks,
+					resolveAgeChecks(24),
+					[]statecheck.StateCheck{
+						statecheck.ExpectKnownValue(rn, tfjsonpath.New("enabled"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue(rn, tfjsonpath.New("owner"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"user_id": knownvalue.Null(),
+							"team_id": knownvalue.StringExact(acctest.TestTeam.Id),
+						})),
+					},
+				),
+			},
+			{
+				ResourceName:                         rn,
+				ImportState:                          true,
+				ImportStateIdFunc:                    resourceid.ImportState2PartIDFunc(rn, "organization", "project"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project",
+			},
+			{
+				Config: testAccProjectDefaultMonitorResourceConfig(resourceType, projectName, ""),
+				ConfigStateChecks: slices.Concat(
+					checks,
+					resolveAgeChecks(24),
+					[]statecheck.StateCheck{
+						statecheck.ExpectKnownValue(rn, tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue(rn, tfjsonpath.New("owner"), knownvalue.Null()),
+					},
+				),
+			},
+			// Destroying the resource resets the monitor.
+			{
+				Config: testAccProjectDefaultMonitorResourceConfig_project(projectName, ""),
+			},
+			{
+				Config: testAccProjectDefaultMonitorResourceConfig_project(projectName, fmt.Sprintf(`
+					data "%s" "test" {
+						organization = sentry_project.test.organization
+						project      = sentry_project.test.slug
+					}
+				`, resourceType)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dn, tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(dn, tfjsonpath.New("owner"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("sentry_project.test", tfjsonpath.New("resolve_age"), knownvalue.Int64Exact(0)),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectDefaultMonitorResourceConfig_project(projectName, extras string) string {
+	return fmt.Sprintf(`
+		resource "sentry_project" "test" {
+			organization = "%[1]s"
+			teams        = ["%[2]s"]
+			name         = "%[3]s"
+			slug         = "%[3]s"
+			platform     = "go"
+		}
+
+		%[4]s
+	`, acctest.TestOrganization, acctest.TestTeam.Slug, projectName, extras)
+}
+
+func testAccProjectDefaultMonitorResourceConfig(resourceType, projectName, extras string) string {
+	return testAccProjectDefaultMonitorResourceConfig_project(projectName, fmt.Sprintf(`
+		resource "%[1]s" "test" {
+			organization = sentry_project.test.organization
+			project      = sentry_project.test.slug
+
+			%[2]s
+		}
+	`, resourceType, extras))
+}
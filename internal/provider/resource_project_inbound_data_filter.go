@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
@@ -9,13 +10,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/jianyuan/go-sentry/v2/sentry"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
 	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
 )
 
@@ -28,7 +31,7 @@ type ProjectInboundDataFilterResourceModel struct {
 	Subfilters   types.Set    `tfsdk:"subfilters"`
 }
 
-func (m *ProjectInboundDataFilterResourceModel) Fill(organization string, project string, filterId string, filter sentry.ProjectInboundDataFilter) error {
+func (m *ProjectInboundDataFilterResourceModel) Fill(organization string, project string, filterId string, filter apiclient.ProjectFilter) error {
 	if id, err := resourceid.BuildPath3(organization, project, filterId); err != nil {
 		return err
 	} else {
@@ -38,23 +41,40 @@ func (m *ProjectInboundDataFilterResourceModel) Fill(organization string, projec
 	m.Project = types.StringValue(project)
 	m.FilterId = types.StringValue(filterId)
 
-	if filter.Active.IsBool {
-		m.Active = types.BoolValue(filter.Active.BoolVal)
-	} else {
+	if active, err := filter.Active.AsProjectFilterActive0(); err == nil {
+		m.Active = types.BoolValue(active)
+	} else if subfilters, err := filter.Active.AsProjectFilterActive1(); err == nil {
 		subfilterElements := []attr.Value{}
-		for _, subfilter := range filter.Active.StringSliceVal {
+		for _, subfilter := range subfilters {
 			subfilterElements = append(subfilterElements, types.StringValue(subfilter))
 		}
 
 		m.Subfilters = types.SetValueMust(types.StringType, subfilterElements)
+	} else {
+		return fmt.Errorf("failed to unmarshal active: %w", err)
 	}
 
 	return nil
 }
 
+type ProjectInboundDataFilterResourceIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	FilterId     types.String `tfsdk:"filter_id"`
+}
+
+func (m ProjectInboundDataFilterResourceModel) Identity() ProjectInboundDataFilterResourceIdentityModel {
+	return ProjectInboundDataFilterResourceIdentityModel{
+		Organization: m.Organization,
+		Project:      m.Project,
+		FilterId:     m.FilterId,
+	}
+}
+
 var _ resource.Resource = &ProjectInboundDataFilterResource{}
 var _ resource.ResourceWithConfigure = &ProjectInboundDataFilterResource{}
 var _ resource.ResourceWithImportState = &ProjectInboundDataFilterResource{}
+var _ resource.ResourceWithIdentity = &ProjectInboundDataFilterResource{}
 
 func NewProjectInboundDataFilterResource() resource.Resource {
 	return &ProjectInboundDataFilterResource{}
@@ -110,17 +130,18 @@ func (r *ProjectInboundDataFilterResource) Create(ctx context.Context, req resou
 		return
 	}
 
-	subfilters := []string{}
+	var subfilters *[]string
 	if !data.Subfilters.IsNull() {
-		resp.Diagnostics.Append(data.Subfilters.ElementsAs(ctx, &subfilters, false)...)
+		subfilters = new([]string{})
+		resp.Diagnostics.Append(data.Subfilters.ElementsAs(ctx, subfilters, false)...)
 	}
 
-	_, err := r.client.ProjectInboundDataFilters.Update(
+	httpResp, err := r.apiClient.UpdateProjectFilterWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Project.ValueString(),
 		data.FilterId.ValueString(),
-		&sentry.UpdateProjectInboundDataFilterParams{
+		apiclient.UpdateProjectFilterJSONRequestBody{
 			Active:     data.Active.ValueBoolPointer(),
 			Subfilters: subfilters,
 		},
@@ -128,6 +149,9 @@ func (r *ProjectInboundDataFilterResource) Create(ctx context.Context, req resou
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
 		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 
 	if id, err := resourceid.BuildPath3(data.Organization.ValueString(), data.Project.ValueString(), data.FilterId.ValueString()); err != nil {
@@ -138,6 +162,7 @@ func (r *ProjectInboundDataFilterResource) Create(ctx context.Context, req resou
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ProjectInboundDataFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -148,26 +173,28 @@ func (r *ProjectInboundDataFilterResource) Read(ctx context.Context, req resourc
 		return
 	}
 
-	filters, apiResp, err := r.client.ProjectInboundDataFilters.List(
+	httpResp, err := r.apiClient.ListProjectFiltersWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Project.ValueString(),
 	)
-	if apiResp.StatusCode == http.StatusNotFound {
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
 		resp.Diagnostics.Append(diagutils.NewNotFoundError("project inbound data filter"))
 		resp.State.RemoveResource(ctx)
 		return
-	}
-	if err != nil {
-		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
 		return
 	}
 
-	var foundFilter *sentry.ProjectInboundDataFilter
+	var foundFilter *apiclient.ProjectFilter
 
-	for _, filter := range filters {
-		if filter.ID == data.FilterId.ValueString() {
-			foundFilter = filter
+	for _, filter := range *httpResp.JSON200 {
+		if filter.Id == data.FilterId.ValueString() {
+			foundFilter = &filter
 			break
 		}
 	}
@@ -184,6 +211,7 @@ func (r *ProjectInboundDataFilterResource) Read(ctx context.Context, req resourc
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ProjectInboundDataFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -194,17 +222,18 @@ func (r *ProjectInboundDataFilterResource) Update(ctx context.Context, req resou
 		return
 	}
 
-	subfilters := []string{}
+	var subfilters *[]string
 	if !plan.Subfilters.IsNull() {
-		resp.Diagnostics.Append(plan.Subfilters.ElementsAs(ctx, &subfilters, false)...)
+		subfilters = new([]string{})
+		resp.Diagnostics.Append(plan.Subfilters.ElementsAs(ctx, subfilters, false)...)
 	}
 
-	_, err := r.client.ProjectInboundDataFilters.Update(
+	httpResp, err := r.apiClient.UpdateProjectFilterWithResponse(
 		ctx,
 		plan.Organization.ValueString(),
 		plan.Project.ValueString(),
 		plan.FilterId.ValueString(),
-		&sentry.UpdateProjectInboundDataFilterParams{
+		apiclient.UpdateProjectFilterJSONRequestBody{
 			Active:     plan.Active.ValueBoolPointer(),
 			Subfilters: subfilters,
 		},
@@ -212,6 +241,9 @@ func (r *ProjectInboundDataFilterResource) Update(ctx context.Context, req resou
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
 		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 
 	if id, err := resourceid.BuildPath3(plan.Organization.ValueString(), plan.Project.ValueString(), plan.FilterId.ValueString()); err != nil {
@@ -222,6 +254,7 @@ func (r *ProjectInboundDataFilterResource) Update(ctx context.Context, req resou
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.Identity())...)
 }
 
 func (r *ProjectInboundDataFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -232,25 +265,31 @@ func (r *ProjectInboundDataFilterResource) Delete(ctx context.Context, req resou
 		return
 	}
 
-	apiResp, err := r.client.ProjectInboundDataFilters.Update(
+	httpResp, err := r.apiClient.UpdateProjectFilterWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Project.ValueString(),
 		data.FilterId.ValueString(),
-		&sentry.UpdateProjectInboundDataFilterParams{
-			Active: sentry.Bool(false),
+		apiclient.UpdateProjectFilterJSONRequestBody{
+			Active: new(false),
 		},
 	)
-	if apiResp.StatusCode == http.StatusNotFound {
-		return
-	}
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
 		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 }
 
 func (r *ProjectInboundDataFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if intresource.ImportStateFromIdentity(ctx, req, resp, "organization", "project", "filter_id") {
+		return
+	}
+
 	organization, project, filterID, err := resourceid.Split3Path(req.ID, "organization", "project-slug", "filter-id")
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewImportError(err))
@@ -269,3 +308,22 @@ func (r *ProjectInboundDataFilterResource) ImportState(ctx context.Context, req
 		ctx, path.Root("id"), req.ID,
 	)...)
 }
+
+func (r *ProjectInboundDataFilterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"project": identityschema.StringAttribute{
+				Description:       "The project of this resource.",
+				RequiredForImport: true,
+			},
+			"filter_id": identityschema.StringAttribute{
+				Description:       "The type of filter toggle.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
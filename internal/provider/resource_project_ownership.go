@@ -6,10 +6,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/jianyuan/go-sentry/v2/sentry"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
 	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
 	"github.com/jianyuan/terraform-provider-sentry/internal/sentrytypes"
@@ -24,9 +25,9 @@ type ProjectOwnershipResourceModel struct {
 	CodeownersAutoSync types.Bool                `tfsdk:"codeowners_auto_sync"`
 }
 
-func (data *ProjectOwnershipResourceModel) Fill(ownership sentry.ProjectOwnership) error {
+func (data *ProjectOwnershipResourceModel) Fill(ownership apiclient.ProjectOwnership) error {
 	data.Raw = sentrytypes.TrimmedStringValue(ownership.Raw)
-	data.Fallthrough = types.BoolValue(ownership.FallThrough)
+	data.Fallthrough = types.BoolValue(ownership.Fallthrough)
 	data.AutoAssignment = types.StringValue(ownership.AutoAssignment)
 
 	if ownership.CodeownersAutoSync == nil {
@@ -38,9 +39,22 @@ func (data *ProjectOwnershipResourceModel) Fill(ownership sentry.ProjectOwnershi
 	return nil
 }
 
+type ProjectOwnershipResourceIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+}
+
+func (m ProjectOwnershipResourceModel) Identity() ProjectOwnershipResourceIdentityModel {
+	return ProjectOwnershipResourceIdentityModel{
+		Organization: m.Organization,
+		Project:      m.Project,
+	}
+}
+
 var _ resource.Resource = &ProjectOwnershipResource{}
 var _ resource.ResourceWithConfigure = &ProjectOwnershipResource{}
 var _ resource.ResourceWithImportState = &ProjectOwnershipResource{}
+var _ resource.ResourceWithIdentity = &ProjectOwnershipResource{}
 
 func NewProjectOwnershipResource() resource.Resource {
 	return &ProjectOwnershipResource{}
@@ -97,30 +111,32 @@ func (r *ProjectOwnershipResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	params := &sentry.UpdateProjectOwnershipParams{
-		Raw:                data.Raw.ValueString(),
-		FallThrough:        data.Fallthrough.ValueBoolPointer(),
-		AutoAssignment:     data.AutoAssignment.ValueStringPointer(),
-		CodeownersAutoSync: data.CodeownersAutoSync.ValueBoolPointer(),
-	}
-
-	source, _, err := r.client.ProjectOwnerships.Update(
+	httpResp, err := r.apiClient.UpdateProjectOwnershipWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Project.ValueString(),
-		params,
+		apiclient.UpdateProjectOwnershipJSONRequestBody{
+			Raw:                data.Raw.ValueString(),
+			Fallthrough:        data.Fallthrough.ValueBool(),
+			AutoAssignment:     data.AutoAssignment.ValueString(),
+			CodeownersAutoSync: data.CodeownersAutoSync.ValueBool(),
+		},
 	)
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
 		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 
-	if err := data.Fill(*source); err != nil {
+	if err := data.Fill(*httpResp.JSON200); err != nil {
 		resp.Diagnostics.Append(diagutils.NewFillError(err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ProjectOwnershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -131,33 +147,30 @@ func (r *ProjectOwnershipResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	ownership, apiResp, err := r.client.ProjectOwnerships.Get(
+	httpResp, err := r.apiClient.GetProjectOwnershipWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Project.ValueString(),
 	)
-	if apiResp.StatusCode == http.StatusNotFound {
-		resp.Diagnostics.Append(diagutils.NewNotFoundError("project ownership"))
-		resp.State.RemoveResource(ctx)
-		return
-	}
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
 		return
-	}
-
-	if ownership == nil {
+	} else if httpResp.StatusCode() == http.StatusNotFound {
 		resp.Diagnostics.Append(diagutils.NewNotFoundError("project ownership"))
 		resp.State.RemoveResource(ctx)
 		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 
-	if err := data.Fill(*ownership); err != nil {
+	if err := data.Fill(*httpResp.JSON200); err != nil {
 		resp.Diagnostics.Append(diagutils.NewFillError(err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ProjectOwnershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -168,30 +181,32 @@ func (r *ProjectOwnershipResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	params := &sentry.UpdateProjectOwnershipParams{
-		Raw:                data.Raw.ValueString(),
-		FallThrough:        data.Fallthrough.ValueBoolPointer(),
-		AutoAssignment:     data.AutoAssignment.ValueStringPointer(),
-		CodeownersAutoSync: data.CodeownersAutoSync.ValueBoolPointer(),
-	}
-
-	source, _, err := r.client.ProjectOwnerships.Update(
+	httpResp, err := r.apiClient.UpdateProjectOwnershipWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Project.ValueString(),
-		params,
+		apiclient.UpdateProjectOwnershipJSONRequestBody{
+			Raw:                data.Raw.ValueString(),
+			Fallthrough:        data.Fallthrough.ValueBool(),
+			AutoAssignment:     data.AutoAssignment.ValueString(),
+			CodeownersAutoSync: data.CodeownersAutoSync.ValueBool(),
+		},
 	)
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
 		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 
-	if err := data.Fill(*source); err != nil {
+	if err := data.Fill(*httpResp.JSON200); err != nil {
 		resp.Diagnostics.Append(diagutils.NewFillError(err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ProjectOwnershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -202,25 +217,25 @@ func (r *ProjectOwnershipResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	trueValue := true
-	autoAssign := "Auto Assign to Issue Owner"
-	_, apiResp, err := r.client.ProjectOwnerships.Update(
+	httpResp, err := r.apiClient.UpdateProjectOwnershipWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Project.ValueString(),
-		&sentry.UpdateProjectOwnershipParams{
+		apiclient.UpdateProjectOwnershipJSONRequestBody{
 			Raw:                "",
-			FallThrough:        &trueValue,
-			AutoAssignment:     &autoAssign,
-			CodeownersAutoSync: &trueValue,
+			Fallthrough:        true,
+			AutoAssignment:     "Auto Assign to Issue Owner",
+			CodeownersAutoSync: true,
 		},
 	)
-	if apiResp.StatusCode == http.StatusNotFound {
-		return
-	}
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
 		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 }
 
@@ -231,3 +246,18 @@ func (r *ProjectOwnershipResource) ImportState(ctx context.Context, req resource
 		"project", "project",
 	)(ctx, req, resp)
 }
+
+func (r *ProjectOwnershipResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"project": identityschema.StringAttribute{
+				Description:       "The project of this resource.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
@@ -39,9 +40,22 @@ func (data *ProjectSpikeProtectionResourceModel) Fill(project apiclient.Project)
 	return nil
 }
 
+type ProjectSpikeProtectionResourceIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+}
+
+func (m ProjectSpikeProtectionResourceModel) Identity() ProjectSpikeProtectionResourceIdentityModel {
+	return ProjectSpikeProtectionResourceIdentityModel{
+		Organization: m.Organization,
+		Project:      m.Project,
+	}
+}
+
 var _ resource.Resource = &ProjectSpikeProtectionResource{}
 var _ resource.ResourceWithConfigure = &ProjectSpikeProtectionResource{}
 var _ resource.ResourceWithImportState = &ProjectSpikeProtectionResource{}
+var _ resource.ResourceWithIdentity = &ProjectSpikeProtectionResource{}
 
 func NewProjectSpikeProtectionResource() resource.Resource {
 	return &ProjectSpikeProtectionResource{}
@@ -53,6 +67,8 @@ type ProjectSpikeProtectionResource struct {
 
 func (r *ProjectSpikeProtectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_project_spike_protection"
+	// Renaming the project slug changes its ID.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *ProjectSpikeProtectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -119,6 +135,7 @@ func (r *ProjectSpikeProtectionResource) Create(ctx context.Context, req resourc
 	data.Id = types.StringValue(id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ProjectSpikeProtectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -155,6 +172,7 @@ func (r *ProjectSpikeProtectionResource) Read(ctx context.Context, req resource.
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ProjectSpikeProtectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -198,6 +216,7 @@ func (r *ProjectSpikeProtectionResource) Update(ctx context.Context, req resourc
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ProjectSpikeProtectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -231,3 +250,18 @@ func (r *ProjectSpikeProtectionResource) ImportState(ctx context.Context, req re
 	intresource.ImportState2PartPath("organization", "project")(ctx, req, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *ProjectSpikeProtectionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"project": identityschema.StringAttribute{
+				Description:       "The project of this resource.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -4,52 +4,63 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/jianyuan/go-sentry/v2/sentry"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
 	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
 )
 
 type ProjectSymbolSourcesResourceModel struct {
-	Id                   types.String                             `tfsdk:"id"`
-	Organization         types.String                             `tfsdk:"organization"`
-	Project              types.String                             `tfsdk:"project"`
-	Type                 types.String                             `tfsdk:"type"`
-	Name                 types.String                             `tfsdk:"name"`
-	Layout               *ProjectSymbolSourcesResourceLayoutModel `tfsdk:"layout"`
-	AppConnectIssuer     types.String                             `tfsdk:"app_connect_issuer"`
-	AppConnectPrivateKey types.String                             `tfsdk:"app_connect_private_key"`
-	AppId                types.String                             `tfsdk:"app_id"`
-	Url                  types.String                             `tfsdk:"url"`
-	Username             types.String                             `tfsdk:"username"`
-	Password             types.String                             `tfsdk:"password"`
-	Bucket               types.String                             `tfsdk:"bucket"`
-	Region               types.String                             `tfsdk:"region"`
-	AccessKey            types.String                             `tfsdk:"access_key"`
-	SecretKey            types.String                             `tfsdk:"secret_key"`
-	Prefix               types.String                             `tfsdk:"prefix"`
-	ClientEmail          types.String                             `tfsdk:"client_email"`
-	PrivateKey           types.String                             `tfsdk:"private_key"`
+	Id                            types.String                             `tfsdk:"id"`
+	Organization                  types.String                             `tfsdk:"organization"`
+	Project                       types.String                             `tfsdk:"project"`
+	Type                          types.String                             `tfsdk:"type"`
+	Name                          types.String                             `tfsdk:"name"`
+	Layout                        *ProjectSymbolSourcesResourceLayoutModel `tfsdk:"layout"`
+	AppConnectIssuer              types.String                             `tfsdk:"app_connect_issuer"`
+	AppConnectPrivateKey          types.String                             `tfsdk:"app_connect_private_key"`
+	AppConnectPrivateKeyWo        types.String                             `tfsdk:"app_connect_private_key_wo"`
+	AppConnectPrivateKeyWoVersion types.Int64                              `tfsdk:"app_connect_private_key_wo_version"`
+	AppId                         types.String                             `tfsdk:"app_id"`
+	Url                           types.String                             `tfsdk:"url"`
+	Username                      types.String                             `tfsdk:"username"`
+	Password                      types.String                             `tfsdk:"password"`
+	PasswordWo                    types.String                             `tfsdk:"password_wo"`
+	PasswordWoVersion             types.Int64                              `tfsdk:"password_wo_version"`
+	Bucket                        types.String                             `tfsdk:"bucket"`
+	Region                        types.String                             `tfsdk:"region"`
+	AccessKey                     types.String                             `tfsdk:"access_key"`
+	SecretKey                     types.String                             `tfsdk:"secret_key"`
+	SecretKeyWo                   types.String                             `tfsdk:"secret_key_wo"`
+	SecretKeyWoVersion            types.Int64                              `tfsdk:"secret_key_wo_version"`
+	Prefix                        types.String                             `tfsdk:"prefix"`
+	ClientEmail                   types.String                             `tfsdk:"client_email"`
+	PrivateKey                    types.String                             `tfsdk:"private_key"`
+	PrivateKeyWo                  types.String                             `tfsdk:"private_key_wo"`
+	PrivateKeyWoVersion           types.Int64                              `tfsdk:"private_key_wo_version"`
 }
 
-func (data *ProjectSymbolSourcesResourceModel) Fill(source sentry.ProjectSymbolSource) error {
-	data.Id = types.StringPointerValue(source.ID)
-	data.Type = types.StringPointerValue(source.Type)
-	data.Name = types.StringPointerValue(source.Name)
+func (data *ProjectSymbolSourcesResourceModel) Fill(source apiclient.ProjectSymbolSource) error {
+	data.Id = types.StringValue(source.Id)
+	data.Type = types.StringValue(source.Type)
+	data.Name = types.StringValue(source.Name)
 	if source.Layout != nil {
 		data.Layout = &ProjectSymbolSourcesResourceLayoutModel{}
 		if err := data.Layout.Fill(*source.Layout); err != nil {
 			return err
 		}
 	}
-	data.AppConnectIssuer = types.StringPointerValue(source.AppConnectIssuer)
+	data.AppConnectIssuer = types.StringPointerValue(source.AppconnectIssuer)
 	data.AppId = types.StringPointerValue(source.AppId)
 	data.Url = types.StringPointerValue(source.Url)
 	data.Username = types.StringPointerValue(source.Username)
@@ -62,9 +73,24 @@ func (data *ProjectSymbolSourcesResourceModel) Fill(source sentry.ProjectSymbolS
 	return nil
 }
 
+type ProjectSymbolSourcesResourceIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func (m ProjectSymbolSourcesResourceModel) Identity() ProjectSymbolSourcesResourceIdentityModel {
+	return ProjectSymbolSourcesResourceIdentityModel{
+		Organization: m.Organization,
+		Project:      m.Project,
+		Id:           m.Id,
+	}
+}
+
 var _ resource.Resource = &ProjectSymbolSourcesResource{}
 var _ resource.ResourceWithConfigure = &ProjectSymbolSourcesResource{}
 var _ resource.ResourceWithImportState = &ProjectSymbolSourcesResource{}
+var _ resource.ResourceWithIdentity = &ProjectSymbolSourcesResource{}
 
 func NewProjectSymbolSourcesResource() resource.Resource {
 	return &ProjectSymbolSourcesResource{}
@@ -79,9 +105,9 @@ type ProjectSymbolSourcesResourceLayoutModel struct {
 	Casing types.String `tfsdk:"casing"`
 }
 
-func (m *ProjectSymbolSourcesResourceLayoutModel) Fill(layout sentry.ProjectSymbolSourceLayout) error {
-	m.Type = types.StringPointerValue(layout.Type)
-	m.Casing = types.StringPointerValue(layout.Casing)
+func (m *ProjectSymbolSourcesResourceLayoutModel) Fill(layout apiclient.ProjectSymbolSourceLayout) error {
+	m.Type = types.StringValue(layout.Type)
+	m.Casing = types.StringValue(layout.Casing)
 
 	return nil
 }
@@ -160,10 +186,30 @@ func (r *ProjectSymbolSourcesResource) Schema(ctx context.Context, req resource.
 				Description: "The App Store Connect API Private Key. Required for AppStoreConnect sources, invalid for all others.",
 				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("app_connect_private_key_wo")),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"app_connect_private_key_wo": schema.StringAttribute{
+				MarkdownDescription: "The App Store Connect API Private Key as a write-only attribute that is never persisted to state. Use in place of `app_connect_private_key` with Terraform 1.11 and later. Must be set together with `app_connect_private_key_wo_version`. Only valid for AppStoreConnect sources.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("app_connect_private_key")),
+					stringvalidator.AlsoRequires(path.MatchRoot("app_connect_private_key_wo_version")),
+				},
+			},
+			"app_connect_private_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of `app_connect_private_key_wo`. Change this value to send an updated `app_connect_private_key_wo` to Sentry.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("app_connect_private_key_wo")),
+				},
+			},
 			"app_id": schema.StringAttribute{
 				Description: "The App Store Connect App ID. Required for AppStoreConnect sources, invalid for all others.",
 				Optional:    true,
@@ -180,10 +226,30 @@ func (r *ProjectSymbolSourcesResource) Schema(ctx context.Context, req resource.
 				Description: "The password for accessing the source. Optional for HTTP sources, invalid for all others.",
 				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "The password for accessing the source as a write-only attribute that is never persisted to state. Use in place of `password` with Terraform 1.11 and later. Must be set together with `password_wo_version`. Only valid for HTTP sources.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of `password_wo`. Change this value to send an updated `password_wo` to Sentry.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
 			"bucket": schema.StringAttribute{
 				Description: "The GCS or S3 bucket where the source resides. Required for GCS and S3 sourcse, invalid for HTTP and AppStoreConnect sources.",
 				Optional:    true,
@@ -200,10 +266,30 @@ func (r *ProjectSymbolSourcesResource) Schema(ctx context.Context, req resource.
 				Description: "The AWS Secret Access Key.Required for S3 sources, invalid for all others.",
 				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("secret_key_wo")),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"secret_key_wo": schema.StringAttribute{
+				MarkdownDescription: "The AWS Secret Access Key as a write-only attribute that is never persisted to state. Use in place of `secret_key` with Terraform 1.11 and later. Must be set together with `secret_key_wo_version`. Only valid for S3 sources.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("secret_key")),
+					stringvalidator.AlsoRequires(path.MatchRoot("secret_key_wo_version")),
+				},
+			},
+			"secret_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of `secret_key_wo`. Change this value to send an updated `secret_key_wo` to Sentry.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("secret_key_wo")),
+				},
+			},
 			"prefix": schema.StringAttribute{
 				Description: "The GCS or S3 prefix. Optional for GCS and S3 sourcse, invalid for HTTP and AppStoreConnect sources.",
 				Optional:    true,
@@ -216,63 +302,88 @@ func (r *ProjectSymbolSourcesResource) Schema(ctx context.Context, req resource.
 				Description: "The GCS private key. Required for GCS sources, invalid for all others.",
 				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("private_key_wo")),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"private_key_wo": schema.StringAttribute{
+				MarkdownDescription: "The GCS private key as a write-only attribute that is never persisted to state. Use in place of `private_key` with Terraform 1.11 and later. Must be set together with `private_key_wo_version`. Only valid for GCS sources.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("private_key")),
+					stringvalidator.AlsoRequires(path.MatchRoot("private_key_wo_version")),
+				},
+			},
+			"private_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of `private_key_wo`. Change this value to send an updated `private_key_wo` to Sentry.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("private_key_wo")),
+				},
+			},
 		},
 	}
 }
 
 func (r *ProjectSymbolSourcesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data ProjectSymbolSourcesResourceModel
+	var data, config ProjectSymbolSourcesResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	params := &sentry.CreateProjectSymbolSourceParams{
-		Type:                 data.Type.ValueStringPointer(),
-		Name:                 data.Name.ValueStringPointer(),
-		AppConnectIssuer:     data.AppConnectIssuer.ValueStringPointer(),
-		AppConnectPrivateKey: data.AppConnectPrivateKey.ValueStringPointer(),
+	body := apiclient.ProjectSymbolSourceRequest{
+		Type:                 data.Type.ValueString(),
+		Name:                 data.Name.ValueString(),
+		AppconnectIssuer:     data.AppConnectIssuer.ValueStringPointer(),
+		AppconnectPrivateKey: writeOnlyStringPointer(data.AppConnectPrivateKey, config.AppConnectPrivateKeyWo),
 		AppId:                data.AppId.ValueStringPointer(),
 		Url:                  data.Url.ValueStringPointer(),
 		Username:             data.Username.ValueStringPointer(),
-		Password:             data.Password.ValueStringPointer(),
+		Password:             writeOnlyStringPointer(data.Password, config.PasswordWo),
 		Bucket:               data.Bucket.ValueStringPointer(),
 		Region:               data.Region.ValueStringPointer(),
 		AccessKey:            data.AccessKey.ValueStringPointer(),
-		SecretKey:            data.SecretKey.ValueStringPointer(),
+		SecretKey:            writeOnlyStringPointer(data.SecretKey, config.SecretKeyWo),
 		Prefix:               data.Prefix.ValueStringPointer(),
 		ClientEmail:          data.ClientEmail.ValueStringPointer(),
-		PrivateKey:           data.PrivateKey.ValueStringPointer(),
+		PrivateKey:           writeOnlyStringPointer(data.PrivateKey, config.PrivateKeyWo),
 	}
 	if data.Layout != nil {
-		params.Layout = &sentry.ProjectSymbolSourceLayout{
-			Type:   data.Layout.Type.ValueStringPointer(),
-			Casing: data.Layout.Casing.ValueStringPointer(),
+		body.Layout = &apiclient.ProjectSymbolSourceLayout{
+			Type:   data.Layout.Type.ValueString(),
+			Casing: data.Layout.Casing.ValueString(),
 		}
 	}
 
-	source, _, err := r.client.ProjectSymbolSources.Create(
+	httpResp, err := r.apiClient.CreateProjectSymbolSourceWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Project.ValueString(),
-		params,
+		body,
 	)
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
 		return
+	} else if httpResp.StatusCode() != http.StatusCreated || httpResp.JSON201 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 
-	if err := data.Fill(*source); err != nil {
+	if err := data.Fill(*httpResp.JSON201); err != nil {
 		resp.Diagnostics.Append(diagutils.NewFillError(err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ProjectSymbolSourcesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -283,91 +394,100 @@ func (r *ProjectSymbolSourcesResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	sources, apiResp, err := r.client.ProjectSymbolSources.List(
+	httpResp, err := r.apiClient.ListProjectSymbolSourcesWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Project.ValueString(),
-		&sentry.ProjectSymbolSourceQueryParams{
-			ID: data.Id.ValueStringPointer(),
+		&apiclient.ListProjectSymbolSourcesParams{
+			Id: data.Id.ValueStringPointer(),
 		},
 	)
-	if apiResp.StatusCode == http.StatusNotFound {
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
 		resp.Diagnostics.Append(diagutils.NewNotFoundError("project symbol source"))
 		resp.State.RemoveResource(ctx)
 		return
-	}
-	if err != nil {
-		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
 		return
 	}
 
+	sources := *httpResp.JSON200
 	if len(sources) != 1 {
 		resp.Diagnostics.Append(diagutils.NewNotFoundError("project symbol source"))
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	source := sources[0]
-
-	if err := data.Fill(*source); err != nil {
+	if err := data.Fill(sources[0]); err != nil {
 		resp.Diagnostics.Append(diagutils.NewFillError(err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ProjectSymbolSourcesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ProjectSymbolSourcesResourceModel
+	var data, config ProjectSymbolSourcesResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	params := &sentry.UpdateProjectSymbolSourceParams{
-		ID:                   data.Id.ValueStringPointer(),
-		Type:                 data.Type.ValueStringPointer(),
-		Name:                 data.Name.ValueStringPointer(),
-		AppConnectIssuer:     data.AppConnectIssuer.ValueStringPointer(),
-		AppConnectPrivateKey: data.AppConnectPrivateKey.ValueStringPointer(),
+	body := apiclient.ProjectSymbolSourceRequest{
+		Id:                   data.Id.ValueStringPointer(),
+		Type:                 data.Type.ValueString(),
+		Name:                 data.Name.ValueString(),
+		AppconnectIssuer:     data.AppConnectIssuer.ValueStringPointer(),
+		AppconnectPrivateKey: writeOnlyStringPointer(data.AppConnectPrivateKey, config.AppConnectPrivateKeyWo),
 		AppId:                data.AppId.ValueStringPointer(),
 		Url:                  data.Url.ValueStringPointer(),
 		Username:             data.Username.ValueStringPointer(),
-		Password:             data.Password.ValueStringPointer(),
+		Password:             writeOnlyStringPointer(data.Password, config.PasswordWo),
 		Bucket:               data.Bucket.ValueStringPointer(),
 		Region:               data.Region.ValueStringPointer(),
 		AccessKey:            data.AccessKey.ValueStringPointer(),
-		SecretKey:            data.SecretKey.ValueStringPointer(),
+		SecretKey:            writeOnlyStringPointer(data.SecretKey, config.SecretKeyWo),
 		Prefix:               data.Prefix.ValueStringPointer(),
 		ClientEmail:          data.ClientEmail.ValueStringPointer(),
-		PrivateKey:           data.PrivateKey.ValueStringPointer(),
+		PrivateKey:           writeOnlyStringPointer(data.PrivateKey, config.PrivateKeyWo),
 	}
 	if data.Layout != nil {
-		params.Layout = &sentry.ProjectSymbolSourceLayout{
-			Type:   data.Layout.Type.ValueStringPointer(),
-			Casing: data.Layout.Casing.ValueStringPointer(),
+		body.Layout = &apiclient.ProjectSymbolSourceLayout{
+			Type:   data.Layout.Type.ValueString(),
+			Casing: data.Layout.Casing.ValueString(),
 		}
 	}
 
-	source, _, err := r.client.ProjectSymbolSources.Update(
+	httpResp, err := r.apiClient.UpdateProjectSymbolSourceWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Project.ValueString(),
-		data.Id.ValueString(),
-		params,
+		&apiclient.UpdateProjectSymbolSourceParams{
+			Id: data.Id.ValueString(),
+		},
+		body,
 	)
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
 		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 
-	if err := data.Fill(*source); err != nil {
+	if err := data.Fill(*httpResp.JSON200); err != nil {
 		resp.Diagnostics.Append(diagutils.NewFillError(err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *ProjectSymbolSourcesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -378,21 +498,44 @@ func (r *ProjectSymbolSourcesResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	apiResp, err := r.client.ProjectSymbolSources.Delete(
+	httpResp, err := r.apiClient.DeleteProjectSymbolSourceWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.Project.ValueString(),
-		data.Id.ValueString(),
+		&apiclient.DeleteProjectSymbolSourceParams{
+			Id: data.Id.ValueString(),
+		},
 	)
-	if apiResp.StatusCode == http.StatusNotFound {
-		return
-	}
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
 		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 }
 
 func (r *ProjectSymbolSourcesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	intresource.ImportState3PartPath("organization", "project", "id")(ctx, req, resp)
 }
+
+func (r *ProjectSymbolSourcesResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"project": identityschema.StringAttribute{
+				Description:       "The project of this resource.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of this resource.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
 	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
 )
@@ -85,3 +86,63 @@ resource "sentry_project_symbol_source" "test" {
 }
 `, acctest.TestOrganization, acctest.TestTeam.Slug, projectName, name)
 }
+
+func TestAccProjectSymbolSourceResource_writeOnly(t *testing.T) {
+	rn := "sentry_project_symbol_source.test"
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectSymbolSourceWriteOnlyConfig(project, "secret_key", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "type", "s3"),
+					resource.TestCheckResourceAttr(rn, "access_key", "access_key"),
+					resource.TestCheckNoResourceAttr(rn, "secret_key"),
+					resource.TestCheckNoResourceAttr(rn, "secret_key_wo"),
+					resource.TestCheckResourceAttr(rn, "secret_key_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccProjectSymbolSourceWriteOnlyConfig(project, "secret_key_rotated", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(rn, "secret_key"),
+					resource.TestCheckNoResourceAttr(rn, "secret_key_wo"),
+					resource.TestCheckResourceAttr(rn, "secret_key_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectSymbolSourceWriteOnlyConfig(projectName string, secretKey string, version int) string {
+	return fmt.Sprintf(`
+resource "sentry_project" "test" {
+	organization = "%[1]s"
+	teams        = ["%[2]s"]
+	name         = "%[3]s"
+	platform     = "go"
+}
+
+resource "sentry_project_symbol_source" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+	type         = "s3"
+	name         = "s3"
+	layout       = {
+		type   = "native"
+		casing = "default"
+	}
+	bucket                = "bucket"
+	region                = "us-east-1"
+	access_key            = "access_key"
+	secret_key_wo         = "%[4]s"
+	secret_key_wo_version = %[5]d
+}
+`, acctest.TestOrganization, acctest.TestTeam.Slug, projectName, secretKey, version)
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+)
+
+type ProjectTeamResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	Team         types.String `tfsdk:"team"`
+}
+
+func (m *ProjectTeamResourceModel) Fill(organization string, project string, team string) error {
+	if id, err := resourceid.BuildPath3(organization, project, team); err != nil {
+		return err
+	} else {
+		m.Id = types.StringValue(id)
+	}
+	m.Organization = types.StringValue(organization)
+	m.Project = types.StringValue(project)
+	m.Team = types.StringValue(team)
+
+	return nil
+}
+
+type ProjectTeamResourceIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	Team         types.String `tfsdk:"team"`
+}
+
+func (m ProjectTeamResourceModel) Identity() ProjectTeamResourceIdentityModel {
+	return ProjectTeamResourceIdentityModel{
+		Organization: m.Organization,
+		Project:      m.Project,
+		Team:         m.Team,
+	}
+}
+
+var _ resource.Resource = &ProjectTeamResource{}
+var _ resource.ResourceWithConfigure = &ProjectTeamResource{}
+var _ resource.ResourceWithImportState = &ProjectTeamResource{}
+var _ resource.ResourceWithIdentity = &ProjectTeamResource{}
+
+func NewProjectTeamResource() resource.Resource {
+	return &ProjectTeamResource{}
+}
+
+type ProjectTeamResource struct {
+	baseResource
+}
+
+func (r *ProjectTeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_team"
+}
+
+func (r *ProjectTeamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Project Team resource. Gives a team access to a project. Set `ignore_team_changes` on the `sentry_project` resource so that it does not undo the changes made by this resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id":           ResourceIdAttribute(),
+			"organization": ResourceOrganizationAttribute(),
+			"project": schema.StringAttribute{
+				Description: "The slug of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team": schema.StringAttribute{
+				Description: "The slug of the team to add to the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectTeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectTeamResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.AddTeamToProjectWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		data.Team.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusCreated {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), data.Team.ValueString()); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
+}
+
+func (r *ProjectTeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectTeamResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.GetOrganizationProjectWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("project"))
+		resp.State.RemoveResource(ctx)
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if !slices.ContainsFunc(httpResp.JSON200.Teams, func(team apiclient.Team) bool {
+		return team.Slug == data.Team.ValueString()
+	}) {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("project team"))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), data.Project.ValueString(), data.Team.ValueString()); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
+}
+
+func (r *ProjectTeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(diagutils.NewNotSupportedError("update"))
+}
+
+func (r *ProjectTeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectTeamResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.RemoveTeamFromProjectWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		data.Team.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
+
+func (r *ProjectTeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState3PartPath("organization", "project", "team")(ctx, req, resp)
+}
+
+func (r *ProjectTeamResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"project": identityschema.StringAttribute{
+				Description:       "The slug of the project.",
+				RequiredForImport: true,
+			},
+			"team": identityschema.StringAttribute{
+				Description:       "The slug of the team.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+)
+
+func TestAccProjectTeamResource(t *testing.T) {
+	rn := "sentry_project_team.test"
+	team1 := acctest.RandomWithPrefix("tf-team")
+	team2 := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTeamConfig(team1, team2, project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "organization", acctest.TestOrganization),
+					resource.TestCheckResourceAttrPair(rn, "project", "sentry_project.test", "slug"),
+					resource.TestCheckResourceAttrPair(rn, "team", "sentry_team.test_2", "slug"),
+					resource.TestCheckResourceAttr("sentry_project.test", "teams.#", "1"),
+					testAccCheckProject("sentry_project.test", func(project apiclient.Project) error {
+						if len(project.Teams) != 2 {
+							return fmt.Errorf("unexpected number of teams %v", len(project.Teams))
+						}
+						if !slices.ContainsFunc(project.Teams, func(team apiclient.Team) bool {
+							return team.Slug == team2
+						}) {
+							return fmt.Errorf("team %v not found", team2)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccProjectTeamResource_identity(t *testing.T) {
+	rn := "sentry_project_team.test"
+	team1 := acctest.RandomWithPrefix("tf-team")
+	team2 := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTeamConfig(team1, team2, project),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(rn, map[string]knownvalue.Check{
+						"organization": knownvalue.StringExact(acctest.TestOrganization),
+						"project":      knownvalue.StringExact(project),
+						"team":         knownvalue.StringExact(team2),
+					}),
+				},
+			},
+			{
+				ResourceName:    rn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func testAccProjectTeamConfig(team1Name, team2Name, projectName string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_team" "test_1" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[1]s"
+	slug         = "%[1]s"
+}
+
+resource "sentry_team" "test_2" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[2]s"
+	slug         = "%[2]s"
+}
+
+resource "sentry_project" "test" {
+	organization        = data.sentry_organization.test.slug
+	teams               = [sentry_team.test_1.slug]
+	ignore_team_changes = true
+	name                = "%[3]s"
+	slug                = "%[3]s"
+	platform            = "go"
+}
+
+resource "sentry_project_team" "test" {
+	organization = data.sentry_organization.test.slug
+	project      = sentry_project.test.slug
+	team         = sentry_team.test_2.slug
+}
+`, team1Name, team2Name, projectName)
+}
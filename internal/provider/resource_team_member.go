@@ -9,16 +9,18 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/jianyuan/go-sentry/v2/sentry"
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
 	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
 	"github.com/samber/lo"
 )
@@ -49,9 +51,24 @@ func (data *TeamMemberResourceModel) Fill(organization string, team string, memb
 	return nil
 }
 
+type TeamMemberResourceIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Team         types.String `tfsdk:"team"`
+	MemberId     types.String `tfsdk:"member_id"`
+}
+
+func (m TeamMemberResourceModel) Identity() TeamMemberResourceIdentityModel {
+	return TeamMemberResourceIdentityModel{
+		Organization: m.Organization,
+		Team:         m.Team,
+		MemberId:     m.MemberId,
+	}
+}
+
 var _ resource.Resource = &TeamMemberResource{}
 var _ resource.ResourceWithConfigure = &TeamMemberResource{}
 var _ resource.ResourceWithImportState = &TeamMemberResource{}
+var _ resource.ResourceWithIdentity = &TeamMemberResource{}
 
 func NewTeamMemberResource() resource.Resource {
 	return &TeamMemberResource{}
@@ -163,22 +180,36 @@ func (r *TeamMemberResource) getEffectiveTeamRole(ctx context.Context, organizat
 	return &member.TeamRoleList[0].Id, nil
 }
 
-func (r *TeamMemberResource) updateRole(ctx context.Context, organization string, memberId string, team string, role string) (*string, error) {
+func (r *TeamMemberResource) updateRole(ctx context.Context, organization string, memberId string, team string, role string) (*string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
 	r.roleMu.Lock()
 	defer r.roleMu.Unlock()
 
-	member, _, err := r.client.TeamMembers.Update(ctx, organization, memberId, team, &sentry.UpdateTeamMemberParams{
-		TeamRole: sentry.String(role),
+	httpResp, err := r.apiClient.UpdateOrganizationMemberTeamRoleWithResponse(ctx, organization, memberId, team, apiclient.UpdateOrganizationMemberTeamRoleJSONRequestBody{
+		TeamRole: role,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to update team member role (organization=%s, team=%s, member_id=%s), got error: %s", organization, team, memberId, err)
+		diags.Append(diagutils.NewClientError("update team member role", err))
+		return nil, diags
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		diags.Append(diagutils.NewNotFoundError("team member"))
+		return nil, diags
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		diags.Append(diagutils.NewClientStatusError("update team member role", httpResp.StatusCode(), httpResp.Body))
+		return nil, diags
 	}
+	member := httpResp.JSON200
 
-	if !sentry.BoolValue(member.IsActive) {
-		return nil, fmt.Errorf("team member is not active")
+	if !member.IsActive {
+		diags.AddError("Client error", "Unable to update team member role, team member is not active")
+		return nil, diags
 	}
 
-	return member.TeamRole, nil
+	if member.TeamRole.IsSpecified() && !member.TeamRole.IsNull() {
+		return new(member.TeamRole.MustGet()), diags
+	}
+	return nil, diags
 }
 
 func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -191,7 +222,7 @@ func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	_, _, err := r.client.TeamMembers.Create(
+	httpResp, err := r.apiClient.AddOrganizationMemberToTeamWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.MemberId.ValueString(),
@@ -200,12 +231,15 @@ func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequ
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
 		return
+	} else if httpResp.StatusCode() != http.StatusCreated && httpResp.StatusCode() != http.StatusAccepted && httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 
 	if !data.Role.IsNull() {
-		_, err = r.updateRole(ctx, data.Organization.ValueString(), data.MemberId.ValueString(), data.Team.ValueString(), data.Role.ValueString())
-		if err != nil {
-			resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		_, diags := r.updateRole(ctx, data.Organization.ValueString(), data.MemberId.ValueString(), data.Team.ValueString(), data.Role.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
@@ -230,6 +264,7 @@ func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *TeamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -265,6 +300,7 @@ func (r *TeamMemberResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *TeamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -279,9 +315,9 @@ func (r *TeamMemberResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Update the role if it has changed
 	if !plan.Role.Equal(state.Role) {
-		_, err := r.updateRole(ctx, plan.Organization.ValueString(), plan.MemberId.ValueString(), plan.Team.ValueString(), plan.Role.ValueString())
-		if err != nil {
-			resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		_, diags := r.updateRole(ctx, plan.Organization.ValueString(), plan.MemberId.ValueString(), plan.Team.ValueString(), plan.Role.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 
@@ -305,6 +341,7 @@ func (r *TeamMemberResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.Identity())...)
 }
 
 func (r *TeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -317,24 +354,30 @@ func (r *TeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	_, apiResp, err := r.client.TeamMembers.Delete(
+	httpResp, err := r.apiClient.RemoveOrganizationMemberFromTeamWithResponse(
 		ctx,
 		data.Organization.ValueString(),
 		data.MemberId.ValueString(),
 		data.Team.ValueString(),
 	)
-	// The membership is already gone (e.g. the team was deleted or re-slugged
-	// out-of-band), so treat a 404 as a successful delete.
-	if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
-		return
-	}
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
 		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		// The membership is already gone (e.g. the team was deleted or re-slugged
+		// out-of-band), so treat a 404 as a successful delete.
+		return
+	} else if httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
 	}
 }
 
 func (r *TeamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if intresource.ImportStateFromIdentity(ctx, req, resp, "organization", "team", "member_id") {
+		return
+	}
+
 	organization, team, memberId, err := resourceid.Split3Path(req.ID, "organization", "team-slug", "member-id")
 	if err != nil {
 		resp.Diagnostics.Append(diagutils.NewImportError(err))
@@ -353,3 +396,22 @@ func (r *TeamMemberResource) ImportState(ctx context.Context, req resource.Impor
 		ctx, path.Root("id"), req.ID,
 	)...)
 }
+
+func (r *TeamMemberResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"team": identityschema.StringAttribute{
+				Description:       "The slug of the team.",
+				RequiredForImport: true,
+			},
+			"member_id": identityschema.StringAttribute{
+				Description:       "The ID of the member.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
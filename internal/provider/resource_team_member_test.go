@@ -6,6 +6,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
 )
 
@@ -60,6 +64,39 @@ func TestAccTeamMemberResource(t *testing.T) {
 	})
 }
 
+func TestAccTeamMemberResource_identity(t *testing.T) {
+	rn := "sentry_team_member.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	member1Email := acctest.RandomWithPrefix("tf-member") + "@example.com"
+	member2Email := acctest.RandomWithPrefix("tf-member") + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMemberConfig(team, member1Email, member2Email, "sentry_organization_member.test_1", "contributor"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(rn, map[string]knownvalue.Check{
+						"organization": knownvalue.StringExact(acctest.TestOrganization),
+						"team":         knownvalue.StringExact(team),
+						"member_id":    knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesState(rn, tfjsonpath.New("member_id")),
+				},
+			},
+			{
+				ResourceName:    rn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 // TestAccTeamMemberResource_teamDeletedOutOfBand reproduces the case where the
 // team backing a membership is removed from Sentry outside of Terraform (e.g. it
 // was deleted or re-slugged). On destroy, the DELETE against the stale team slug
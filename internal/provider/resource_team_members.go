@@ -0,0 +1,473 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+type TeamMembersResourceMemberModel struct {
+	MemberId types.String `tfsdk:"member_id"`
+	Role     types.String `tfsdk:"role"`
+}
+
+type TeamMembersResourceModel struct {
+	Id            types.String                                                      `tfsdk:"id"`
+	Organization  types.String                                                      `tfsdk:"organization"`
+	Team          types.String                                                      `tfsdk:"team"`
+	Members       supertypes.SetNestedObjectValueOf[TeamMembersResourceMemberModel] `tfsdk:"members"`
+	IgnoreMembers supertypes.SetValueOf[string]                                     `tfsdk:"ignore_members"`
+}
+
+// Fill sets the members from the team's members, skipping the ignored ones.
+// The role is only read back for members managed with a role, so that members
+// without one do not show a difference.
+func (m *TeamMembersResourceModel) Fill(ctx context.Context, members []apiclient.TeamMember) (diags diag.Diagnostics) {
+	if id, err := resourceid.BuildPath2(m.Organization.ValueString(), m.Team.ValueString()); err != nil {
+		diags.Append(diagutils.NewFillError(err))
+		return
+	} else {
+		m.Id = types.StringValue(id)
+	}
+
+	priorRoles := make(map[string]types.String)
+	if m.Members.IsKnown() && !m.Members.IsNull() {
+		for _, member := range m.Members.DiagsGet(ctx, diags) {
+			priorRoles[member.MemberId.ValueString()] = member.Role
+		}
+	}
+
+	var ignoreMembers []string
+	if m.IgnoreMembers.IsKnown() && !m.IgnoreMembers.IsNull() {
+		ignoreMembers = m.IgnoreMembers.DiagsGet(ctx, diags)
+	}
+	if diags.HasError() {
+		return
+	}
+
+	items := make([]TeamMembersResourceMemberModel, 0, len(members))
+	for _, member := range members {
+		if slices.Contains(ignoreMembers, member.Id) {
+			continue
+		}
+
+		item := TeamMembersResourceMemberModel{
+			MemberId: types.StringValue(member.Id),
+			Role:     types.StringNull(),
+		}
+		if priorRole, ok := priorRoles[member.Id]; ok && priorRole.IsNull() {
+			// The role is not managed.
+		} else if member.TeamRole.IsSpecified() && !member.TeamRole.IsNull() {
+			item.Role = types.StringValue(member.TeamRole.MustGet())
+		} else if ok {
+			item.Role = priorRole
+		}
+		items = append(items, item)
+	}
+	m.Members = supertypes.NewSetNestedObjectValueOfValueSlice(ctx, items)
+
+	return
+}
+
+type TeamMembersResourceIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Team         types.String `tfsdk:"team"`
+}
+
+func (m TeamMembersResourceModel) Identity() TeamMembersResourceIdentityModel {
+	return TeamMembersResourceIdentityModel{
+		Organization: m.Organization,
+		Team:         m.Team,
+	}
+}
+
+var _ resource.Resource = &TeamMembersResource{}
+var _ resource.ResourceWithConfigure = &TeamMembersResource{}
+var _ resource.ResourceWithValidateConfig = &TeamMembersResource{}
+var _ resource.ResourceWithImportState = &TeamMembersResource{}
+var _ resource.ResourceWithIdentity = &TeamMembersResource{}
+
+func NewTeamMembersResource() resource.Resource {
+	return &TeamMembersResource{}
+}
+
+type TeamMembersResource struct {
+	baseResource
+}
+
+func (r *TeamMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (r *TeamMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Team Members resource. Manages the full set of members of a team: members that are not listed, and not ignored, are removed from the team. Do not use it together with `sentry_team_member` for the same team.",
+
+		Attributes: map[string]schema.Attribute{
+			"id":           ResourceIdAttribute(),
+			"organization": ResourceOrganizationAttribute(),
+			"team": schema.StringAttribute{
+				Description: "The slug of the team.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				MarkdownDescription: "The members of the team.",
+				Required:            true,
+				CustomType:          supertypes.NewSetNestedObjectTypeOf[TeamMembersResourceMemberModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"member_id": schema.StringAttribute{
+							Description: "The ID of the member.",
+							Required:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role of the member in the team. When not set, resolve to the minimum team role given by this member's organization role.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("contributor", "admin"),
+							},
+						},
+					},
+				},
+			},
+			"ignore_members": schema.SetAttribute{
+				MarkdownDescription: "The IDs of members that are neither added to nor removed from the team, e.g. organization owners or bot accounts.",
+				Optional:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+			},
+		},
+	}
+}
+
+func (r *TeamMembersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Members.IsKnown() {
+		return
+	}
+
+	members := data.Members.DiagsGet(ctx, resp.Diagnostics)
+
+	// Overlaps with the ignored members are only checked once they are known.
+	var ignoreMembers []string
+	if data.IgnoreMembers.IsKnown() {
+		ignoreMembers = data.IgnoreMembers.DiagsGet(ctx, resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool)
+	for _, member := range members {
+		if member.MemberId.IsUnknown() {
+			continue
+		}
+		memberId := member.MemberId.ValueString()
+
+		if seen[memberId] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("members"),
+				"Duplicate member",
+				fmt.Sprintf("Member %q is listed more than once.", memberId),
+			)
+		}
+		seen[memberId] = true
+
+		if slices.Contains(ignoreMembers, memberId) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ignore_members"),
+				"Ignored member is managed",
+				fmt.Sprintf("Member %q is listed in both `members` and `ignore_members`.", memberId),
+			)
+		}
+	}
+}
+
+func (r *TeamMembersResource) listMembers(ctx context.Context, organization string, team string) ([]apiclient.TeamMember, int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var members []apiclient.TeamMember
+	params := &apiclient.ListOrganizationTeamMembersParams{}
+	for {
+		httpResp, err := r.apiClient.ListOrganizationTeamMembersWithResponse(ctx, organization, team, params)
+		if err != nil {
+			diags.Append(diagutils.NewClientError("read", err))
+			return nil, 0, diags
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			if httpResp.StatusCode() != http.StatusNotFound {
+				diags.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+			}
+			return nil, httpResp.StatusCode(), diags
+		}
+
+		members = append(members, *httpResp.JSON200...)
+
+		params.Cursor = sentryclient.ParseNextPaginationCursor(httpResp.HTTPResponse)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	return members, http.StatusOK, diags
+}
+
+func (r *TeamMembersResource) addMember(ctx context.Context, organization string, team string, member TeamMembersResourceMemberModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	httpResp, err := r.apiClient.AddOrganizationMemberToTeamWithResponse(ctx, organization, member.MemberId.ValueString(), team)
+	if err != nil {
+		diags.Append(diagutils.NewClientError("add team member", err))
+		return diags
+	} else if httpResp.StatusCode() != http.StatusCreated && httpResp.StatusCode() != http.StatusAccepted && httpResp.StatusCode() != http.StatusNoContent {
+		diags.Append(diagutils.NewClientStatusError("add team member", httpResp.StatusCode(), httpResp.Body))
+		return diags
+	}
+
+	if !member.Role.IsNull() {
+		diags.Append(r.updateMemberRole(ctx, organization, team, member)...)
+	}
+
+	return diags
+}
+
+func (r *TeamMembersResource) updateMemberRole(ctx context.Context, organization string, team string, member TeamMembersResourceMemberModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	httpResp, err := r.apiClient.UpdateOrganizationMemberTeamRoleWithResponse(ctx, organization, member.MemberId.ValueString(), team, apiclient.UpdateOrganizationMemberTeamRoleJSONRequestBody{
+		TeamRole: member.Role.ValueString(),
+	})
+	if err != nil {
+		diags.Append(diagutils.NewClientError("update team member role", err))
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		diags.Append(diagutils.NewClientStatusError("update team member role", httpResp.StatusCode(), httpResp.Body))
+	} else if !httpResp.JSON200.IsActive {
+		diags.AddError("Client error", fmt.Sprintf("Unable to update team member role, member %q is not active", member.MemberId.ValueString()))
+	}
+
+	return diags
+}
+
+func (r *TeamMembersResource) removeMember(ctx context.Context, organization string, team string, memberId string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	httpResp, err := r.apiClient.RemoveOrganizationMemberFromTeamWithResponse(ctx, organization, memberId, team)
+	if err != nil {
+		diags.Append(diagutils.NewClientError("remove team member", err))
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		// The member already left the team.
+	} else if httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusNoContent {
+		diags.Append(diagutils.NewClientStatusError("remove team member", httpResp.StatusCode(), httpResp.Body))
+	}
+
+	return diags
+}
+
+// readCurrent reads the existing members of the team the same way as Read, so
+// that syncing removes the members that are not planned and leaves ignored
+// members alone.
+func (r *TeamMembersResource) readCurrent(ctx context.Context, data TeamMembersResourceModel) (*TeamMembersResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	members, statusCode, listDiags := r.listMembers(ctx, data.Organization.ValueString(), data.Team.ValueString())
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return nil, diags
+	} else if statusCode == http.StatusNotFound {
+		diags.Append(diagutils.NewNotFoundError("team"))
+		return nil, diags
+	}
+
+	current := data
+	current.Members = supertypes.NewSetNestedObjectValueOfNull[TeamMembersResourceMemberModel](ctx)
+	diags.Append(current.Fill(ctx, members)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &current, diags
+}
+
+// sync applies the planned members to the team, given its current members.
+func (r *TeamMembersResource) sync(ctx context.Context, plan TeamMembersResourceModel, current []*TeamMembersResourceMemberModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	organization := plan.Organization.ValueString()
+	team := plan.Team.ValueString()
+
+	planMembers := plan.Members.DiagsGet(ctx, diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	currentRoles := make(map[string]types.String, len(current))
+	for _, member := range current {
+		currentRoles[member.MemberId.ValueString()] = member.Role
+	}
+
+	// Add members and update roles
+	planMemberIds := make([]string, 0, len(planMembers))
+	for _, member := range planMembers {
+		memberId := member.MemberId.ValueString()
+		planMemberIds = append(planMemberIds, memberId)
+
+		if currentRole, ok := currentRoles[memberId]; !ok {
+			diags.Append(r.addMember(ctx, organization, team, *member)...)
+		} else if !member.Role.IsNull() && !member.Role.Equal(currentRole) {
+			diags.Append(r.updateMemberRole(ctx, organization, team, *member)...)
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// Remove members
+	for _, member := range current {
+		if !slices.Contains(planMemberIds, member.MemberId.ValueString()) {
+			diags.Append(r.removeMember(ctx, organization, team, member.MemberId.ValueString())...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+func (r *TeamMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := tfutils.MergeDiagnostics(r.readCurrent(ctx, data))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, data, current.Members.DiagsGet(ctx, resp.Diagnostics))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = current.Id
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
+}
+
+func (r *TeamMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, statusCode, diags := r.listMembers(ctx, data.Organization.ValueString(), data.Team.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if statusCode == http.StatusNotFound {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("team"))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, members)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
+}
+
+func (r *TeamMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The members may have changed outside of Terraform since the last refresh.
+	current := tfutils.MergeDiagnostics(r.readCurrent(ctx, plan))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, plan, current.Members.DiagsGet(ctx, resp.Diagnostics))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.Identity())...)
+}
+
+func (r *TeamMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, member := range data.Members.DiagsGet(ctx, resp.Diagnostics) {
+		resp.Diagnostics.Append(r.removeMember(ctx, data.Organization.ValueString(), data.Team.ValueString(), member.MemberId.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+func (r *TeamMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState2PartPath("organization", "team")(ctx, req, resp)
+}
+
+func (r *TeamMembersResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"team": identityschema.StringAttribute{
+				Description:       "The slug of the team.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccTeamMembersResource(t *testing.T) {
+	rn := "sentry_team_members.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	member1Email := acctest.RandomWithPrefix("tf-member") + "@example.com"
+	member2Email := acctest.RandomWithPrefix("tf-member") + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMembersConfig(team, member1Email, member2Email, `
+	{
+		member_id = sentry_organization_member.test_1.internal_id
+		role      = "contributor"
+	},
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "organization", acctest.TestOrganization),
+					resource.TestCheckResourceAttrPair(rn, "team", "sentry_team.test", "slug"),
+					resource.TestCheckResourceAttr(rn, "members.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(rn, "members.*", map[string]string{
+						"role": "contributor",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(rn, "members.*.member_id", "sentry_organization_member.test_1", "internal_id"),
+				),
+			},
+			{
+				Config: testAccTeamMembersConfig(team, member1Email, member2Email, `
+	{
+		member_id = sentry_organization_member.test_1.internal_id
+		role      = "admin"
+	},
+	{
+		member_id = sentry_organization_member.test_2.internal_id
+		role      = "contributor"
+	},
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(rn, "members.*", map[string]string{
+						"role": "admin",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(rn, "members.*", map[string]string{
+						"role": "contributor",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(rn, "members.*.member_id", "sentry_organization_member.test_1", "internal_id"),
+					resource.TestCheckTypeSetElemAttrPair(rn, "members.*.member_id", "sentry_organization_member.test_2", "internal_id"),
+				),
+			},
+			{
+				Config: testAccTeamMembersConfig(team, member1Email, member2Email, `
+	{
+		member_id = sentry_organization_member.test_2.internal_id
+		role      = "contributor"
+	},
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(rn, "members.*.member_id", "sentry_organization_member.test_2", "internal_id"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTeamMembersResource_identity(t *testing.T) {
+	rn := "sentry_team_members.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	member1Email := acctest.RandomWithPrefix("tf-member") + "@example.com"
+	member2Email := acctest.RandomWithPrefix("tf-member") + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMembersConfig(team, member1Email, member2Email, `
+	{
+		member_id = sentry_organization_member.test_1.internal_id
+		role      = "contributor"
+	},
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(rn, map[string]knownvalue.Check{
+						"organization": knownvalue.StringExact(acctest.TestOrganization),
+						"team":         knownvalue.StringExact(team),
+					}),
+				},
+			},
+			{
+				ResourceName:    rn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccTeamMembersResource_ignoreMembers(t *testing.T) {
+	rn := "sentry_team_members.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	member1Email := acctest.RandomWithPrefix("tf-member") + "@example.com"
+	member2Email := acctest.RandomWithPrefix("tf-member") + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The second member is added outside of the authoritative resource
+				// and must be left alone.
+				Config: testAccTeamMembersConfig_ignoreMembers(team, member1Email, member2Email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(rn, "members.*.member_id", "sentry_organization_member.test_1", "internal_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTeamMembersResource_duplicateMember(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_team_members" "test" {
+						organization = "1"
+						team         = "team"
+						members = [
+							{
+								member_id = "1"
+								role      = "contributor"
+							},
+							{
+								member_id = "1"
+								role      = "admin"
+							},
+						]
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Member "1" is listed more than once.`),
+			},
+		},
+	})
+}
+
+func testAccTeamMembersConfig(teamName, member1Email, member2Email, members string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_team" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[1]s"
+	slug         = "%[1]s"
+}
+
+resource "sentry_organization_member" "test_1" {
+	organization = data.sentry_organization.test.slug
+	email        = "%[2]s"
+	role         = "member"
+}
+
+resource "sentry_organization_member" "test_2" {
+	organization = data.sentry_organization.test.slug
+	email        = "%[3]s"
+	role         = "member"
+}
+
+resource "sentry_team_members" "test" {
+	organization = data.sentry_organization.test.slug
+	team         = sentry_team.test.slug
+	members      = [%[4]s]
+}
+`, teamName, member1Email, member2Email, members)
+}
+
+func testAccTeamMembersConfig_ignoreMembers(teamName, member1Email, member2Email string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_team" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[1]s"
+	slug         = "%[1]s"
+}
+
+resource "sentry_organization_member" "test_1" {
+	organization = data.sentry_organization.test.slug
+	email        = "%[2]s"
+	role         = "member"
+}
+
+resource "sentry_organization_member" "test_2" {
+	organization = data.sentry_organization.test.slug
+	email        = "%[3]s"
+	role         = "member"
+}
+
+resource "sentry_team_members" "test" {
+	organization = data.sentry_organization.test.slug
+	team         = sentry_team.test.slug
+	members = [
+		{
+			member_id = sentry_organization_member.test_1.internal_id
+			role      = "contributor"
+		},
+	]
+	ignore_members = [sentry_organization_member.test_2.internal_id]
+}
+
+resource "sentry_team_member" "test_2" {
+	organization = data.sentry_organization.test.slug
+	team         = sentry_team.test.slug
+	member_id    = sentry_organization_member.test_2.internal_id
+
+	depends_on = [sentry_team_members.test]
+}
+`, teamName, member1Email, member2Email)
+}
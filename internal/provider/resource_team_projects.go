@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+type TeamProjectsResourceModel struct {
+	Id             types.String                  `tfsdk:"id"`
+	Organization   types.String                  `tfsdk:"organization"`
+	Team           types.String                  `tfsdk:"team"`
+	Projects       supertypes.SetValueOf[string] `tfsdk:"projects"`
+	IgnoreProjects supertypes.SetValueOf[string] `tfsdk:"ignore_projects"`
+}
+
+// Fill sets the projects from the team's projects, skipping the ignored ones.
+func (m *TeamProjectsResourceModel) Fill(ctx context.Context, projects []apiclient.Project) (diags diag.Diagnostics) {
+	if id, err := resourceid.BuildPath2(m.Organization.ValueString(), m.Team.ValueString()); err != nil {
+		diags.Append(diagutils.NewFillError(err))
+		return
+	} else {
+		m.Id = types.StringValue(id)
+	}
+
+	var ignoreProjects []string
+	if m.IgnoreProjects.IsKnown() && !m.IgnoreProjects.IsNull() {
+		ignoreProjects = m.IgnoreProjects.DiagsGet(ctx, diags)
+	}
+	if diags.HasError() {
+		return
+	}
+
+	slugs := make([]string, 0, len(projects))
+	for _, project := range projects {
+		if !slices.Contains(ignoreProjects, project.Slug) {
+			slugs = append(slugs, project.Slug)
+		}
+	}
+	m.Projects = supertypes.NewSetValueOfSlice(ctx, slugs)
+
+	return
+}
+
+type TeamProjectsResourceIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Team         types.String `tfsdk:"team"`
+}
+
+func (m TeamProjectsResourceModel) Identity() TeamProjectsResourceIdentityModel {
+	return TeamProjectsResourceIdentityModel{
+		Organization: m.Organization,
+		Team:         m.Team,
+	}
+}
+
+var _ resource.Resource = &TeamProjectsResource{}
+var _ resource.ResourceWithConfigure = &TeamProjectsResource{}
+var _ resource.ResourceWithValidateConfig = &TeamProjectsResource{}
+var _ resource.ResourceWithImportState = &TeamProjectsResource{}
+var _ resource.ResourceWithIdentity = &TeamProjectsResource{}
+
+func NewTeamProjectsResource() resource.Resource {
+	return &TeamProjectsResource{}
+}
+
+type TeamProjectsResource struct {
+	baseResource
+}
+
+func (r *TeamProjectsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_projects"
+}
+
+func (r *TeamProjectsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sentry Team Projects resource. Manages the full set of projects a team has access to: the team is removed from projects that are not listed, and not ignored. Do not use it together with `sentry_project_team` for the same team, and set `ignore_team_changes` on the `sentry_project` resources of the listed projects.",
+
+		Attributes: map[string]schema.Attribute{
+			"id":           ResourceIdAttribute(),
+			"organization": ResourceOrganizationAttribute(),
+			"team": schema.StringAttribute{
+				Description: "The slug of the team.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"projects": schema.SetAttribute{
+				Description: "The slugs of the projects the team has access to.",
+				Required:    true,
+				CustomType:  supertypes.NewSetTypeOf[string](ctx),
+			},
+			"ignore_projects": schema.SetAttribute{
+				Description: "The slugs of projects that the team is neither added to nor removed from.",
+				Optional:    true,
+				CustomType:  supertypes.NewSetTypeOf[string](ctx),
+			},
+		},
+	}
+}
+
+func (r *TeamProjectsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TeamProjectsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Projects.IsKnown() || data.Projects.IsNull() || !data.IgnoreProjects.IsKnown() {
+		return
+	}
+
+	projects := data.Projects.DiagsGet(ctx, resp.Diagnostics)
+	ignoreProjects := data.IgnoreProjects.DiagsGet(ctx, resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, project := range projects {
+		if slices.Contains(ignoreProjects, project) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ignore_projects"),
+				"Ignored project is managed",
+				fmt.Sprintf("Project %q is listed in both `projects` and `ignore_projects`.", project),
+			)
+		}
+	}
+}
+
+func (r *TeamProjectsResource) listProjects(ctx context.Context, organization string, team string) ([]apiclient.Project, int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var projects []apiclient.Project
+	params := &apiclient.ListTeamProjectsParams{}
+	for {
+		httpResp, err := r.apiClient.ListTeamProjectsWithResponse(ctx, organization, team, params)
+		if err != nil {
+			diags.Append(diagutils.NewClientError("read", err))
+			return nil, 0, diags
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			if httpResp.StatusCode() != http.StatusNotFound {
+				diags.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+			}
+			return nil, httpResp.StatusCode(), diags
+		}
+
+		projects = append(projects, *httpResp.JSON200...)
+
+		params.Cursor = sentryclient.ParseNextPaginationCursor(httpResp.HTTPResponse)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	return projects, http.StatusOK, diags
+}
+
+func (r *TeamProjectsResource) addProject(ctx context.Context, organization string, team string, project string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	httpResp, err := r.apiClient.AddTeamToProjectWithResponse(ctx, organization, project, team)
+	if err != nil {
+		diags.Append(diagutils.NewClientError("add team to project", err))
+	} else if httpResp.StatusCode() != http.StatusCreated {
+		diags.Append(diagutils.NewClientStatusError("add team to project", httpResp.StatusCode(), httpResp.Body))
+	}
+
+	return diags
+}
+
+func (r *TeamProjectsResource) removeProject(ctx context.Context, organization string, team string, project string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	httpResp, err := r.apiClient.RemoveTeamFromProjectWithResponse(ctx, organization, project, team)
+	if err != nil {
+		diags.Append(diagutils.NewClientError("remove team from project", err))
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		// The project was deleted or the team already left it.
+	} else if httpResp.StatusCode() != http.StatusOK {
+		diags.Append(diagutils.NewClientStatusError("remove team from project", httpResp.StatusCode(), httpResp.Body))
+	}
+
+	return diags
+}
+
+// readCurrent reads the existing projects of the team the same way as Read,
+// so that syncing removes the projects that are not planned and leaves ignored
+// projects alone.
+func (r *TeamProjectsResource) readCurrent(ctx context.Context, data TeamProjectsResourceModel) (*TeamProjectsResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	projects, statusCode, listDiags := r.listProjects(ctx, data.Organization.ValueString(), data.Team.ValueString())
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return nil, diags
+	} else if statusCode == http.StatusNotFound {
+		diags.Append(diagutils.NewNotFoundError("team"))
+		return nil, diags
+	}
+
+	current := data
+	diags.Append(current.Fill(ctx, projects)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &current, diags
+}
+
+// sync applies the planned projects to the team, given its current projects.
+func (r *TeamProjectsResource) sync(ctx context.Context, plan TeamProjectsResourceModel, current []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	organization := plan.Organization.ValueString()
+	team := plan.Team.ValueString()
+
+	planProjects := plan.Projects.DiagsGet(ctx, diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Add projects
+	for _, project := range planProjects {
+		if !slices.Contains(current, project) {
+			diags.Append(r.addProject(ctx, organization, team, project)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	// Remove projects
+	for _, project := range current {
+		if !slices.Contains(planProjects, project) {
+			diags.Append(r.removeProject(ctx, organization, team, project)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+func (r *TeamProjectsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamProjectsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := tfutils.MergeDiagnostics(r.readCurrent(ctx, data))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, data, current.Projects.DiagsGet(ctx, resp.Diagnostics))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = current.Id
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
+}
+
+func (r *TeamProjectsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamProjectsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, statusCode, diags := r.listProjects(ctx, data.Organization.ValueString(), data.Team.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if statusCode == http.StatusNotFound {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("team"))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, projects)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
+}
+
+func (r *TeamProjectsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TeamProjectsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The projects may have changed outside of Terraform since the last
+	// refresh, and projects that are no longer ignored are not in the state.
+	current := tfutils.MergeDiagnostics(r.readCurrent(ctx, plan))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, plan, current.Projects.DiagsGet(ctx, resp.Diagnostics))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.Identity())...)
+}
+
+func (r *TeamProjectsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamProjectsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, project := range data.Projects.DiagsGet(ctx, resp.Diagnostics) {
+		resp.Diagnostics.Append(r.removeProject(ctx, data.Organization.ValueString(), data.Team.ValueString(), project)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+func (r *TeamProjectsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState2PartPath("organization", "team")(ctx, req, resp)
+}
+
+func (r *TeamProjectsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization of this resource.",
+				RequiredForImport: true,
+			},
+			"team": identityschema.StringAttribute{
+				Description:       "The slug of the team.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccTeamProjectsResource(t *testing.T) {
+	rn := "sentry_team_projects.test"
+	ownerTeam := acctest.RandomWithPrefix("tf-team")
+	team := acctest.RandomWithPrefix("tf-team")
+	project1 := acctest.RandomWithPrefix("tf-project")
+	project2 := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamProjectsConfig(ownerTeam, team, project1, project2, "sentry_project.test_1.slug"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "organization", acctest.TestOrganization),
+					resource.TestCheckResourceAttrPair(rn, "team", "sentry_team.test", "slug"),
+					resource.TestCheckResourceAttr(rn, "projects.#", "1"),
+					resource.TestCheckTypeSetElemAttr(rn, "projects.*", project1),
+				),
+			},
+			{
+				Config: testAccTeamProjectsConfig(ownerTeam, team, project1, project2, "sentry_project.test_1.slug, sentry_project.test_2.slug"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "projects.#", "2"),
+					resource.TestCheckTypeSetElemAttr(rn, "projects.*", project1),
+					resource.TestCheckTypeSetElemAttr(rn, "projects.*", project2),
+				),
+			},
+			{
+				Config: testAccTeamProjectsConfig(ownerTeam, team, project1, project2, "sentry_project.test_2.slug"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "projects.#", "1"),
+					resource.TestCheckTypeSetElemAttr(rn, "projects.*", project2),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTeamProjectsResource_identity(t *testing.T) {
+	rn := "sentry_team_projects.test"
+	ownerTeam := acctest.RandomWithPrefix("tf-team")
+	team := acctest.RandomWithPrefix("tf-team")
+	project1 := acctest.RandomWithPrefix("tf-project")
+	project2 := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamProjectsConfig(ownerTeam, team, project1, project2, "sentry_project.test_1.slug"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(rn, map[string]knownvalue.Check{
+						"organization": knownvalue.StringExact(acctest.TestOrganization),
+						"team":         knownvalue.StringExact(team),
+					}),
+				},
+			},
+			{
+				ResourceName:    rn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccTeamProjectsResource_ignoreProjects(t *testing.T) {
+	rn := "sentry_team_projects.test"
+	ownerTeam := acctest.RandomWithPrefix("tf-team")
+	team := acctest.RandomWithPrefix("tf-team")
+	project1 := acctest.RandomWithPrefix("tf-project")
+	project2 := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamProjectsConfig_ignoreProjects(ownerTeam, team, project1, project2, "[sentry_project.test_2.slug]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "projects.#", "1"),
+					resource.TestCheckTypeSetElemAttr(rn, "projects.*", project1),
+				),
+			},
+			{
+				// The team is added to the ignored project outside of Terraform.
+				PreConfig: func() {
+					httpResp, err := acctest.SharedApiClient.AddTeamToProjectWithResponse(context.Background(), acctest.TestOrganization, project2, team)
+					if err != nil {
+						t.Fatal(err)
+					} else if httpResp.StatusCode() != http.StatusCreated {
+						t.Fatalf("failed to add team to project: %s", httpResp.Status())
+					}
+				},
+				Config: testAccTeamProjectsConfig_ignoreProjects(ownerTeam, team, project1, project2, "[sentry_project.test_2.slug]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "projects.#", "1"),
+					resource.TestCheckTypeSetElemAttr(rn, "projects.*", project1),
+				),
+			},
+			{
+				// Once the project is no longer ignored, the team is removed from it
+				// in the same apply.
+				Config: testAccTeamProjectsConfig_ignoreProjects(ownerTeam, team, project1, project2, "null"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "projects.#", "1"),
+					resource.TestCheckTypeSetElemAttr(rn, "projects.*", project1),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamProjectsConfig(ownerTeamName, teamName, project1Name, project2Name, projects string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_team" "owner" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[1]s"
+	slug         = "%[1]s"
+}
+
+resource "sentry_team" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[2]s"
+	slug         = "%[2]s"
+}
+
+resource "sentry_project" "test_1" {
+	organization        = data.sentry_organization.test.slug
+	teams               = [sentry_team.owner.slug]
+	ignore_team_changes = true
+	name                = "%[3]s"
+	slug                = "%[3]s"
+	platform            = "go"
+}
+
+resource "sentry_project" "test_2" {
+	organization        = data.sentry_organization.test.slug
+	teams               = [sentry_team.owner.slug]
+	ignore_team_changes = true
+	name                = "%[4]s"
+	slug                = "%[4]s"
+	platform            = "go"
+}
+
+resource "sentry_team_projects" "test" {
+	organization = data.sentry_organization.test.slug
+	team         = sentry_team.test.slug
+	projects     = [%[5]s]
+}
+`, ownerTeamName, teamName, project1Name, project2Name, projects)
+}
+
+func testAccTeamProjectsConfig_ignoreProjects(ownerTeamName, teamName, project1Name, project2Name, ignoreProjects string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_team" "owner" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[1]s"
+	slug         = "%[1]s"
+}
+
+resource "sentry_team" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[2]s"
+	slug         = "%[2]s"
+}
+
+resource "sentry_project" "test_1" {
+	organization        = data.sentry_organization.test.slug
+	teams               = [sentry_team.owner.slug]
+	ignore_team_changes = true
+	name                = "%[3]s"
+	slug                = "%[3]s"
+	platform            = "go"
+}
+
+resource "sentry_project" "test_2" {
+	organization        = data.sentry_organization.test.slug
+	teams               = [sentry_team.owner.slug]
+	ignore_team_changes = true
+	name                = "%[4]s"
+	slug                = "%[4]s"
+	platform            = "go"
+}
+
+resource "sentry_team_projects" "test" {
+	organization    = data.sentry_organization.test.slug
+	team            = sentry_team.test.slug
+	projects        = [sentry_project.test_1.slug]
+	ignore_projects = %[5]s
+}
+`, ownerTeamName, teamName, project1Name, project2Name, ignoreProjects)
+}
@@ -7,14 +7,19 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
@@ -27,6 +32,7 @@ import (
 
 var _ resource.Resource = &UptimeMonitorResource{}
 var _ resource.ResourceWithImportState = &UptimeMonitorResource{}
+var _ resource.ResourceWithIdentity = &UptimeMonitorResource{}
 
 func NewUptimeMonitorResource() resource.Resource {
 	return &UptimeMonitorResource{}
@@ -53,18 +59,22 @@ func (r *UptimeMonitorResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"organization": schema.StringAttribute{
-				MarkdownDescription: "The organization slug or internal ID to create the monitor for.",
-				Required:            true,
+				MarkdownDescription: "The organization slug or internal ID to create the monitor for. Defaults to the `default_organization` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"project": schema.StringAttribute{
-				MarkdownDescription: "The project slug or internal ID to create the monitor for.",
-				Required:            true,
+				MarkdownDescription: "The project slug or internal ID to create the monitor for. Defaults to the `default_project` provider attribute.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.StringType{},
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -149,6 +159,47 @@ func (r *UptimeMonitorResource) Schema(ctx context.Context, req resource.SchemaR
 				Required:            true,
 				CustomType:          supertypes.Int64Type{},
 			},
+			"trace_sampling": schema.BoolAttribute{
+				MarkdownDescription: "Whether to sample traces of the uptime check requests, which links them to the traces of the monitored service. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				CustomType:          supertypes.BoolType{},
+			},
+			"follow_redirects": schema.BoolAttribute{
+				MarkdownDescription: "Whether to follow HTTP redirects. When `false`, a redirect response is checked as the final response.",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          supertypes.BoolType{},
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"regions": schema.SetAttribute{
+				MarkdownDescription: "The regions to run the uptime checks from. The regions take turns checking the URL. Defaults to the regions chosen by Sentry.",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(sentrydata.UptimeRegions...)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region_mode": tfutils.WithEnumStringAttribute(
+				schema.StringAttribute{
+					MarkdownDescription: "How the checks from `regions` are used. `active` checks create issues, `shadow` checks are recorded without creating issues, and `inactive` regions do not run checks.",
+					Optional:            true,
+					Computed:            true,
+					CustomType:          supertypes.StringType{},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				sentrydata.UptimeRegionModes,
+			),
 			"environment": schema.StringAttribute{
 				MarkdownDescription: "Name of the environment to create uptime issues in.",
 				Required:            true,
@@ -162,12 +213,22 @@ func (r *UptimeMonitorResource) Schema(ctx context.Context, req resource.SchemaR
 				CustomType:          supertypes.Int64Type{},
 			},
 			"downtime_threshold": schema.Int64Attribute{
-				MarkdownDescription: "Number of consecutive failed checks required to mark monitor as down. Defaults to `3`.",
+				MarkdownDescription: "Number of consecutive failed checks required to mark monitor as down. Must be at least the number of `regions`. Defaults to `3`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(3),
 				CustomType:          supertypes.Int64Type{},
 			},
+			"mode": tfutils.WithEnumStringAttribute(
+				schema.StringAttribute{
+					MarkdownDescription: "The mode of the monitor. Monitors created by users are `MANUAL`, while `AUTO_DETECTED_ONBOARDING` and `AUTO_DETECTED_ACTIVE` are used by monitors that Sentry detected automatically. Defaults to `MANUAL`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString("MANUAL"),
+					CustomType:          supertypes.StringType{},
+				},
+				sentrydata.UptimeMonitorModes,
+			),
 			"assertion_json": schema.StringAttribute{
 				MarkdownDescription: "Define conditions that must be met for the check to be considered successful.",
 				Optional:            true,
@@ -212,6 +273,7 @@ func (r *UptimeMonitorResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *UptimeMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -250,6 +312,7 @@ func (r *UptimeMonitorResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *UptimeMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -284,6 +347,7 @@ func (r *UptimeMonitorResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *UptimeMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -314,6 +378,35 @@ func (r *UptimeMonitorResource) ImportState(ctx context.Context, req resource.Im
 	)(ctx, req, resp)
 }
 
+func (r *UptimeMonitorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				Description:       "The organization slug or internal ID to create the monitor for.",
+				RequiredForImport: true,
+				CustomType:        supertypes.StringType{},
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The internal ID of this monitor.",
+				RequiredForImport: true,
+				CustomType:        supertypes.StringType{},
+			},
+		},
+	}
+}
+
+type UptimeMonitorResourceIdentityModel struct {
+	Organization supertypes.StringValue `tfsdk:"organization"`
+	Id           supertypes.StringValue `tfsdk:"id"`
+}
+
+func (m UptimeMonitorResourceModel) Identity() UptimeMonitorResourceIdentityModel {
+	return UptimeMonitorResourceIdentityModel{
+		Organization: m.Organization,
+		Id:           m.Id,
+	}
+}
+
 type UptimeMonitorResourceModel struct {
 	Id                supertypes.StringValue                                                `tfsdk:"id"`
 	Organization      supertypes.StringValue                                                `tfsdk:"organization"`
@@ -328,9 +421,14 @@ type UptimeMonitorResourceModel struct {
 	Headers           supertypes.MapValueOf[string]                                         `tfsdk:"headers"`
 	IntervalSeconds   supertypes.Int64Value                                                 `tfsdk:"interval_seconds"`
 	TimeoutMs         supertypes.Int64Value                                                 `tfsdk:"timeout_ms"`
+	TraceSampling     supertypes.BoolValue                                                  `tfsdk:"trace_sampling"`
+	FollowRedirects   supertypes.BoolValue                                                  `tfsdk:"follow_redirects"`
+	Regions           supertypes.SetValueOf[string]                                         `tfsdk:"regions"`
+	RegionMode        supertypes.StringValue                                                `tfsdk:"region_mode"`
 	Environment       supertypes.StringValue                                                `tfsdk:"environment"`
 	RecoveryThreshold supertypes.Int64Value                                                 `tfsdk:"recovery_threshold"`
 	DowntimeThreshold supertypes.Int64Value                                                 `tfsdk:"downtime_threshold"`
+	Mode              supertypes.StringValue                                                `tfsdk:"mode"`
 	AssertionJson     jsontypes.Normalized                                                  `tfsdk:"assertion_json"`
 }
 
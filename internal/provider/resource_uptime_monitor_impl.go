@@ -4,15 +4,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
 	"github.com/jianyuan/terraform-provider-sentry/internal/sentrytypes"
 	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
 )
 
+var _ resource.ResourceWithValidateConfig = &UptimeMonitorResource{}
+
 func (r *UptimeMonitorResource) getCreateJSONRequestBody(ctx context.Context, data UptimeMonitorResourceModel) (*apiclient.CreateProjectMonitorJSONRequestBody, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -22,6 +27,21 @@ func (r *UptimeMonitorResource) getCreateJSONRequestBody(ctx context.Context, da
 		Headers:         [][]string{},
 		IntervalSeconds: data.IntervalSeconds.Get(),
 		TimeoutMs:       data.TimeoutMs.Get(),
+		TraceSampling:   data.TraceSampling.Get(),
+	}
+	if data.FollowRedirects.IsKnown() {
+		outDs.FollowRedirects = new(data.FollowRedirects.Get())
+	}
+	if data.Regions.IsKnown() {
+		inRegions := tfutils.MergeDiagnostics(data.Regions.Get(ctx))(&diags)
+		if diags.HasError() {
+			return nil, diags
+		}
+		slices.Sort(inRegions)
+		outDs.Regions = &inRegions
+	}
+	if data.RegionMode.IsKnown() {
+		outDs.RegionMode = new(data.RegionMode.Get())
 	}
 	if !data.Body.IsNull() && !data.Body.IsUnknown() {
 		outDs.Body.Set(data.Body.ValueString())
@@ -45,7 +65,7 @@ func (r *UptimeMonitorResource) getCreateJSONRequestBody(ctx context.Context, da
 
 	var outConfig apiclient.ProjectMonitorConfig
 	if err := outConfig.FromProjectMonitorConfigUptimeDomainFailure(apiclient.ProjectMonitorConfigUptimeDomainFailure{
-		Mode:              apiclient.ProjectMonitorConfigUptimeDomainFailureMode(sentrydata.UptimeMonitorModeNameToId["MANUAL"]),
+		Mode:              apiclient.ProjectMonitorConfigUptimeDomainFailureMode(sentrydata.UptimeMonitorModeNameToId[data.Mode.Get()]),
 		Environment:       data.Environment.Get(),
 		RecoveryThreshold: data.RecoveryThreshold.Get(),
 		DowntimeThreshold: data.DowntimeThreshold.Get(),
@@ -100,6 +120,34 @@ func (r *UptimeMonitorResource) getCreateJSONRequestBody(ctx context.Context, da
 	return &req, nil
 }
 
+func (r *UptimeMonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UptimeMonitorResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Regions.IsKnown() || !data.DowntimeThreshold.IsKnown() {
+		return
+	}
+
+	regions := tfutils.MergeDiagnostics(data.Regions.Get(ctx))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The regions take turns running the checks, so a lower threshold would
+	// mark the monitor as down before every region has confirmed the failure.
+	if data.DowntimeThreshold.Get() < int64(len(regions)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("downtime_threshold"),
+			"Invalid downtime threshold",
+			fmt.Sprintf("The downtime threshold (%d) must be at least the number of regions (%d).", data.DowntimeThreshold.Get(), len(regions)),
+		)
+	}
+}
+
 func (r *UptimeMonitorResource) getUpdateJSONRequestBody(ctx context.Context, data UptimeMonitorResourceModel) (*apiclient.UpdateProjectMonitorJSONRequestBody, diag.Diagnostics) {
 	return r.getCreateJSONRequestBody(ctx, data)
 }
@@ -174,11 +222,36 @@ func (m *UptimeMonitorResourceModel) Fill(ctx context.Context, data apiclient.Pr
 
 	m.IntervalSeconds.Set(dataSource.QueryObj.IntervalSeconds)
 	m.TimeoutMs.Set(dataSource.QueryObj.TimeoutMs)
+	m.TraceSampling.Set(dataSource.QueryObj.TraceSampling)
+
+	// Keep the planned values if Sentry does not return the following fields.
+	if v := dataSource.QueryObj.FollowRedirects; v != nil {
+		m.FollowRedirects.Set(*v)
+	} else if m.FollowRedirects.IsUnknown() {
+		m.FollowRedirects.SetNull()
+	}
+	if v := dataSource.QueryObj.Regions; v != nil {
+		diags.Append(m.Regions.Set(ctx, *v)...)
+	} else if m.Regions.IsUnknown() {
+		m.Regions.SetNull(ctx)
+	}
+	if v := dataSource.QueryObj.RegionMode; v != nil {
+		m.RegionMode.Set(*v)
+	} else if m.RegionMode.IsUnknown() {
+		m.RegionMode.SetNull()
+	}
 
 	if config, err := data.Config.AsProjectMonitorConfigUptimeDomainFailure(); err == nil {
 		m.Environment.Set(config.Environment)
 		m.RecoveryThreshold.Set(config.RecoveryThreshold)
 		m.DowntimeThreshold.Set(config.DowntimeThreshold)
+
+		if mode, ok := sentrydata.UptimeMonitorModeIdToName[int64(config.Mode)]; ok {
+			m.Mode.Set(mode)
+		} else {
+			diags.AddError("Invalid config", fmt.Sprintf("Unknown mode %d", config.Mode))
+			return
+		}
 	} else {
 		diags.AddError("Invalid config", err.Error())
 		return
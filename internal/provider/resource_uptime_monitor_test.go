@@ -81,6 +81,73 @@ func TestAccUptimeMonitorResource_validation(t *testing.T) {
 					`If method attribute is set and the value is one of "GET", "HEAD", "OPTIONS" this attribute is NULL`,
 				),
 			},
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_uptime_monitor" "test" {
+						organization = "1"
+						project      = "2"
+						name         = "uptime monitor name"
+
+						url = "https://sentry.io"
+						method = "GET"
+						interval_seconds = 60
+						timeout_ms = 5000
+
+						environment = "production"
+
+						regions = ["mars-north-1"]
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(
+					`Attribute regions[Value("mars-north-1")] value must be one of`,
+				),
+			},
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_uptime_monitor" "test" {
+						organization = "1"
+						project      = "2"
+						name         = "uptime monitor name"
+
+						url = "https://sentry.io"
+						method = "GET"
+						interval_seconds = 60
+						timeout_ms = 5000
+
+						environment = "production"
+
+						regions = ["us-east-1", "us-west-1", "eu-central-1"]
+						downtime_threshold = 2
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(
+					"The downtime threshold (2) must be at least the number of regions (3).",
+				),
+			},
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_uptime_monitor" "test" {
+						organization = "1"
+						project      = "2"
+						name         = "uptime monitor name"
+
+						url = "https://sentry.io"
+						method = "GET"
+						interval_seconds = 60
+						timeout_ms = 5000
+
+						environment = "production"
+
+						mode = "AUTOMATIC"
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(
+					"Attribute mode value must be one of",
+				),
+			},
 		},
 	})
 }
@@ -125,6 +192,8 @@ func TestAccUptimeMonitorResource_basic(t *testing.T) {
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("headers"), knownvalue.MapSizeExact(0)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("interval_seconds"), knownvalue.Int64Exact(60)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("timeout_ms"), knownvalue.Int64Exact(5000)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("trace_sampling"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("mode"), knownvalue.StringExact("MANUAL")),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environment"), knownvalue.StringExact("production")),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("assertion_json"), knownvalue.Null()),
 				),
@@ -143,6 +212,10 @@ func TestAccUptimeMonitorResource_basic(t *testing.T) {
 					}
 					interval_seconds = 300
 					timeout_ms = 10000
+					trace_sampling = true
+					follow_redirects = false
+					regions = ["us-east-1", "eu-central-1"]
+					region_mode = "active"
 					
 					environment = "production"
 
@@ -165,6 +238,13 @@ func TestAccUptimeMonitorResource_basic(t *testing.T) {
 					})),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("interval_seconds"), knownvalue.Int64Exact(300)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("timeout_ms"), knownvalue.Int64Exact(10000)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("trace_sampling"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("follow_redirects"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("regions"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("us-east-1"),
+						knownvalue.StringExact("eu-central-1"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("region_mode"), knownvalue.StringExact("active")),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environment"), knownvalue.StringExact("production")),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("assertion_json"), knownvalue.NotNull()),
 				),
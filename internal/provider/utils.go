@@ -4,6 +4,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/oapi-codegen/nullable"
 )
 
@@ -19,8 +20,9 @@ func ResourceIdAttribute() schema.Attribute {
 
 func ResourceOrganizationAttribute() schema.Attribute {
 	return schema.StringAttribute{
-		MarkdownDescription: "The organization of this resource.",
-		Required:            true,
+		MarkdownDescription: "The organization of this resource. Defaults to the `default_organization` provider attribute.",
+		Optional:            true,
+		Computed:            true,
 		PlanModifiers: []planmodifier.String{
 			stringplanmodifier.UseStateForUnknown(),
 		},
@@ -29,8 +31,9 @@ func ResourceOrganizationAttribute() schema.Attribute {
 
 func ResourceProjectAttribute() schema.Attribute {
 	return schema.StringAttribute{
-		MarkdownDescription: "The project of this resource.",
-		Required:            true,
+		MarkdownDescription: "The project of this resource. Defaults to the `default_project` provider attribute.",
+		Optional:            true,
+		Computed:            true,
 		PlanModifiers: []planmodifier.String{
 			stringplanmodifier.UseStateForUnknown(),
 		},
@@ -39,15 +42,17 @@ func ResourceProjectAttribute() schema.Attribute {
 
 func DataSourceOrganizationAttribute() schema.Attribute {
 	return schema.StringAttribute{
-		MarkdownDescription: "The organization the resource belongs to.",
-		Required:            true,
+		MarkdownDescription: "The organization the resource belongs to. Defaults to the `default_organization` provider attribute.",
+		Optional:            true,
+		Computed:            true,
 	}
 }
 
 func DataSourceProjectAttribute() schema.Attribute {
 	return schema.StringAttribute{
-		MarkdownDescription: "The project the resource belongs to.",
-		Required:            true,
+		MarkdownDescription: "The project the resource belongs to. Defaults to the `default_project` provider attribute.",
+		Optional:            true,
+		Computed:            true,
 	}
 }
 
@@ -57,3 +62,12 @@ func nullableFromPtr[T any](v *T) nullable.Nullable[T] {
 	}
 	return nullable.NewNullableWithValue(*v)
 }
+
+// writeOnlyStringPointer returns the value of a Sensitive attribute, falling
+// back to its write-only counterpart read from the configuration.
+func writeOnlyStringPointer(value, writeOnly types.String) *string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueStringPointer()
+	}
+	return writeOnly.ValueStringPointer()
+}
@@ -0,0 +1,55 @@
+package providerdata
+
+import (
+	"fmt"
+	"os"
+)
+
+// Defaults are the attribute values that resources and data sources fall back
+// to when they are not configured.
+type Defaults struct {
+	Organization Default
+	Project      Default
+}
+
+// Default is the value of an attribute configured on the provider.
+type Default struct {
+	// Value is empty when no default is configured.
+	Value string
+	// Source describes where the value came from.
+	Source string
+
+	// ProviderAttribute and EnvVar are the ways to configure the default.
+	ProviderAttribute string
+	EnvVar            string
+}
+
+// NewDefault returns the default configured by the provider attribute, falling
+// back to the environment variable.
+func NewDefault(value string, providerAttribute string, envVar string) Default {
+	d := Default{
+		ProviderAttribute: providerAttribute,
+		EnvVar:            envVar,
+	}
+	if value != "" {
+		d.Value = value
+		d.Source = fmt.Sprintf("the %q provider attribute", providerAttribute)
+	} else if v := os.Getenv(envVar); v != "" {
+		d.Value = v
+		d.Source = fmt.Sprintf("the %s environment variable", envVar)
+	}
+	return d
+}
+
+// NewDefaults returns the organization and project defaults.
+func NewDefaults(organization string, project string) Defaults {
+	return Defaults{
+		Organization: NewDefault(organization, "default_organization", "SENTRY_ORGANIZATION"),
+		Project:      NewDefault(project, "default_project", "SENTRY_PROJECT"),
+	}
+}
+
+// Missing describes how to configure the attribute when it has no value.
+func (d Default) Missing(attribute string) string {
+	return fmt.Sprintf("The %q attribute must be set, or a default configured with the %q provider attribute or the %s environment variable.", attribute, d.ProviderAttribute, d.EnvVar)
+}
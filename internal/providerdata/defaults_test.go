@@ -0,0 +1,28 @@
+package providerdata
+
+import "testing"
+
+func TestNewDefault(t *testing.T) {
+	t.Setenv("SENTRY_ORGANIZATION", "from-env")
+
+	testCases := []struct {
+		name       string
+		value      string
+		envVar     string
+		wantValue  string
+		wantSource string
+	}{
+		{"provider attribute", "from-provider", "SENTRY_ORGANIZATION", "from-provider", `the "default_organization" provider attribute`},
+		{"environment variable", "", "SENTRY_ORGANIZATION", "from-env", "the SENTRY_ORGANIZATION environment variable"},
+		{"unset", "", "SENTRY_UNSET_TEST_VARIABLE", "", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewDefault(tc.value, "default_organization", tc.envVar)
+			if got.Value != tc.wantValue || got.Source != tc.wantSource {
+				t.Errorf("got %q from %q, want %q from %q", got.Value, got.Source, tc.wantValue, tc.wantSource)
+			}
+		})
+	}
+}
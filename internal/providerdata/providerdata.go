@@ -8,4 +8,22 @@ import (
 type ProviderData struct {
 	Client    *sentry.Client
 	ApiClient *apiclient.ClientWithResponses
+	Defaults  Defaults
+}
+
+// ConnectionAttributes are the provider attributes that connecting to Sentry
+// depends on. The provider configuration is deferred while any of them is
+// unknown, but not for the other attributes.
+var ConnectionAttributes = []string{
+	"token",
+	"token_file",
+	"oauth",
+	"exec",
+	"base_url",
+	"ca_cert_file",
+	"ca_cert_pem",
+	"insecure_skip_verify",
+	"proxy_url",
+	"client_cert",
+	"client_key",
 }
@@ -17,8 +17,9 @@ export default {
     {
       name: "organization",
       type: "string",
-      description: "The organization slug or internal ID to list projects for.",
-      computedOptionalRequired: "required",
+      description:
+        "The organization slug or internal ID to list projects for. Defaults to the `default_organization` provider attribute.",
+      computedOptionalRequired: "computed_optional",
       skipFill: true,
     },
     {
@@ -0,0 +1,100 @@
+import dedent from "dedent";
+import type { DataSource } from "../schema";
+
+export default {
+  name: "monitors",
+  description: dedent.withOptions({ trimWhitespace: true })`
+      List the Monitors of an organization, optionally filtered by project, type and a search query.
+    `,
+  api: {
+    model: "ProjectMonitor",
+    readStrategy: "custom",
+  },
+  generate: {
+    modelFillers: false,
+  },
+  attributes: [
+    {
+      name: "organization",
+      type: "string",
+      description:
+        "The organization slug or internal ID to list monitors for. Defaults to the `default_organization` provider attribute.",
+      computedOptionalRequired: "computed_optional",
+    },
+    {
+      name: "project",
+      type: "string",
+      description:
+        "The project slug or internal ID to limit the results to.",
+      computedOptionalRequired: "optional",
+    },
+    {
+      name: "type",
+      type: "string",
+      description:
+        "The monitor type to limit the results to, e.g. `error`, `metric_issue`, `monitor_check_in_failure` or `uptime_domain_failure`.",
+      computedOptionalRequired: "optional",
+    },
+    {
+      name: "query",
+      type: "string",
+      description:
+        "An additional Sentry search query to filter the monitors by, e.g. `name:checkout`.",
+      computedOptionalRequired: "optional",
+    },
+    {
+      name: "monitors",
+      type: "list_nested",
+      description: "The monitors found.",
+      computedOptionalRequired: "computed",
+      attributes: [
+        {
+          name: "id",
+          type: "string",
+          description: "The internal ID of the monitor.",
+          computedOptionalRequired: "computed",
+        },
+        {
+          name: "project_id",
+          type: "string",
+          description: "The internal ID of the project of the monitor.",
+          computedOptionalRequired: "computed",
+        },
+        {
+          name: "type",
+          type: "string",
+          description: "The type of the monitor.",
+          computedOptionalRequired: "computed",
+        },
+        {
+          name: "name",
+          type: "string",
+          description: "The name of the monitor.",
+          computedOptionalRequired: "computed",
+        },
+        {
+          name: "description",
+          type: "string",
+          description: "The description of the monitor.",
+          computedOptionalRequired: "computed",
+        },
+        {
+          name: "enabled",
+          type: "bool",
+          description: "Whether the monitor is enabled.",
+          computedOptionalRequired: "computed",
+        },
+        {
+          name: "config_json",
+          type: "string",
+          customType: {
+            type: "jsontypes.NormalizedType{}",
+            value: "jsontypes.Normalized",
+          },
+          description: "The configuration of the monitor, as a JSON object.",
+          computedOptionalRequired: "computed",
+        },
+      ],
+    },
+  ],
+} satisfies DataSource;
@@ -16,8 +16,9 @@ export default {
     {
       name: "organization",
       type: "string",
-      description: "The organization slug.",
-      computedOptionalRequired: "required",
+      description:
+        "The organization slug. Defaults to the `default_organization` provider attribute.",
+      computedOptionalRequired: "computed_optional",
       sourceAttribute: ["Organization", "Slug"],
     },
     {
@@ -19,15 +19,17 @@ export default {
     {
       name: "organization",
       type: "string",
-      description: "The organization slug or internal ID of the monitor.",
-      computedOptionalRequired: "required",
+      description:
+        "The organization slug or internal ID of the monitor. Defaults to the `default_organization` provider attribute.",
+      computedOptionalRequired: "computed_optional",
       skipFill: true,
     },
     {
       name: "project",
       type: "string",
-      description: "The project slug or internal ID of the monitor.",
-      computedOptionalRequired: "required",
+      description:
+        "The project slug or internal ID of the monitor. Defaults to the `default_project` provider attribute.",
+      computedOptionalRequired: "computed_optional",
       skipFill: true,
     },
     {
@@ -16,8 +16,9 @@ export default {
     {
       name: "organization",
       type: "string",
-      description: "The organization slug or internal ID of the organization.",
-      computedOptionalRequired: "required",
+      description:
+        "The organization slug or internal ID of the organization. Defaults to the `default_organization` provider attribute.",
+      computedOptionalRequired: "computed_optional",
     },
     {
       name: "slug",
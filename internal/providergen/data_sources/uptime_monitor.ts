@@ -17,8 +17,9 @@ export default {
     {
       name: "organization",
       type: "string",
-      description: "The organization slug or internal ID of the monitor.",
-      computedOptionalRequired: "required",
+      description:
+        "The organization slug or internal ID of the monitor. Defaults to the `default_organization` provider attribute.",
+      computedOptionalRequired: "computed_optional",
       skipFill: true,
     },
     {
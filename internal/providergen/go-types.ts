@@ -174,10 +174,10 @@ export function tfPlanModifierType({ type }: { type: Attribute["type"] }) {
     .with("float64", () => "planmodifier.Float64")
     .with("bool", () => "planmodifier.Bool")
     .with("list", () => "planmodifier.List")
-    .with("list_nested", () => "planmodifier.ListNested")
+    .with("list_nested", () => "planmodifier.List")
     .with("set", () => "planmodifier.Set")
-    .with("set_nested", () => "planmodifier.SetNested")
-    .with("single_nested", () => "planmodifier.SingleNested")
+    .with("set_nested", () => "planmodifier.Set")
+    .with("single_nested", () => "planmodifier.Object")
     .with("map", () => "planmodifier.Map")
     .with("object", () => "planmodifier.Object")
     .exhaustive();
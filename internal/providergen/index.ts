@@ -15,6 +15,117 @@ import {
 } from "./go-types";
 import { tfAttributeDescription } from "./tf-utils";
 
+function isBlock(attribute: Attribute) {
+  return (
+    (attribute.type === "list_nested" || attribute.type === "single_nested") &&
+    attribute.block === true
+  );
+}
+
+function generateTerraformAttributesAndBlocks({
+  parent,
+  attributes,
+}: {
+  parent: string;
+  attributes: Array<Attribute>;
+}) {
+  const parts: string[] = [];
+
+  parts.push("Attributes: map[string]schema.Attribute{");
+  for (const attribute of attributes.filter((attribute) => !isBlock(attribute))) {
+    parts.push(
+      `"${attribute.name}": ${generateTerraformAttribute({
+        parent,
+        attribute,
+      })},`,
+    );
+  }
+  parts.push("},");
+
+  const blocks = attributes.filter(isBlock);
+  if (blocks.length > 0) {
+    parts.push("Blocks: map[string]schema.Block{");
+    for (const block of blocks) {
+      parts.push(
+        `"${block.name}": ${generateTerraformBlock({
+          parent,
+          attribute: block,
+        })},`,
+      );
+    }
+    parts.push("},");
+  }
+
+  return parts.join("\n");
+}
+
+function generateTerraformBlock({
+  parent,
+  attribute,
+}: {
+  parent: string;
+  attribute: Attribute;
+}) {
+  if (attribute.type !== "list_nested" && attribute.type !== "single_nested") {
+    throw new Error(`Unsupported block type: ${attribute.type}`);
+  } else if (attribute.computedOptionalRequired !== "optional") {
+    throw new Error(`Block ${attribute.name} must be optional`);
+  }
+
+  const parts: string[] = [];
+
+  parts.push(
+    attribute.type === "list_nested"
+      ? "schema.ListNestedBlock{"
+      : "schema.SingleNestedBlock{",
+  );
+
+  parts.push(
+    `MarkdownDescription: ${JSON.stringify(tfAttributeDescription(attribute))},`,
+  );
+
+  if (attribute.deprecationMessage) {
+    parts.push(
+      `DeprecationMessage: ${JSON.stringify(attribute.deprecationMessage)},`,
+    );
+  }
+
+  parts.push(`CustomType: ${tfAttributeType(attribute, parent)},`);
+
+  if (attribute.validators) {
+    parts.push(`Validators: []${tfValidatorType({ type: attribute.type })}{`);
+    parts.push(...attribute.validators.map((validator) => `${validator},`));
+    parts.push("},");
+  }
+
+  if (attribute.planModifiers) {
+    parts.push(
+      `PlanModifiers: []${tfPlanModifierType({ type: attribute.type })}{`,
+    );
+    parts.push(...attribute.planModifiers.map((modifier) => `${modifier},`));
+    parts.push("},");
+  }
+
+  if (attribute.type === "list_nested") {
+    parts.push("NestedObject: schema.NestedBlockObject{");
+  }
+
+  parts.push(
+    generateTerraformAttributesAndBlocks({
+      parent: modelType(attribute, parent),
+      attributes: attribute.attributes,
+    }),
+  );
+
+  if (attribute.type === "list_nested") {
+    parts.push("},");
+  }
+
+  parts.push("}");
+
+  return parts.join("\n");
+}
+
 function generateTerraformAttribute({
   parent,
   attribute,
@@ -82,6 +193,11 @@ function generateTerraformAttribute({
     attribute.type === "set_nested" ||
     attribute.type === "single_nested"
   ) {
+    if (attribute.attributes.some(isBlock)) {
+      throw new Error(
+        `Nested attribute ${attribute.name} cannot contain blocks`,
+      );
+    }
     parts.push("Attributes: map[string]schema.Attribute{");
     for (const nestedAttribute of attribute.attributes) {
       parts.push(
@@ -456,6 +572,12 @@ function generateDataSource({ dataSource }: { dataSource: DataSource }) {
     )
     .exhaustive();
 
+  const usesProviderDefaults = dataSource.attributes.some(
+    (attribute) =>
+      (attribute.name === "organization" || attribute.name === "project") &&
+      attribute.computedOptionalRequired === "computed_optional",
+  );
+
   return `
 // Code generated by providergen. DO NOT EDIT.
 package provider
@@ -492,7 +614,12 @@ func (d *${dataSourceName}) Schema(ctx context.Context, req datasource.SchemaReq
 
 func (d *${dataSourceName}) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
   var data ${modelName}
-
+${
+  usesProviderDefaults
+    ? `
+  resp.Diagnostics.Append(applyProviderDefaultsToConfig(ctx, d.defaults, &req.Config)...)`
+    : ""
+}
   resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
   if resp.Diagnostics.HasError() {
     return
@@ -522,18 +649,81 @@ function generateResourceSchemaAttributes({
 }: {
   resource: Resource;
 }) {
-  const lines: string[] = [];
+  return generateTerraformAttributesAndBlocks({
+    parent: `${camelize(resource.name)}ResourceModel`,
+    attributes: resource.attributes,
+  });
+}
 
-  for (const attribute of resource.attributes) {
-    lines.push(
-      `"${attribute.name}": ${generateTerraformAttribute({
-        parent: `${camelize(resource.name)}ResourceModel`,
-        attribute,
-      })},`,
+function generateResourceIdentity({ resource }: { resource: Resource }) {
+  const resourceName = `${camelize(resource.name)}Resource`;
+  const modelName = `${camelize(resource.name)}ResourceModel`;
+  const identityModelName = `${camelize(resource.name)}ResourceIdentityModel`;
+
+  const targetAttributes = resource.import?.targetAttributes ?? [];
+  if (targetAttributes.length === 0) {
+    throw new Error(
+      `Resource ${resource.name} requires import target attributes for its identity`,
     );
   }
 
-  return lines.join("\n");
+  const attributes = targetAttributes.map((name) => {
+    const attribute = resource.attributes.find(
+      (attribute) => attribute.name === name,
+    );
+    if (!attribute) {
+      throw new Error(`Attribute ${name} not found in resource ${resource.name}`);
+    } else if (attribute.type !== "string") {
+      throw new Error(
+        `Identity attribute ${name} in resource ${resource.name} must be a string`,
+      );
+    }
+    return attribute;
+  });
+
+  return `
+func (r *${resourceName}) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+  resp.IdentitySchema = identityschema.Schema{
+    Attributes: map[string]identityschema.Attribute{
+      ${attributes
+        .map(
+          (attribute) => `"${attribute.name}": identityschema.StringAttribute{
+            Description: ${JSON.stringify(
+              // Identities are always complete, so provider defaults do not apply.
+              attribute.description.replace(
+                / Defaults to the `default_\w+` provider attribute\./,
+                "",
+              ),
+            )},
+            RequiredForImport: true,
+            CustomType: supertypes.StringType{},
+          },`,
+        )
+        .join("\n")}
+    },
+  }
+}
+
+type ${identityModelName} struct {
+  ${attributes
+    .map(
+      (attribute) =>
+        `${camelize(attribute.name)} supertypes.StringValue \`tfsdk:"${attribute.name}"\``,
+    )
+    .join("\n")}
+}
+
+func (m ${modelName}) Identity() ${identityModelName} {
+  return ${identityModelName}{
+    ${attributes
+      .map(
+        (attribute) =>
+          `${camelize(attribute.name)}: m.${camelize(attribute.name)},`,
+      )
+      .join("\n")}
+  }
+}
+`;
 }
 
 function generateResource({ resource }: { resource: Resource }) {
@@ -541,6 +731,12 @@ function generateResource({ resource }: { resource: Resource }) {
 
   const resourceName = `${camelize(resource.name)}Resource`;
   const modelName = `${camelize(resource.name)}ResourceModel`;
+  const setState = [
+    "resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)",
+    ...(resource.identity
+      ? ["resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)"]
+      : []),
+  ].join("\n");
 
   const createRequestParams = ["ctx"];
   if (resource.api.createRequestAttributes) {
@@ -649,6 +845,7 @@ function generateResource({ resource }: { resource: Resource }) {
 package provider
 
 import (
+  "github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
   "github.com/hashicorp/terraform-plugin-framework/resource/schema"
   supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
   fint64validator "github.com/orange-cloudavenue/terraform-plugin-framework-validators/int64validator"
@@ -656,12 +853,18 @@ import (
   intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
 )
 
-var _ resource.Resource = &${resourceName}{}
-${
-  resource.import
-    ? `var _ resource.ResourceWithImportState = &${resourceName}{}`
-    : ""
-}
+${[
+  `var _ resource.Resource = &${resourceName}{}`,
+  ...(resource.import
+    ? [`var _ resource.ResourceWithImportState = &${resourceName}{}`]
+    : []),
+  ...(resource.version !== undefined
+    ? [`var _ resource.ResourceWithUpgradeState = &${resourceName}{}`]
+    : []),
+  ...(resource.identity
+    ? [`var _ resource.ResourceWithIdentity = &${resourceName}{}`]
+    : []),
+].join("\n")}
 
 func New${resourceName}() resource.Resource {
   return &${resourceName}{}
@@ -672,15 +875,19 @@ type ${resourceName} struct {
 }
 
 func (r *${resourceName}) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-  resp.TypeName = req.ProviderTypeName + "_${resource.name}"
+  resp.TypeName = req.ProviderTypeName + "_${resource.name}"${
+    resource.mutableIdentity
+      ? "\n  resp.ResourceBehavior.MutableIdentity = true"
+      : ""
+  }
 }
 
 func (r *${resourceName}) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
   resp.Schema = schema.Schema{
-    MarkdownDescription: ${JSON.stringify(resource.description)},
-    Attributes: map[string]schema.Attribute{
-      ${generateResourceSchemaAttributes({ resource })}
-    },
+    MarkdownDescription: ${JSON.stringify(resource.description)},${
+      resource.version !== undefined ? `\n    Version: ${resource.version},` : ""
+    }
+    ${generateResourceSchemaAttributes({ resource })}
   }
 }
 
@@ -763,7 +970,7 @@ func (r *${resourceName}) Create(ctx context.Context, req resource.CreateRequest
         `
   }
 
-  resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+  ${setState}
 }
 
 func (r *${resourceName}) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -783,7 +990,7 @@ func (r *${resourceName}) Read(ctx context.Context, req resource.ReadRequest, re
           return
         }
 
-        resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+        ${setState}
         return
       `,
     )
@@ -880,7 +1087,18 @@ func (r *${resourceName}) Read(ctx context.Context, req resource.ReadRequest, re
           return
         }
 
-        resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+        ${
+          resource.api.afterRead
+            ? dedent`
+              resp.Diagnostics.Append(r.afterRead(ctx, &data, *responseData)...)
+              if resp.Diagnostics.HasError() {
+                return
+              }
+            `
+            : ""
+        }
+
+        ${setState}
       `,
     )}
 }
@@ -921,7 +1139,7 @@ func (r *${resourceName}) Update(ctx context.Context, req resource.UpdateRequest
         return
       }
 
-      resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+      ${setState}
       `.trim()
       : dedent`
       resp.Diagnostics.AddError("Not Supported", "Update is not supported for this resource")
@@ -1034,6 +1252,8 @@ ${match(resource.import)
   )
   .otherwise(() => "")}
 
+${resource.identity ? generateResourceIdentity({ resource }) : ""}
+
 ${generateResourceModel({ resource })}
 `;
 }
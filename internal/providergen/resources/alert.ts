@@ -29,6 +29,7 @@ export default {
     url: "https://{organization}.sentry.io/monitors/alerts/{id}/",
     targetAttributes: ["organization", "id"],
   },
+  identity: true,
   attributes: [
     {
       name: "id",
@@ -42,9 +43,12 @@ export default {
       name: "organization",
       type: "string",
       description:
-        "The organization slug or internal ID to create the alert for.",
-      computedOptionalRequired: "required",
-      planModifiers: ["stringplanmodifier.RequiresReplace()"],
+        "The organization slug or internal ID to create the alert for. Defaults to the `default_organization` provider attribute.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: [
+        "stringplanmodifier.UseStateForUnknown()",
+        "stringplanmodifier.RequiresReplace()",
+      ],
     },
     {
       name: "enabled",
@@ -21,6 +21,7 @@ export default {
     url: "https://{organization}.sentry.io/monitors/{id}/",
     targetAttributes: ["organization", "id"],
   },
+  identity: true,
   attributes: [
     {
       name: "id",
@@ -34,16 +35,23 @@ export default {
       name: "organization",
       type: "string",
       description:
-        "The organization slug or internal ID to create the monitor for.",
-      computedOptionalRequired: "required",
-      planModifiers: ["stringplanmodifier.RequiresReplace()"],
+        "The organization slug or internal ID to create the monitor for. Defaults to the `default_organization` provider attribute.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: [
+        "stringplanmodifier.UseStateForUnknown()",
+        "stringplanmodifier.RequiresReplace()",
+      ],
     },
     {
       name: "project",
       type: "string",
-      description: "The project slug or internal ID to create the monitor for.",
-      computedOptionalRequired: "required",
-      planModifiers: ["stringplanmodifier.RequiresReplace()"],
+      description:
+        "The project slug or internal ID to create the monitor for. Defaults to the `default_project` provider attribute.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: [
+        "stringplanmodifier.UseStateForUnknown()",
+        "stringplanmodifier.RequiresReplace()",
+      ],
     },
     {
       name: "enabled",
@@ -134,9 +142,10 @@ export default {
           name: "crontab",
           type: "string",
           description:
-            "Use the crontab syntax (e.g. `0 0 * * *`). Conflicts with `interval_value` and `interval_unit`.",
+            "Use the crontab syntax (e.g. `0 0 * * *`), including ranges, steps, lists and macros such as `@daily`. Conflicts with `interval_value` and `interval_unit`.",
           computedOptionalRequired: "optional",
           validators: [
+            "crontab.Validator()",
             `stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("interval_value"), path.MatchRelative().AtParent().AtName("interval_unit"))`,
             `stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("interval_value"))`,
             `stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("interval_unit"))`,
@@ -175,5 +184,16 @@ export default {
       default: `stringdefault.StaticString("UTC")`,
       enum: "sentrydata.Timezones",
     },
+    {
+      name: "next_runs",
+      type: "list",
+      description:
+        "The next five times the monitor expects a check-in, in RFC 3339 format and in the monitor's `timezone`. Only set for `crontab` schedules. The times are computed when the schedule or timezone changes and are not updated as they pass, so use `provider::sentry::cron_next(schedule, timezone, plantimestamp(), 5)` for the upcoming times as of a plan.",
+      computedOptionalRequired: "computed",
+      elementType: "string",
+      planModifiers: [
+        "cronMonitorNextRunsUseStateUnlessScheduleChangesModifier{}",
+      ],
+    },
   ],
 } satisfies Resource;
@@ -0,0 +1,264 @@
+import dedent from "dedent";
+import type { Resource } from "../schema";
+
+export default {
+  name: "dashboard",
+  description: dedent.withOptions({ trimWhitespace: true })`
+      Create a custom Dashboard for an Organization.
+
+      Widgets are placed on a grid that is 6 columns wide. Each widget's \`layout\` is validated against the grid, and the widget's queries are validated against its \`display_type\` during \`terraform validate\`.
+    `,
+  version: 1,
+  api: {
+    model: "OrganizationDashboard",
+    createMethod: "CreateOrganizationDashboard",
+    createRequestAttributes: ["organization"],
+    readMethod: "GetOrganizationDashboard",
+    readRequestAttributes: ["organization", "id"],
+    updateMethod: "UpdateOrganizationDashboard",
+    updateRequestAttributes: ["organization", "id"],
+    deleteMethod: "DeleteOrganizationDashboard",
+    deleteRequestAttributes: ["organization", "id"],
+  },
+  generate: {
+    modelFillers: false,
+  },
+  import: {
+    url: "https://{organization}.sentry.io/dashboard/{id}/",
+    targetAttributes: ["organization", "id"],
+  },
+  identity: true,
+  attributes: [
+    {
+      name: "id",
+      type: "string",
+      description: "The internal ID of this dashboard.",
+      computedOptionalRequired: "computed",
+      planModifiers: ["stringplanmodifier.UseStateForUnknown()"],
+    },
+    {
+      name: "organization",
+      type: "string",
+      description:
+        "The organization slug or internal ID to create the dashboard for. Defaults to the `default_organization` provider attribute.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: [
+        "stringplanmodifier.UseStateForUnknown()",
+        "stringplanmodifier.RequiresReplace()",
+      ],
+    },
+    {
+      name: "title",
+      type: "string",
+      description: "The title of this dashboard.",
+      computedOptionalRequired: "required",
+    },
+    {
+      name: "projects",
+      type: "set",
+      elementType: "string",
+      description:
+        "The internal IDs of the projects to filter the dashboard by. Use `-1` to select all projects. Omit to use the default project selection.",
+      computedOptionalRequired: "computed_optional",
+    },
+    {
+      name: "environments",
+      type: "set",
+      elementType: "string",
+      description:
+        "The environments to filter the dashboard by. Omit to select all environments.",
+      computedOptionalRequired: "computed_optional",
+    },
+    {
+      name: "period",
+      type: "string",
+      description:
+        "The relative time period to filter the dashboard by, for example `24h` or `14d`. Omit to use the default period.",
+      computedOptionalRequired: "optional",
+      validators: [
+        `stringvalidator.RegexMatches(regexp.MustCompile("^[1-9][0-9]*[smhdw]$"), "must be a relative time period such as 24h or 14d")`,
+      ],
+    },
+    {
+      name: "widget",
+      type: "list_nested",
+      description: "The widgets to display on this dashboard.",
+      computedOptionalRequired: "optional",
+      block: true,
+      attributes: [
+        {
+          name: "id",
+          type: "string",
+          description: "The internal ID of this widget.",
+          computedOptionalRequired: "computed",
+          planModifiers: ["stringplanmodifier.UseStateForUnknown()"],
+        },
+        {
+          name: "title",
+          type: "string",
+          description: "The title of this widget.",
+          computedOptionalRequired: "required",
+        },
+        {
+          name: "description",
+          type: "string",
+          description: "The description of this widget.",
+          computedOptionalRequired: "optional",
+        },
+        {
+          name: "display_type",
+          type: "string",
+          description:
+            "How the widget is displayed. The allowed queries depend on the display type: `big_number` takes a single query with a single aggregate, `table` takes a single query, `line`, `area` and `bar` require an aggregate in every query, and `text` takes no queries.",
+          computedOptionalRequired: "required",
+          enum: "sentrydata.DashboardWidgetDisplayTypes",
+        },
+        {
+          name: "widget_type",
+          type: "string",
+          description: "The dataset that this widget queries.",
+          computedOptionalRequired: "computed_optional",
+          enum: "sentrydata.DashboardWidgetTypes",
+        },
+        {
+          name: "interval",
+          type: "string",
+          description: "The interval of the widget's time series, for example `5m`.",
+          computedOptionalRequired: "computed_optional",
+        },
+        {
+          name: "limit",
+          type: "int64",
+          description:
+            "The number of top series to display. Only applicable to `line`, `area` and `bar` widgets that group by `columns`.",
+          computedOptionalRequired: "optional",
+          validators: ["int64validator.Between(1, 10)"],
+        },
+        {
+          name: "query",
+          type: "list_nested",
+          description: "The queries that produce the widget's data.",
+          computedOptionalRequired: "optional",
+          block: true,
+          attributes: [
+            {
+              name: "id",
+              type: "string",
+              description: "The internal ID of this query.",
+              computedOptionalRequired: "computed",
+              planModifiers: ["stringplanmodifier.UseStateForUnknown()"],
+            },
+            {
+              name: "name",
+              type: "string",
+              description: "The legend name of this query.",
+              computedOptionalRequired: "computed_optional",
+            },
+            {
+              name: "fields",
+              type: "list",
+              elementType: "string",
+              description:
+                "The fields to query, in display order. Includes both `columns` and `aggregates`.",
+              computedOptionalRequired: "computed_optional",
+            },
+            {
+              name: "aggregates",
+              type: "set",
+              elementType: "string",
+              description: "The aggregate functions to query, for example `count()`.",
+              computedOptionalRequired: "computed_optional",
+              validators: [
+                "setvalidator.ValueStringsAre(sentryquery.AggregateValidator())",
+              ],
+            },
+            {
+              name: "columns",
+              type: "set",
+              elementType: "string",
+              description: "The columns to group by.",
+              computedOptionalRequired: "computed_optional",
+            },
+            {
+              name: "field_aliases",
+              type: "list",
+              elementType: "string",
+              description: "The display aliases of `fields`, in the same order.",
+              computedOptionalRequired: "computed_optional",
+            },
+            {
+              name: "conditions",
+              type: "string",
+              description: "The search query to filter events by.",
+              computedOptionalRequired: "computed_optional",
+              validators: ["sentryquery.SearchQueryValidator()"],
+            },
+            {
+              name: "order_by",
+              type: "string",
+              description:
+                "The field or aggregate to sort by. Prefix with `-` to sort in descending order. Must be one of the query's `fields`, `aggregates` or `columns`, or an `equation[N]` reference.",
+              computedOptionalRequired: "computed_optional",
+            },
+          ],
+        },
+        {
+          name: "layout",
+          type: "single_nested",
+          description: "The position and size of the widget on the 6-column grid.",
+          computedOptionalRequired: "optional",
+          block: true,
+          validators: ["objectvalidator.IsRequired()"],
+          attributes: [
+            {
+              name: "x",
+              type: "int64",
+              description: "The column of the widget's left edge, from `0` to `5`.",
+              computedOptionalRequired: "required",
+              validators: ["int64validator.Between(0, 5)"],
+            },
+            {
+              name: "y",
+              type: "int64",
+              description: "The row of the widget's top edge.",
+              computedOptionalRequired: "required",
+              validators: ["int64validator.AtLeast(0)"],
+            },
+            {
+              name: "w",
+              type: "int64",
+              description:
+                "The width of the widget in columns, from `1` to `6`. `x + w` must not exceed `6`.",
+              computedOptionalRequired: "required",
+              validators: ["int64validator.Between(1, 6)"],
+            },
+            {
+              name: "h",
+              type: "int64",
+              description: "The height of the widget in rows.",
+              computedOptionalRequired: "required",
+              validators: ["int64validator.AtLeast(1)"],
+            },
+            {
+              name: "min_h",
+              type: "int64",
+              description:
+                "The minimum height of the widget in rows. Must not exceed `h`. Defaults to `1`.",
+              computedOptionalRequired: "computed_optional",
+              default: "int64default.StaticInt64(1)",
+              validators: ["int64validator.AtLeast(1)"],
+            },
+          ],
+        },
+      ],
+    },
+    {
+      name: "internal_id",
+      type: "string",
+      description: "The internal ID of this dashboard.",
+      deprecationMessage: "Use `id` instead.",
+      computedOptionalRequired: "computed",
+      planModifiers: ["stringplanmodifier.UseStateForUnknown()"],
+    },
+  ],
+} satisfies Resource;
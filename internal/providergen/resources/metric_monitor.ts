@@ -26,6 +26,7 @@ export default {
     url: "https://{organization}.sentry.io/monitors/{id}/",
     targetAttributes: ["organization", "id"],
   },
+  identity: true,
   attributes: [
     {
       name: "id",
@@ -39,16 +40,23 @@ export default {
       name: "organization",
       type: "string",
       description:
-        "The organization slug or internal ID to create the monitor for.",
-      computedOptionalRequired: "required",
-      planModifiers: ["stringplanmodifier.RequiresReplace()"],
+        "The organization slug or internal ID to create the monitor for. Defaults to the `default_organization` provider attribute.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: [
+        "stringplanmodifier.UseStateForUnknown()",
+        "stringplanmodifier.RequiresReplace()",
+      ],
     },
     {
       name: "project",
       type: "string",
-      description: "The project slug or internal ID to create the monitor for.",
-      computedOptionalRequired: "required",
-      planModifiers: ["stringplanmodifier.RequiresReplace()"],
+      description:
+        "The project slug or internal ID to create the monitor for. Defaults to the `default_project` provider attribute.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: [
+        "stringplanmodifier.UseStateForUnknown()",
+        "stringplanmodifier.RequiresReplace()",
+      ],
     },
     {
       name: "enabled",
@@ -104,8 +112,10 @@ export default {
     {
       name: "aggregate",
       type: "string",
-      description: "Aggregate query to run on the metric.",
+      description:
+        "Aggregate query to run on the metric, for example `count()` or `p95(transaction.duration)`.",
       computedOptionalRequired: "required",
+      validators: ["sentryquery.AlertAggregateValidator()"],
     },
     {
       name: "dataset",
@@ -134,6 +144,7 @@ export default {
       description:
         "An event search query to subscribe to and monitor for alerts. For example, to filter transactions so that only those with status code 400 are included, you could use `http.status_code:400`.",
       computedOptionalRequired: "computed_optional",
+      validators: ["sentryquery.SearchQueryValidator()"],
     },
     {
       name: "query_type",
@@ -149,6 +160,7 @@ export default {
       type: "int64",
       description: "The time window in seconds to use for the aggregate query.",
       computedOptionalRequired: "computed_optional",
+      enum: "sentryquery.AlertTimeWindowSeconds",
     },
     {
       name: "extrapolation_mode",
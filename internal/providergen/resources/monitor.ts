@@ -0,0 +1,246 @@
+import dedent from "dedent";
+import type { Resource } from "../schema";
+
+export default {
+  name: "monitor",
+  description: dedent.withOptions({ trimWhitespace: true })`
+      Create a Monitor of any type for a Project.
+
+      Prefer the dedicated [\`sentry_metric_monitor\`](metric_monitor.md), [\`sentry_cron_monitor\`](cron_monitor.md) and [\`sentry_uptime_monitor\`](uptime_monitor.md) resources when they support the monitor type. This resource manages the monitor types that do not have a dedicated resource yet.
+
+      For more information about configuring monitors, see [Create a Monitor for a Project](https://docs.sentry.io/api/monitors/create-a-monitor-for-a-project/).
+    `,
+  api: {
+    model: "ProjectMonitor",
+    createMethod: "CreateProjectMonitor",
+    createRequestAttributes: ["organization", "project"],
+    readMethod: "GetProjectMonitor",
+    readRequestAttributes: ["organization", "id"],
+    updateMethod: "UpdateProjectMonitor",
+    updateRequestAttributes: ["organization", "id"],
+    deleteMethod: "DeleteProjectMonitor",
+    deleteRequestAttributes: ["organization", "id"],
+    afterRead: true,
+  },
+  generate: {
+    modelFillers: false,
+  },
+  import: {
+    url: "https://{organization}.sentry.io/monitors/{id}/",
+    targetAttributes: ["organization", "id"],
+  },
+  identity: true,
+  attributes: [
+    {
+      name: "id",
+      type: "string",
+      description: "The internal ID of this monitor.",
+      computedOptionalRequired: "computed",
+      sourceAttribute: ["Id"],
+      planModifiers: ["stringplanmodifier.UseStateForUnknown()"],
+    },
+    {
+      name: "organization",
+      type: "string",
+      description:
+        "The organization slug or internal ID to create the monitor for. Defaults to the `default_organization` provider attribute.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: [
+        "stringplanmodifier.UseStateForUnknown()",
+        "stringplanmodifier.RequiresReplace()",
+      ],
+    },
+    {
+      name: "project",
+      type: "string",
+      description:
+        "The project slug or internal ID to create the monitor for. Defaults to the `default_project` provider attribute.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: [
+        "stringplanmodifier.UseStateForUnknown()",
+        "stringplanmodifier.RequiresReplace()",
+      ],
+    },
+    {
+      name: "type",
+      type: "string",
+      description:
+        "The type of the monitor, e.g. `metric_issue`, `monitor_check_in_failure`, `uptime_domain_failure` or a performance monitor type. Changing this forces a new monitor to be created.",
+      computedOptionalRequired: "required",
+      validators: ["stringvalidator.LengthAtLeast(1)"],
+      planModifiers: ["stringplanmodifier.RequiresReplace()"],
+    },
+    {
+      name: "enabled",
+      type: "bool",
+      description: "Whether the monitor is enabled. Defaults to `true`.",
+      computedOptionalRequired: "computed_optional",
+      default: `booldefault.StaticBool(true)`,
+    },
+    {
+      name: "name",
+      type: "string",
+      description: "The name of this monitor.",
+      computedOptionalRequired: "required",
+    },
+    {
+      name: "description",
+      type: "string",
+      description:
+        "A description of the monitor. Will be used in the resulting issue.",
+      computedOptionalRequired: "optional",
+      nullable: true,
+    },
+    {
+      name: "owner",
+      type: "single_nested",
+      description: "Sentry will assign new issues to this assignee.",
+      computedOptionalRequired: "optional",
+      nullable: true,
+      attributes: [
+        {
+          name: "user_id",
+          type: "string",
+          description:
+            "The user ID to assign new issues to. Conflicts with `team_id`.",
+          computedOptionalRequired: "optional",
+          validators: [
+            `stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("team_id"))`,
+            `stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("team_id"))`,
+          ],
+        },
+        {
+          name: "team_id",
+          type: "string",
+          description:
+            "The team internal ID to assign new issues to. Conflicts with `user_id`.",
+          computedOptionalRequired: "optional",
+          validators: [
+            `stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("user_id"))`,
+          ],
+        },
+      ],
+    },
+    {
+      name: "config",
+      type: "single_nested",
+      description:
+        "The configuration of the monitor. Set the attribute matching the monitor `type`, or `json` for monitor types without a typed configuration. Computed from the server-side defaults when not set.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: ["objectplanmodifier.UseStateForUnknown()"],
+      attributes: [
+        {
+          name: "metric_issue",
+          type: "single_nested",
+          description:
+            "The configuration of a `metric_issue` monitor. Conflicts with `uptime_domain_failure` and `json`.",
+          computedOptionalRequired: "optional",
+          validators: [
+            `objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("uptime_domain_failure"), path.MatchRelative().AtParent().AtName("json"))`,
+          ],
+          attributes: [
+            {
+              name: "detection_type",
+              type: "string",
+              description:
+                "`static`: Threshold based monitor; `percent`: Change based monitor; `dynamic`: Dynamic monitor.",
+              computedOptionalRequired: "required",
+              enum: "sentrydata.AlertRuleDetectionTypes",
+            },
+            {
+              name: "comparison_delta",
+              type: "int64",
+              description:
+                "The comparison delta in seconds to use for the aggregate query. Only required for `percent` detection type.",
+              computedOptionalRequired: "optional",
+              validators: [
+                `fint64validator.RequireIfAttributeIsOneOf(path.MatchRelative().AtParent().AtName("detection_type"), []attr.Value{supertypes.NewStringValue("percent")})`,
+                `fint64validator.NullIfAttributeIsOneOf(path.MatchRelative().AtParent().AtName("detection_type"), []attr.Value{supertypes.NewStringValue("static"), supertypes.NewStringValue("dynamic")})`,
+              ],
+            },
+          ],
+        },
+        {
+          name: "uptime_domain_failure",
+          type: "single_nested",
+          description:
+            "The configuration of an `uptime_domain_failure` monitor. Conflicts with `metric_issue` and `json`.",
+          computedOptionalRequired: "optional",
+          validators: [
+            `objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("metric_issue"), path.MatchRelative().AtParent().AtName("json"))`,
+          ],
+          attributes: [
+            {
+              name: "mode",
+              type: "string",
+              description:
+                "The mode of the monitor. Monitors created by users are `MANUAL`, while `AUTO_DETECTED_ONBOARDING` and `AUTO_DETECTED_ACTIVE` are used by monitors that Sentry detected automatically.",
+              computedOptionalRequired: "required",
+              enum: "sentrydata.UptimeMonitorModes",
+            },
+            {
+              name: "environment",
+              type: "string",
+              description: "The environment to monitor.",
+              computedOptionalRequired: "required",
+            },
+            {
+              name: "recovery_threshold",
+              type: "int64",
+              description:
+                "The number of consecutive successful checks required to mark the monitor as recovered.",
+              computedOptionalRequired: "required",
+              validators: ["int64validator.AtLeast(1)"],
+            },
+            {
+              name: "downtime_threshold",
+              type: "int64",
+              description:
+                "The number of consecutive failed checks required to mark the monitor as down.",
+              computedOptionalRequired: "required",
+              validators: ["int64validator.AtLeast(1)"],
+            },
+          ],
+        },
+        {
+          name: "json",
+          type: "string",
+          customType: {
+            type: "sentrytypes.LossyJsonType{}",
+            value: "sentrytypes.LossyJson",
+          },
+          description:
+            "The configuration of any other monitor type, as a JSON object. Conflicts with `metric_issue` and `uptime_domain_failure`.",
+          computedOptionalRequired: "optional",
+          validators: [
+            `stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("metric_issue"), path.MatchRelative().AtParent().AtName("uptime_domain_failure"))`,
+          ],
+        },
+      ],
+    },
+    {
+      name: "data_sources_json",
+      type: "string",
+      customType: {
+        type: "sentrytypes.LossyJsonType{}",
+        value: "sentrytypes.LossyJson",
+      },
+      description:
+        "The data sources of the monitor, as a JSON array in the shape of the monitor type's `dataSources` request field. Computed from the monitor's data sources when not set.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: ["stringplanmodifier.UseStateForUnknown()"],
+    },
+    {
+      name: "condition_group_json",
+      type: "string",
+      customType: {
+        type: `sentrytypes.LossyJsonType{IgnoreKeys: []string{"id"}}`,
+        value: "sentrytypes.LossyJson",
+      },
+      description:
+        "The issue detection condition group of the monitor, as a JSON object with `logicType` and `conditions`. Computed from the server-side defaults when not set.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: ["stringplanmodifier.UseStateForUnknown()"],
+    },
+  ],
+} satisfies Resource;
@@ -17,7 +17,11 @@ export default {
   generate: {
     modelFillers: false,
   },
-  importStateAttributes: ["id"],
+  import: {
+    targetAttributes: ["id"],
+  },
+  identity: true,
+  mutableIdentity: true,
   attributes: [
     {
       name: "id",
@@ -28,7 +28,10 @@ export default {
   generate: {
     modelFillers: false,
   },
-  importStateAttributes: ["organization", "internal_id"],
+  import: {
+    targetAttributes: ["organization", "internal_id"],
+  },
+  identity: true,
   attributes: [
     {
       name: "id",
@@ -42,9 +45,13 @@ export default {
     {
       name: "organization",
       type: "string",
-      description: "The slug of the organization the mapping belongs to.",
-      computedOptionalRequired: "required",
-      planModifiers: ["stringplanmodifier.RequiresReplace()"],
+      description:
+        "The slug of the organization the mapping belongs to. Defaults to the `default_organization` provider attribute.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: [
+        "stringplanmodifier.UseStateForUnknown()",
+        "stringplanmodifier.RequiresReplace()",
+      ],
     },
     {
       name: "internal_id",
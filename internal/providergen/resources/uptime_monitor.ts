@@ -26,6 +26,7 @@ export default {
     url: "https://{organization}.sentry.io/monitors/{id}/",
     targetAttributes: ["organization", "id"],
   },
+  identity: true,
   attributes: [
     {
       name: "id",
@@ -39,16 +40,23 @@ export default {
       name: "organization",
       type: "string",
       description:
-        "The organization slug or internal ID to create the monitor for.",
-      computedOptionalRequired: "required",
-      planModifiers: ["stringplanmodifier.RequiresReplace()"],
+        "The organization slug or internal ID to create the monitor for. Defaults to the `default_organization` provider attribute.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: [
+        "stringplanmodifier.UseStateForUnknown()",
+        "stringplanmodifier.RequiresReplace()",
+      ],
     },
     {
       name: "project",
       type: "string",
-      description: "The project slug or internal ID to create the monitor for.",
-      computedOptionalRequired: "required",
-      planModifiers: ["stringplanmodifier.RequiresReplace()"],
+      description:
+        "The project slug or internal ID to create the monitor for. Defaults to the `default_project` provider attribute.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: [
+        "stringplanmodifier.UseStateForUnknown()",
+        "stringplanmodifier.RequiresReplace()",
+      ],
     },
     {
       name: "enabled",
@@ -150,6 +158,44 @@ export default {
       description: "The request timeout in milliseconds.",
       computedOptionalRequired: "required",
     },
+    {
+      name: "trace_sampling",
+      type: "bool",
+      description:
+        "Whether to sample traces of the uptime check requests, which links them to the traces of the monitored service. Defaults to `false`.",
+      computedOptionalRequired: "computed_optional",
+      default: `booldefault.StaticBool(false)`,
+    },
+    {
+      name: "follow_redirects",
+      type: "bool",
+      description:
+        "Whether to follow HTTP redirects. When `false`, a redirect response is checked as the final response.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: ["boolplanmodifier.UseStateForUnknown()"],
+    },
+    {
+      name: "regions",
+      type: "set",
+      elementType: "string",
+      description:
+        "The regions to run the uptime checks from. The regions take turns checking the URL. Defaults to the regions chosen by Sentry.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: ["setplanmodifier.UseStateForUnknown()"],
+      validators: [
+        "setvalidator.SizeAtLeast(1)",
+        "setvalidator.ValueStringsAre(stringvalidator.OneOf(sentrydata.UptimeRegions...))",
+      ],
+    },
+    {
+      name: "region_mode",
+      type: "string",
+      description:
+        "How the checks from `regions` are used. `active` checks create issues, `shadow` checks are recorded without creating issues, and `inactive` regions do not run checks.",
+      computedOptionalRequired: "computed_optional",
+      planModifiers: ["stringplanmodifier.UseStateForUnknown()"],
+      enum: "sentrydata.UptimeRegionModes",
+    },
     {
       name: "environment",
       type: "string",
@@ -168,10 +214,19 @@ export default {
       name: "downtime_threshold",
       type: "int64",
       description:
-        "Number of consecutive failed checks required to mark monitor as down. Defaults to `3`.",
+        "Number of consecutive failed checks required to mark monitor as down. Must be at least the number of `regions`. Defaults to `3`.",
       computedOptionalRequired: "computed_optional",
       default: `int64default.StaticInt64(3)`,
     },
+    {
+      name: "mode",
+      type: "string",
+      description:
+        "The mode of the monitor. Monitors created by users are `MANUAL`, while `AUTO_DETECTED_ONBOARDING` and `AUTO_DETECTED_ACTIVE` are used by monitors that Sentry detected automatically. Defaults to `MANUAL`.",
+      computedOptionalRequired: "computed_optional",
+      default: `stringdefault.StaticString("MANUAL")`,
+      enum: "sentrydata.UptimeMonitorModes",
+    },
     {
       name: "assertion_json",
       type: "string",
@@ -64,6 +64,8 @@ export interface ListNestedAttribute extends BaseAttribute {
   type: "list_nested";
   attributes: Array<Attribute>;
   model?: string;
+  /** Render as a nested block, e.g. to keep the configuration syntax of an SDKv2 resource. Blocks must be "optional". */
+  block?: boolean;
 }
 
 export interface SetAttribute extends BaseAttribute {
@@ -91,6 +93,8 @@ export interface SingleNestedAttribute extends BaseAttribute {
   type: "single_nested";
   attributes: Array<Attribute>;
   model?: string;
+  /** Render as a nested block. Use `objectvalidator.IsRequired()` for a required block. */
+  block?: boolean;
 }
 
 export interface BaseDataSourceApiStrategy {
@@ -147,6 +151,8 @@ export interface ResourceApiStrategy {
   updateRequestAttributes?: Array<string>;
   /** After create, PUT attributes the create API does not accept, using the planned values. */
   createThenUpdate?: boolean;
+  /** After read, call the resource's afterRead method with the response, e.g. to fill attributes that only the configuration sets. */
+  afterRead?: boolean;
   deleteMethod?: string;
   deleteRequestAttributes?: Array<string>;
 }
@@ -154,6 +160,8 @@ export interface ResourceApiStrategy {
 export interface Resource {
   name: string;
   description: string;
+  /** Schema version. The resource must implement UpgradeState when set. */
+  version?: number;
   api: ResourceApiStrategy;
   generate?: {
     modelFillers?: boolean;
@@ -162,5 +170,9 @@ export interface Resource {
     url?: string;
     targetAttributes?: Array<string>;
   };
+  /** Expose a resource identity made of the import target attributes. */
+  identity?: boolean;
+  /** Allow the identity to change in place, e.g. when a slug is renamed. */
+  mutableIdentity?: boolean;
   attributes: Array<Attribute>;
 }
@@ -10,11 +10,37 @@ import (
 	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
 )
 
+// ImportStateFromIdentity handles an import driven by an `identity` attribute of
+// an import block, copying each identity attribute into the state attribute of
+// the same name. It reports whether the import used the resource identity, in
+// which case the caller must not parse the import ID.
+func ImportStateFromIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attrPaths ...string) bool {
+	if req.ID != "" || req.Identity == nil || req.Identity.Raw.IsNull() {
+		return false
+	}
+
+	for _, attrPath := range attrPaths {
+		var value string
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(attrPath), &value)...)
+		if resp.Diagnostics.HasError() {
+			return true
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attrPath), value)...)
+	}
+
+	return true
+}
+
 // ImportState1PartPassthrough imports a single-part ID (e.g. "my-org" or "12345").
 func ImportState1PartPassthrough(
 	attrPathA string,
 ) func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	return func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		if ImportStateFromIdentity(ctx, req, resp, attrPathA) {
+			return
+		}
+
 		id := strings.TrimSpace(req.ID)
 		if id == "" {
 			resp.Diagnostics.AddError(
@@ -33,6 +59,10 @@ func ImportState2PartPath(
 	attrPathA, attrPathB string,
 ) func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	return func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		if ImportStateFromIdentity(ctx, req, resp, attrPathA, attrPathB) {
+			return
+		}
+
 		parts := strings.Split(strings.TrimSpace(req.ID), "/")
 		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 			resp.Diagnostics.AddError(
@@ -52,6 +82,10 @@ func ImportState3PartPath(
 	attrPathA, attrPathB, attrPathC string,
 ) func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	return func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		if ImportStateFromIdentity(ctx, req, resp, attrPathA, attrPathB, attrPathC) {
+			return
+		}
+
 		parts := strings.Split(strings.TrimSpace(req.ID), "/")
 		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
 			resp.Diagnostics.AddError(
@@ -72,6 +106,10 @@ func ImportState4PartPath(
 	attrPathA, attrPathB, attrPathC, attrPathD string,
 ) func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	return func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		if ImportStateFromIdentity(ctx, req, resp, attrPathA, attrPathB, attrPathC, attrPathD) {
+			return
+		}
+
 		parts := strings.Split(strings.TrimSpace(req.ID), "/")
 		if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
 			resp.Diagnostics.AddError(
@@ -96,6 +134,10 @@ func ImportState1Part(
 	labelA, attrPathA string,
 ) func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	return func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		if ImportStateFromIdentity(ctx, req, resp, attrPathA) {
+			return
+		}
+
 		valA, err := resourceid.Parse(req.ID, urlTemplate, labelA)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -119,6 +161,10 @@ func ImportState2Part(
 	labelB, attrPathB string,
 ) func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	return func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		if ImportStateFromIdentity(ctx, req, resp, attrPathA, attrPathB) {
+			return
+		}
+
 		valA, valB, err := resourceid.Split2(req.ID, urlTemplate, labelA, labelB)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -142,6 +188,10 @@ func ImportState3Part(
 	labelC, attrPathC string,
 ) func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	return func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		if ImportStateFromIdentity(ctx, req, resp, attrPathA, attrPathB, attrPathC) {
+			return
+		}
+
 		valA, valB, valC, err := resourceid.Split3(req.ID, urlTemplate, labelA, labelB, labelC)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -166,6 +216,10 @@ func ImportState4Part(
 	labelD, attrPathD string,
 ) func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	return func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		if ImportStateFromIdentity(ctx, req, resp, attrPathA, attrPathB, attrPathC, attrPathD) {
+			return
+		}
+
 		valA, valB, valC, valD, err := resourceid.Split4(req.ID, urlTemplate, labelA, labelB, labelC, labelD)
 		if err != nil {
 			resp.Diagnostics.AddError(
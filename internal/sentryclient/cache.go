@@ -0,0 +1,169 @@
+package sentryclient
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Cache holds the GET responses cached by CacheRoundTripper. A single Cache
+// may be shared by several clients, so that a write made through one of them
+// invalidates the responses cached by the others.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	// generation is incremented by every Clear, so that a response read
+	// concurrently with a write is not cached.
+	generation uint64
+}
+
+func NewCache() *Cache {
+	return &Cache{
+		entries: make(map[string]*cacheEntry),
+	}
+}
+
+// SharedCache is the cache shared by the clients of the framework and SDKv2
+// providers, which are served from the same process.
+var SharedCache = NewCache()
+
+func (c *Cache) get(key string) (*cacheEntry, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.entries[key], c.generation
+}
+
+func (c *Cache) set(key string, entry *cacheEntry, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation == generation {
+		c.entries[key] = entry
+	}
+}
+
+// Clear removes every entry from the cache.
+func (c *Cache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+	c.generation++
+}
+
+// NewCacheRoundTripper caches successful GET responses in the given cache.
+// Entries are keyed by URL and Authorization header. Entries with an ETag are
+// revalidated with If-None-Match, entries without one are served from memory.
+// Any other request clears the whole cache, as a write is not limited to the
+// objects under its path, e.g. adding a member to a team changes the member's
+// teams and the organization's projects list the teams that have access.
+func NewCacheRoundTripper(delegate http.RoundTripper, cache *Cache) http.RoundTripper {
+	if delegate == nil {
+		delegate = http.DefaultTransport
+	}
+	if cache == nil {
+		cache = NewCache()
+	}
+
+	return &CacheRoundTripper{
+		delegate: delegate,
+		cache:    cache,
+	}
+}
+
+type CacheRoundTripper struct {
+	delegate http.RoundTripper
+	cache    *Cache
+}
+
+type cacheEntry struct {
+	status     string
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        e.status,
+		StatusCode:    e.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+func (t *CacheRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet:
+		return t.get(req)
+	case http.MethodHead, http.MethodOptions:
+		return t.delegate.RoundTrip(req)
+	}
+
+	resp, err := t.delegate.RoundTrip(req)
+	t.cache.Clear()
+	return resp, err
+}
+
+func (t *CacheRoundTripper) get(req *http.Request) (*http.Response, error) {
+	// Leave conditional requests made by the caller alone.
+	if req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return t.delegate.RoundTrip(req)
+	}
+
+	key := cacheKey(req)
+	entry, generation := t.cache.get(key)
+
+	if entry != nil {
+		etag := entry.header.Get("ETag")
+		if etag == "" {
+			return entry.response(req), nil
+		}
+
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	resp, err := t.delegate.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry != nil && resp.StatusCode == http.StatusNotModified {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		return entry.response(req), nil
+	}
+
+	if resp.StatusCode != http.StatusOK || strings.Contains(resp.Header.Get("Cache-Control"), "no-store") {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.cache.set(key, &cacheEntry{
+		status:     resp.Status,
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+	}, generation)
+
+	return resp, nil
+}
+
+func cacheKey(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return hex.EncodeToString(sum[:]) + " " + req.URL.String()
+}
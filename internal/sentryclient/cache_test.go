@@ -0,0 +1,191 @@
+package sentryclient
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func doCacheRequest(t *testing.T, rt http.RoundTripper, method, url, token string) (int, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, string(body)
+}
+
+func TestCacheRoundTripper(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			hits.Add(1)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, r.URL.Path)
+	}))
+	defer srv.Close()
+
+	rt := NewCacheRoundTripper(nil, nil)
+
+	for range 3 {
+		status, body := doCacheRequest(t, rt, http.MethodGet, srv.URL+"/api/0/projects/org/project/keys/", "token")
+		if status != http.StatusOK || body != "/api/0/projects/org/project/keys/" {
+			t.Fatalf("unexpected response %d %q", status, body)
+		}
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("hits = %d, want 1", got)
+	}
+
+	// A different token is a different entry.
+	doCacheRequest(t, rt, http.MethodGet, srv.URL+"/api/0/projects/org/project/keys/", "other")
+	if got := hits.Load(); got != 2 {
+		t.Errorf("hits = %d, want 2", got)
+	}
+
+	// A different query is a different entry.
+	doCacheRequest(t, rt, http.MethodGet, srv.URL+"/api/0/projects/org/project/keys/?cursor=next", "token")
+	if got := hits.Load(); got != 3 {
+		t.Errorf("hits = %d, want 3", got)
+	}
+}
+
+func TestCacheRoundTripper_invalidation(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			hits.Add(1)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	paths := []string{
+		"/api/0/projects/org/project/",
+		"/api/0/projects/org/project/keys/",
+		"/api/0/teams/org/team/members/",
+		"/api/0/organizations/org/projects/",
+	}
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodDelete} {
+		t.Run(method, func(t *testing.T) {
+			rt := NewCacheRoundTripper(nil, nil)
+			for _, path := range paths {
+				doCacheRequest(t, rt, http.MethodGet, srv.URL+path, "token")
+			}
+
+			// A write to an unrelated path still invalidates every entry, e.g.
+			// adding a member to a team changes the team's members.
+			doCacheRequest(t, rt, method, srv.URL+"/api/0/organizations/org/members/1/teams/team/", "token")
+
+			for _, path := range paths {
+				before := hits.Load()
+				doCacheRequest(t, rt, http.MethodGet, srv.URL+path, "token")
+				if hits.Load() == before {
+					t.Errorf("%s was not refetched", path)
+				}
+			}
+		})
+	}
+}
+
+func TestCacheRoundTripper_shared(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			hits.Add(1)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	cache := NewCache()
+	rt1 := NewCacheRoundTripper(nil, cache)
+	rt2 := NewCacheRoundTripper(nil, cache)
+
+	doCacheRequest(t, rt1, http.MethodGet, srv.URL+"/api/0/organizations/org/", "token")
+	doCacheRequest(t, rt2, http.MethodGet, srv.URL+"/api/0/organizations/org/", "token")
+	if got := hits.Load(); got != 1 {
+		t.Errorf("hits = %d, want 1", got)
+	}
+
+	// A write through one round tripper invalidates the other.
+	doCacheRequest(t, rt2, http.MethodPut, srv.URL+"/api/0/organizations/org/", "token")
+	doCacheRequest(t, rt1, http.MethodGet, srv.URL+"/api/0/organizations/org/", "token")
+	if got := hits.Load(); got != 2 {
+		t.Errorf("hits = %d, want 2", got)
+	}
+}
+
+func TestCacheRoundTripper_etag(t *testing.T) {
+	var hits, notModified atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, "body")
+	}))
+	defer srv.Close()
+
+	rt := NewCacheRoundTripper(nil, nil)
+
+	for range 3 {
+		status, body := doCacheRequest(t, rt, http.MethodGet, srv.URL+"/api/0/organizations/org/", "token")
+		if status != http.StatusOK || body != "body" {
+			t.Fatalf("unexpected response %d %q", status, body)
+		}
+	}
+	if got := hits.Load(); got != 3 {
+		t.Errorf("hits = %d, want 3", got)
+	}
+	if got := notModified.Load(); got != 2 {
+		t.Errorf("not modified = %d, want 2", got)
+	}
+}
+
+func TestCacheRoundTripper_uncacheable(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		switch r.URL.Path {
+		case "/not-found/":
+			w.WriteHeader(http.StatusNotFound)
+		case "/no-store/":
+			w.Header().Set("Cache-Control", "no-store")
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer srv.Close()
+
+	rt := NewCacheRoundTripper(nil, nil)
+
+	for _, path := range []string{"/not-found/", "/no-store/"} {
+		before := hits.Load()
+		doCacheRequest(t, rt, http.MethodGet, srv.URL+path, "token")
+		doCacheRequest(t, rt, http.MethodGet, srv.URL+path, "token")
+		if got := hits.Load() - before; got != 2 {
+			t.Errorf("%s hits = %d, want 2", path, got)
+		}
+	}
+}
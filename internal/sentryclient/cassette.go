@@ -0,0 +1,391 @@
+package sentryclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// CassetteMode selects whether a Cassette records or replays interactions.
+type CassetteMode string
+
+const (
+	// CassetteModeRecord sends requests to Sentry and records them.
+	CassetteModeRecord CassetteMode = "record"
+	// CassetteModeReplay responds to requests with recorded responses,
+	// without sending them to Sentry.
+	CassetteModeReplay CassetteMode = "replay"
+)
+
+// cassetteRedacted replaces scrubbed secrets in recordings.
+const cassetteRedacted = "REDACTED"
+
+// cassetteSecretKeys are the JSON keys whose string values are scrubbed from
+// recordings, wherever the values appear. The secret of a client key, for
+// example, is also part of its DSN.
+var cassetteSecretKeys = []string{
+	"secret",
+	"token",
+	"access_token",
+	"refresh_token",
+	"client_secret",
+	"password",
+}
+
+// CassetteInteraction is a recorded request and its response.
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request" yaml:"request"`
+	Response CassetteResponse `json:"response" yaml:"response"`
+}
+
+// CassetteRequest is a recorded request. Request headers are not recorded, so
+// that credentials never reach the recording.
+type CassetteRequest struct {
+	Method string `json:"method" yaml:"method"`
+	// URL is the path and query of the request, so that recordings can be
+	// replayed against any base URL.
+	URL  string `json:"url" yaml:"url"`
+	Body string `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// CassetteResponse is a recorded response.
+type CassetteResponse struct {
+	StatusCode int                 `json:"status_code" yaml:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body       string              `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+type cassetteFile struct {
+	// Variables are values of the recording environment needed to replay
+	// it, such as the organization slug.
+	Variables    map[string]string      `json:"variables,omitempty" yaml:"variables,omitempty"`
+	Interactions []*CassetteInteraction `json:"interactions" yaml:"interactions"`
+}
+
+// Cassette records the API interactions of clients to files, or replays them
+// from files instead of sending requests. Files are YAML when their name ends
+// with .yaml or .yml, and JSON otherwise.
+//
+// A recording is loaded with Load and saved with Eject. In record mode,
+// requests sent while no recording is loaded are not recorded; in replay mode
+// they fail.
+type Cassette struct {
+	mode CassetteMode
+
+	mu     sync.Mutex
+	path   string
+	loaded bool
+	file   cassetteFile
+	used   []bool
+}
+
+// NewCassette returns a cassette in the given mode.
+func NewCassette(mode CassetteMode) (*Cassette, error) {
+	switch mode {
+	case CassetteModeRecord, CassetteModeReplay:
+		return &Cassette{mode: mode}, nil
+	default:
+		return nil, fmt.Errorf("invalid cassette mode %q: must be %q or %q", mode, CassetteModeRecord, CassetteModeReplay)
+	}
+}
+
+// Mode returns the mode of the cassette.
+func (c *Cassette) Mode() CassetteMode {
+	return c.mode
+}
+
+// Load starts a new recording to be saved to path, or loads the recording to
+// replay from path.
+func (c *Cassette) Load(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loaded {
+		return fmt.Errorf("cassette %q is already loaded", c.path)
+	}
+
+	file := cassetteFile{}
+	if c.mode == CassetteModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read cassette: %w", err)
+		}
+		if isYAMLCassette(path) {
+			err = yaml.Unmarshal(data, &file)
+		} else {
+			err = json.Unmarshal(data, &file)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to decode cassette %q: %w", path, err)
+		}
+	}
+
+	c.path = path
+	c.loaded = true
+	c.file = file
+	c.used = make([]bool, len(file.Interactions))
+	return nil
+}
+
+// Variable returns a value of the recording environment. In record mode, the
+// given value is recorded and returned. In replay mode, the recorded value is
+// returned.
+func (c *Cassette) Variable(name, value string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded {
+		return "", errors.New("no cassette is loaded")
+	}
+
+	if c.mode == CassetteModeReplay {
+		recorded, ok := c.file.Variables[name]
+		if !ok {
+			return "", fmt.Errorf("cassette %q does not contain variable %q", c.path, name)
+		}
+		return recorded, nil
+	}
+
+	if c.file.Variables == nil {
+		c.file.Variables = make(map[string]string)
+	}
+	c.file.Variables[name] = value
+	return value, nil
+}
+
+// Eject unloads the recording. In record mode, the recording is saved first.
+func (c *Cassette) Eject() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded {
+		return nil
+	}
+	c.loaded = false
+
+	if c.mode != CassetteModeRecord {
+		return nil
+	}
+
+	var data []byte
+	var err error
+	if isYAMLCassette(c.path) {
+		data, err = yaml.Marshal(c.file)
+	} else {
+		data, err = json.MarshalIndent(c.file, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("failed to save cassette: %w", err)
+	}
+	if err := os.WriteFile(c.path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to save cassette: %w", err)
+	}
+	return nil
+}
+
+func isYAMLCassette(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// RoundTripper returns a transport that records the requests sent to
+// delegate, or replays them without using delegate.
+func (c *Cassette) RoundTripper(delegate http.RoundTripper) http.RoundTripper {
+	if delegate == nil {
+		delegate = http.DefaultTransport
+	}
+
+	return &cassetteRoundTripper{
+		cassette: c,
+		delegate: delegate,
+	}
+}
+
+type cassetteRoundTripper struct {
+	cassette *Cassette
+	delegate http.RoundTripper
+}
+
+func (t *cassetteRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The bearer token is scrubbed wherever it appears.
+	var secrets []string
+	if token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer "); ok && token != "" {
+		secrets = append(secrets, token)
+	}
+
+	recordedReq := CassetteRequest{
+		Method: req.Method,
+		URL:    scrubCassette(req.URL.RequestURI(), secrets),
+		Body:   scrubCassette(string(body), append(secrets, cassetteSecrets(body)...)),
+	}
+
+	if t.cassette.mode == CassetteModeReplay {
+		return t.cassette.replay(req, recordedReq)
+	}
+
+	resp, err := t.delegate.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	headers := resp.Header.Clone()
+	headers.Del("Set-Cookie")
+
+	t.cassette.record(&CassetteInteraction{
+		Request: recordedReq,
+		Response: CassetteResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    headers,
+			Body:       scrubCassette(string(respBody), append(secrets, cassetteSecrets(respBody)...)),
+		},
+	})
+
+	return resp, nil
+}
+
+func (c *Cassette) record(interaction *CassetteInteraction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loaded {
+		c.file.Interactions = append(c.file.Interactions, interaction)
+	}
+}
+
+// replay responds with the first unused interaction matching the request.
+// GET requests may reuse the last matching interaction, since Terraform may
+// refresh more often than during the recording.
+func (c *Cassette) replay(req *http.Request, recordedReq CassetteRequest) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded {
+		return nil, fmt.Errorf("no cassette is loaded to replay %s %s", req.Method, req.URL.RequestURI())
+	}
+
+	match := -1
+	for i, interaction := range c.file.Interactions {
+		if !cassetteRequestsEqual(interaction.Request, recordedReq) {
+			continue
+		}
+		if !c.used[i] {
+			match = i
+			break
+		}
+		if req.Method == http.MethodGet {
+			match = i
+		}
+	}
+	if match == -1 {
+		return nil, fmt.Errorf("cassette %q has no recorded interaction for %s %s, record it again", c.path, req.Method, req.URL.RequestURI())
+	}
+	c.used[match] = true
+
+	recorded := c.file.Interactions[match].Response
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header(recorded.Headers).Clone(),
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+func cassetteRequestsEqual(a, b CassetteRequest) bool {
+	if a.Method != b.Method || a.URL != b.URL {
+		return false
+	}
+	if a.Body == b.Body {
+		return true
+	}
+
+	// JSON bodies are compared regardless of formatting and key order.
+	var va, vb any
+	if json.Unmarshal([]byte(a.Body), &va) != nil || json.Unmarshal([]byte(b.Body), &vb) != nil {
+		return false
+	}
+	ja, _ := json.Marshal(va)
+	jb, _ := json.Marshal(vb)
+	return bytes.Equal(ja, jb)
+}
+
+// readRequestBody reads the body of a request and restores it, so that it
+// can still be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// cassetteSecrets returns the values of secret keys in a JSON document.
+func cassetteSecrets(body []byte) []string {
+	var v any
+	if json.Unmarshal(body, &v) != nil {
+		return nil
+	}
+
+	var secrets []string
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			for key, value := range v {
+				if s, ok := value.(string); ok && s != "" && slices.Contains(cassetteSecretKeys, key) {
+					secrets = append(secrets, s)
+				}
+				walk(value)
+			}
+		case []any:
+			for _, value := range v {
+				walk(value)
+			}
+		}
+	}
+	walk(v)
+	return secrets
+}
+
+func scrubCassette(s string, secrets []string) string {
+	for _, secret := range secrets {
+		s = strings.ReplaceAll(s, secret, cassetteRedacted)
+	}
+	return s
+}
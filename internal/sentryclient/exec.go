@@ -0,0 +1,99 @@
+package sentryclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ExecConfig configures a credential helper command that prints a token.
+type ExecConfig struct {
+	Command string
+	Args    []string
+	// Env is added to the environment of the provider.
+	Env map[string]string
+}
+
+// NewExecRoundTripper authenticates requests with the token printed by a
+// credential helper command. The command either prints the token, or a JSON
+// object such as {"token": "...", "expires_at": "2006-01-02T15:04:05Z"}. The
+// command is run again when the token expires and when Sentry rejects it.
+func NewExecRoundTripper(delegate http.RoundTripper, config ExecConfig) http.RoundTripper {
+	return newTokenRoundTripper(delegate, &execSource{config: config})
+}
+
+type execSource struct {
+	config ExecConfig
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+type execOutput struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (s *execSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expiresAt.IsZero() || time.Now().Before(s.expiresAt)) {
+		return s.token, nil
+	}
+	return s.run(ctx)
+}
+
+func (s *execSource) Refresh(ctx context.Context, rejected string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Another request has already replaced the rejected token.
+	if s.token != rejected {
+		return s.token, nil
+	}
+	return s.run(ctx)
+}
+
+func (s *execSource) run(ctx context.Context) (string, error) {
+	cmd := exec.CommandContext(ctx, s.config.Command, s.config.Args...)
+	cmd.Env = os.Environ()
+	for key, value := range s.config.Env {
+		cmd.Env = append(cmd.Env, key+"="+value)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	stdout, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("credential helper %q failed: %w: %s", s.config.Command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var output execOutput
+	trimmed := bytes.TrimSpace(stdout)
+	if bytes.HasPrefix(trimmed, []byte("{")) {
+		if err := json.Unmarshal(trimmed, &output); err != nil {
+			return "", fmt.Errorf("failed to decode credential helper output: %w", err)
+		}
+	} else {
+		output.Token = string(trimmed)
+	}
+
+	if output.Token == "" {
+		return "", errors.New("credential helper did not print a token")
+	}
+
+	s.token = output.Token
+	s.expiresAt = output.ExpiresAt
+	return s.token, nil
+}
@@ -0,0 +1,67 @@
+package sentryclient
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExecRoundTripper(t *testing.T) {
+	// The helper prints the token stored in a file, so the test can rotate it.
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("first"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	valid := "first"
+	srv := newTokenEchoServer(t, func(token string) bool { return token == valid })
+
+	rt := NewExecRoundTripper(nil, ExecConfig{
+		Command: "sh",
+		Args:    []string{"-c", `printf '{"token": "%s"}' "$(cat "$TOKEN_PATH")"`},
+		Env:     map[string]string{"TOKEN_PATH": path},
+	})
+
+	if status, token := doTokenRequest(t, rt, srv.URL); status != http.StatusOK || token != "first" {
+		t.Errorf("got %d %q, want 200 %q", status, token, "first")
+	}
+
+	// The helper is run again after Sentry rejects the token.
+	valid = "second"
+	if err := os.WriteFile(path, []byte("second"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if status, token := doTokenRequest(t, rt, srv.URL); status != http.StatusOK || token != "second" {
+		t.Errorf("got %d %q, want 200 %q", status, token, "second")
+	}
+}
+
+func TestExecRoundTripper_plainText(t *testing.T) {
+	srv := newTokenEchoServer(t, func(token string) bool { return token == "plain" })
+
+	rt := NewExecRoundTripper(nil, ExecConfig{
+		Command: "echo",
+		Args:    []string{"plain"},
+	})
+
+	if status, token := doTokenRequest(t, rt, srv.URL); status != http.StatusOK || token != "plain" {
+		t.Errorf("got %d %q, want 200 %q", status, token, "plain")
+	}
+}
+
+func TestExecRoundTripper_failure(t *testing.T) {
+	rt := NewExecRoundTripper(nil, ExecConfig{
+		Command: "sh",
+		Args:    []string{"-c", "echo denied >&2; exit 1"},
+	})
+
+	req, err := http.NewRequest(http.MethodGet, "http://localhost/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rt.RoundTrip(req); err == nil || !strings.Contains(err.Error(), "denied") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
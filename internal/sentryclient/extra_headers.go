@@ -0,0 +1,29 @@
+package sentryclient
+
+import "net/http"
+
+func NewExtraHeadersRoundTripper(delegate http.RoundTripper, headers map[string]string) http.RoundTripper {
+	if delegate == nil {
+		delegate = http.DefaultTransport
+	}
+
+	return &ExtraHeadersRoundTripper{
+		delegate: delegate,
+		headers:  headers,
+	}
+}
+
+type ExtraHeadersRoundTripper struct {
+	delegate http.RoundTripper
+	headers  map[string]string
+}
+
+func (t *ExtraHeadersRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the caller's request, and the transports
+	// below set further headers on it.
+	req = req.Clone(req.Context())
+	for key, value := range t.headers {
+		req.Header.Set(key, value)
+	}
+	return t.delegate.RoundTrip(req)
+}
@@ -0,0 +1,33 @@
+package sentryclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExtraHeadersRoundTripper(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Proxy-Auth"); got != "secret" {
+			t.Errorf("X-Proxy-Auth = %q, want %q", got, "secret")
+		}
+	}))
+	defer srv.Close()
+
+	rt := NewExtraHeadersRoundTripper(nil, map[string]string{"X-Proxy-Auth": "secret"})
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if got := req.Header.Get("X-Proxy-Auth"); got != "" {
+		t.Errorf("caller's request was modified: X-Proxy-Auth = %q", got)
+	}
+}
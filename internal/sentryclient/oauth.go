@@ -0,0 +1,152 @@
+package sentryclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OAuthConfig configures authentication with OAuth access tokens.
+type OAuthConfig struct {
+	// TokenURL is the URL of the token endpoint, e.g.
+	// https://sentry.io/oauth/token/.
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	// RefreshToken is exchanged for access tokens with the refresh_token
+	// grant. The client_credentials grant is used when empty.
+	RefreshToken string
+}
+
+// NewOAuthRoundTripper authenticates requests with OAuth access tokens.
+// Access tokens are obtained from the token endpoint on first use, when they
+// expire and when Sentry rejects them.
+func NewOAuthRoundTripper(delegate http.RoundTripper, config OAuthConfig) http.RoundTripper {
+	if delegate == nil {
+		delegate = http.DefaultTransport
+	}
+
+	return newTokenRoundTripper(delegate, &oauthSource{
+		client:       &http.Client{Transport: delegate},
+		config:       config,
+		refreshToken: config.RefreshToken,
+	})
+}
+
+type oauthSource struct {
+	client *http.Client
+	config OAuthConfig
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+	expiresAt    time.Time
+}
+
+type oauthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+// oauthExpiryDelta refreshes access tokens shortly before they expire.
+const oauthExpiryDelta = 30 * time.Second
+
+func (s *oauthSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken != "" && (s.expiresAt.IsZero() || time.Now().Add(oauthExpiryDelta).Before(s.expiresAt)) {
+		return s.accessToken, nil
+	}
+	return s.fetch(ctx)
+}
+
+func (s *oauthSource) Refresh(ctx context.Context, rejected string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Another request has already replaced the rejected token.
+	if s.accessToken != rejected {
+		return s.accessToken, nil
+	}
+	return s.fetch(ctx)
+}
+
+func (s *oauthSource) fetch(ctx context.Context) (string, error) {
+	form := url.Values{
+		"client_id":     {s.config.ClientID},
+		"client_secret": {s.config.ClientSecret},
+	}
+	if s.refreshToken != "" {
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", s.refreshToken)
+	} else {
+		form.Set("grant_type", "client_credentials")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token endpoint returned status %d: %s", resp.StatusCode, body)
+	}
+
+	var token oauthTokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return "", errors.New("token response does not contain an access token")
+	}
+
+	s.accessToken = token.AccessToken
+	// Refresh tokens may be rotated on every use.
+	if token.RefreshToken != "" {
+		s.refreshToken = token.RefreshToken
+	}
+	if token.ExpiresIn > 0 {
+		s.expiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	} else {
+		s.expiresAt = time.Time{}
+	}
+	return s.accessToken, nil
+}
+
+// DefaultOAuthTokenURL returns the URL of the OAuth token endpoint of the
+// Sentry instance serving the given API base URL.
+func DefaultOAuthTokenURL(baseURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("base URL %q must be absolute", baseURL)
+	}
+
+	u.Path = strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), "/api") + "/oauth/token/"
+	u.RawPath = ""
+	u.RawQuery = ""
+	return u.String(), nil
+}
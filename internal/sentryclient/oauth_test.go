@@ -0,0 +1,121 @@
+package sentryclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestOAuthRoundTripper(t *testing.T) {
+	var (
+		mu           sync.Mutex
+		issued       int
+		validToken   string
+		refreshToken = "refresh-0"
+	)
+
+	tokenSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		if got := r.PostForm.Get("grant_type"); got != "refresh_token" {
+			t.Errorf("grant_type = %q, want refresh_token", got)
+		}
+		if got := r.PostForm.Get("client_id"); got != "client" {
+			t.Errorf("client_id = %q, want client", got)
+		}
+		if got := r.PostForm.Get("refresh_token"); got != refreshToken {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		issued++
+		validToken = fmt.Sprintf("access-%d", issued)
+		refreshToken = fmt.Sprintf("refresh-%d", issued)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  validToken,
+			"refresh_token": refreshToken,
+			"expires_in":    3600,
+		})
+	}))
+	defer tokenSrv.Close()
+
+	srv := newTokenEchoServer(t, func(token string) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return token == validToken
+	})
+
+	rt := NewOAuthRoundTripper(nil, OAuthConfig{
+		TokenURL:     tokenSrv.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RefreshToken: "refresh-0",
+	})
+
+	for range 2 {
+		if status, token := doTokenRequest(t, rt, srv.URL); status != http.StatusOK || token != "access-1" {
+			t.Errorf("got %d %q, want 200 %q", status, token, "access-1")
+		}
+	}
+
+	// Revoke the access token. The rotated refresh token is used to get a new one.
+	mu.Lock()
+	validToken = ""
+	mu.Unlock()
+	if status, token := doTokenRequest(t, rt, srv.URL); status != http.StatusOK || token != "access-2" {
+		t.Errorf("got %d %q, want 200 %q", status, token, "access-2")
+	}
+
+	if issued != 2 {
+		t.Errorf("issued = %d, want 2", issued)
+	}
+}
+
+func TestOAuthRoundTripper_clientCredentials(t *testing.T) {
+	tokenSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		if got := r.PostForm.Get("grant_type"); got != "client_credentials" {
+			t.Errorf("grant_type = %q, want client_credentials", got)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "access"})
+	}))
+	defer tokenSrv.Close()
+
+	srv := newTokenEchoServer(t, func(token string) bool { return token == "access" })
+
+	rt := NewOAuthRoundTripper(nil, OAuthConfig{
+		TokenURL:     tokenSrv.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+	})
+
+	if status, token := doTokenRequest(t, rt, srv.URL); status != http.StatusOK || token != "access" {
+		t.Errorf("got %d %q, want 200 %q", status, token, "access")
+	}
+}
+
+func TestOAuthRoundTripper_tokenEndpointError(t *testing.T) {
+	tokenSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer tokenSrv.Close()
+
+	rt := NewOAuthRoundTripper(nil, OAuthConfig{TokenURL: tokenSrv.URL})
+
+	req, err := http.NewRequest(http.MethodGet, "http://localhost/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rt.RoundTrip(req); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -0,0 +1,161 @@
+package sentryclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// regionPathRegexp matches the paths scoped to an organization, capturing the
+// API prefix and the organization slug.
+var regionPathRegexp = regexp.MustCompile(`^(.*)/0/(?:organizations|projects|teams)/([^/]+)/`)
+
+// IsSentryIO reports whether the base URL points at sentry.io, where
+// organizations are hosted in several regions.
+func IsSentryIO(baseUrl string) bool {
+	u, err := url.Parse(baseUrl)
+	if err != nil {
+		return false
+	}
+	return isSentryIOHost(u.Hostname())
+}
+
+func isSentryIOHost(host string) bool {
+	return host == "sentry.io" || strings.HasSuffix(host, ".sentry.io")
+}
+
+// isSentryIORegion reports whether the region URL is a sentry.io region that
+// the token may be sent to.
+func isSentryIORegion(region *url.URL) bool {
+	return region.Scheme == "https" && isSentryIOHost(region.Hostname())
+}
+
+// NewRegionRoundTripper sends requests scoped to an organization to the region
+// hosting the organization, e.g. https://us.sentry.io or https://de.sentry.io.
+// The region URL is taken from the organization details, looked up once per
+// host and organization. Requests are sent as is when the organization has no
+// region URL on sentry.io, e.g. when it does not exist, so that they fail with
+// the usual error.
+func NewRegionRoundTripper(delegate http.RoundTripper) http.RoundTripper {
+	if delegate == nil {
+		delegate = http.DefaultTransport
+	}
+
+	return &RegionRoundTripper{
+		delegate:    delegate,
+		allowRegion: isSentryIORegion,
+		regions:     make(map[string]*url.URL),
+	}
+}
+
+type RegionRoundTripper struct {
+	delegate http.RoundTripper
+
+	// allowRegion reports whether requests, and so the token, may be sent to
+	// the region URL.
+	allowRegion func(*url.URL) bool
+
+	group   singleflight.Group
+	mu      sync.Mutex
+	regions map[string]*url.URL
+}
+
+func (t *RegionRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	m := regionPathRegexp.FindStringSubmatch(req.URL.Path)
+	if m == nil {
+		return t.delegate.RoundTrip(req)
+	}
+
+	region, err := t.region(req, m[1], m[2])
+	if err != nil {
+		return nil, err
+	}
+	if region == nil || region.Host == req.URL.Host {
+		return t.delegate.RoundTrip(req)
+	}
+
+	req = req.Clone(req.Context())
+	req.URL.Scheme = region.Scheme
+	req.URL.Host = region.Host
+	req.Host = ""
+	return t.delegate.RoundTrip(req)
+}
+
+// region returns the region URL of the organization, or nil if it is unknown.
+// Concurrent lookups of the same organization are merged.
+func (t *RegionRoundTripper) region(req *http.Request, prefix, organization string) (*url.URL, error) {
+	key := req.URL.Host + "/" + organization
+
+	t.mu.Lock()
+	region, ok := t.regions[key]
+	t.mu.Unlock()
+	if ok {
+		return region, nil
+	}
+
+	v, err, _ := t.group.Do(key, func() (interface{}, error) {
+		lookupURL := url.URL{
+			Scheme: req.URL.Scheme,
+			Host:   req.URL.Host,
+			Path:   fmt.Sprintf("%s/0/organizations/%s/", prefix, organization),
+		}
+		lookupReq, err := http.NewRequestWithContext(req.Context(), http.MethodGet, lookupURL.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.delegate.RoundTrip(lookupReq)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up the region of organization %q: %w", organization, err)
+		}
+		defer resp.Body.Close()
+
+		region := regionFromResponse(resp)
+		if region == nil || !t.allowRegion(region) {
+			return (*url.URL)(nil), nil
+		}
+
+		// Only regions are cached, as failed lookups may succeed later, e.g.
+		// once the organization is created or the rate limit is reset.
+		t.mu.Lock()
+		t.regions[key] = region
+		t.mu.Unlock()
+
+		return region, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*url.URL), nil
+}
+
+// regionFromResponse returns the region URL in the organization details, or nil
+// if there is none.
+func regionFromResponse(resp *http.Response) *url.URL {
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	var body struct {
+		Links struct {
+			RegionUrl string `json:"regionUrl"`
+		} `json:"links"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil || body.Links.RegionUrl == "" {
+		return nil
+	}
+
+	region, err := url.Parse(body.Links.RegionUrl)
+	if err != nil || region.Scheme == "" || region.Host == "" {
+		return nil
+	}
+	return region
+}
@@ -0,0 +1,106 @@
+package sentryclient
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRegionRoundTripper(t *testing.T) {
+	region := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "region "+r.URL.Path)
+	}))
+	defer region.Close()
+
+	var lookups atomic.Int32
+	control := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/0/organizations/eu-org/":
+			lookups.Add(1)
+			_, _ = fmt.Fprintf(w, `{"slug": "eu-org", "links": {"organizationUrl": "https://eu-org.sentry.io", "regionUrl": %q}}`, region.URL)
+		case "/api/0/organizations/missing/":
+			lookups.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+		case "/api/0/organizations/other-org/":
+			lookups.Add(1)
+			_, _ = io.WriteString(w, `{"slug": "other-org", "links": {"regionUrl": "https://sentry.example.com"}}`)
+		default:
+			_, _ = io.WriteString(w, "control "+r.URL.Path)
+		}
+	}))
+	defer control.Close()
+
+	rt := NewRegionRoundTripper(nil)
+	// The test region is not on sentry.io.
+	rt.(*RegionRoundTripper).allowRegion = func(region *url.URL) bool {
+		return region.Host != "sentry.example.com"
+	}
+
+	testCases := []struct {
+		path string
+		want string
+	}{
+		{"/api/0/organizations/eu-org/projects/", "region /api/0/organizations/eu-org/projects/"},
+		{"/api/0/projects/eu-org/my-project/keys/", "region /api/0/projects/eu-org/my-project/keys/"},
+		{"/api/0/teams/eu-org/my-team/", "region /api/0/teams/eu-org/my-team/"},
+		{"/api/0/organizations/missing/projects/", "control /api/0/organizations/missing/projects/"},
+		{"/api/0/organizations/missing/projects/", "control /api/0/organizations/missing/projects/"},
+		{"/api/0/organizations/other-org/projects/", "control /api/0/organizations/other-org/projects/"},
+		{"/api/0/organizations/", "control /api/0/organizations/"},
+	}
+	for _, tc := range testCases {
+		status, body := doCacheRequest(t, rt, http.MethodGet, control.URL+tc.path, "token")
+		if status != http.StatusOK || body != tc.want {
+			t.Errorf("%s: unexpected response %d %q, want %q", tc.path, status, body, tc.want)
+		}
+	}
+
+	// Organizations with a region are looked up once, and the others on every
+	// request.
+	if got := lookups.Load(); got != 4 {
+		t.Errorf("lookups = %d, want 4", got)
+	}
+}
+
+func TestIsSentryIORegion(t *testing.T) {
+	testCases := []struct {
+		region string
+		want   bool
+	}{
+		{"https://us.sentry.io", true},
+		{"https://de.sentry.io", true},
+		{"http://us.sentry.io", false},
+		{"https://sentry.io.example.com", false},
+		{"https://example.com", false},
+	}
+	for _, tc := range testCases {
+		region, err := url.Parse(tc.region)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := isSentryIORegion(region); got != tc.want {
+			t.Errorf("isSentryIORegion(%q) = %t, want %t", tc.region, got, tc.want)
+		}
+	}
+}
+
+func TestIsSentryIO(t *testing.T) {
+	testCases := []struct {
+		baseUrl string
+		want    bool
+	}{
+		{"https://sentry.io/api/", true},
+		{"https://de.sentry.io/api/", true},
+		{"https://sentry.example.com/api/", false},
+		{"https://notsentry.io/api/", false},
+	}
+	for _, tc := range testCases {
+		if got := IsSentryIO(tc.baseUrl); got != tc.want {
+			t.Errorf("IsSentryIO(%q) = %t, want %t", tc.baseUrl, got, tc.want)
+		}
+	}
+}
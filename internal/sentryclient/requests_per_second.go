@@ -0,0 +1,31 @@
+package sentryclient
+
+import (
+	"math"
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+func NewRequestsPerSecondRoundTripper(delegate http.RoundTripper, requestsPerSecond float64) http.RoundTripper {
+	if delegate == nil {
+		delegate = http.DefaultTransport
+	}
+
+	return &RequestsPerSecondRoundTripper{
+		delegate: delegate,
+		limiter:  rate.NewLimiter(rate.Limit(requestsPerSecond), max(1, int(math.Ceil(requestsPerSecond)))),
+	}
+}
+
+type RequestsPerSecondRoundTripper struct {
+	delegate http.RoundTripper
+	limiter  *rate.Limiter
+}
+
+func (t *RequestsPerSecondRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.delegate.RoundTrip(req)
+}
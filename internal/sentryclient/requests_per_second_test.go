@@ -0,0 +1,81 @@
+package sentryclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestsPerSecondRoundTripper(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	rt := NewRequestsPerSecondRoundTripper(nil, 20)
+
+	start := time.Now()
+	for range 30 {
+		req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	// The first 20 requests use the burst, the next 10 are spread over
+	// half a second.
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("30 requests took %s, want at least 400ms", elapsed)
+	}
+}
+
+func TestSemaphoreRoundTripper_maxConcurrency(t *testing.T) {
+	var current, peak atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		// Sentry reports a higher limit, which must not override the configured one.
+		w.Header().Set("X-Sentry-Rate-Limit-ConcurrentLimit", "25")
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	rt := NewSemaphoreRoundTripper(nil, 2)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		})
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > 2 {
+		t.Errorf("peak concurrency = %d, want at most 2", got)
+	}
+}
@@ -0,0 +1,220 @@
+package sentryclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// RetryConfig controls how failed requests are retried.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts of a request, including
+	// the first one.
+	MaxAttempts int
+	// MinBackoff and MaxBackoff bound the exponential backoff between attempts.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// Jitter randomizes each backoff between MinBackoff and the exponential
+	// backoff, spreading out retries of concurrent requests.
+	Jitter bool
+	// RetryableStatusCodes are the response status codes that are retried.
+	// Connection errors are always retried.
+	RetryableStatusCodes []int
+	// MaxWait is the maximum total time spent waiting between the attempts of
+	// a request. Zero means no limit.
+	MaxWait time.Duration
+	// AttemptTimeout limits the time taken by each attempt of a request,
+	// including reading the response body. Zero means no limit.
+	AttemptTimeout time.Duration
+}
+
+// DefaultRetryConfig returns the retry policy used when none is configured.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts: 5,
+		MinBackoff:  1 * time.Second,
+		MaxBackoff:  30 * time.Second,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// Validate reports whether the retry policy is usable.
+func (c RetryConfig) Validate() error {
+	if c.MaxAttempts < 1 {
+		return fmt.Errorf("max attempts must be at least 1, got %d", c.MaxAttempts)
+	}
+	if c.MinBackoff < 0 || c.MaxBackoff < 0 || c.MaxWait < 0 {
+		return errors.New("backoff and wait durations must not be negative")
+	}
+	if c.AttemptTimeout < 0 {
+		return errors.New("attempt timeout must not be negative")
+	}
+	if c.MinBackoff > c.MaxBackoff {
+		return fmt.Errorf("min backoff %s must not be greater than max backoff %s", c.MinBackoff, c.MaxBackoff)
+	}
+	for _, code := range c.RetryableStatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf("invalid retryable status code %d", code)
+		}
+	}
+	return nil
+}
+
+func NewRetryRoundTripper(delegate http.RoundTripper, config RetryConfig) http.RoundTripper {
+	if delegate == nil {
+		delegate = http.DefaultTransport
+	}
+
+	return &RetryRoundTripper{
+		delegate: delegate,
+		config:   config,
+		sleep:    sleepContext,
+	}
+}
+
+type RetryRoundTripper struct {
+	delegate http.RoundTripper
+	config   RetryConfig
+
+	// sleep is replaced in tests to avoid waiting.
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func (t *RetryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	// Buffer the body so that it can be replayed on every attempt.
+	getBody := req.GetBody
+	if req.Body != nil && req.Body != http.NoBody && getBody == nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		getBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	var waited time.Duration
+	for attempt := 1; ; attempt++ {
+		attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+		if t.config.AttemptTimeout > 0 {
+			attemptCtx, cancel = context.WithTimeout(ctx, t.config.AttemptTimeout)
+		}
+
+		attemptReq := req.Clone(attemptCtx)
+		if getBody != nil {
+			body, err := getBody()
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+
+		resp, err := t.delegate.RoundTrip(attemptReq)
+		if resp != nil {
+			// Keep the attempt alive until its body has been read.
+			resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+		} else {
+			cancel()
+		}
+		if attempt >= t.config.MaxAttempts || !t.shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if t.config.MaxWait > 0 && waited+wait > t.config.MaxWait {
+			return resp, err
+		}
+
+		if resp != nil {
+			// Drain the body so that the connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := t.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+		waited += wait
+	}
+}
+
+func (t *RetryRoundTripper) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		// Leave the classification of connection errors, e.g. invalid
+		// certificates are not worth retrying, to retryablehttp.
+		retry, _ := retryablehttp.DefaultRetryPolicy(ctx, nil, err)
+		return retry
+	}
+
+	return slices.Contains(t.config.RetryableStatusCodes, resp.StatusCode)
+}
+
+func (t *RetryRoundTripper) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if rateLimitErr, ok := sentry.CheckResponse(resp).(*sentry.RateLimitError); ok {
+			if wait := time.Until(rateLimitErr.Rate.Reset); wait > 0 {
+				return wait
+			}
+		}
+	}
+
+	wait := t.config.MinBackoff
+	for i := 1; i < attempt && wait < t.config.MaxBackoff; i++ {
+		wait *= 2
+	}
+	wait = min(wait, t.config.MaxBackoff)
+
+	if t.config.Jitter && wait > t.config.MinBackoff {
+		wait = t.config.MinBackoff + rand.N(wait-t.config.MinBackoff)
+	}
+
+	return wait
+}
+
+// cancelOnCloseBody releases the context of an attempt once its response body
+// is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,256 @@
+package sentryclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestRetryRoundTripper(config RetryConfig, waits *[]time.Duration) *RetryRoundTripper {
+	t := NewRetryRoundTripper(nil, config).(*RetryRoundTripper)
+	t.sleep = func(ctx context.Context, d time.Duration) error {
+		*waits = append(*waits, d)
+		return nil
+	}
+	return t
+}
+
+func TestRetryRoundTripper(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("body = %q, want %q", body, "payload")
+		}
+
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	var waits []time.Duration
+	config := DefaultRetryConfig()
+	config.MinBackoff = 100 * time.Millisecond
+	config.MaxBackoff = time.Second
+	rt := newTestRetryRoundTripper(config, &waits)
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.GetBody = nil
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("attempts = %d, want 3", got)
+	}
+	if want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}; !equalDurations(waits, want) {
+		t.Errorf("waits = %v, want %v", waits, want)
+	}
+}
+
+func TestRetryRoundTripper_policy(t *testing.T) {
+	testCases := []struct {
+		name         string
+		status       int
+		config       func(*RetryConfig)
+		wantAttempts int32
+		wantWaits    []time.Duration
+	}{
+		{
+			name:         "max attempts",
+			status:       http.StatusBadGateway,
+			config:       func(c *RetryConfig) { c.MaxAttempts = 3 },
+			wantAttempts: 3,
+			wantWaits:    []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name:         "max backoff",
+			status:       http.StatusBadGateway,
+			config:       func(c *RetryConfig) { c.MaxBackoff = 3 * time.Second },
+			wantAttempts: 5,
+			wantWaits:    []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second},
+		},
+		{
+			name:         "max wait",
+			status:       http.StatusBadGateway,
+			config:       func(c *RetryConfig) { c.MaxWait = 4 * time.Second },
+			wantAttempts: 3,
+			wantWaits:    []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name:         "not retryable",
+			status:       http.StatusBadRequest,
+			config:       func(c *RetryConfig) {},
+			wantAttempts: 1,
+		},
+		{
+			name:         "custom status codes",
+			status:       http.StatusConflict,
+			config:       func(c *RetryConfig) { c.RetryableStatusCodes = []int{http.StatusConflict}; c.MaxAttempts = 2 },
+			wantAttempts: 2,
+			wantWaits:    []time.Duration{time.Second},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				w.WriteHeader(tc.status)
+			}))
+			defer srv.Close()
+
+			var waits []time.Duration
+			config := DefaultRetryConfig()
+			tc.config(&config)
+			rt := newTestRetryRoundTripper(config, &waits)
+
+			req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.status {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tc.status)
+			}
+			if got := attempts.Load(); got != tc.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tc.wantAttempts)
+			}
+			if !equalDurations(waits, tc.wantWaits) {
+				t.Errorf("waits = %v, want %v", waits, tc.wantWaits)
+			}
+		})
+	}
+}
+
+func TestRetryRoundTripper_jitter(t *testing.T) {
+	rt := NewRetryRoundTripper(nil, RetryConfig{
+		MinBackoff: time.Second,
+		MaxBackoff: 10 * time.Second,
+		Jitter:     true,
+	}).(*RetryRoundTripper)
+
+	for attempt := 1; attempt <= 5; attempt++ {
+		for range 100 {
+			wait := rt.backoff(attempt, nil)
+			if wait < time.Second || wait > 10*time.Second {
+				t.Fatalf("attempt %d: backoff %s out of bounds", attempt, wait)
+			}
+		}
+	}
+}
+
+func TestRetryRoundTripper_rateLimitReset(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("X-Sentry-Rate-Limit-Remaining", "0")
+			w.Header().Set("X-Sentry-Rate-Limit-Reset", strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	var waits []time.Duration
+	rt := newTestRetryRoundTripper(DefaultRetryConfig(), &waits)
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if len(waits) != 1 || waits[0] < 30*time.Second {
+		t.Errorf("waits = %v, want a single wait until the rate limit reset", waits)
+	}
+}
+
+func equalDurations(a, b []time.Duration) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRetryRoundTripper_attemptTimeout(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			// Outlive the attempt timeout
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	var waits []time.Duration
+	config := DefaultRetryConfig()
+	config.AttemptTimeout = 100 * time.Millisecond
+	rt := newTestRetryRoundTripper(config, &waits)
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != "ok" {
+		t.Errorf("body = %q, want %q", body, "ok")
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("attempts = %d, want 3", got)
+	}
+}
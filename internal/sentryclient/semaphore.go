@@ -8,14 +8,21 @@ import (
 	"golang.org/x/sync/semaphore"
 )
 
-func NewSemaphoreRoundTripper(delegate http.RoundTripper) http.RoundTripper {
+// NewSemaphoreRoundTripper limits the number of concurrent requests. The limit
+// is maxConcurrency when positive, otherwise it is sized from the concurrent
+// rate limit reported by the first response.
+func NewSemaphoreRoundTripper(delegate http.RoundTripper, maxConcurrency int) http.RoundTripper {
 	if delegate == nil {
 		delegate = http.DefaultTransport
 	}
 
-	return &SemaphoreTransport{
+	t := &SemaphoreTransport{
 		delegate: delegate,
 	}
+	if maxConcurrency > 0 {
+		t.w = semaphore.NewWeighted(int64(maxConcurrency))
+	}
+	return t
 }
 
 type SemaphoreTransport struct {
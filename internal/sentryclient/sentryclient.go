@@ -2,7 +2,10 @@ package sentryclient
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 )
@@ -11,29 +14,151 @@ import (
 // provider.
 type Config struct {
 	UserAgent string
+
+	// Token, TokenFile, OAuth and Exec are the mutually exclusive ways to
+	// authenticate with Sentry.
 	Token     string
+	TokenFile string
+	OAuth     *OAuthConfig
+	Exec      *ExecConfig
+
+	// CACertFile is the path to a PEM-encoded CA bundle trusted in addition to
+	// the system roots.
+	CACertFile string
+	// CACertPEM is a PEM-encoded CA bundle trusted in addition to the system
+	// roots.
+	CACertPEM string
+	// InsecureSkipVerify disables TLS certificate verification.
+	InsecureSkipVerify bool
+	// ProxyURL overrides the proxy taken from the HTTP_PROXY, HTTPS_PROXY and
+	// NO_PROXY environment variables.
+	ProxyURL string
+	// ClientCertPEM and ClientKeyPEM are the PEM-encoded certificate and
+	// private key presented for mutual TLS.
+	ClientCertPEM string
+	ClientKeyPEM  string
+	// Timeout limits the time taken by each attempt of a request. Every retry
+	// gets a fresh timeout.
+	Timeout time.Duration
+	// ExtraHeaders are added to every request.
+	ExtraHeaders map[string]string
+
+	// Retry is the retry policy of failed requests. DefaultRetryConfig is used
+	// when nil.
+	Retry *RetryConfig
+	// RequestsPerSecond limits the rate of requests when positive.
+	RequestsPerSecond float64
+	// MaxConcurrency limits the number of concurrent requests when positive,
+	// overriding the limit reported by Sentry.
+	MaxConcurrency int
+
+	// RegionRouting sends the requests scoped to an organization to the region
+	// hosting the organization.
+	RegionRouting bool
+
+	// Cache enables an in-memory cache of GET responses, cleared by every
+	// write. Caching is disabled when nil.
+	Cache *Cache
+
+	// Cassette records or replays the requests of the client. DefaultCassette
+	// is used when nil.
+	Cassette *Cassette
 }
 
+// DefaultCassette records or replays the requests of clients whose
+// configuration does not set a cassette. It is set by acceptance tests.
+var DefaultCassette *Cassette
+
 // Client to connect to Sentry.
-func (c *Config) HttpClient(ctx context.Context) *http.Client {
-	transport := http.DefaultTransport
+func (c *Config) HttpClient(ctx context.Context) (*http.Client, error) {
+	var authMethods int
+	for _, set := range []bool{c.Token != "", c.TokenFile != "", c.OAuth != nil, c.Exec != nil} {
+		if set {
+			authMethods++
+		}
+	}
+	if authMethods > 1 {
+		return nil, errors.New("only one of token, token file, OAuth and exec authentication may be configured")
+	}
+
+	retry := DefaultRetryConfig()
+	if c.Retry != nil {
+		retry = *c.Retry
+	}
+	if c.Timeout > 0 {
+		retry.AttemptTimeout = c.Timeout
+	}
+	if err := retry.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid retry configuration: %w", err)
+	}
+	if c.RequestsPerSecond < 0 {
+		return nil, errors.New("requests per second must not be negative")
+	}
+	if c.MaxConcurrency < 0 {
+		return nil, errors.New("max concurrency must not be negative")
+	}
+
+	baseTransport, err := c.baseTransport()
+	if err != nil {
+		return nil, err
+	}
+
+	var transport http.RoundTripper = baseTransport
+
+	// Handle recording and replaying, below all other transports so that
+	// replayed responses are handled like real ones
+	cassette := c.Cassette
+	if cassette == nil {
+		cassette = DefaultCassette
+	}
+	if cassette != nil {
+		transport = cassette.RoundTripper(transport)
+	}
 
 	// Handle logging
 	transport = logging.NewLoggingHTTPTransport(transport)
 
+	// Handle concurrency limit
+	transport = NewSemaphoreRoundTripper(transport, c.MaxConcurrency)
+
+	// Handle client-side rate limit
+	if c.RequestsPerSecond > 0 {
+		transport = NewRequestsPerSecondRoundTripper(transport, c.RequestsPerSecond)
+	}
+
+	// Handle retries, including server-side rate limits
+	transport = NewRetryRoundTripper(transport, retry)
+
+	// Handle caching, keyed by the Authorization header set below
+	if c.Cache != nil {
+		transport = NewCacheRoundTripper(transport, c.Cache)
+	}
+
 	// Handle authentication
-	transport = NewBearerTokenRoundTripper(transport, c.Token)
+	switch {
+	case c.TokenFile != "":
+		transport = NewTokenFileRoundTripper(transport, c.TokenFile)
+	case c.OAuth != nil:
+		transport = NewOAuthRoundTripper(transport, *c.OAuth)
+	case c.Exec != nil:
+		transport = NewExecRoundTripper(transport, *c.Exec)
+	default:
+		transport = NewBearerTokenRoundTripper(transport, c.Token)
+	}
 
 	// Handle user agent
 	transport = NewUserAgentRoundTripper(transport, c.UserAgent)
 
-	// Handle concurrency limit
-	transport = NewSemaphoreRoundTripper(transport)
+	// Handle extra headers, which are overridden by the headers set above
+	transport = NewExtraHeadersRoundTripper(transport, c.ExtraHeaders)
 
-	// Handle rate limit
-	transport = NewRateLimiterRoundTripper(transport)
+	// Handle region routing, above all other transports so that region lookups
+	// are sent like any other request
+	if c.RegionRouting {
+		transport = NewRegionRoundTripper(transport)
+	}
 
 	return &http.Client{
 		Transport: transport,
-	}
+	}, nil
 }
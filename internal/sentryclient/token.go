@@ -0,0 +1,83 @@
+package sentryclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// tokenSource supplies the bearer tokens of a TokenRoundTripper.
+type tokenSource interface {
+	// Token returns the current token.
+	Token(ctx context.Context) (string, error)
+	// Refresh returns a new token after Sentry rejected the given one. It
+	// returns the rejected token when no new token is available.
+	Refresh(ctx context.Context, rejected string) (string, error)
+}
+
+// TokenRoundTripper authenticates requests with a bearer token obtained from
+// a token source. Requests rejected with 401 Unauthorized are retried once
+// with a refreshed token.
+type TokenRoundTripper struct {
+	delegate http.RoundTripper
+	source   tokenSource
+}
+
+func newTokenRoundTripper(delegate http.RoundTripper, source tokenSource) *TokenRoundTripper {
+	if delegate == nil {
+		delegate = http.DefaultTransport
+	}
+
+	return &TokenRoundTripper{
+		delegate: delegate,
+		source:   source,
+	}
+}
+
+func (t *TokenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	token, err := t.source.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.delegate.RoundTrip(withBearerToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// The body can only be sent again if it can be recreated.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+
+	refreshed, err := t.source.Refresh(ctx, token)
+	if err != nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+	if refreshed == token {
+		return resp, nil
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	retryReq := withBearerToken(req, refreshed)
+	if req.GetBody != nil {
+		retryReq.Body, err = req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return t.delegate.RoundTrip(retryReq)
+}
+
+func withBearerToken(req *http.Request, token string) *http.Request {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
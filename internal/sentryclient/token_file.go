@@ -0,0 +1,68 @@
+package sentryclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NewTokenFileRoundTripper authenticates requests with the token stored in
+// the given file. The file is read again whenever it changes, so that the
+// token can be rotated without restarting the provider.
+func NewTokenFileRoundTripper(delegate http.RoundTripper, path string) http.RoundTripper {
+	return newTokenRoundTripper(delegate, &tokenFileSource{path: path})
+}
+
+type tokenFileSource struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+func (s *tokenFileSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.read(false)
+}
+
+func (s *tokenFileSource) Refresh(ctx context.Context, rejected string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.read(true)
+}
+
+func (s *tokenFileSource) read(force bool) (string, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	if !force && s.token != "" && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return s.token, nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", errors.New("token file is empty")
+	}
+
+	s.token = token
+	s.modTime = info.ModTime()
+	s.size = info.Size()
+	return s.token, nil
+}
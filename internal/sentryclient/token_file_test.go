@@ -0,0 +1,107 @@
+package sentryclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTokenEchoServer(t *testing.T, valid func(token string) bool) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !valid(token) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("X-Token", token)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func doTokenRequest(t *testing.T, rt http.RoundTripper, url string) (int, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode, resp.Header.Get("X-Token")
+}
+
+func TestTokenFileRoundTripper(t *testing.T) {
+	valid := "first"
+	srv := newTokenEchoServer(t, func(token string) bool { return token == valid })
+
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("first\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	rt := NewTokenFileRoundTripper(nil, path)
+
+	if status, token := doTokenRequest(t, rt, srv.URL); status != http.StatusOK || token != "first" {
+		t.Errorf("got %d %q, want 200 %q", status, token, "first")
+	}
+
+	// Rotate the token.
+	valid = "second"
+	if err := os.WriteFile(path, []byte("second\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, time.Time{}, time.Now().Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	if status, token := doTokenRequest(t, rt, srv.URL); status != http.StatusOK || token != "second" {
+		t.Errorf("got %d %q, want 200 %q", status, token, "second")
+	}
+
+	// Rotate the token without changing the modification time or size. The
+	// file is read again after Sentry rejects the cached token.
+	valid = "third!"
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("third!\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, time.Time{}, info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+
+	if status, token := doTokenRequest(t, rt, srv.URL); status != http.StatusOK || token != "third!" {
+		t.Errorf("got %d %q, want 200 %q", status, token, "third!")
+	}
+
+	// The file is unchanged, so the rejection is returned.
+	valid = "fourth"
+	if status, _ := doTokenRequest(t, rt, srv.URL); status != http.StatusUnauthorized {
+		t.Errorf("got %d, want 401", status)
+	}
+}
+
+func TestTokenFileRoundTripper_missing(t *testing.T) {
+	rt := NewTokenFileRoundTripper(nil, filepath.Join(t.TempDir(), "missing"))
+
+	req, err := http.NewRequest(http.MethodGet, "http://localhost/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rt.RoundTrip(req); err == nil || !strings.Contains(err.Error(), "failed to read token file") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
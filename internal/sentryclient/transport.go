@@ -0,0 +1,81 @@
+package sentryclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// baseTransport returns a copy of http.DefaultTransport with the TLS and proxy
+// settings of the configuration applied.
+func (c *Config) baseTransport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if c.ProxyURL != "" {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		} else if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: must be an absolute URL", c.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
+
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+
+	if c.CACertFile != "" || c.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if c.CACertFile != "" {
+			pem, err := os.ReadFile(c.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read CA certificate file: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no valid certificates found in CA certificate file %q", c.CACertFile)
+			}
+		}
+
+		if c.CACertPEM != "" {
+			if !pool.AppendCertsFromPEM([]byte(c.CACertPEM)) {
+				return nil, errors.New("no valid certificates found in CA certificate PEM")
+			}
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.ClientCertPEM != "" || c.ClientKeyPEM != "" {
+		if c.ClientCertPEM == "" || c.ClientKeyPEM == "" {
+			return nil, errors.New("both the client certificate and the client key must be set for mutual TLS")
+		}
+
+		cert, err := tls.X509KeyPair([]byte(c.ClientCertPEM), []byte(c.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,138 @@
+package sentryclient
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestConfigHttpClient_tls(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Extra"); got != "value" {
+			t.Errorf("X-Extra header = %q, want %q", got, "value")
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("Authorization header = %q, want %q", got, "Bearer token")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(caPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	extraHeaders := map[string]string{
+		"X-Extra":       "value",
+		"Authorization": "ignored",
+	}
+
+	testCases := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{
+			name:    "untrusted",
+			config:  Config{},
+			wantErr: true,
+		},
+		{
+			name:   "ca_cert_pem",
+			config: Config{CACertPEM: caPEM},
+		},
+		{
+			name:   "ca_cert_file",
+			config: Config{CACertFile: caFile},
+		},
+		{
+			name:   "insecure_skip_verify",
+			config: Config{InsecureSkipVerify: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.config.Token = "token"
+			tc.config.ExtraHeaders = extraHeaders
+			tc.config.Timeout = 5 * time.Second
+
+			httpClient, err := tc.config.HttpClient(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := httpClient.Do(req)
+			if tc.wantErr {
+				if err == nil {
+					resp.Body.Close()
+					t.Fatal("expected a TLS error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+		})
+	}
+}
+
+func TestConfigHttpClient_invalid(t *testing.T) {
+	testCases := map[string]Config{
+		"missing ca_cert_file": {CACertFile: filepath.Join(t.TempDir(), "missing.pem")},
+		"invalid ca_cert_pem":  {CACertPEM: "not a certificate"},
+		"client_cert only":     {ClientCertPEM: "cert"},
+		"invalid client_cert":  {ClientCertPEM: "cert", ClientKeyPEM: "key"},
+		"relative proxy_url":   {ProxyURL: "proxy.example.com"},
+		"token and token_file": {Token: "token", TokenFile: "token"},
+		"oauth and exec":       {OAuth: &OAuthConfig{}, Exec: &ExecConfig{}},
+		"invalid retry":        {Retry: &RetryConfig{MaxAttempts: 0}},
+		"negative rate":        {RequestsPerSecond: -1},
+	}
+
+	for name, config := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := config.HttpClient(context.Background()); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestConfigHttpClient_proxy(t *testing.T) {
+	var proxied bool
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.Host == "sentry.invalid"
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	config := Config{ProxyURL: proxy.URL}
+	httpClient, err := config.HttpClient(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := httpClient.Get("http://sentry.invalid/api/0/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if !proxied {
+		t.Fatal("expected the request to go through the proxy")
+	}
+}
@@ -1048,6 +1048,20 @@ var UptimeMonitorModeIdToName = map[int64]string{
 	3: "AUTO_DETECTED_ACTIVE",
 }
 
+// https://github.com/getsentry/sentry/blob/master/src/sentry/conf/server.py
+var UptimeRegions = []string{
+	"us-east-1",
+	"us-west-1",
+	"eu-central-1",
+}
+
+// https://github.com/getsentry/sentry/blob/master/src/sentry/uptime/models.py
+var UptimeRegionModes = []string{
+	"active",
+	"shadow",
+	"inactive",
+}
+
 // https://github.com/getsentry/sentry/blob/master/src/sentry/snuba/models.py
 var SnubaQueryTypes = []string{
 	"error",
@@ -1183,6 +1197,14 @@ var DataConditionGroupTypes = []string{
 	"none",
 }
 
+// https://github.com/getsentry/sentry/blob/master/src/sentry/workflow_engine/types.py
+var DetectorPriorityLevelNameToId = map[string]int64{
+	"ok":     0,
+	"low":    25,
+	"medium": 50,
+	"high":   75,
+}
+
 // https://github.com/getsentry/sentry/blob/master/src/sentry/rules/conditions/event_frequency.py
 var EventFrequencyStandardIntervals = []string{
 	"1m",
@@ -0,0 +1,208 @@
+package sentryquery
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// Aggregate is a parsed aggregate such as `p95(transaction.duration)`.
+type Aggregate struct {
+	Function  string
+	Arguments []string
+	// Alias is the name given with `AS`, as in
+	// `percentage(sessions_crashed, sessions) AS _crash_rate_alert_aggregate`.
+	Alias string
+}
+
+// Function describes the arguments of an aggregate function.
+type Function struct {
+	MinArguments int
+	MaxArguments int
+}
+
+// AlertFunctions are the aggregate functions that metric alerts and metric
+// monitors may use. Functions on trace metrics take up to four arguments, as
+// in `sum(value,my.metric,counter,none)`.
+var AlertFunctions = map[string]Function{
+	"apdex":           {0, 1},
+	"avg":             {1, 4},
+	"count":           {0, 4},
+	"count_miserable": {1, 2},
+	"count_unique":    {1, 1},
+	"cpm":             {0, 1},
+	"epm":             {0, 1},
+	"eps":             {0, 1},
+	"failure_count":   {0, 0},
+	"failure_rate":    {0, 0},
+	"max":             {1, 4},
+	"min":             {1, 4},
+	"p50":             {0, 4},
+	"p75":             {0, 4},
+	"p90":             {0, 4},
+	"p95":             {0, 4},
+	"p99":             {0, 4},
+	"p100":            {0, 4},
+	"per_minute":      {1, 4},
+	"per_second":      {1, 4},
+	"percentage":      {2, 2},
+	"percentile":      {2, 2},
+	"spm":             {0, 1},
+	"sum":             {1, 4},
+	"tpm":             {0, 1},
+	"tps":             {0, 1},
+	"user_misery":     {0, 1},
+}
+
+// UnknownFunctionError is returned for a syntactically valid aggregate whose
+// function is not one of AlertFunctions. Sentry adds functions over time, so it
+// should be reported as a warning rather than reject the aggregate.
+type UnknownFunctionError struct {
+	Function string
+}
+
+func (e *UnknownFunctionError) Error() string {
+	names := lo.Keys(AlertFunctions)
+	slices.Sort(names)
+	return fmt.Sprintf("unknown function %q, which Sentry may reject. Known functions are: %s", e.Function, strings.Join(names, ", "))
+}
+
+// AlertTimeWindowSeconds are the time windows, in seconds, that metric
+// alerts and metric monitors may aggregate over.
+var AlertTimeWindowSeconds = []int64{
+	60,
+	300,
+	600,
+	900,
+	1800,
+	3600,
+	7200,
+	14400,
+	86400,
+}
+
+var (
+	aggregateAliasRegexp    = regexp.MustCompile(`\s+AS\s+([A-Za-z_][A-Za-z0-9_]*)$`)
+	aggregateFunctionRegexp = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+)
+
+// equationPrefix prefixes the arithmetic equations of dashboard widgets, as in
+// `equation|count() / 2`.
+const equationPrefix = "equation|"
+
+// ParseAggregate parses an aggregate function call such as `count()` or
+// `percentile(transaction.duration, 0.95)`, optionally followed by an alias.
+func ParseAggregate(aggregate string) (*Aggregate, error) {
+	if strings.TrimSpace(aggregate) == "" {
+		return nil, syntaxErrorf(0, "missing aggregate")
+	}
+
+	result := &Aggregate{}
+	expr := aggregate
+	if m := aggregateAliasRegexp.FindStringSubmatchIndex(expr); m != nil {
+		result.Alias = expr[m[2]:m[3]]
+		expr = expr[:m[0]]
+	}
+
+	open := strings.IndexByte(expr, '(')
+	if open == -1 {
+		return nil, syntaxErrorf(len(expr), "expected a function call such as count()")
+	}
+
+	result.Function = expr[:open]
+	if !aggregateFunctionRegexp.MatchString(result.Function) {
+		return nil, syntaxErrorf(0, "invalid function name %q", result.Function)
+	}
+
+	if !strings.HasSuffix(expr, ")") {
+		if close := strings.IndexByte(expr, ')'); close != -1 {
+			return nil, syntaxErrorf(close+1, "unexpected characters after the function call")
+		}
+		return nil, syntaxErrorf(open, "unclosed parenthesis")
+	}
+
+	args := expr[open+1 : len(expr)-1]
+	if i := strings.IndexAny(args, "()"); i != -1 {
+		return nil, syntaxErrorf(open+1+i, "unexpected parenthesis in function arguments")
+	}
+
+	if strings.TrimSpace(args) != "" {
+		offset := open + 1
+		for _, arg := range strings.Split(args, ",") {
+			if strings.TrimSpace(arg) == "" {
+				return nil, syntaxErrorf(offset, "empty function argument")
+			}
+			result.Arguments = append(result.Arguments, strings.TrimSpace(arg))
+			offset += len(arg) + 1
+		}
+	}
+
+	return result, nil
+}
+
+// ValidateAggregate checks the syntax of an aggregate of a dashboard widget
+// query. Any function name is accepted, and so are equations.
+func ValidateAggregate(aggregate string) error {
+	if equation, ok := strings.CutPrefix(aggregate, equationPrefix); ok {
+		if strings.TrimSpace(equation) == "" {
+			return syntaxErrorf(len(equationPrefix), "missing equation")
+		}
+		return nil
+	}
+
+	_, err := ParseAggregate(aggregate)
+	return err
+}
+
+// ValidateAlertAggregate checks an aggregate of a metric alert or monitor,
+// including that a function of AlertFunctions has the right number of
+// arguments. Only the syntax of other functions is checked, and an
+// *UnknownFunctionError is returned for them.
+func ValidateAlertAggregate(aggregate string) error {
+	result, err := ParseAggregate(aggregate)
+	if err != nil {
+		return err
+	}
+
+	function, ok := AlertFunctions[result.Function]
+	if !ok {
+		return &UnknownFunctionError{Function: result.Function}
+	}
+
+	if n := len(result.Arguments); n < function.MinArguments || n > function.MaxArguments {
+		return syntaxErrorf(len(result.Function), "%s() takes %s, got %d", result.Function, describeArguments(function), n)
+	}
+
+	if result.Function == "percentile" {
+		if v, err := strconv.ParseFloat(result.Arguments[1], 64); err != nil || v < 0 || v > 1 {
+			return syntaxErrorf(strings.LastIndexByte(aggregate, ',')+1, "the percentile must be a number between 0 and 1, got %q", result.Arguments[1])
+		}
+	}
+
+	return nil
+}
+
+func describeArguments(function Function) string {
+	plural := func(n int) string {
+		switch n {
+		case 0:
+			return "no arguments"
+		case 1:
+			return "1 argument"
+		}
+		return strconv.Itoa(n) + " arguments"
+	}
+
+	switch {
+	case function.MinArguments == function.MaxArguments:
+		return plural(function.MinArguments)
+	case function.MinArguments == 0:
+		return "at most " + plural(function.MaxArguments)
+	default:
+		return "between " + strconv.Itoa(function.MinArguments) + " and " + plural(function.MaxArguments)
+	}
+}
@@ -0,0 +1,87 @@
+package sentryquery
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseAggregate(t *testing.T) {
+	got, err := ParseAggregate("percentage(sessions_crashed, sessions) AS _crash_rate_alert_aggregate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Function != "percentage" || !slices.Equal(got.Arguments, []string{"sessions_crashed", "sessions"}) || got.Alias != "_crash_rate_alert_aggregate" {
+		t.Errorf("unexpected aggregate %+v", got)
+	}
+}
+
+func TestValidateAlertAggregate(t *testing.T) {
+	testCases := []struct {
+		aggregate   string
+		wantErr     string
+		wantUnknown bool
+	}{
+		{aggregate: "count()"},
+		{aggregate: "count_unique(user)"},
+		{aggregate: "p95(transaction.duration)"},
+		{aggregate: "p75(measurements.lcp)"},
+		{aggregate: "p50()"},
+		{aggregate: "failure_rate()"},
+		{aggregate: "apdex(300)"},
+		{aggregate: "percentile(transaction.duration, 0.95)"},
+		{aggregate: "count(span.duration)"},
+		{aggregate: "sum(value,my.metric,counter,none)"},
+		{aggregate: "percentage(sessions_crashed, sessions) AS _crash_rate_alert_aggregate"},
+		{aggregate: "", wantErr: "missing aggregate"},
+		{aggregate: "count", wantErr: "expected a function call"},
+		{aggregate: "cout()", wantErr: `unknown function "cout"`, wantUnknown: true},
+		{aggregate: "count_if(transaction.duration,greater,300)", wantErr: `unknown function "count_if"`, wantUnknown: true},
+		{aggregate: "crash_free_rate(session)", wantErr: `unknown function "crash_free_rate"`, wantUnknown: true},
+		{aggregate: "last(d:custom/foo@none)", wantErr: `unknown function "last"`, wantUnknown: true},
+		{aggregate: "count_web_vitals(measurements.lcp,good)", wantErr: `unknown function "count_web_vitals"`, wantUnknown: true},
+		{aggregate: "count_if(transaction.duration,", wantErr: "unclosed parenthesis"},
+		{aggregate: "Count()", wantErr: `invalid function name "Count"`},
+		{aggregate: "count(", wantErr: "unclosed parenthesis"},
+		{aggregate: "count() * 2", wantErr: "unexpected characters after the function call"},
+		{aggregate: "avg(count())", wantErr: "unexpected parenthesis in function arguments"},
+		{aggregate: "percentile(transaction.duration,)", wantErr: "empty function argument"},
+		{aggregate: "failure_rate(transaction.duration)", wantErr: "failure_rate() takes no arguments, got 1"},
+		{aggregate: "avg()", wantErr: "avg() takes between 1 and 4 arguments, got 0"},
+		{aggregate: "count_unique(a, b)", wantErr: "count_unique() takes 1 argument, got 2"},
+		{aggregate: "apdex(1, 2)", wantErr: "apdex() takes at most 1 argument, got 2"},
+		{aggregate: "percentile(transaction.duration, 95)", wantErr: "the percentile must be a number between 0 and 1"},
+		{aggregate: "equation|count() / 2", wantErr: "invalid function name"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.aggregate, func(t *testing.T) {
+			err := ValidateAlertAggregate(tc.aggregate)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("error = %v, want %q", err, tc.wantErr)
+			}
+
+			var unknownFunctionErr *UnknownFunctionError
+			if got := errors.As(err, &unknownFunctionErr); got != tc.wantUnknown {
+				t.Errorf("unknown function = %t, want %t", got, tc.wantUnknown)
+			}
+		})
+	}
+}
+
+func TestValidateAggregate(t *testing.T) {
+	for _, aggregate := range []string{"count()", "count_web_vitals(measurements.lcp, good)", "equation|count() / 2"} {
+		if err := ValidateAggregate(aggregate); err != nil {
+			t.Errorf("%s: unexpected error: %v", aggregate, err)
+		}
+	}
+	for _, aggregate := range []string{"count", "equation|", "count())"} {
+		if err := ValidateAggregate(aggregate); err == nil {
+			t.Errorf("%s: expected an error", aggregate)
+		}
+	}
+}
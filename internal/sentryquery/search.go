@@ -0,0 +1,320 @@
+// Package sentryquery checks the syntax of Sentry's event search queries and
+// aggregate functions, so that mistakes are reported when the configuration
+// is validated rather than by the API when it is applied.
+package sentryquery
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// SyntaxError describes an invalid search query or aggregate.
+type SyntaxError struct {
+	// Offset is the byte offset in the input where the error was found.
+	Offset  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s (at position %d)", e.Message, e.Offset+1)
+}
+
+func syntaxErrorf(offset int, format string, args ...any) *SyntaxError {
+	return &SyntaxError{Offset: offset, Message: fmt.Sprintf(format, args...)}
+}
+
+// searchOperators are the comparison operators that may prefix the value of
+// a filter, longest first.
+var searchOperators = []string{">=", "<=", "!=", ">", "<", "="}
+
+// ValidateSearchQuery checks the syntax of an event search query such as
+// `is:unresolved level:error !browser:"Firefox 120" (a OR b)`. An empty query
+// is valid.
+//
+// Filters, free text, quoted strings, lists, comparison operators and the
+// AND and OR operators are checked. Keys and values are not checked against
+// the fields of a dataset.
+func ValidateSearchQuery(query string) error {
+	p := &searchParser{input: query}
+	return p.parse()
+}
+
+type searchParser struct {
+	input string
+	pos   int
+}
+
+func (p *searchParser) parse() error {
+	// open holds the offsets of unclosed parentheses.
+	var open []int
+	// afterTerm is false at the start of the query or a group and after a
+	// boolean operator, where a term is required.
+	afterTerm := false
+	lastOperator, lastOperatorOffset := "", 0
+
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.input) {
+			break
+		}
+
+		start := p.pos
+		switch c := p.input[p.pos]; {
+		case c == '(':
+			open = append(open, start)
+			p.pos++
+			afterTerm = false
+			lastOperator = ""
+		case c == ')':
+			if len(open) == 0 {
+				return syntaxErrorf(start, "unexpected closing parenthesis")
+			}
+			if lastOperator != "" {
+				return syntaxErrorf(lastOperatorOffset, "%s must be followed by a search term", lastOperator)
+			}
+			if !afterTerm {
+				return syntaxErrorf(open[len(open)-1], "empty parentheses")
+			}
+			open = open[:len(open)-1]
+			p.pos++
+		default:
+			if word := p.peekWord(); word == "AND" || word == "OR" {
+				if !afterTerm {
+					return syntaxErrorf(start, "%s must follow a search term", word)
+				}
+				p.pos += len(word)
+				afterTerm = false
+				lastOperator, lastOperatorOffset = word, start
+				continue
+			}
+
+			if err := p.parseTerm(); err != nil {
+				return err
+			}
+			afterTerm = true
+			lastOperator = ""
+		}
+	}
+
+	if lastOperator != "" {
+		return syntaxErrorf(lastOperatorOffset, "%s must be followed by a search term", lastOperator)
+	}
+	if len(open) > 0 {
+		return syntaxErrorf(open[len(open)-1], "unclosed parenthesis")
+	}
+	return nil
+}
+
+func (p *searchParser) skipSpace() {
+	for p.pos < len(p.input) && isSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+// peekWord returns the run of non-space characters at the current position.
+func (p *searchParser) peekWord() string {
+	end := p.pos
+	for end < len(p.input) && !isSpace(p.input[end]) && p.input[end] != '(' && p.input[end] != ')' {
+		end++
+	}
+	return p.input[p.pos:end]
+}
+
+// parseTerm parses free text or a filter such as `key:value`. An unmatched
+// closing parenthesis ends the term.
+func (p *searchParser) parseTerm() error {
+	start := p.pos
+
+	if p.input[p.pos] == '"' {
+		return p.parseQuoted()
+	}
+
+	// The key of a filter may be an aggregate such as `count():>10` or a tag
+	// such as `tags[foo:bar]:baz`.
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		switch {
+		case isSpace(c):
+			return nil
+		case c == ')':
+			return nil
+		case c == '(':
+			if p.pos == start || !isKeyChar(p.input[p.pos-1]) {
+				return syntaxErrorf(p.pos, "unexpected opening parenthesis")
+			}
+			if err := p.skipUntil(')', "unclosed parenthesis"); err != nil {
+				return err
+			}
+		case c == '[':
+			if err := p.skipUntil(']', "unclosed bracket"); err != nil {
+				return err
+			}
+		case c == '"':
+			return syntaxErrorf(p.pos, "unexpected quote")
+		case c == ':':
+			return p.parseFilter(start)
+		default:
+			p.pos++
+		}
+	}
+	return nil
+}
+
+// skipUntil moves past the closing character matching the opening character
+// at the current position.
+func (p *searchParser) skipUntil(closing byte, message string) error {
+	start := p.pos
+	end := strings.IndexByte(p.input[p.pos:], closing)
+	if end == -1 {
+		return syntaxErrorf(start, "%s", message)
+	}
+	p.pos += end + 1
+	return nil
+}
+
+func (p *searchParser) parseFilter(start int) error {
+	key := strings.TrimPrefix(p.input[start:p.pos], "!")
+	if key == "" {
+		return syntaxErrorf(start, "missing filter key")
+	}
+	if !isKeyStart(key[0]) {
+		return syntaxErrorf(start, "invalid filter key %q", key)
+	}
+	p.pos++ // ':'
+
+	operator := ""
+	for _, op := range searchOperators {
+		if strings.HasPrefix(p.input[p.pos:], op) {
+			operator = op
+			p.pos += len(op)
+			break
+		}
+	}
+
+	valueStart := p.pos
+	if p.pos >= len(p.input) || isSpace(p.input[p.pos]) || p.input[p.pos] == ')' {
+		return syntaxErrorf(valueStart, "missing value for filter %q, use %s:\"\" to match an empty value", key, key)
+	}
+
+	switch p.input[p.pos] {
+	case '"':
+		return p.parseQuoted()
+	case '[':
+		if operator != "" {
+			return syntaxErrorf(valueStart, "comparison operator %q cannot be used with a list", operator)
+		}
+		return p.parseList()
+	}
+
+	// Values may contain balanced parentheses, as in `message:foo(bar)`.
+	depth := 0
+	for p.pos < len(p.input) && !isSpace(p.input[p.pos]) {
+		switch p.input[p.pos] {
+		case '"':
+			return syntaxErrorf(p.pos, "unexpected quote")
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return nil
+			}
+			depth--
+		}
+		p.pos++
+	}
+	return nil
+}
+
+func (p *searchParser) parseQuoted() error {
+	start := p.pos
+	p.pos++ // '"'
+	for p.pos < len(p.input) {
+		switch p.input[p.pos] {
+		case '\\':
+			p.pos += 2
+		case '"':
+			p.pos++
+			if p.pos < len(p.input) && !isSpace(p.input[p.pos]) && p.input[p.pos] != ')' {
+				return syntaxErrorf(p.pos, "unexpected character after quoted string")
+			}
+			return nil
+		default:
+			p.pos++
+		}
+	}
+	return syntaxErrorf(start, "unterminated quoted string")
+}
+
+// parseList parses a list value such as `[a, "b c"]`.
+func (p *searchParser) parseList() error {
+	start := p.pos
+	p.pos++ // '['
+
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.input) {
+			return syntaxErrorf(start, "unclosed list")
+		}
+
+		itemStart := p.pos
+		if p.input[p.pos] == '"' {
+			end, err := p.quotedEnd()
+			if err != nil {
+				return err
+			}
+			p.pos = end
+		} else {
+			for p.pos < len(p.input) && p.input[p.pos] != ',' && p.input[p.pos] != ']' {
+				p.pos++
+			}
+			if strings.TrimSpace(p.input[itemStart:p.pos]) == "" {
+				return syntaxErrorf(itemStart, "empty list item")
+			}
+		}
+
+		p.skipSpace()
+		if p.pos >= len(p.input) {
+			return syntaxErrorf(start, "unclosed list")
+		}
+		switch p.input[p.pos] {
+		case ',':
+			p.pos++
+		case ']':
+			p.pos++
+			if p.pos < len(p.input) && !isSpace(p.input[p.pos]) && p.input[p.pos] != ')' {
+				return syntaxErrorf(p.pos, "unexpected character after list")
+			}
+			return nil
+		default:
+			return syntaxErrorf(p.pos, "expected a comma or the end of the list")
+		}
+	}
+}
+
+// quotedEnd returns the offset after the quoted string at the current
+// position.
+func (p *searchParser) quotedEnd() (int, error) {
+	for i := p.pos + 1; i < len(p.input); i++ {
+		switch p.input[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1, nil
+		}
+	}
+	return 0, syntaxErrorf(p.pos, "unterminated quoted string")
+}
+
+func isSpace(c byte) bool {
+	return unicode.IsSpace(rune(c))
+}
+
+func isKeyStart(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isKeyChar(c byte) bool {
+	return c == '_' || c == '.' || c == '-' || c == ']' || c == ')' ||
+		('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
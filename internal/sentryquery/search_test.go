@@ -0,0 +1,75 @@
+package sentryquery
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateSearchQuery(t *testing.T) {
+	testCases := []struct {
+		query   string
+		wantErr string
+		offset  int
+	}{
+		{query: ""},
+		{query: "is:unresolved"},
+		{query: "is:unresolved level:error"},
+		{query: "http.url:http://testservice.com/stats"},
+		{query: `!browser:"Firefox 120" event.type:transaction`},
+		{query: `message:"with \"escaped\" quotes"`},
+		{query: "transaction.duration:>=100ms"},
+		{query: "count():>10 p95(transaction.duration):<1s"},
+		{query: "tags[foo:bar]:baz"},
+		{query: `release:[1.0, "2.0 beta", 3.0]`},
+		{query: "(level:error OR level:fatal) AND environment:production"},
+		{query: "((a OR b) c)"},
+		{query: "free text search"},
+		{query: `"quoted free text"`},
+		{query: "has:user !has:release"},
+		{query: "user.email:*@example.com"},
+		{query: "message:foo(bar) level:error"},
+		{query: "level:", wantErr: `missing value for filter "level"`, offset: 6},
+		{query: "level:> x", wantErr: `missing value for filter "level"`, offset: 7},
+		{query: "(level:)", wantErr: `missing value for filter "level"`, offset: 7},
+		{query: `message:"unterminated`, wantErr: "unterminated quoted string", offset: 8},
+		{query: `message:"a"b`, wantErr: "unexpected character after quoted string", offset: 11},
+		{query: `message:a"b"`, wantErr: "unexpected quote", offset: 9},
+		{query: "release:[1.0, 2.0", wantErr: "unclosed list", offset: 8},
+		{query: "release:[1.0,, 2.0]", wantErr: "empty list item", offset: 13},
+		{query: "release:>[1.0]", wantErr: `comparison operator ">" cannot be used with a list`, offset: 9},
+		{query: ":value", wantErr: "missing filter key", offset: 0},
+		{query: "1abc:value", wantErr: `invalid filter key "1abc"`, offset: 0},
+		{query: "(level:error", wantErr: "unclosed parenthesis", offset: 0},
+		{query: "level:error)", wantErr: "unexpected closing parenthesis", offset: 11},
+		{query: "()", wantErr: "empty parentheses", offset: 0},
+		{query: "OR level:error", wantErr: "OR must follow a search term", offset: 0},
+		{query: "level:error AND", wantErr: "AND must be followed by a search term", offset: 12},
+		{query: "a AND OR b", wantErr: "OR must follow a search term", offset: 6},
+		{query: "(a OR)", wantErr: "OR must be followed by a search term", offset: 3},
+		{query: "count(:>10", wantErr: "unclosed parenthesis", offset: 5},
+		{query: "tags[foo:bar", wantErr: "unclosed bracket", offset: 4},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			err := ValidateSearchQuery(tc.query)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("expected a syntax error, got %v", err)
+			}
+			if !strings.Contains(syntaxErr.Message, tc.wantErr) {
+				t.Errorf("error = %q, want %q", syntaxErr.Message, tc.wantErr)
+			}
+			if syntaxErr.Offset != tc.offset {
+				t.Errorf("offset = %d, want %d", syntaxErr.Offset, tc.offset)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package sentryquery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// SearchQueryValidator returns a validator which ensures that a string is a
+// valid event search query.
+func SearchQueryValidator() validator.String {
+	return stringValidator{
+		description: "value must be a valid event search query",
+		validate:    ValidateSearchQuery,
+		summary:     "Invalid search query",
+	}
+}
+
+// AggregateValidator returns a validator which ensures that a string is a
+// valid aggregate or equation of a dashboard widget query.
+func AggregateValidator() validator.String {
+	return stringValidator{
+		description: "value must be a valid aggregate function call",
+		validate:    ValidateAggregate,
+		summary:     "Invalid aggregate",
+	}
+}
+
+// AlertAggregateValidator returns a validator which ensures that a string is
+// a valid aggregate of a metric alert or monitor. Functions unknown to the
+// provider are reported as a warning.
+func AlertAggregateValidator() validator.String {
+	return stringValidator{
+		description: "value must be a valid aggregate function call supported by metric alerts",
+		validate:    ValidateAlertAggregate,
+		summary:     "Invalid aggregate",
+	}
+}
+
+var _ validator.String = stringValidator{}
+
+type stringValidator struct {
+	description string
+	validate    func(string) error
+	summary     string
+}
+
+func (v stringValidator) Description(ctx context.Context) string {
+	return v.description
+}
+
+func (v stringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.description
+}
+
+func (v stringValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	err := v.validate(req.ConfigValue.ValueString())
+
+	var unknownFunctionErr *UnknownFunctionError
+	if errors.As(err, &unknownFunctionErr) {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Unknown aggregate function",
+			fmt.Sprintf("Attribute %s uses an %s.", req.Path, err),
+		)
+	} else if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			v.summary,
+			fmt.Sprintf("Attribute %s %s, got %s: %s.", req.Path, v.description, req.ConfigValue, err),
+		)
+	}
+}
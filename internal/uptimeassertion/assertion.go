@@ -0,0 +1,357 @@
+// Package uptimeassertion evaluates uptime monitor assertions, as built by the
+// provider's `assertion` and `op_*` functions, against sample HTTP responses.
+//
+// An assertion is a tree of operations:
+//
+//   - `and`, `or` and `not` combine other operations. An empty `and` passes
+//     and an empty `or` fails.
+//   - `status_code_check` compares the status code with a number.
+//   - `header_check` passes when any header has a name matching the key
+//     comparison and a value matching the value comparison. Header names are
+//     compared case-insensitively.
+//   - `json_path` selects values from the JSON body and passes when any of
+//     them matches the comparison. It fails when the body is not JSON or
+//     nothing is selected.
+//
+// Comparisons against literals use `equals`, `not_equal`, `less_than` and
+// `greater_than`, comparing numerically when both sides are numbers.
+// Comparisons against glob patterns only support `equals` and `not_equal`.
+// `always` and `never` ignore the operand.
+package uptimeassertion
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
+)
+
+// Response is the HTTP response an assertion is evaluated against.
+type Response struct {
+	StatusCode int
+	Headers    http.Header
+	Body       []byte
+}
+
+// Assertion is a parsed uptime assertion.
+type Assertion struct {
+	root node
+}
+
+// Parse parses an assertion, either as returned by the `assertion` function
+// with a `root` operation or as a single operation.
+func Parse(data []byte) (*Assertion, error) {
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(data, &top); err != nil {
+		return nil, fmt.Errorf("invalid assertion: %w", err)
+	}
+
+	if root, ok := top["root"]; ok {
+		if err := sentrydata.ValidateJSONUptimeAssertionForDefinition("Assertion", data); err != nil {
+			return nil, err
+		}
+		data = root
+	} else if err := sentrydata.ValidateJSONUptimeAssertionForDefinition("Op", data); err != nil {
+		return nil, err
+	}
+
+	root, err := parseOp(data)
+	if err != nil {
+		return nil, err
+	}
+	return &Assertion{root: root}, nil
+}
+
+// Evaluate reports whether the response passes the assertion.
+func (a *Assertion) Evaluate(resp Response) bool {
+	return a.root.evaluate(&evaluation{resp: resp})
+}
+
+// evaluation holds the response and its JSON body, which is decoded once.
+type evaluation struct {
+	resp Response
+
+	bodyDecoded bool
+	body        any
+	bodyErr     error
+}
+
+func (e *evaluation) jsonBody() (any, error) {
+	if !e.bodyDecoded {
+		e.bodyDecoded = true
+		e.bodyErr = json.Unmarshal(e.resp.Body, &e.body)
+	}
+	return e.body, e.bodyErr
+}
+
+type node interface {
+	evaluate(e *evaluation) bool
+}
+
+type rawOp struct {
+	Op           string            `json:"op"`
+	Children     []json.RawMessage `json:"children"`
+	Operand      json.RawMessage   `json:"operand"`
+	Operator     comparison        `json:"operator"`
+	Value        json.RawMessage   `json:"value"`
+	KeyOp        comparison        `json:"key_op"`
+	KeyOperand   rawOperand        `json:"key_operand"`
+	ValueOp      comparison        `json:"value_op"`
+	ValueOperand rawOperand        `json:"value_operand"`
+}
+
+type comparison struct {
+	Cmp string `json:"cmp"`
+}
+
+type rawOperand struct {
+	HeaderOp   string `json:"header_op"`
+	JSONPathOp string `json:"jsonpath_op"`
+	Value      string `json:"value"`
+	Pattern    struct {
+		Value string `json:"value"`
+	} `json:"pattern"`
+}
+
+func parseOp(data []byte) (node, error) {
+	var op rawOp
+	if err := json.Unmarshal(data, &op); err != nil {
+		return nil, fmt.Errorf("invalid operation: %w", err)
+	}
+
+	switch op.Op {
+	case "and", "or":
+		children := make([]node, 0, len(op.Children))
+		for _, child := range op.Children {
+			n, err := parseOp(child)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, n)
+		}
+		if op.Op == "and" {
+			return andNode(children), nil
+		}
+		return orNode(children), nil
+
+	case "not":
+		operand, err := parseOp(op.Operand)
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+
+	case "status_code_check":
+		var value int
+		if err := json.Unmarshal(op.Value, &value); err != nil {
+			return nil, fmt.Errorf("invalid status code: %w", err)
+		}
+		return statusCodeNode{cmp: op.Operator.Cmp, value: value}, nil
+
+	case "header_check":
+		key, err := newMatcher(op.KeyOp.Cmp, op.KeyOperand.HeaderOp, op.KeyOperand)
+		if err != nil {
+			return nil, fmt.Errorf("invalid header key: %w", err)
+		}
+		key.ignoreCase = true
+		value, err := newMatcher(op.ValueOp.Cmp, op.ValueOperand.HeaderOp, op.ValueOperand)
+		if err != nil {
+			return nil, fmt.Errorf("invalid header value: %w", err)
+		}
+		return headerCheckNode{key: key, value: value}, nil
+
+	case "json_path":
+		var expr string
+		if err := json.Unmarshal(op.Value, &expr); err != nil {
+			return nil, fmt.Errorf("invalid JSONPath: %w", err)
+		}
+		path, err := parseJSONPath(expr)
+		if err != nil {
+			return nil, err
+		}
+
+		var operand rawOperand
+		if err := json.Unmarshal(op.Operand, &operand); err != nil {
+			return nil, fmt.Errorf("invalid JSONPath operand: %w", err)
+		}
+		value, err := newMatcher(op.Operator.Cmp, operand.JSONPathOp, operand)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSONPath operand: %w", err)
+		}
+		return jsonPathNode{path: path, value: value}, nil
+	}
+
+	return nil, fmt.Errorf("unknown operation %q", op.Op)
+}
+
+type andNode []node
+
+func (n andNode) evaluate(e *evaluation) bool {
+	for _, child := range n {
+		if !child.evaluate(e) {
+			return false
+		}
+	}
+	return true
+}
+
+type orNode []node
+
+func (n orNode) evaluate(e *evaluation) bool {
+	for _, child := range n {
+		if child.evaluate(e) {
+			return true
+		}
+	}
+	return false
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n notNode) evaluate(e *evaluation) bool {
+	return !n.operand.evaluate(e)
+}
+
+type statusCodeNode struct {
+	cmp   string
+	value int
+}
+
+func (n statusCodeNode) evaluate(e *evaluation) bool {
+	switch n.cmp {
+	case "equals":
+		return e.resp.StatusCode == n.value
+	case "not_equal":
+		return e.resp.StatusCode != n.value
+	case "less_than":
+		return e.resp.StatusCode < n.value
+	case "greater_than":
+		return e.resp.StatusCode > n.value
+	case "always":
+		return true
+	}
+	return false
+}
+
+type headerCheckNode struct {
+	key, value matcher
+}
+
+func (n headerCheckNode) evaluate(e *evaluation) bool {
+	for key, values := range e.resp.Headers {
+		if !n.key.match(key) {
+			continue
+		}
+		for _, value := range values {
+			if n.value.match(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type jsonPathNode struct {
+	path  *jsonPath
+	value matcher
+}
+
+func (n jsonPathNode) evaluate(e *evaluation) bool {
+	body, err := e.jsonBody()
+	if err != nil {
+		return false
+	}
+
+	for _, v := range n.path.evaluate(body) {
+		if n.value.match(jsonString(v)) {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonString returns strings as they are and other JSON values as JSON.
+func jsonString(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// matcher compares strings with a literal or glob operand.
+type matcher struct {
+	cmp        string
+	glob       bool
+	value      string
+	ignoreCase bool
+}
+
+func newMatcher(cmp string, kind string, operand rawOperand) (matcher, error) {
+	switch kind {
+	case "literal":
+		return matcher{cmp: cmp, value: operand.Value}, nil
+	case "glob":
+		if cmp != "equals" && cmp != "not_equal" && cmp != "always" && cmp != "never" {
+			return matcher{}, fmt.Errorf("the %s comparison cannot be used with a glob pattern", cmp)
+		}
+		if err := validateGlob(operand.Pattern.Value); err != nil {
+			return matcher{}, err
+		}
+		return matcher{cmp: cmp, glob: true, value: operand.Pattern.Value}, nil
+	}
+	return matcher{}, fmt.Errorf("unknown operand type %q", kind)
+}
+
+func (m matcher) match(s string) bool {
+	value := m.value
+	if m.ignoreCase {
+		s, value = strings.ToLower(s), strings.ToLower(value)
+	}
+
+	switch m.cmp {
+	case "always":
+		return true
+	case "never":
+		return false
+	}
+
+	if m.glob {
+		return matchGlob(value, s) == (m.cmp == "equals")
+	}
+
+	switch m.cmp {
+	case "equals":
+		return compareValues(s, value) == 0
+	case "not_equal":
+		return compareValues(s, value) != 0
+	case "less_than":
+		return compareValues(s, value) < 0
+	case "greater_than":
+		return compareValues(s, value) > 0
+	}
+	return false
+}
+
+var numberRegexp = regexp.MustCompile(`^-?\d+(\.\d+)?([eE][+-]?\d+)?$`)
+
+// compareValues compares two strings numerically when both are numbers.
+func compareValues(a, b string) int {
+	if numberRegexp.MatchString(a) && numberRegexp.MatchString(b) {
+		an, aErr := strconv.ParseFloat(a, 64)
+		bn, bErr := strconv.ParseFloat(b, 64)
+		if aErr == nil && bErr == nil {
+			return compareFloat(an, bn)
+		}
+	}
+	return strings.Compare(a, b)
+}
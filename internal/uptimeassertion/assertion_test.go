@@ -0,0 +1,165 @@
+package uptimeassertion
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAssertion_Evaluate(t *testing.T) {
+	healthy := Response{
+		StatusCode: 200,
+		Headers: http.Header{
+			"Content-Type": {"application/json; charset=utf-8"},
+			"X-Version":    {"1.2.3"},
+		},
+		Body: []byte(`{"status": "ok", "checks": [{"name": "db", "latency": 12}, {"name": "cache", "latency": 3}]}`),
+	}
+	unhealthy := Response{
+		StatusCode: 503,
+		Headers: http.Header{
+			"Content-Type": {"text/html"},
+		},
+		Body: []byte(`<html>Service Unavailable</html>`),
+	}
+
+	testCases := []struct {
+		name          string
+		assertion     string
+		wantHealthy   bool
+		wantUnhealthy bool
+	}{
+		{
+			name:          "status code range",
+			assertion:     `{"root":{"op":"and","children":[{"op":"status_code_check","operator":{"cmp":"greater_than"},"value":199},{"op":"status_code_check","operator":{"cmp":"less_than"},"value":300}]}}`,
+			wantHealthy:   true,
+			wantUnhealthy: false,
+		},
+		{
+			name:          "status code not equal",
+			assertion:     `{"op":"status_code_check","operator":{"cmp":"not_equal"},"value":503}`,
+			wantHealthy:   true,
+			wantUnhealthy: false,
+		},
+		{
+			name:          "always",
+			assertion:     `{"op":"status_code_check","operator":{"cmp":"always"},"value":0}`,
+			wantHealthy:   true,
+			wantUnhealthy: true,
+		},
+		{
+			name:          "never",
+			assertion:     `{"op":"status_code_check","operator":{"cmp":"never"},"value":0}`,
+			wantHealthy:   false,
+			wantUnhealthy: false,
+		},
+		{
+			name:          "or",
+			assertion:     `{"op":"or","children":[{"op":"status_code_check","operator":{"cmp":"equals"},"value":503},{"op":"status_code_check","operator":{"cmp":"equals"},"value":200}]}`,
+			wantHealthy:   true,
+			wantUnhealthy: true,
+		},
+		{
+			name:          "empty and",
+			assertion:     `{"op":"and","children":[]}`,
+			wantHealthy:   true,
+			wantUnhealthy: true,
+		},
+		{
+			name:          "empty or",
+			assertion:     `{"op":"or","children":[]}`,
+			wantHealthy:   false,
+			wantUnhealthy: false,
+		},
+		{
+			name:          "not",
+			assertion:     `{"op":"not","operand":{"op":"status_code_check","operator":{"cmp":"equals"},"value":503}}`,
+			wantHealthy:   true,
+			wantUnhealthy: false,
+		},
+		{
+			name:          "header glob",
+			assertion:     `{"op":"header_check","key_op":{"cmp":"equals"},"key_operand":{"header_op":"literal","value":"content-type"},"value_op":{"cmp":"equals"},"value_operand":{"header_op":"glob","pattern":{"value":"application/json*"}}}`,
+			wantHealthy:   true,
+			wantUnhealthy: false,
+		},
+		{
+			name:          "header not glob",
+			assertion:     `{"op":"header_check","key_op":{"cmp":"equals"},"key_operand":{"header_op":"glob","pattern":{"value":"CONTENT-*"}},"value_op":{"cmp":"not_equal"},"value_operand":{"header_op":"glob","pattern":{"value":"*json*"}}}`,
+			wantHealthy:   false,
+			wantUnhealthy: true,
+		},
+		{
+			name:          "header present",
+			assertion:     `{"op":"header_check","key_op":{"cmp":"equals"},"key_operand":{"header_op":"literal","value":"X-Version"},"value_op":{"cmp":"always"},"value_operand":{"header_op":"literal","value":""}}`,
+			wantHealthy:   true,
+			wantUnhealthy: false,
+		},
+		{
+			name:          "jsonpath literal",
+			assertion:     `{"op":"json_path","operand":{"jsonpath_op":"literal","value":"ok"},"operator":{"cmp":"equals"},"value":"$.status"}`,
+			wantHealthy:   true,
+			wantUnhealthy: false,
+		},
+		{
+			name:          "jsonpath glob",
+			assertion:     `{"op":"json_path","operand":{"jsonpath_op":"glob","pattern":{"value":"o*"}},"operator":{"cmp":"equals"},"value":"$.status"}`,
+			wantHealthy:   true,
+			wantUnhealthy: false,
+		},
+		{
+			name:          "jsonpath numeric",
+			assertion:     `{"op":"json_path","operand":{"jsonpath_op":"literal","value":"10"},"operator":{"cmp":"greater_than"},"value":"$.checks[*].latency"}`,
+			wantHealthy:   true,
+			wantUnhealthy: false,
+		},
+		{
+			name:          "jsonpath filter",
+			assertion:     `{"op":"json_path","operand":{"jsonpath_op":"literal","value":"3"},"operator":{"cmp":"equals"},"value":"$.checks[?(@.name == 'cache')].latency"}`,
+			wantHealthy:   true,
+			wantUnhealthy: false,
+		},
+		{
+			name:          "jsonpath nothing selected",
+			assertion:     `{"op":"json_path","operand":{"jsonpath_op":"literal","value":"ok"},"operator":{"cmp":"not_equal"},"value":"$.missing"}`,
+			wantHealthy:   false,
+			wantUnhealthy: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := Parse([]byte(tc.assertion))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := a.Evaluate(healthy); got != tc.wantHealthy {
+				t.Errorf("Evaluate(healthy) = %v, want %v", got, tc.wantHealthy)
+			}
+			if got := a.Evaluate(unhealthy); got != tc.wantUnhealthy {
+				t.Errorf("Evaluate(unhealthy) = %v, want %v", got, tc.wantUnhealthy)
+			}
+		})
+	}
+}
+
+func TestParse_invalid(t *testing.T) {
+	testCases := []struct {
+		assertion string
+		wantErr   string
+	}{
+		{`not json`, "invalid assertion"},
+		{`{"root":{"op":"bogus"}}`, "validating root"},
+		{`{"op":"status_code_check","operator":{"cmp":"equals"}}`, "validating root"},
+		{`{"op":"json_path","operand":{"jsonpath_op":"literal","value":"ok"},"operator":{"cmp":"equals"},"value":"status"}`, "expected the expression to start with $"},
+		{`{"op":"json_path","operand":{"jsonpath_op":"glob","pattern":{"value":"o*"}},"operator":{"cmp":"less_than"},"value":"$.status"}`, "the less_than comparison cannot be used with a glob pattern"},
+		{`{"op":"header_check","key_op":{"cmp":"equals"},"key_operand":{"header_op":"glob","pattern":{"value":"[a"}},"value_op":{"cmp":"always"},"value_operand":{"header_op":"literal","value":""}}`, "unclosed character class"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.assertion, func(t *testing.T) {
+			_, err := Parse([]byte(tc.assertion))
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("error = %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
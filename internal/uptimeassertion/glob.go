@@ -0,0 +1,140 @@
+package uptimeassertion
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// validateGlob reports whether pattern is a well-formed glob pattern.
+func validateGlob(pattern string) error {
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			if i+1 == len(pattern) {
+				return fmt.Errorf("glob pattern %q ends with an escape character", pattern)
+			}
+			i++
+		case '[':
+			end := classEnd(pattern, i)
+			if end < 0 {
+				return fmt.Errorf("glob pattern %q has an unclosed character class", pattern)
+			}
+			i = end
+		}
+	}
+	return nil
+}
+
+// matchGlob reports whether s matches the glob pattern, where `*` matches any
+// sequence of characters, `?` matches a single character, `[...]` and `[!...]`
+// match a character in or not in a set or range, and `\` escapes the next
+// character. Unlike path.Match, `*` also matches `/`.
+func matchGlob(pattern, s string) bool {
+	// On a mismatch, backtrack to the last `*` and let it consume one more
+	// character.
+	var px, sx int
+	starPx, starSx := -1, -1
+	for px < len(pattern) || sx < len(s) {
+		if px < len(pattern) {
+			switch c := pattern[px]; c {
+			case '*':
+				starPx, starSx = px, sx
+				px++
+				continue
+			case '?':
+				if sx < len(s) {
+					_, n := utf8.DecodeRuneInString(s[sx:])
+					px++
+					sx += n
+					continue
+				}
+			case '[':
+				if sx < len(s) {
+					r, n := utf8.DecodeRuneInString(s[sx:])
+					end := classEnd(pattern, px)
+					if end >= 0 && matchClass(pattern[px+1:end], r) {
+						px = end + 1
+						sx += n
+						continue
+					}
+				}
+			default:
+				if c == '\\' && px+1 < len(pattern) {
+					c = pattern[px+1]
+					if sx < len(s) && s[sx] == c {
+						px += 2
+						sx++
+						continue
+					}
+				} else if sx < len(s) && s[sx] == c {
+					px++
+					sx++
+					continue
+				}
+			}
+		}
+		if starPx >= 0 && starSx < len(s) {
+			_, n := utf8.DecodeRuneInString(s[starSx:])
+			starSx += n
+			px, sx = starPx+1, starSx
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// classEnd returns the index of the `]` closing the character class starting
+// at pattern[start], or -1 if it is not closed.
+func classEnd(pattern string, start int) int {
+	i := start + 1
+	if i < len(pattern) && pattern[i] == '!' {
+		i++
+	}
+	// A `]` right after the opening bracket is part of the set.
+	if i < len(pattern) && pattern[i] == ']' {
+		i++
+	}
+	for ; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			i++
+		case ']':
+			return i
+		}
+	}
+	return -1
+}
+
+// matchClass reports whether r is in the character class, given without its
+// brackets.
+func matchClass(class string, r rune) bool {
+	negate := false
+	if len(class) > 0 && class[0] == '!' {
+		negate = true
+		class = class[1:]
+	}
+
+	matched := false
+	for i := 0; i < len(class); {
+		lo, n := classRune(class[i:])
+		i += n
+		hi := lo
+		if i+1 < len(class) && class[i] == '-' {
+			hi, n = classRune(class[i+1:])
+			i += 1 + n
+		}
+		if lo <= r && r <= hi {
+			matched = true
+		}
+	}
+	return matched != negate
+}
+
+func classRune(s string) (rune, int) {
+	if s[0] == '\\' && len(s) > 1 {
+		r, n := utf8.DecodeRuneInString(s[1:])
+		return r, n + 1
+	}
+	return utf8.DecodeRuneInString(s)
+}
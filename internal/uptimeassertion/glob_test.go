@@ -0,0 +1,50 @@
+package uptimeassertion
+
+import "testing"
+
+func TestMatchGlob(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		s       string
+		want    bool
+	}{
+		{"", "", true},
+		{"", "a", false},
+		{"*", "", true},
+		{"*", "text/html; charset=utf-8", true},
+		{"text/*", "text/html", true},
+		{"*html*", "text/html; charset=utf-8", true},
+		{"application/*", "text/html", false},
+		{"a?c", "abc", true},
+		{"a?c", "ac", false},
+		{"a*b*c", "aXXbYYc", true},
+		{"a*b*c", "aXXbYY", false},
+		{"[abc]x", "bx", true},
+		{"[!abc]x", "bx", false},
+		{"[a-z]1", "q1", true},
+		{"[a-z]1", "Q1", false},
+		{"[]]", "]", true},
+		{`\*`, "*", true},
+		{`\*`, "a", false},
+		{"héllo*", "héllo wörld", true},
+		{"h?llo", "héllo", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.pattern+"/"+tc.s, func(t *testing.T) {
+			if err := validateGlob(tc.pattern); err != nil {
+				t.Fatal(err)
+			}
+			if got := matchGlob(tc.pattern, tc.s); got != tc.want {
+				t.Errorf("matchGlob(%q, %q) = %v, want %v", tc.pattern, tc.s, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateGlob_invalid(t *testing.T) {
+	for _, pattern := range []string{"[abc", `abc\`, "[!"} {
+		if err := validateGlob(pattern); err == nil {
+			t.Errorf("%q: expected an error", pattern)
+		}
+	}
+}
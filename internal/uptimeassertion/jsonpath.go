@@ -0,0 +1,659 @@
+package uptimeassertion
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// jsonPath is a compiled JSONPath expression. It supports the root `$`, child
+// names (`.name`, `['name']`), wildcards (`.*`, `[*]`), recursive descent
+// (`..`), indexes including negative ones, slices (`[start:end:step]`), unions
+// (`[0,1]`) and filters such as `[?(@.status == 'ok' && @.count > 0)]`.
+type jsonPath struct {
+	segments []pathSegment
+}
+
+type pathSegment struct {
+	// recursive is true for `..` segments, which apply the selectors to the
+	// node and all of its descendants.
+	recursive bool
+	selectors []pathSelector
+}
+
+type pathSelector interface {
+	selectNodes(node any, root any, out []any) []any
+}
+
+type nameSelector string
+
+func (s nameSelector) selectNodes(node any, root any, out []any) []any {
+	if obj, ok := node.(map[string]any); ok {
+		if v, ok := obj[string(s)]; ok {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+type wildcardSelector struct{}
+
+func (wildcardSelector) selectNodes(node any, root any, out []any) []any {
+	return append(out, children(node)...)
+}
+
+type indexSelector int
+
+func (s indexSelector) selectNodes(node any, root any, out []any) []any {
+	if arr, ok := node.([]any); ok {
+		i := int(s)
+		if i < 0 {
+			i += len(arr)
+		}
+		if i >= 0 && i < len(arr) {
+			out = append(out, arr[i])
+		}
+	}
+	return out
+}
+
+type sliceSelector struct {
+	start, end *int
+	step       int
+}
+
+func (s sliceSelector) selectNodes(node any, root any, out []any) []any {
+	arr, ok := node.([]any)
+	if !ok || s.step == 0 {
+		return out
+	}
+
+	normalize := func(i int) int {
+		if i < 0 {
+			return i + len(arr)
+		}
+		return i
+	}
+
+	if s.step > 0 {
+		start, end := 0, len(arr)
+		if s.start != nil {
+			start = min(max(normalize(*s.start), 0), len(arr))
+		}
+		if s.end != nil {
+			end = min(max(normalize(*s.end), 0), len(arr))
+		}
+		for i := start; i < end; i += s.step {
+			out = append(out, arr[i])
+		}
+	} else {
+		start, end := len(arr)-1, -1
+		if s.start != nil {
+			start = min(max(normalize(*s.start), -1), len(arr)-1)
+		}
+		if s.end != nil {
+			end = min(max(normalize(*s.end), -1), len(arr)-1)
+		}
+		for i := start; i > end; i += s.step {
+			out = append(out, arr[i])
+		}
+	}
+	return out
+}
+
+type filterSelector struct {
+	expr filterExpr
+}
+
+func (s filterSelector) selectNodes(node any, root any, out []any) []any {
+	for _, child := range children(node) {
+		if s.expr.test(child, root) {
+			out = append(out, child)
+		}
+	}
+	return out
+}
+
+// children returns the elements of an array or the values of an object, in
+// key order.
+func children(node any) []any {
+	switch node := node.(type) {
+	case []any:
+		return node
+	case map[string]any:
+		out := make([]any, 0, len(node))
+		for _, k := range slices.Sorted(maps.Keys(node)) {
+			out = append(out, node[k])
+		}
+		return out
+	}
+	return nil
+}
+
+// descendants returns the node followed by all of its descendants.
+func descendants(node any, out []any) []any {
+	out = append(out, node)
+	for _, child := range children(node) {
+		out = descendants(child, out)
+	}
+	return out
+}
+
+// evaluate returns the nodes of the document selected by the expression.
+func (p *jsonPath) evaluate(root any) []any {
+	return evaluateSegments(p.segments, root, root)
+}
+
+func evaluateSegments(segments []pathSegment, node any, root any) []any {
+	nodes := []any{node}
+	for _, segment := range segments {
+		var out []any
+		for _, node := range nodes {
+			targets := []any{node}
+			if segment.recursive {
+				targets = descendants(node, nil)
+			}
+			for _, target := range targets {
+				for _, selector := range segment.selectors {
+					out = selector.selectNodes(target, root, out)
+				}
+			}
+		}
+		nodes = out
+	}
+	return nodes
+}
+
+// filterExpr is a boolean expression of a filter selector.
+type filterExpr interface {
+	test(node any, root any) bool
+}
+
+type orExpr []filterExpr
+
+func (e orExpr) test(node any, root any) bool {
+	for _, expr := range e {
+		if expr.test(node, root) {
+			return true
+		}
+	}
+	return false
+}
+
+type andExpr []filterExpr
+
+func (e andExpr) test(node any, root any) bool {
+	for _, expr := range e {
+		if !expr.test(node, root) {
+			return false
+		}
+	}
+	return true
+}
+
+type notExpr struct {
+	expr filterExpr
+}
+
+func (e notExpr) test(node any, root any) bool {
+	return !e.expr.test(node, root)
+}
+
+// existsExpr is true when the path selects at least one node.
+type existsExpr struct {
+	path filterValue
+}
+
+func (e existsExpr) test(node any, root any) bool {
+	_, ok := e.path.resolve(node, root)
+	return ok
+}
+
+type comparisonExpr struct {
+	left, right filterValue
+	operator    string
+}
+
+func (e comparisonExpr) test(node any, root any) bool {
+	left, leftOk := e.left.resolve(node, root)
+	right, rightOk := e.right.resolve(node, root)
+
+	switch e.operator {
+	case "==":
+		return leftOk == rightOk && (!leftOk || reflect.DeepEqual(left, right))
+	case "!=":
+		return leftOk != rightOk || (leftOk && !reflect.DeepEqual(left, right))
+	}
+
+	if !leftOk || !rightOk {
+		return false
+	}
+
+	var cmp int
+	switch left := left.(type) {
+	case float64:
+		right, ok := right.(float64)
+		if !ok {
+			return false
+		}
+		cmp = compareFloat(left, right)
+	case string:
+		right, ok := right.(string)
+		if !ok {
+			return false
+		}
+		cmp = strings.Compare(left, right)
+	default:
+		return false
+	}
+
+	switch e.operator {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// filterValue is a literal or a path in a filter expression.
+type filterValue struct {
+	literal any
+
+	// segments is the path relative to the current node (`@`) or, when
+	// fromRoot is true, to the document root (`$`).
+	isPath   bool
+	fromRoot bool
+	segments []pathSegment
+}
+
+// resolve returns the value, which for paths is the first selected node.
+func (v filterValue) resolve(node any, root any) (any, bool) {
+	if !v.isPath {
+		return v.literal, true
+	}
+
+	start := node
+	if v.fromRoot {
+		start = root
+	}
+	nodes := evaluateSegments(v.segments, start, root)
+	if len(nodes) == 0 {
+		return nil, false
+	}
+	return nodes[0], true
+}
+
+// parseJSONPath compiles a JSONPath expression.
+func parseJSONPath(expr string) (*jsonPath, error) {
+	p := &pathParser{s: expr}
+	p.skipSpace()
+	if !p.consume("$") {
+		return nil, p.errorf("expected the expression to start with $")
+	}
+
+	segments, err := p.parseSegments()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	if p.pos < len(p.s) {
+		return nil, p.errorf("unexpected %q", p.s[p.pos:])
+	}
+	return &jsonPath{segments: segments}, nil
+}
+
+type pathParser struct {
+	s   string
+	pos int
+}
+
+func (p *pathParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid JSONPath %q at position %d: %s", p.s, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *pathParser) peek(prefix string) bool {
+	return strings.HasPrefix(p.s[p.pos:], prefix)
+}
+
+func (p *pathParser) consume(prefix string) bool {
+	if p.peek(prefix) {
+		p.pos += len(prefix)
+		return true
+	}
+	return false
+}
+
+func (p *pathParser) skipSpace() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *pathParser) parseSegments() ([]pathSegment, error) {
+	var segments []pathSegment
+	for {
+		switch {
+		case p.consume(".."):
+			segment, err := p.parseDotSegment()
+			if err != nil {
+				return nil, err
+			}
+			segment.recursive = true
+			segments = append(segments, segment)
+		case p.consume("."):
+			segment, err := p.parseDotSegment()
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, segment)
+		case p.peek("["):
+			selectors, err := p.parseBracket()
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, pathSegment{selectors: selectors})
+		default:
+			return segments, nil
+		}
+	}
+}
+
+// parseDotSegment parses the name, wildcard or bracket after `.` or `..`.
+func (p *pathParser) parseDotSegment() (pathSegment, error) {
+	if p.consume("*") {
+		return pathSegment{selectors: []pathSelector{wildcardSelector{}}}, nil
+	}
+	if p.peek("[") {
+		selectors, err := p.parseBracket()
+		return pathSegment{selectors: selectors}, err
+	}
+
+	start := p.pos
+	for p.pos < len(p.s) {
+		r, n := utf8.DecodeRuneInString(p.s[p.pos:])
+		if r != '_' && r != '-' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			break
+		}
+		p.pos += n
+	}
+	if p.pos == start {
+		return pathSegment{}, p.errorf("expected a member name")
+	}
+	return pathSegment{selectors: []pathSelector{nameSelector(p.s[start:p.pos])}}, nil
+}
+
+func (p *pathParser) parseBracket() ([]pathSelector, error) {
+	open := p.pos
+	p.pos++
+
+	var selectors []pathSelector
+	for {
+		p.skipSpace()
+		selector, err := p.parseSelector()
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+
+		p.skipSpace()
+		switch {
+		case p.consume(","):
+		case p.consume("]"):
+			return selectors, nil
+		case p.pos == len(p.s):
+			p.pos = open
+			return nil, p.errorf("unclosed bracket")
+		default:
+			return nil, p.errorf("expected , or ]")
+		}
+	}
+}
+
+func (p *pathParser) parseSelector() (pathSelector, error) {
+	switch {
+	case p.consume("*"):
+		return wildcardSelector{}, nil
+	case p.peek("'") || p.peek(`"`):
+		name, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return nameSelector(name), nil
+	case p.consume("?"):
+		p.skipSpace()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return filterSelector{expr: expr}, nil
+	}
+
+	// An index or a slice.
+	var bounds []*int
+	for {
+		p.skipSpace()
+		var bound *int
+		if p.pos < len(p.s) && (p.s[p.pos] == '-' || isDigit(p.s[p.pos])) {
+			n, err := p.parseInt()
+			if err != nil {
+				return nil, err
+			}
+			bound = &n
+		}
+		bounds = append(bounds, bound)
+
+		p.skipSpace()
+		if !p.consume(":") {
+			break
+		}
+		if len(bounds) == 3 {
+			return nil, p.errorf("too many slice parameters")
+		}
+	}
+
+	if len(bounds) == 1 {
+		if bounds[0] == nil {
+			return nil, p.errorf("expected a selector")
+		}
+		return indexSelector(*bounds[0]), nil
+	}
+
+	slice := sliceSelector{start: bounds[0], end: bounds[1], step: 1}
+	if len(bounds) == 3 && bounds[2] != nil {
+		slice.step = *bounds[2]
+		if slice.step == 0 {
+			return nil, p.errorf("slice step cannot be zero")
+		}
+	}
+	return slice, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func (p *pathParser) parseInt() (int, error) {
+	start := p.pos
+	if p.pos < len(p.s) && p.s[p.pos] == '-' {
+		p.pos++
+	}
+	for p.pos < len(p.s) && isDigit(p.s[p.pos]) {
+		p.pos++
+	}
+	n, err := strconv.Atoi(p.s[start:p.pos])
+	if err != nil {
+		p.pos = start
+		return 0, p.errorf("invalid integer")
+	}
+	return n, nil
+}
+
+func (p *pathParser) parseString() (string, error) {
+	start := p.pos
+	quote := p.s[p.pos]
+	p.pos++
+
+	var b strings.Builder
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		switch {
+		case c == quote:
+			p.pos++
+			return b.String(), nil
+		case c == '\\' && p.pos+1 < len(p.s):
+			b.WriteByte(p.s[p.pos+1])
+			p.pos += 2
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+	p.pos = start
+	return "", p.errorf("unterminated string")
+}
+
+func (p *pathParser) parseOr() (filterExpr, error) {
+	expr, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	exprs := orExpr{expr}
+	for p.skipSpace(); p.consume("||"); p.skipSpace() {
+		expr, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return exprs, nil
+}
+
+func (p *pathParser) parseAnd() (filterExpr, error) {
+	expr, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	exprs := andExpr{expr}
+	for p.skipSpace(); p.consume("&&"); p.skipSpace() {
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return exprs, nil
+}
+
+func (p *pathParser) parseUnary() (filterExpr, error) {
+	p.skipSpace()
+	switch {
+	case p.peek("!") && !p.peek("!="):
+		p.pos++
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr: expr}, nil
+	case p.consume("("):
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.consume(")") {
+			return nil, p.errorf("expected )")
+		}
+		return expr, nil
+	}
+	return p.parseComparison()
+}
+
+var comparisonOperators = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+func (p *pathParser) parseComparison() (filterExpr, error) {
+	left, err := p.parseFilterValue()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	for _, operator := range comparisonOperators {
+		if p.consume(operator) {
+			p.skipSpace()
+			right, err := p.parseFilterValue()
+			if err != nil {
+				return nil, err
+			}
+			return comparisonExpr{left: left, right: right, operator: operator}, nil
+		}
+	}
+
+	if !left.isPath {
+		return nil, p.errorf("expected a comparison operator")
+	}
+	return existsExpr{path: left}, nil
+}
+
+func (p *pathParser) parseFilterValue() (filterValue, error) {
+	switch {
+	case p.peek("@") || p.peek("$"):
+		fromRoot := p.s[p.pos] == '$'
+		p.pos++
+		segments, err := p.parseSegments()
+		if err != nil {
+			return filterValue{}, err
+		}
+		return filterValue{isPath: true, fromRoot: fromRoot, segments: segments}, nil
+	case p.peek("'") || p.peek(`"`):
+		s, err := p.parseString()
+		if err != nil {
+			return filterValue{}, err
+		}
+		return filterValue{literal: s}, nil
+	case p.consume("true"):
+		return filterValue{literal: true}, nil
+	case p.consume("false"):
+		return filterValue{literal: false}, nil
+	case p.consume("null"):
+		return filterValue{literal: nil}, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte("+-.0123456789eE", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+	n, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+	if err != nil || math.IsInf(n, 0) {
+		p.pos = start
+		return filterValue{}, p.errorf("expected a path, string, number, boolean or null")
+	}
+	return filterValue{literal: n}, nil
+}
@@ -0,0 +1,104 @@
+package uptimeassertion
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testDocument = `{
+	"status": "ok",
+	"version": 2,
+	"store": {
+		"book": [
+			{"category": "reference", "title": "Sayings", "price": 8.95},
+			{"category": "fiction", "title": "Sword", "price": 12.99},
+			{"category": "fiction", "title": "Moby Dick", "isbn": "0-553", "price": 8.99},
+			{"category": "fiction", "title": "The Lord", "isbn": "0-395", "price": 22.99}
+		],
+		"bicycle": {"color": "red", "price": 19.95}
+	},
+	"weird key": true
+}`
+
+func TestJSONPath(t *testing.T) {
+	var doc any
+	if err := json.Unmarshal([]byte(testDocument), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		expr string
+		want []string
+	}{
+		{"$.status", []string{"ok"}},
+		{"$.version", []string{"2"}},
+		{"$['weird key']", []string{"true"}},
+		{`$["status"]`, []string{"ok"}},
+		{"$.missing", nil},
+		{"$.store.book[0].title", []string{"Sayings"}},
+		{"$.store.book[-1].title", []string{"The Lord"}},
+		{"$.store.book[9].title", nil},
+		{"$.store.book[0,2].title", []string{"Sayings", "Moby Dick"}},
+		{"$.store.book[1:3].title", []string{"Sword", "Moby Dick"}},
+		{"$.store.book[:2].title", []string{"Sayings", "Sword"}},
+		{"$.store.book[-2:].title", []string{"Moby Dick", "The Lord"}},
+		{"$.store.book[::-2].title", []string{"The Lord", "Sword"}},
+		{"$.store.book[*].category", []string{"reference", "fiction", "fiction", "fiction"}},
+		{"$.store.bicycle.*", []string{"red", "19.95"}},
+		{"$..isbn", []string{"0-553", "0-395"}},
+		{"$.store..price", []string{"19.95", "8.95", "12.99", "8.99", "22.99"}},
+		{"$.store.book[?(@.isbn)].title", []string{"Moby Dick", "The Lord"}},
+		{"$.store.book[?(!@.isbn)].title", []string{"Sayings", "Sword"}},
+		{"$.store.book[?(@.price < 10)].title", []string{"Sayings", "Moby Dick"}},
+		{"$.store.book[?(@.category == 'fiction' && @.price >= 20)].title", []string{"The Lord"}},
+		{"$.store.book[?(@.price > 20 || @.category == \"reference\")].title", []string{"Sayings", "The Lord"}},
+		{"$.store.book[?(@.price < $.store.bicycle.price)].title", []string{"Sayings", "Sword", "Moby Dick"}},
+		{"$.store.book[?(@.title != 'Sword')].price", []string{"8.95", "8.99", "22.99"}},
+		{"$.store.book[?@.price == 8.95].title", []string{"Sayings"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			path, err := parseJSONPath(tc.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, v := range path.evaluate(doc) {
+				got = append(got, jsonString(v))
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("evaluate() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseJSONPath_invalid(t *testing.T) {
+	testCases := []struct {
+		expr    string
+		wantErr string
+	}{
+		{"", "expected the expression to start with $"},
+		{"status", "expected the expression to start with $"},
+		{"$.", "expected a member name"},
+		{"$.store[", "expected a selector"},
+		{"$.store[0", "unclosed bracket"},
+		{"$['status]", "unterminated string"},
+		{"$.store.book[::0]", "slice step cannot be zero"},
+		{"$.store.book[1:2:3:4]", "too many slice parameters"},
+		{"$.store.book[?(@.price <)]", "expected a path, string, number, boolean or null"},
+		{"$.store.book[?('a')]", "expected a comparison operator"},
+		{"$.status extra", `unexpected "extra"`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			_, err := parseJSONPath(tc.expr)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("error = %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -18,9 +18,10 @@ func dataSourceSentryDashboard() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"organization": {
-				Description: "The slug of the organization the dashboard belongs to.",
+				Description: "The slug of the organization the dashboard belongs to. Defaults to the `default_organization` provider attribute.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"internal_id": {
 				Description: "The internal ID for this dashboard.",
@@ -152,7 +153,10 @@ func dataSourceSentryDashboard() *schema.Resource {
 func dataSourceSentryDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*providerdata.ProviderData).Client
 
-	org := d.Get("organization").(string)
+	org, diags := getWithProviderDefault(d, meta, "organization")
+	if diags.HasError() {
+		return diags
+	}
 	dashboardID := d.Get("internal_id").(string)
 
 	tflog.Debug(ctx, "Reading dashboard", map[string]interface{}{
@@ -177,3 +181,57 @@ func dataSourceSentryDashboardRead(ctx context.Context, d *schema.ResourceData,
 	)
 	return diag.FromErr(err)
 }
+
+func flattenDashboardWidgets(widgets []*sentry.DashboardWidget) []interface{} {
+	if widgets == nil {
+		return []interface{}{}
+	}
+
+	widgetList := make([]interface{}, 0, len(widgets))
+	for _, widget := range widgets {
+		widgetMap := make(map[string]interface{})
+		widgetMap["id"] = widget.ID
+		widgetMap["title"] = widget.Title
+		widgetMap["display_type"] = widget.DisplayType
+		widgetMap["interval"] = widget.Interval
+		widgetMap["query"] = flattenDashboardWidgetQueries(widget.Queries)
+		widgetMap["widget_type"] = widget.WidgetType
+		widgetMap["limit"] = widget.Limit
+		if widget.Layout != nil {
+			widgetMap["layout"] = []interface{}{
+				map[string]interface{}{
+					"x":     widget.Layout.X,
+					"y":     widget.Layout.Y,
+					"w":     widget.Layout.W,
+					"h":     widget.Layout.H,
+					"min_h": widget.Layout.MinH,
+				},
+			}
+		} else {
+			widgetMap["layout"] = []interface{}{}
+		}
+		widgetList = append(widgetList, widgetMap)
+	}
+	return widgetList
+}
+
+func flattenDashboardWidgetQueries(queries []*sentry.DashboardWidgetQuery) []interface{} {
+	if queries == nil {
+		return []interface{}{}
+	}
+
+	queryList := make([]interface{}, 0, len(queries))
+	for _, query := range queries {
+		queryMap := make(map[string]interface{})
+		queryMap["id"] = query.ID
+		queryMap["fields"] = query.Fields
+		queryMap["aggregates"] = flattenStringSet(query.Aggregates)
+		queryMap["columns"] = flattenStringSet(query.Columns)
+		queryMap["field_aliases"] = query.FieldAliases
+		queryMap["name"] = query.Name
+		queryMap["conditions"] = query.Conditions
+		queryMap["order_by"] = query.OrderBy
+		queryList = append(queryList, queryMap)
+	}
+	return queryList
+}
@@ -1,10 +1,14 @@
 package sentry
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jianyuan/go-sentry/v2/sentry"
 	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
 )
 
@@ -14,9 +18,33 @@ func TestAccSentryDashboardDataSource_basic(t *testing.T) {
 	dn := "data.sentry_dashboard.test"
 	rnCopy := "sentry_dashboard.test_copy"
 
-	check := func(name, dashboardTitle string) resource.TestCheckFunc {
-		var dashboardID string
+	var dashboardID string
 
+	resourceCheck := func(name, dashboardTitle string) resource.TestCheckFunc {
+		return resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(name, "organization", acctest.TestOrganization),
+			resource.TestCheckResourceAttr(name, "title", dashboardTitle),
+			resource.TestCheckResourceAttr(name, "widget.#", "1"),
+			resource.TestCheckResourceAttr(name, "widget.0.title", "Custom Widget"),
+			resource.TestCheckResourceAttr(name, "widget.0.display_type", "table"),
+			resource.TestCheckResourceAttr(name, "widget.0.query.#", "1"),
+			resource.TestCheckResourceAttr(name, "widget.0.query.0.name", "Metric"),
+			resource.TestCheckResourceAttr(name, "widget.0.query.0.conditions", "!event.type:transaction has:geo.country_code"),
+			resource.TestCheckResourceAttr(name, "widget.0.query.0.fields.#", "3"),
+			resource.TestCheckResourceAttr(name, "widget.0.query.0.fields.0", "geo.country_code"),
+			resource.TestCheckResourceAttr(name, "widget.0.query.0.fields.1", "geo.region"),
+			resource.TestCheckResourceAttr(name, "widget.0.query.0.fields.2", "count()"),
+			resource.TestCheckResourceAttr(name, "widget.0.query.0.aggregates.#", "1"),
+			resource.TestCheckResourceAttr(name, "widget.0.query.0.aggregates.0", "count()"),
+			resource.TestCheckResourceAttr(name, "widget.0.layout.x", "0"),
+			resource.TestCheckResourceAttr(name, "widget.0.layout.y", "0"),
+			resource.TestCheckResourceAttr(name, "widget.0.layout.w", "2"),
+			resource.TestCheckResourceAttr(name, "widget.0.layout.h", "1"),
+			resource.TestCheckResourceAttr(name, "widget.0.layout.min_h", "1"),
+		)
+	}
+
+	dataSourceCheck := func(name, dashboardTitle string) resource.TestCheckFunc {
 		return resource.ComposeTestCheckFunc(
 			testAccCheckSentryDashboardExists(name, &dashboardID),
 			resource.TestCheckResourceAttr(name, "organization", acctest.TestOrganization),
@@ -56,15 +84,36 @@ func TestAccSentryDashboardDataSource_basic(t *testing.T) {
 			{
 				Config: testAccSentryDashboardDataSourceConfig(dashboardTitle),
 				Check: resource.ComposeTestCheckFunc(
-					check(rn, dashboardTitle),
-					check(dn, dashboardTitle),
-					check(rnCopy, dashboardTitle+"-copy"),
+					resourceCheck(rn, dashboardTitle),
+					dataSourceCheck(dn, dashboardTitle),
+					resourceCheck(rnCopy, dashboardTitle+"-copy"),
 				),
 			},
 		},
 	})
 }
 
+func testAccCheckSentryDashboardExists(n string, dashboardID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("no ID is set")
+		}
+
+		ctx := context.Background()
+		gotDashboard, _, err := acctest.SharedClient.Dashboards.Get(ctx, rs.Primary.Attributes["organization"], rs.Primary.Attributes["internal_id"])
+		if err != nil {
+			return err
+		}
+		*dashboardID = sentry.StringValue(gotDashboard.ID)
+		return nil
+	}
+}
+
 func testAccSentryDashboardDataSourceConfig(dashboardTitle string) string {
 	return testAccSentryOrganizationDataSourceConfig + fmt.Sprintf(`
 resource "sentry_dashboard" "test" {
@@ -76,7 +125,7 @@ resource "sentry_dashboard" "test" {
 		display_type = "table"
 
 		query {
-			name       = "Metric"
+			name = "Metric"
 
 			fields     = ["geo.country_code", "geo.region", "count()"]
 			aggregates = ["count()"]
@@ -109,7 +158,6 @@ resource "sentry_dashboard" "test_copy" {
 			display_type = widget.value.display_type
 			interval     = widget.value.interval
 			widget_type  = widget.value.widget_type
-			limit        = widget.value.limit
 
 			dynamic "query" {
 				for_each = widget.value.query
@@ -20,14 +20,16 @@ func dataSourceSentryMetricAlert() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"organization": {
-				Description: "The slug of the organization the metric alert belongs to.",
+				Description: "The slug of the organization the metric alert belongs to. Defaults to the `default_organization` provider attribute.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"project": {
-				Description: "The slug of the project the metric alert belongs to.",
+				Description: "The slug of the project the metric alert belongs to. Defaults to the `default_project` provider attribute.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"internal_id": {
 				Description: "The internal ID for this metric alert.",
@@ -146,8 +148,14 @@ func dataSourceSentryMetricAlert() *schema.Resource {
 func dataSourceSentryMetricAlertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*providerdata.ProviderData).Client
 
-	org := d.Get("organization").(string)
-	project := d.Get("project").(string)
+	org, diags := getWithProviderDefault(d, meta, "organization")
+	if diags.HasError() {
+		return diags
+	}
+	project, diags := getWithProviderDefault(d, meta, "project")
+	if diags.HasError() {
+		return diags
+	}
 	alertID := d.Get("internal_id").(string)
 
 	tflog.Debug(ctx, "Reading metric alert", map[string]interface{}{"org": org, "project": project, "alertID": alertID})
@@ -2,13 +2,36 @@ package sentry
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jianyuan/go-sentry/v2/sentry"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryquery"
 )
 
+// validateStringFunc adapts a function that checks a string to a schema
+// validation function. Unknown aggregate functions are reported as warnings.
+func validateStringFunc(validate func(string) error) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+		err := validate(v)
+
+		var unknownFunctionErr *sentryquery.UnknownFunctionError
+		if errors.As(err, &unknownFunctionErr) {
+			return []string{fmt.Sprintf("%s uses an %s", k, err)}, nil
+		} else if err != nil {
+			return nil, []error{fmt.Errorf("invalid value for %s: %w", k, err)}
+		}
+		return nil, nil
+	}
+}
+
 func SuppressEquivalentJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
 	var o interface{}
 	if err := json.Unmarshal([]byte(old), &o); err != nil {
@@ -2,9 +2,13 @@ package sentry
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jianyuan/go-sentry/v2/sentry"
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 	"github.com/jianyuan/terraform-provider-sentry/internal/providerdata"
@@ -30,18 +34,232 @@ func NewProvider(version string) func() *schema.Provider {
 					DefaultFunc: schema.MultiEnvDefaultFunc([]string{"SENTRY_AUTH_TOKEN", "SENTRY_TOKEN"}, nil),
 					Sensitive:   true,
 				},
+				"token_file": {
+					Description: "Path to a file containing the authentication token used to connect to Sentry. The file is " +
+						"read again whenever it changes, so that the token can be rotated. Conflicts with `token`, `oauth` " +
+						"and `exec`.",
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"oauth": {
+					Description: "Authenticate with OAuth access tokens, which are refreshed when they expire or are rejected " +
+						"by Sentry. Conflicts with `token`, `token_file` and `exec`. At most one block may be specified.",
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"token_url": {
+								Description: "The URL of the OAuth token endpoint. Defaults to `/oauth/token/` on the host " +
+									"of `base_url`.",
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"client_id": {
+								Description: "The client ID of the OAuth application.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"client_secret": {
+								Description: "The client secret of the OAuth application.",
+								Type:        schema.TypeString,
+								Required:    true,
+								Sensitive:   true,
+							},
+							"refresh_token": {
+								Description: "The refresh token exchanged for access tokens. The client credentials grant is " +
+									"used when not set.",
+								Type:      schema.TypeString,
+								Optional:  true,
+								Sensitive: true,
+							},
+						},
+					},
+				},
+				"exec": {
+					Description: "Authenticate with the token printed by a credential helper command. The command either " +
+						"prints the token, or a JSON object with the `token` and an optional RFC 3339 `expires_at` timestamp. " +
+						"The command is run again when the token expires or is rejected by Sentry. Conflicts with `token`, " +
+						"`token_file` and `oauth`. At most one block may be specified.",
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Description: "The command to run.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"args": {
+								Description: "The arguments of the command.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"env": {
+								Description: "Environment variables added to the environment of the command.",
+								Type:        schema.TypeMap,
+								Optional:    true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
 				"base_url": {
 					Description: "The target Sentry Base API URL in the format `https://[hostname]/api/`. " +
 						"The default value is `https://sentry.io/api/`. The value must be provided when working with " +
-						"Sentry On-Premise. The value can be sourced from the `SENTRY_BASE_URL` environment variable.",
+						"Sentry On-Premise. The value can be sourced from the `SENTRY_BASE_URL` environment variable. On " +
+						"sentry.io, the requests of each organization are sent to the data storage region hosting it, e.g. " +
+						"`https://de.sentry.io`.",
 					Type:        schema.TypeString,
 					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc("SENTRY_BASE_URL", "https://sentry.io/api/"),
 				},
+				"default_organization": {
+					Description: "The organization used by resources and data sources that do not set `organization`. The " +
+						"value can be sourced from the `SENTRY_ORGANIZATION` environment variable.",
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"default_project": {
+					Description: "The project used by resources and data sources that do not set `project`. The value can " +
+						"be sourced from the `SENTRY_PROJECT` environment variable.",
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"ca_cert_file": {
+					Description: "Path to a PEM-encoded CA certificate bundle to trust when connecting to Sentry, in addition " +
+						"to the system certificate pool.",
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"ca_cert_pem": {
+					Description: "PEM-encoded CA certificate bundle to trust when connecting to Sentry, in addition to the " +
+						"system certificate pool.",
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"insecure_skip_verify": {
+					Description: "Skip TLS certificate verification when connecting to Sentry. Only use this for testing.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+				},
+				"proxy_url": {
+					Description: "The URL of the proxy used to connect to Sentry, e.g. `http://proxy.example.com:3128`. " +
+						"Defaults to the proxy configured by the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"client_cert": {
+					Description: "PEM-encoded client certificate presented to Sentry for mutual TLS. Must be set together " +
+						"with `client_key`.",
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"client_key": {
+					Description: "PEM-encoded private key of the client certificate used for mutual TLS. Must be set " +
+						"together with `client_cert`.",
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+				},
+				"timeout": {
+					Description: "The maximum duration of each attempt of a request to Sentry, as a duration string " +
+						"such as `30s` or `2m`. Every retry gets a fresh timeout. Defaults to no timeout.",
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"extra_headers": {
+					Description: "Additional HTTP headers sent with every request to Sentry, e.g. headers required by an " +
+						"authenticating proxy. The `Authorization` and `User-Agent` headers set by the provider take precedence.",
+					Type:      schema.TypeMap,
+					Optional:  true,
+					Sensitive: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"requests_per_second": {
+					Description:  "The maximum number of requests per second sent to Sentry. Defaults to no limit.",
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					ValidateFunc: validation.FloatAtLeast(0),
+				},
+				"max_concurrency": {
+					Description: "The maximum number of concurrent requests sent to Sentry. Defaults to the concurrency limit " +
+						"reported by Sentry.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"retry": {
+					Description: "Controls how requests that failed with a connection error or a retryable status code are " +
+						"retried. At most one block may be specified.",
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_attempts": {
+								Description: "The maximum number of attempts of a request, including the first one. " +
+									"Defaults to `5`.",
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"min_backoff": {
+								Description: "The minimum duration to wait between attempts, as a duration string such as " +
+									"`500ms`. Defaults to `1s`.",
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"max_backoff": {
+								Description: "The maximum duration to wait between attempts, as a duration string such as " +
+									"`1m`. Defaults to `30s`.",
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"jitter": {
+								Description: "Randomize the duration to wait between attempts to spread out concurrent " +
+									"retries. Defaults to `false`.",
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"retryable_status_codes": {
+								Description: "The response status codes that are retried. Defaults to " +
+									"`[429, 500, 502, 503, 504]`.",
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type: schema.TypeInt,
+								},
+							},
+							"max_wait": {
+								Description: "The maximum total duration to wait between the attempts of a request, as a " +
+									"duration string such as `5m`. Defaults to no limit.",
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"skip_health_check": {
+					Description: "Skip the request made to Sentry to verify the base URL and credentials when the provider " +
+						"is configured, e.g. when Sentry is not reachable while running `terraform validate`. Defaults to `false`.",
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"disable_cache": {
+					Description: "Disable the in-memory cache of responses to `GET` requests. The cache only lasts for a " +
+						"single Terraform command and is cleared by every change made by the provider. Defaults to `false`.",
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
 			},
 
 			ResourcesMap: map[string]*schema.Resource{
-				"sentry_dashboard":                 resourceSentryDashboard(),
 				"sentry_metric_alert":              resourceSentryMetricAlert(),
 				"sentry_organization_code_mapping": resourceSentryOrganizationCodeMapping(),
 				"sentry_organization_member":       resourceSentryOrganizationMember(),
@@ -55,25 +273,143 @@ func NewProvider(version string) func() *schema.Provider {
 			},
 		}
 
-		p.ConfigureContextFunc = configure(version, p)
+		p.ConfigureProvider = configureProvider(version, p)
 
 		return p
 	}
 }
 
+// configureProvider defers all resources and data sources while the connection to Sentry depends on values that are
+// not known yet, if Terraform supports it.
+func configureProvider(version string, p *schema.Provider) func(context.Context, schema.ConfigureProviderRequest, *schema.ConfigureProviderResponse) {
+	configure := configure(version, p)
+	return func(ctx context.Context, req schema.ConfigureProviderRequest, resp *schema.ConfigureProviderResponse) {
+		if req.DeferralAllowed && hasUnknownConnectionAttributes(req.ResourceData.GetRawConfig()) {
+			resp.Deferred = &schema.Deferred{
+				Reason: schema.DeferredReasonProviderConfigUnknown,
+			}
+			return
+		}
+
+		resp.Meta, resp.Diagnostics = configure(ctx, req.ResourceData)
+	}
+}
+
+// hasUnknownConnectionAttributes returns whether any of the connection attributes of the provider configuration is not
+// known yet.
+func hasUnknownConnectionAttributes(config cty.Value) bool {
+	if !config.IsKnown() {
+		return true
+	}
+	if config.IsNull() {
+		return false
+	}
+
+	for _, name := range providerdata.ConnectionAttributes {
+		if config.Type().HasAttribute(name) && !config.GetAttr(name).IsWhollyKnown() {
+			return true
+		}
+	}
+	return false
+}
+
 func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		config := sentryclient.Config{
-			UserAgent: p.UserAgent("terraform-provider-sentry", version),
-			Token:     d.Get("token").(string),
+			UserAgent:          p.UserAgent("terraform-provider-sentry", version),
+			Token:              d.Get("token").(string),
+			CACertFile:         d.Get("ca_cert_file").(string),
+			CACertPEM:          d.Get("ca_cert_pem").(string),
+			InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+			ProxyURL:           d.Get("proxy_url").(string),
+			ClientCertPEM:      d.Get("client_cert").(string),
+			ClientKeyPEM:       d.Get("client_key").(string),
+		}
+		if !d.Get("disable_cache").(bool) {
+			// The cache is shared with the framework provider, which clears it
+			// when configured.
+			config.Cache = sentryclient.SharedCache
 		}
 		baseUrl := d.Get("base_url").(string)
+		config.RegionRouting = sentryclient.IsSentryIO(baseUrl)
 
-		httpClient := config.HttpClient(ctx)
+		config.TokenFile = d.Get("token_file").(string)
+
+		// The retry, oauth and exec blocks are limited to a single element by the framework provider schema.
+		if v := d.Get("oauth").([]interface{}); len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			oauth := sentryclient.OAuthConfig{
+				TokenURL:     m["token_url"].(string),
+				ClientID:     m["client_id"].(string),
+				ClientSecret: m["client_secret"].(string),
+				RefreshToken: m["refresh_token"].(string),
+			}
+			if oauth.TokenURL == "" {
+				tokenUrl, err := sentryclient.DefaultOAuthTokenURL(baseUrl)
+				if err != nil {
+					return nil, diag.FromErr(err)
+				}
+				oauth.TokenURL = tokenUrl
+			}
+			config.OAuth = &oauth
+		}
+
+		if v := d.Get("exec").([]interface{}); len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			exec := sentryclient.ExecConfig{
+				Command: m["command"].(string),
+			}
+			for _, arg := range m["args"].([]interface{}) {
+				exec.Args = append(exec.Args, arg.(string))
+			}
+			if env := m["env"].(map[string]interface{}); len(env) > 0 {
+				exec.Env = make(map[string]string, len(env))
+				for key, value := range env {
+					exec.Env[key] = value.(string)
+				}
+			}
+			config.Exec = &exec
+		}
+
+		// The token defaults to the environment variables, which are ignored when another authentication method
+		// is configured.
+		if d.GetRawConfig().GetAttr("token").IsNull() && (config.TokenFile != "" || config.OAuth != nil || config.Exec != nil) {
+			config.Token = ""
+		}
+
+		if v := d.Get("timeout").(string); v != "" {
+			timeout, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, diag.Errorf("invalid timeout: %s", err)
+			}
+			config.Timeout = timeout
+		}
+
+		if v := d.Get("extra_headers").(map[string]interface{}); len(v) > 0 {
+			config.ExtraHeaders = make(map[string]string, len(v))
+			for key, value := range v {
+				config.ExtraHeaders[key] = value.(string)
+			}
+		}
+
+		config.RequestsPerSecond = d.Get("requests_per_second").(float64)
+		config.MaxConcurrency = d.Get("max_concurrency").(int)
+
+		if v := d.Get("retry").([]interface{}); len(v) > 0 && v[0] != nil {
+			retry, err := expandRetryConfig(v[0].(map[string]interface{}))
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			config.Retry = retry
+		}
+
+		httpClient, err := config.HttpClient(ctx)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
 
 		// Old Sentry client
 		var client *sentry.Client
-		var err error
 		if baseUrl == "" {
 			client = sentry.NewClient(httpClient)
 		} else {
@@ -95,6 +431,7 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		providerData := &providerdata.ProviderData{
 			Client:    client,
 			ApiClient: apiClient,
+			Defaults:  providerdata.NewDefaults(d.Get("default_organization").(string), d.Get("default_project").(string)),
 		}
 
 		if err != nil {
@@ -104,3 +441,36 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		return providerData, nil
 	}
 }
+
+func expandRetryConfig(m map[string]interface{}) (*sentryclient.RetryConfig, error) {
+	retry := sentryclient.DefaultRetryConfig()
+
+	if v := m["max_attempts"].(int); v != 0 {
+		retry.MaxAttempts = v
+	}
+
+	for name, dest := range map[string]*time.Duration{
+		"min_backoff": &retry.MinBackoff,
+		"max_backoff": &retry.MaxBackoff,
+		"max_wait":    &retry.MaxWait,
+	} {
+		if v := m[name].(string); v != "" {
+			duration, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid retry %s: %w", name, err)
+			}
+			*dest = duration
+		}
+	}
+
+	retry.Jitter = m["jitter"].(bool)
+
+	if v := m["retryable_status_codes"].([]interface{}); len(v) > 0 {
+		retry.RetryableStatusCodes = make([]int, len(v))
+		for i, code := range v {
+			retry.RetryableStatusCodes[i] = code.(int)
+		}
+	}
+
+	return &retry, nil
+}
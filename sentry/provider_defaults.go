@@ -0,0 +1,67 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jianyuan/terraform-provider-sentry/internal/providerdata"
+)
+
+func providerDefault(meta interface{}, key string) providerdata.Default {
+	defaults := meta.(*providerdata.ProviderData).Defaults
+	if key == "project" {
+		return defaults.Project
+	}
+	return defaults.Organization
+}
+
+// customizeDiffProviderDefaults sets the unconfigured organization and project
+// of new resources to the provider defaults.
+func customizeDiffProviderDefaults(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Existing resources keep their values.
+	if d.Id() != "" || meta == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, key := range []string{"organization", "project"} {
+		config := d.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute(key) || !config.GetAttr(key).IsNull() {
+			continue
+		}
+
+		def := providerDefault(meta, key)
+		if def.Value == "" {
+			errs = append(errs, fmt.Errorf("missing %s: %s", key, def.Missing(key)))
+			continue
+		}
+
+		if err := d.SetNew(key, def.Value); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// getWithProviderDefault returns the organization or project of a data source,
+// falling back to the provider default.
+func getWithProviderDefault(d *schema.ResourceData, meta interface{}, key string) (string, diag.Diagnostics) {
+	if v := d.Get(key).(string); v != "" {
+		return v, nil
+	}
+
+	def := providerDefault(meta, key)
+	if def.Value == "" {
+		return "", diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Missing %s", key),
+			Detail:        def.Missing(key),
+			AttributePath: cty.GetAttrPath(key),
+		}}
+	}
+	return def.Value, nil
+}
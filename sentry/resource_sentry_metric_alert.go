@@ -13,11 +13,14 @@ import (
 	"github.com/jianyuan/go-sentry/v2/sentry"
 	"github.com/jianyuan/terraform-provider-sentry/internal/providerdata"
 	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryquery"
 )
 
 func resourceSentryMetricAlert() *schema.Resource {
 	return &schema.Resource{
-		Description:        "⚠️ This resource is deprecated. Please migrate to [`sentry_metric_monitor`](metric_monitor.md) and [`sentry_alert`](alert.md) resources instead.\n\nSentry Metric Alert resource.",
+		Description: "⚠️ This resource is deprecated. Please migrate to [`sentry_metric_monitor`](metric_monitor.md) and [`sentry_alert`](alert.md) resources instead.\n\n" +
+			"In Terraform v1.8.0 and later, an existing metric alert can be moved without being recreated. Terraform only allows one `moved` block per resource, so add a `moved` block from `sentry_metric_alert` to either `sentry_metric_monitor` or `sentry_alert`, and an `import` block for the other. The provider looks up the monitor and alert Sentry migrated the metric alert to. Each trigger becomes a monitor condition raising an issue of high (`critical`) or medium (`warning`) priority, and an alert action filter running the trigger's actions for issues of that priority. Metric alerts using the `above_and_below` threshold type fail with an error.\n\n" +
+			"Sentry Metric Alert resource.",
 		DeprecationMessage: "This resource is deprecated. Please migrate to `sentry_metric_monitor` and `sentry_alert` resources instead.",
 
 		CreateContext: resourceSentryMetricAlertCreate,
@@ -29,6 +32,8 @@ func resourceSentryMetricAlert() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeDiffProviderDefaults,
+
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description: "The ID of the metric alert.",
@@ -36,14 +41,16 @@ func resourceSentryMetricAlert() *schema.Resource {
 				Computed:    true,
 			},
 			"organization": {
-				Description: "The slug of the organization the metric alert belongs to.",
+				Description: "The slug of the organization the metric alert belongs to. Defaults to the `default_organization` provider attribute.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"project": {
-				Description: "The slug of the project to create the metric alert for.",
+				Description: "The slug of the project to create the metric alert for. Defaults to the `default_project` provider attribute.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": {
 				Description: "The metric alert name.",
@@ -69,14 +76,16 @@ func resourceSentryMetricAlert() *schema.Resource {
 				},
 			},
 			"query": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The query filter to apply",
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The query filter to apply",
+				ValidateFunc: validateStringFunc(sentryquery.ValidateSearchQuery),
 			},
 			"aggregate": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The aggregation criteria to apply",
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The aggregation criteria to apply",
+				ValidateFunc: validateStringFunc(sentryquery.ValidateAlertAggregate),
 			},
 			"time_window": {
 				Type:        schema.TypeFloat,
@@ -88,11 +88,14 @@ func resourceSentryOrganizationCodeMapping() *schema.Resource {
 			StateContext: importSentryOrganizationCodeMapping,
 		},
 
+		CustomizeDiff: customizeDiffProviderDefaults,
+
 		Schema: map[string]*schema.Schema{
 			"organization": {
-				Description: "The slug of the organization the code mapping is under.",
+				Description: "The slug of the organization the code mapping is under. Defaults to the `default_organization` provider attribute.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"integration_id": {
 				Description: "Sentry Organization Integration ID.",
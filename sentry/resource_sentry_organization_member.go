@@ -28,11 +28,14 @@ func resourceSentryOrganizationMember() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeDiffProviderDefaults,
+
 		Schema: map[string]*schema.Schema{
 			"organization": {
-				Description: "The slug of the organization the user should be invited to.",
+				Description: "The slug of the organization the user should be invited to. Defaults to the `default_organization` provider attribute.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"email": {
 				Description: "The email of the organization member.",
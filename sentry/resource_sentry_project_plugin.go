@@ -21,16 +21,20 @@ func resourceSentryPlugin() *schema.Resource {
 			StateContext: importOrganizationProjectAndID,
 		},
 
+		CustomizeDiff: customizeDiffProviderDefaults,
+
 		Schema: map[string]*schema.Schema{
 			"organization": {
-				Description: "The slug of the organization the project belongs to.",
+				Description: "The slug of the organization the project belongs to. Defaults to the `default_organization` provider attribute.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"project": {
-				Description: "The slug of the project to create the plugin for.",
+				Description: "The slug of the project to create the plugin for. Defaults to the `default_project` provider attribute.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"plugin": {
 				Description: "Plugin ID.",
@@ -24,11 +24,14 @@ func resourceSentryTeam() *schema.Resource {
 			StateContext: importOrganizationAndID,
 		},
 
+		CustomizeDiff: customizeDiffProviderDefaults,
+
 		Schema: map[string]*schema.Schema{
 			"organization": {
-				Description: "The slug of the organization the team should be created for.",
+				Description: "The slug of the organization the team should be created for. Defaults to the `default_organization` provider attribute.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": {
 				Description: "The name of the team.",